@@ -0,0 +1,62 @@
+package balance
+
+import (
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/jobs"
+	"github.com/centrifuge/pod/notification"
+)
+
+const (
+	BootstrappedBalanceService = "BootstrappedBalanceService"
+)
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+type Bootstrapper struct{}
+
+// Bootstrap initialises the balance service and replaces the job dispatcher with one
+// that checks the pod operator balance before dispatching.
+func (*Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	cfg, err := config.RetrieveConfig(false, ctx)
+	if err != nil {
+		return err
+	}
+
+	centAPI, ok := ctx[centchain.BootstrappedCentChainClient].(centchain.API)
+
+	if !ok {
+		return errors.New("centchain API not initialised")
+	}
+
+	cfgService, ok := ctx[config.BootstrappedConfigStorage].(config.Service)
+
+	if !ok {
+		return errors.New("config service not initialised")
+	}
+
+	jobDispatcher, ok := ctx[jobs.BootstrappedJobDispatcher].(jobs.Dispatcher)
+
+	if !ok {
+		return errors.New("jobs dispatcher not initialised")
+	}
+
+	podOperator, err := cfgService.GetPodOperator()
+
+	if err != nil {
+		return errors.ErrPodOperatorRetrieval
+	}
+
+	balanceSrv := NewService(
+		centAPI,
+		cfgService,
+		notification.NewWebhookSender(),
+		podOperator,
+		cfg.GetCentChainLowBalanceThreshold(),
+	)
+
+	ctx[BootstrappedBalanceService] = balanceSrv
+	ctx[jobs.BootstrappedJobDispatcher] = NewDispatcher(jobDispatcher, balanceSrv)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package balance
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/gocelery/v2"
+	"github.com/centrifuge/pod/jobs"
+)
+
+// dispatcher is a jobs.Dispatcher that checks the pod operator balance before dispatching a job.
+type dispatcher struct {
+	jobs.Dispatcher
+
+	balanceSrv Service
+}
+
+// NewDispatcher returns a jobs.Dispatcher that rejects the jobs that the pod operator cannot pay for.
+func NewDispatcher(jobDispatcher jobs.Dispatcher, balanceSrv Service) jobs.Dispatcher {
+	return &dispatcher{
+		Dispatcher: jobDispatcher,
+		balanceSrv: balanceSrv,
+	}
+}
+
+func (d *dispatcher) Dispatch(accountID *types.AccountID, job *gocelery.Job) (jobs.Result, error) {
+	cost, err := d.balanceSrv.CheckBalance(accountID, job.Runner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.Dispatcher.Dispatch(accountID, job)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cost != nil {
+		d.balanceSrv.TrackJob(job.ID, cost, res)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,113 @@
+//go:build unit
+
+package balance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/centrifuge/gocelery/v2"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/jobs"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	balanceSrvMock := NewServiceMock(t)
+	resultMock := jobs.NewResultMock(t)
+
+	dispatcher := NewDispatcher(dispatcherMock, balanceSrvMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	job := &gocelery.Job{
+		ID:     utils.RandomSlice(32),
+		Runner: testRunner,
+	}
+
+	cost := big.NewInt(10)
+
+	balanceSrvMock.On("CheckBalance", accountID, testRunner).
+		Return(cost, nil).
+		Once()
+
+	dispatcherMock.On("Dispatch", accountID, job).
+		Return(resultMock, nil).
+		Once()
+
+	balanceSrvMock.On("TrackJob", job.ID, cost, resultMock).
+		Once()
+
+	res, err := dispatcher.Dispatch(accountID, job)
+	assert.NoError(t, err)
+	assert.Equal(t, resultMock, res)
+
+	// No cost, the job is not tracked.
+	balanceSrvMock.On("CheckBalance", accountID, testRunner).
+		Return(nil, nil).
+		Once()
+
+	dispatcherMock.On("Dispatch", accountID, job).
+		Return(resultMock, nil).
+		Once()
+
+	res, err = dispatcher.Dispatch(accountID, job)
+	assert.NoError(t, err)
+	assert.Equal(t, resultMock, res)
+}
+
+func TestDispatcher_Dispatch_InsufficientBalance(t *testing.T) {
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	balanceSrvMock := NewServiceMock(t)
+
+	dispatcher := NewDispatcher(dispatcherMock, balanceSrvMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	job := &gocelery.Job{
+		ID:     utils.RandomSlice(32),
+		Runner: testRunner,
+	}
+
+	balanceSrvMock.On("CheckBalance", accountID, testRunner).
+		Return(nil, ErrInsufficientBalance).
+		Once()
+
+	res, err := dispatcher.Dispatch(accountID, job)
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+	assert.Nil(t, res)
+}
+
+func TestDispatcher_Dispatch_DispatchError(t *testing.T) {
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	balanceSrvMock := NewServiceMock(t)
+
+	dispatcher := NewDispatcher(dispatcherMock, balanceSrvMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	job := &gocelery.Job{
+		ID:     utils.RandomSlice(32),
+		Runner: testRunner,
+	}
+
+	balanceSrvMock.On("CheckBalance", accountID, testRunner).
+		Return(big.NewInt(10), nil).
+		Once()
+
+	dispatchErr := errors.New("error")
+
+	dispatcherMock.On("Dispatch", accountID, job).
+		Return(nil, dispatchErr).
+		Once()
+
+	res, err := dispatcher.Dispatch(accountID, job)
+	assert.ErrorIs(t, err, dispatchErr)
+	assert.Nil(t, res)
+}
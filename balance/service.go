@@ -0,0 +1,339 @@
+package balance
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	proxyType "github.com/centrifuge/chain-custom-types/pkg/proxy"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/gocelery/v2"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/jobs"
+	"github.com/centrifuge/pod/notification"
+	"github.com/centrifuge/pod/pallets/proxy"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	logging "github.com/ipfs/go-log"
+)
+
+var log = logging.Logger("balance")
+
+const (
+	ErrInsufficientBalance = errors.Error("insufficient pod operator balance")
+	ErrFeeEstimation       = errors.Error("couldn't estimate job fee")
+	ErrBalanceRetrieval    = errors.Error("couldn't retrieve pod operator balance")
+)
+
+const (
+	// costEstimateTTL is the duration for which the cost estimated for a job runner is reused.
+	costEstimateTTL = 10 * time.Minute
+
+	// lowBalanceNotificationInterval is the minimum duration between two low balance notifications sent to an account.
+	lowBalanceNotificationInterval = time.Hour
+)
+
+// JobCallProviderFn returns the call provider for the call that is proxied by a job on behalf of the account.
+//
+// The arguments of the call are only used for estimating the fee, so placeholder values can be used
+// as long as they have the same encoded size as the real ones.
+type JobCallProviderFn func(accountID *types.AccountID) centchain.CallProviderFn
+
+// OperatorBalance holds the balance of the pod operator and the estimated cost of the pending jobs.
+type OperatorBalance struct {
+	Operator             *types.AccountID
+	FreeBalance          *big.Int
+	PendingJobs          int
+	EstimatedPendingCost *big.Int
+	LowBalanceThreshold  *big.Int
+}
+
+//go:generate mockery --name Service --structname ServiceMock --filename service_mock.go --inpackage
+
+// Service estimates the fees of the jobs that submit extrinsics and checks that the pod operator
+// can pay for them before they are dispatched.
+type Service interface {
+	// RegisterJobCall registers the call that is submitted by the jobs of the runner.
+	RegisterJobCall(runner string, callProviderFn JobCallProviderFn)
+
+	// CheckBalance checks that the pod operator can pay for a job of the runner, on top of the pending jobs.
+	// The estimated cost is returned, or nil if no call is registered for the runner.
+	CheckBalance(accountID *types.AccountID, runner string) (*big.Int, error)
+
+	// TrackJob adds the cost of the job to the pending cost until the job result is available.
+	TrackJob(jobID gocelery.JobID, cost *big.Int, result jobs.Result)
+
+	// GetOperatorBalance returns the pod operator balance and the estimated cost of the pending jobs.
+	GetOperatorBalance() (*OperatorBalance, error)
+}
+
+type jobCall struct {
+	callProviderFn JobCallProviderFn
+}
+
+type costEstimate struct {
+	cost      *big.Int
+	expiresAt time.Time
+}
+
+type service struct {
+	centAPI             centchain.API
+	cfgService          config.Service
+	sender              notification.Sender
+	podOperator         config.PodOperator
+	lowBalanceThreshold *big.Int
+
+	mu               sync.Mutex
+	jobCalls         map[string]jobCall
+	costEstimates    map[string]costEstimate
+	pendingJobs      int
+	pendingCost      *big.Int
+	lastNotification map[types.AccountID]time.Time
+}
+
+// NewService returns a new balance Service.
+func NewService(
+	centAPI centchain.API,
+	cfgService config.Service,
+	sender notification.Sender,
+	podOperator config.PodOperator,
+	lowBalanceThreshold *big.Int,
+) Service {
+	return &service{
+		centAPI:             centAPI,
+		cfgService:          cfgService,
+		sender:              sender,
+		podOperator:         podOperator,
+		lowBalanceThreshold: lowBalanceThreshold,
+		jobCalls:            make(map[string]jobCall),
+		costEstimates:       make(map[string]costEstimate),
+		pendingCost:         big.NewInt(0),
+		lastNotification:    make(map[types.AccountID]time.Time),
+	}
+}
+
+func (s *service) RegisterJobCall(runner string, callProviderFn JobCallProviderFn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobCalls[runner] = jobCall{
+		callProviderFn: callProviderFn,
+	}
+}
+
+// CheckBalance estimates the cost of the job and compares it against the free balance of the pod operator minus
+// the estimated cost of the pending jobs. The cost only includes the transaction fee since the deposits and the
+// fees charged by the proxied call are reserved from the account of the identity. Errors that occur while estimating are
+// logged and do not prevent the job from being dispatched, the job will fail on submission if the balance is not
+// sufficient.
+func (s *service) CheckBalance(accountID *types.AccountID, runner string) (*big.Int, error) {
+	s.mu.Lock()
+	call, ok := s.jobCalls[runner]
+	s.mu.Unlock()
+
+	if !ok {
+		return nil, nil
+	}
+
+	cost, err := s.estimateCost(accountID, runner, call)
+
+	if err != nil {
+		log.Warnf("Couldn't estimate cost for job '%s': %s", runner, err)
+
+		return nil, nil
+	}
+
+	operatorBalance, err := s.GetOperatorBalance()
+
+	if err != nil {
+		log.Warnf("Couldn't retrieve pod operator balance: %s", err)
+
+		return cost, nil
+	}
+
+	remaining := new(big.Int).Sub(operatorBalance.FreeBalance, operatorBalance.EstimatedPendingCost)
+	remaining.Sub(remaining, cost)
+
+	if remaining.Cmp(s.lowBalanceThreshold) < 0 {
+		go s.notifyLowBalance(accountID, operatorBalance)
+	}
+
+	if remaining.Sign() < 0 {
+		log.Errorf(
+			"Insufficient pod operator balance for job '%s', free balance - %s, pending cost - %s, job cost - %s",
+			runner,
+			operatorBalance.FreeBalance,
+			operatorBalance.EstimatedPendingCost,
+			cost,
+		)
+
+		return nil, ErrInsufficientBalance
+	}
+
+	return cost, nil
+}
+
+// TrackJob adds the cost of the job to the pending cost and removes it once the job result is available.
+func (s *service) TrackJob(jobID gocelery.JobID, cost *big.Int, result jobs.Result) {
+	s.mu.Lock()
+	s.pendingJobs++
+	s.pendingCost.Add(s.pendingCost, cost)
+	s.mu.Unlock()
+
+	go func() {
+		if _, err := result.Await(context.Background()); err != nil {
+			log.Debugf("Job %s finished with error: %s", hexutil.Encode(jobID), err)
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.pendingJobs--
+		s.pendingCost.Sub(s.pendingCost, cost)
+	}()
+}
+
+func (s *service) GetOperatorBalance() (*OperatorBalance, error) {
+	meta, err := s.centAPI.GetMetadataLatest()
+
+	if err != nil {
+		log.Errorf("Couldn't retrieve latest metadata: %s", err)
+
+		return nil, errors.ErrMetadataRetrieval
+	}
+
+	freeBalance, err := s.centAPI.GetFreeBalance(meta, s.podOperator.GetAccountID())
+
+	if err != nil {
+		log.Errorf("Couldn't retrieve free balance: %s", err)
+
+		return nil, ErrBalanceRetrieval
+	}
+
+	pendingJobs, pendingCost := s.getPendingCost()
+
+	return &OperatorBalance{
+		Operator:             s.podOperator.GetAccountID(),
+		FreeBalance:          freeBalance.Int,
+		PendingJobs:          pendingJobs,
+		EstimatedPendingCost: pendingCost,
+		LowBalanceThreshold:  s.lowBalanceThreshold,
+	}, nil
+}
+
+// getPendingCost returns the number and the estimated cost of the pending jobs.
+func (s *service) getPendingCost() (int, *big.Int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pendingJobs, new(big.Int).Set(s.pendingCost)
+}
+
+// estimateCost estimates the fee of the proxied call of the runner, the estimate is reused
+// for all the jobs of the runner until it expires.
+func (s *service) estimateCost(accountID *types.AccountID, runner string, call jobCall) (*big.Int, error) {
+	s.mu.Lock()
+	estimate, ok := s.costEstimates[runner]
+	s.mu.Unlock()
+
+	if ok && time.Now().Before(estimate.expiresAt) {
+		return estimate.cost, nil
+	}
+
+	fee, err := s.estimateFee(accountID, call.callProviderFn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.costEstimates[runner] = costEstimate{
+		cost:      fee,
+		expiresAt: time.Now().Add(costEstimateTTL),
+	}
+	s.mu.Unlock()
+
+	return fee, nil
+}
+
+// estimateFee estimates the fee of the proxied call.
+func (s *service) estimateFee(accountID *types.AccountID, callProviderFn JobCallProviderFn) (*big.Int, error) {
+	meta, err := s.centAPI.GetMetadataLatest()
+
+	if err != nil {
+		return nil, errors.NewTypedError(ErrFeeEstimation, err)
+	}
+
+	proxiedCall, err := callProviderFn(accountID)(meta)
+
+	if err != nil {
+		return nil, errors.NewTypedError(ErrFeeEstimation, err)
+	}
+
+	delegatorMultiAddress, err := types.NewMultiAddressFromAccountID(accountID.ToBytes())
+
+	if err != nil {
+		return nil, errors.NewTypedError(ErrFeeEstimation, err)
+	}
+
+	call, err := types.NewCall(
+		meta,
+		proxy.ProxyCall,
+		delegatorMultiAddress,
+		types.NewOption(proxyType.PodOperation),
+		*proxiedCall,
+	)
+
+	if err != nil {
+		return nil, errors.NewTypedError(ErrFeeEstimation, err)
+	}
+
+	feeInfo, err := s.centAPI.EstimateFee(meta, call, s.podOperator.ToKeyringPair())
+
+	if err != nil {
+		return nil, errors.NewTypedError(ErrFeeEstimation, err)
+	}
+
+	return feeInfo.PartialFee.Int, nil
+}
+
+// notifyLowBalance sends a low balance notification to the webhook of the account, at most once per interval.
+func (s *service) notifyLowBalance(accountID *types.AccountID, operatorBalance *OperatorBalance) {
+	s.mu.Lock()
+
+	if lastNotification, ok := s.lastNotification[*accountID]; ok &&
+		time.Since(lastNotification) < lowBalanceNotificationInterval {
+		s.mu.Unlock()
+
+		return
+	}
+
+	s.lastNotification[*accountID] = time.Now()
+	s.mu.Unlock()
+
+	acc, err := s.cfgService.GetAccount(accountID.ToBytes())
+
+	if err != nil {
+		log.Errorf("Couldn't retrieve account: %s", err)
+
+		return
+	}
+
+	msg := notification.Message{
+		EventType:  notification.EventTypeBalance,
+		RecordedAt: time.Now().UTC(),
+		Balance: &notification.BalanceMessage{
+			Operator:             operatorBalance.Operator.ToBytes(),
+			FreeBalance:          operatorBalance.FreeBalance.String(),
+			EstimatedPendingCost: operatorBalance.EstimatedPendingCost.String(),
+			Threshold:            operatorBalance.LowBalanceThreshold.String(),
+		},
+	}
+
+	if err := s.sender.Send(contextutil.WithAccount(context.Background(), acc), msg); err != nil {
+		log.Errorf("Couldn't send low balance notification: %s", err)
+	}
+}
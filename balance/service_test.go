@@ -0,0 +1,275 @@
+//go:build unit
+
+package balance
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/gocelery/v2"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/jobs"
+	"github.com/centrifuge/pod/notification"
+	"github.com/centrifuge/pod/pallets/anchors"
+	"github.com/centrifuge/pod/testingutils"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/testingutils/keyrings"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testRunner = "test_runner"
+)
+
+type testMocks struct {
+	centAPIMock     *centchain.APIMock
+	cfgServiceMock  *config.ServiceMock
+	senderMock      *notification.SenderMock
+	podOperatorMock *config.PodOperatorMock
+}
+
+func getTestService(t *testing.T, lowBalanceThreshold int64) (*service, *testMocks) {
+	mocks := &testMocks{
+		centAPIMock:     centchain.NewAPIMock(t),
+		cfgServiceMock:  config.NewServiceMock(t),
+		senderMock:      notification.NewSenderMock(t),
+		podOperatorMock: config.NewPodOperatorMock(t),
+	}
+
+	srv := NewService(
+		mocks.centAPIMock,
+		mocks.cfgServiceMock,
+		mocks.senderMock,
+		mocks.podOperatorMock,
+		big.NewInt(lowBalanceThreshold),
+	)
+
+	return srv.(*service), mocks
+}
+
+func testJobCallProviderFn(_ *types.AccountID) centchain.CallProviderFn {
+	return anchors.GetCommitCallProviderFn(anchors.AnchorID{}, anchors.DocumentRoot{}, [32]byte{}, time.Now())
+}
+
+// getTestResult returns a job result that is available once the returned function is called.
+func getTestResult(t *testing.T) (*jobs.ResultMock, func()) {
+	resultMock := jobs.NewResultMock(t)
+
+	done := make(chan struct{})
+
+	resultMock.On("Await", mock.Anything).
+		Run(func(_ mock.Arguments) {
+			<-done
+		}).
+		Return(nil, nil).
+		Once()
+
+	return resultMock, func() {
+		close(done)
+	}
+}
+
+// waitPendingJobs waits until the number of pending jobs is the expected one.
+func waitPendingJobs(t *testing.T, srv *service, pendingJobs int) {
+	assert.Eventually(t, func() bool {
+		res, _ := srv.getPendingCost()
+
+		return res == pendingJobs
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestService_CheckBalance(t *testing.T) {
+	srv, mocks := getTestService(t, 100)
+
+	srv.RegisterJobCall(testRunner, testJobCallProviderFn)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	podOperatorAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	meta, err := testingutils.GetTestMetadata()
+	assert.NoError(t, err)
+
+	mocks.centAPIMock.On("GetMetadataLatest").
+		Return(meta, nil)
+
+	mocks.podOperatorMock.On("ToKeyringPair").
+		Return(keyrings.AliceKeyRingPair).
+		Once()
+
+	mocks.podOperatorMock.On("GetAccountID").
+		Return(podOperatorAccountID)
+
+	mocks.centAPIMock.On("EstimateFee", meta, mock.IsType(types.Call{}), keyrings.AliceKeyRingPair).
+		Return(&centchain.FeeInfo{PartialFee: types.NewU128(*big.NewInt(10))}, nil).
+		Once()
+
+	mocks.centAPIMock.On("GetFreeBalance", meta, podOperatorAccountID).
+		Return(types.NewU128(*big.NewInt(1000)), nil).
+		Once()
+
+	cost, err := srv.CheckBalance(accountID, testRunner)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(10), cost)
+
+	// Pending jobs are taken into account, the cost estimate is reused.
+	jobID := gocelery.JobID(utils.RandomSlice(32))
+
+	result, completeJob := getTestResult(t)
+
+	srv.TrackJob(jobID, big.NewInt(900), result)
+
+	mocks.centAPIMock.On("GetFreeBalance", meta, podOperatorAccountID).
+		Return(types.NewU128(*big.NewInt(1000)), nil).
+		Once()
+
+	notificationSent := make(chan notification.Message, 1)
+
+	accountMock := config.NewAccountMock(t)
+
+	mocks.cfgServiceMock.On("GetAccount", accountID.ToBytes()).
+		Return(accountMock, nil).
+		Once()
+
+	mocks.senderMock.On("Send", mock.Anything, mock.IsType(notification.Message{})).
+		Run(func(args mock.Arguments) {
+			notificationSent <- args.Get(1).(notification.Message)
+		}).
+		Return(nil).
+		Once()
+
+	cost, err = srv.CheckBalance(accountID, testRunner)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(10), cost)
+
+	select {
+	case msg := <-notificationSent:
+		assert.Equal(t, notification.EventTypeBalance, msg.EventType)
+		assert.Equal(t, "1000", msg.Balance.FreeBalance)
+		assert.Equal(t, "900", msg.Balance.EstimatedPendingCost)
+		assert.Equal(t, "100", msg.Balance.Threshold)
+	case <-time.After(5 * time.Second):
+		t.Fatal("low balance notification was not sent")
+	}
+
+	// Insufficient balance, the notification is not sent again.
+	mocks.centAPIMock.On("GetFreeBalance", meta, podOperatorAccountID).
+		Return(types.NewU128(*big.NewInt(905)), nil).
+		Once()
+
+	cost, err = srv.CheckBalance(accountID, testRunner)
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+	assert.Nil(t, cost)
+
+	// Completed jobs are not pending anymore.
+	completeJob()
+
+	waitPendingJobs(t, srv, 0)
+
+	mocks.centAPIMock.On("GetFreeBalance", meta, podOperatorAccountID).
+		Return(types.NewU128(*big.NewInt(905)), nil).
+		Once()
+
+	cost, err = srv.CheckBalance(accountID, testRunner)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(10), cost)
+}
+
+func TestService_CheckBalance_UnregisteredRunner(t *testing.T) {
+	srv, _ := getTestService(t, 100)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	cost, err := srv.CheckBalance(accountID, testRunner)
+	assert.NoError(t, err)
+	assert.Nil(t, cost)
+}
+
+func TestService_CheckBalance_EstimationError(t *testing.T) {
+	srv, mocks := getTestService(t, 100)
+
+	srv.RegisterJobCall(testRunner, testJobCallProviderFn)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	meta, err := testingutils.GetTestMetadata()
+	assert.NoError(t, err)
+
+	mocks.centAPIMock.On("GetMetadataLatest").
+		Return(meta, nil).
+		Once()
+
+	mocks.podOperatorMock.On("ToKeyringPair").
+		Return(keyrings.AliceKeyRingPair).
+		Once()
+
+	mocks.centAPIMock.On("EstimateFee", meta, mock.IsType(types.Call{}), keyrings.AliceKeyRingPair).
+		Return(nil, errors.New("error")).
+		Once()
+
+	// Estimation errors do not prevent the job from being dispatched.
+	cost, err := srv.CheckBalance(accountID, testRunner)
+	assert.NoError(t, err)
+	assert.Nil(t, cost)
+}
+
+func TestService_GetOperatorBalance(t *testing.T) {
+	srv, mocks := getTestService(t, 100)
+
+	podOperatorAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	meta, err := testingutils.GetTestMetadata()
+	assert.NoError(t, err)
+
+	mocks.centAPIMock.On("GetMetadataLatest").
+		Return(meta, nil)
+
+	mocks.podOperatorMock.On("GetAccountID").
+		Return(podOperatorAccountID)
+
+	// Balance retrieval error
+	mocks.centAPIMock.On("GetFreeBalance", meta, podOperatorAccountID).
+		Return(types.U128{}, errors.New("error")).
+		Once()
+
+	res, err := srv.GetOperatorBalance()
+	assert.ErrorIs(t, err, ErrBalanceRetrieval)
+	assert.Nil(t, res)
+
+	result1, completeJob1 := getTestResult(t)
+	result2, completeJob2 := getTestResult(t)
+
+	srv.TrackJob(gocelery.JobID(utils.RandomSlice(32)), big.NewInt(10), result1)
+	srv.TrackJob(gocelery.JobID(utils.RandomSlice(32)), big.NewInt(20), result2)
+
+	completeJob2()
+
+	waitPendingJobs(t, srv, 1)
+
+	mocks.centAPIMock.On("GetFreeBalance", meta, podOperatorAccountID).
+		Return(types.NewU128(*big.NewInt(1000)), nil).
+		Once()
+
+	res, err = srv.GetOperatorBalance()
+	assert.NoError(t, err)
+	assert.Equal(t, podOperatorAccountID, res.Operator)
+	assert.Equal(t, big.NewInt(1000), res.FreeBalance)
+	assert.Equal(t, 1, res.PendingJobs)
+	assert.Equal(t, big.NewInt(10), res.EstimatedPendingCost)
+	assert.Equal(t, big.NewInt(100), res.LowBalanceThreshold)
+
+	completeJob1()
+
+	waitPendingJobs(t, srv, 0)
+}
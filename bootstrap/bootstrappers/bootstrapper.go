@@ -1,15 +1,18 @@
 package bootstrappers
 
 import (
+	"github.com/centrifuge/pod/balance"
 	"github.com/centrifuge/pod/bootstrap"
 	"github.com/centrifuge/pod/centchain"
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/config/configstore"
 	"github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/documents/entity"
 	"github.com/centrifuge/pod/documents/entityrelationship"
 	"github.com/centrifuge/pod/documents/generic"
+	"github.com/centrifuge/pod/documents/invoice"
 	"github.com/centrifuge/pod/http"
 	httpv2 "github.com/centrifuge/pod/http/v2"
 	httpv3 "github.com/centrifuge/pod/http/v3"
@@ -43,14 +46,17 @@ func (m *MainBootstrapper) PopulateBaseBootstrappers() {
 		&jobs.Bootstrapper{},
 		centchain.Bootstrapper{},
 		&pallets.Bootstrapper{},
+		&balance.Bootstrapper{},
 		&dispatcher.Bootstrapper{},
 		&identityv2.Bootstrapper{},
 		documents.Bootstrapper{},
 		&http.Bootstrapper{},
 		&entityrelationship.Bootstrapper{},
 		generic.Bootstrapper{},
+		invoice.Bootstrapper{},
 		pending.Bootstrapper{},
 		&ipfs.Bootstrapper{},
+		attachments.Bootstrapper{},
 		&nftv3.Bootstrapper{},
 		&p2p.Bootstrapper{},
 		documents.PostBootstrapper{},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/gob"
 	"fmt"
+	"math/big"
 	"strings"
 	"sync"
 	"time"
@@ -38,6 +39,10 @@ const (
 	ErrExtrinsicSubmission = errors.Error("couldn't submit extrinsic")
 
 	ErrMultisigNotSupported = errors.Error("multi signature not supported")
+
+	ErrFeeEstimation = errors.Error("couldn't estimate extrinsic fee")
+
+	ErrAccountInfoNotFound = errors.Error("account information not found on chain")
 )
 
 func init() {
@@ -48,6 +53,12 @@ var log = logging.Logger("centchain-client")
 
 type CallProviderFn func(metadata *types.Metadata) (*types.Call, error)
 
+// FeeInfo holds the fee details of an extrinsic, as returned by payment_queryInfo.
+type FeeInfo struct {
+	Class      string
+	PartialFee types.U128
+}
+
 // ExtrinsicInfo holds details of a successful extrinsic
 type ExtrinsicInfo struct {
 	Hash      types.Hash
@@ -88,6 +99,12 @@ type API interface {
 
 	// GetPendingExtrinsics returns all pending extrinsics
 	GetPendingExtrinsics() ([]types.Extrinsic, error)
+
+	// EstimateFee returns the fee that would be paid by the KeyRingPair for submitting an extrinsic with the given call.
+	EstimateFee(meta *types.Metadata, c types.Call, krp signature.KeyringPair) (*FeeInfo, error)
+
+	// GetFreeBalance returns the free balance of the given account.
+	GetFreeBalance(meta *types.Metadata, accountID *types.AccountID) (types.U128, error)
 }
 
 //go:generate mockery --name substrateAPI --structname SubstrateAPIMock --filename substrate_api_mock.go --inpackage
@@ -104,6 +121,7 @@ type substrateAPI interface {
 	GetStorage(key types.StorageKey, target interface{}, blockHash types.Hash) error
 	GetBlock(blockHash types.Hash) (*types.SignedBlock, error)
 	GetPendingExtrinsics() ([]types.Extrinsic, error)
+	SubscribeFinalizedHeads() (HeadsSubscription, error)
 }
 
 type defaultSubstrateAPI struct {
@@ -155,6 +173,10 @@ func (dsa *defaultSubstrateAPI) GetPendingExtrinsics() ([]types.Extrinsic, error
 	return dsa.sapi.RPC.Author.PendingExtrinsics()
 }
 
+func (dsa *defaultSubstrateAPI) SubscribeFinalizedHeads() (HeadsSubscription, error) {
+	return dsa.sapi.RPC.Chain.SubscribeFinalizedHeads()
+}
+
 type api struct {
 	sapi           substrateAPI
 	dispatcher     jobs.Dispatcher
@@ -207,17 +229,33 @@ func (a *api) submitExtrinsic(
 	sig types.MultiSignature,
 	err error,
 ) {
+	ext, err := a.signExtrinsic(c, nonce, krp)
+	if err != nil {
+		return txHash, bn, sig, err
+	}
+
+	startBlock, err := a.sapi.GetBlockLatest()
+	if err != nil {
+		return txHash, bn, sig, err
+	}
+
+	startBlockNumber := startBlock.Block.Header.Number
+	txHash, err = a.sapi.SubmitExtrinsic(ext)
+	return txHash, startBlockNumber, ext.Signature.Signature, err
+}
+
+func (a *api) signExtrinsic(c types.Call, nonce uint64, krp signature.KeyringPair) (types.Extrinsic, error) {
 	ext := types.NewExtrinsic(c)
 	era := types.ExtrinsicEra{IsMortalEra: false}
 
 	genesisHash, err := a.sapi.GetBlockHash(0)
 	if err != nil {
-		return txHash, bn, sig, err
+		return ext, err
 	}
 
 	rv, err := a.sapi.GetRuntimeVersionLatest()
 	if err != nil {
-		return txHash, bn, sig, err
+		return ext, err
 	}
 
 	o := types.SignatureOptions{
@@ -231,18 +269,8 @@ func (a *api) submitExtrinsic(
 	}
 
 	err = ext.Sign(krp, o)
-	if err != nil {
-		return txHash, bn, sig, err
-	}
 
-	startBlock, err := a.sapi.GetBlockLatest()
-	if err != nil {
-		return txHash, bn, sig, err
-	}
-
-	startBlockNumber := startBlock.Block.Header.Number
-	txHash, err = a.sapi.SubmitExtrinsic(ext)
-	return txHash, startBlockNumber, ext.Signature.Signature, err
+	return ext, err
 }
 
 func (a *api) SubmitExtrinsic(_ context.Context, meta *types.Metadata, c types.Call, krp signature.KeyringPair) (types.Hash, types.BlockNumber, types.MultiSignature, error) {
@@ -361,6 +389,76 @@ func (a *api) GetPendingExtrinsics() ([]types.Extrinsic, error) {
 	return a.sapi.GetPendingExtrinsics()
 }
 
+const (
+	paymentQueryInfoMethod = "payment_queryInfo"
+)
+
+type runtimeDispatchInfo struct {
+	Class      string `json:"class"`
+	PartialFee string `json:"partialFee"`
+}
+
+// EstimateFee signs an extrinsic with the given call and queries its fee, the extrinsic is not submitted.
+// The fee does not depend on the nonce, so the nonce of the account is not retrieved.
+func (a *api) EstimateFee(_ *types.Metadata, c types.Call, krp signature.KeyringPair) (*FeeInfo, error) {
+	ext, err := a.signExtrinsic(c, 0, krp)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrFeeEstimation, err)
+	}
+
+	encodedExt, err := codec.EncodeToHex(ext)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrFeeEstimation, err)
+	}
+
+	var info runtimeDispatchInfo
+
+	if err := a.sapi.Call(&info, paymentQueryInfoMethod, encodedExt); err != nil {
+		return nil, errors.NewTypedError(ErrFeeEstimation, err)
+	}
+
+	partialFee, ok := new(big.Int).SetString(info.PartialFee, 10)
+	if !ok {
+		return nil, errors.NewTypedError(ErrFeeEstimation, fmt.Errorf("invalid partial fee '%s'", info.PartialFee))
+	}
+
+	return &FeeInfo{
+		Class:      info.Class,
+		PartialFee: types.NewU128(*partialFee),
+	}, nil
+}
+
+// GetFreeBalance returns the free balance stored in the account info of the given account.
+func (a *api) GetFreeBalance(meta *types.Metadata, accountID *types.AccountID) (types.U128, error) {
+	accountInfo, err := a.getAccountInfo(meta, accountID.ToBytes())
+	if err != nil {
+		return types.U128{}, err
+	}
+
+	return accountInfo.Data.Free, nil
+}
+
+func (a *api) getAccountInfo(meta *types.Metadata, accountID []byte) (*types.AccountInfo, error) {
+	key, err := types.CreateStorageKey(meta, "System", "Account", accountID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountInfo types.AccountInfo
+
+	ok, err := a.sapi.GetStorageLatest(key, &accountInfo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, ErrAccountInfoNotFound
+	}
+
+	return &accountInfo, nil
+}
+
 func (a *api) getDispatcherRunnerFunc(
 	blockNumber *types.BlockNumber,
 	txHash types.Hash,
@@ -528,6 +626,8 @@ const (
 	ResultFieldName        = "Result.result"
 )
 
+// checkSuccessfulProxyExecution checks the result of every proxy call of the extrinsic, a batch
+// of proxy calls emits one ProxyExecuted event per call.
 func checkSuccessfulProxyExecution(meta *types.Metadata, events []*parser.Event, extrinsicIdx int) error {
 	for _, event := range events {
 		if event.Name == ProxyExecutedEventName && event.Phase.IsApplyExtrinsic && event.Phase.AsApplyExtrinsic == uint32(extrinsicIdx) {
@@ -545,7 +645,7 @@ func checkSuccessfulProxyExecution(meta *types.Metadata, events []*parser.Event,
 
 			if res[0].Value == nil {
 				// The DispatchResult is Ok(()).
-				return nil
+				continue
 			}
 
 			errorID, err := getErrorIDFromDispatchError(res[0].Value)
@@ -580,23 +680,11 @@ func (a *api) incrementNonce(accountID []byte) {
 }
 
 func (a *api) getNonceFromChain(meta *types.Metadata, krp []byte) (uint32, error) {
-	key, err := types.CreateStorageKey(meta, "System", "Account", krp, nil)
+	accountInfo, err := a.getAccountInfo(meta, krp)
 	if err != nil {
 		return 0, err
 	}
 
-	var accountInfo types.AccountInfo
-
-	ok, err := a.sapi.GetStorageLatest(key, &accountInfo)
-
-	if err != nil {
-		return 0, err
-	}
-
-	if !ok {
-		return 0, errors.New("account information not found on chain")
-	}
-
 	return uint32(accountInfo.Nonce), nil
 }
 
@@ -33,6 +33,29 @@ func (_m *APIMock) Call(result interface{}, method string, args ...interface{})
 	return r0
 }
 
+// EstimateFee provides a mock function with given fields: meta, c, krp
+func (_m *APIMock) EstimateFee(meta *types.Metadata, c types.Call, krp signature.KeyringPair) (*FeeInfo, error) {
+	ret := _m.Called(meta, c, krp)
+
+	var r0 *FeeInfo
+	if rf, ok := ret.Get(0).(func(*types.Metadata, types.Call, signature.KeyringPair) *FeeInfo); ok {
+		r0 = rf(meta, c, krp)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FeeInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Metadata, types.Call, signature.KeyringPair) error); ok {
+		r1 = rf(meta, c, krp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlock provides a mock function with given fields: blockHash
 func (_m *APIMock) GetBlock(blockHash types.Hash) (*types.SignedBlock, error) {
 	ret := _m.Called(blockHash)
@@ -102,6 +125,27 @@ func (_m *APIMock) GetBlockLatest() (*types.SignedBlock, error) {
 	return r0, r1
 }
 
+// GetFreeBalance provides a mock function with given fields: meta, accountID
+func (_m *APIMock) GetFreeBalance(meta *types.Metadata, accountID *types.AccountID) (types.U128, error) {
+	ret := _m.Called(meta, accountID)
+
+	var r0 types.U128
+	if rf, ok := ret.Get(0).(func(*types.Metadata, *types.AccountID) types.U128); ok {
+		r0 = rf(meta, accountID)
+	} else {
+		r0 = ret.Get(0).(types.U128)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Metadata, *types.AccountID) error); ok {
+		r1 = rf(meta, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMetadataLatest provides a mock function with given fields:
 func (_m *APIMock) GetMetadataLatest() (*types.Metadata, error) {
 	ret := _m.Called()
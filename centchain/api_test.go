@@ -4,6 +4,7 @@ package centchain
 
 import (
 	"context"
+	"math/big"
 	"strings"
 	"testing"
 	"time"
@@ -606,6 +607,117 @@ func TestApi_GetPendingExtrinsics(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestApi_EstimateFee(t *testing.T) {
+	substrateAPIMock := NewSubstrateAPIMock(t)
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	eventRetrieverMock := retriever.NewEventRetrieverMock(t)
+
+	api := NewAPI(substrateAPIMock, dispatcherMock, 1, 5*time.Second, eventRetrieverMock)
+
+	meta := metaDataWithCall("Anchor.commit")
+	c, err := types.NewCall(
+		meta,
+		"Anchor.commit",
+		types.NewHash(utils.RandomSlice(32)),
+		types.NewHash(utils.RandomSlice(32)),
+		types.NewHash(utils.RandomSlice(32)),
+		types.NewMoment(time.Now()),
+	)
+	assert.NoError(t, err)
+
+	krp := keyrings.AliceKeyRingPair
+
+	substrateAPIMock.On("GetBlockHash", uint64(0)).
+		Return(types.Hash(utils.RandomByte32()), nil)
+
+	substrateAPIMock.On("GetRuntimeVersionLatest").
+		Return(types.NewRuntimeVersion(), nil)
+
+	substrateAPIMock.On("Call", mock.IsType(&runtimeDispatchInfo{}), paymentQueryInfoMethod, mock.IsType("")).
+		Run(func(args mock.Arguments) {
+			info := args.Get(0).(*runtimeDispatchInfo)
+			info.Class = "normal"
+			info.PartialFee = "1234567890123456789012"
+		}).
+		Return(nil).
+		Once()
+
+	res, err := api.EstimateFee(meta, c, krp)
+	assert.NoError(t, err)
+	assert.Equal(t, "normal", res.Class)
+	assert.Equal(t, "1234567890123456789012", res.PartialFee.String())
+
+	// Invalid fee
+	substrateAPIMock.On("Call", mock.IsType(&runtimeDispatchInfo{}), paymentQueryInfoMethod, mock.IsType("")).
+		Run(func(args mock.Arguments) {
+			info := args.Get(0).(*runtimeDispatchInfo)
+			info.PartialFee = "invalid"
+		}).
+		Return(nil).
+		Once()
+
+	res, err = api.EstimateFee(meta, c, krp)
+	assert.True(t, errors.IsOfType(ErrFeeEstimation, err))
+	assert.Nil(t, res)
+
+	// Call error
+	substrateAPIMock.On("Call", mock.IsType(&runtimeDispatchInfo{}), paymentQueryInfoMethod, mock.IsType("")).
+		Return(errors.New("error")).
+		Once()
+
+	res, err = api.EstimateFee(meta, c, krp)
+	assert.True(t, errors.IsOfType(ErrFeeEstimation, err))
+	assert.Nil(t, res)
+}
+
+func TestApi_GetFreeBalance(t *testing.T) {
+	substrateAPIMock := NewSubstrateAPIMock(t)
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	eventRetrieverMock := retriever.NewEventRetrieverMock(t)
+
+	api := NewAPI(substrateAPIMock, dispatcherMock, 1, 5*time.Second, eventRetrieverMock)
+
+	meta := metaDataWithCall("Anchor.commit")
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	storageKey, err := types.CreateStorageKey(meta, "System", "Account", accountID.ToBytes())
+	assert.NoError(t, err)
+
+	freeBalance := types.NewU128(*big.NewInt(1000))
+
+	substrateAPIMock.On("GetStorageLatest", storageKey, mock.IsType(&types.AccountInfo{})).
+		Run(func(args mock.Arguments) {
+			accountInfo := args.Get(1).(*types.AccountInfo)
+			accountInfo.Data.Free = freeBalance
+		}).
+		Return(true, nil).
+		Once()
+
+	res, err := api.GetFreeBalance(meta, accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, freeBalance, res)
+
+	// Account not found
+	substrateAPIMock.On("GetStorageLatest", storageKey, mock.IsType(&types.AccountInfo{})).
+		Return(false, nil).
+		Once()
+
+	_, err = api.GetFreeBalance(meta, accountID)
+	assert.ErrorIs(t, err, ErrAccountInfoNotFound)
+
+	// Storage error
+	storageErr := errors.New("error")
+
+	substrateAPIMock.On("GetStorageLatest", storageKey, mock.IsType(&types.AccountInfo{})).
+		Return(false, storageErr).
+		Once()
+
+	_, err = api.GetFreeBalance(meta, accountID)
+	assert.ErrorIs(t, err, storageErr)
+}
+
 func TestApi_dispatcherRunnerFunc(t *testing.T) {
 	substrateAPIMock := NewSubstrateAPIMock(t)
 	dispatcherMock := jobs.NewDispatcherMock(t)
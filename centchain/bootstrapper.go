@@ -1,40 +1,62 @@
 package centchain
 
 import (
-	gsrpc "github.com/centrifuge/go-substrate-rpc-client/v4"
-	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/retriever"
-	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/state"
+	"context"
+
 	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/jobs"
 )
 
-// BootstrappedCentChainClient is a key to mapped client in bootstrap context.
-const BootstrappedCentChainClient string = "BootstrappedCentChainClient"
+const (
+	// BootstrappedCentChainClient is a key to mapped client in bootstrap context.
+	BootstrappedCentChainClient string = "BootstrappedCentChainClient"
+
+	// BootstrappedConnectionManager is a key to the chain connection manager in bootstrap context.
+	BootstrappedConnectionManager string = "BootstrappedCentChainConnectionManager"
+
+	// BootstrappedEventDispatcher is a key to the dispatcher of chain events in bootstrap context.
+	BootstrappedEventDispatcher string = "BootstrappedCentChainEventDispatcher"
+
+	// BootstrappedEventListener is a key to the chain event listener in bootstrap context.
+	BootstrappedEventListener string = "BootstrappedCentChainEventListener"
+)
 
 // Bootstrapper implements bootstrap.Bootstrapper.
 type Bootstrapper struct{}
 
 // Bootstrap initialises centchain client.
-func (Bootstrapper) Bootstrap(context map[string]interface{}) error {
-	cfg, err := config.RetrieveConfig(false, context)
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	cfg, err := config.RetrieveConfig(false, ctx)
 	if err != nil {
 		return err
 	}
 
-	dispatcher := context[jobs.BootstrappedJobDispatcher].(jobs.Dispatcher)
-	sapi, err := gsrpc.NewSubstrateAPI(cfg.GetCentChainNodeURL())
+	jobDispatcher := ctx[jobs.BootstrappedJobDispatcher].(jobs.Dispatcher)
+	connectionManager, err := NewConnectionManager(cfg.GetCentChainNodeURLs(), cfg.GetCentChainHealthCheckInterval())
 	if err != nil {
 		return err
 	}
 
-	eventRetriever, err := retriever.NewDefaultEventRetriever(state.NewEventProvider(sapi.RPC.State), sapi.RPC.State)
-
-	if err != nil {
+	if err := connectionManager.Connect(); err != nil {
 		return err
 	}
 
-	centSAPI := &defaultSubstrateAPI{sapi}
-	client := NewAPI(centSAPI, dispatcher, cfg.GetCentChainMaxRetries(), cfg.GetCentChainIntervalRetry(), eventRetriever)
-	context[BootstrappedCentChainClient] = client
+	ctx[BootstrappedConnectionManager] = connectionManager
+
+	client := NewAPI(connectionManager, jobDispatcher, cfg.GetCentChainMaxRetries(), cfg.GetCentChainIntervalRetry(), connectionManager)
+	ctx[BootstrappedCentChainClient] = client
+
+	eventDispatcher := dispatcher.NewDispatcher[*Event](context.Background())
+	ctx[BootstrappedEventDispatcher] = eventDispatcher
+
+	ctx[BootstrappedEventListener] = NewEventListener(
+		connectionManager,
+		connectionManager,
+		eventDispatcher,
+		DefaultEventPallets,
+		cfg.GetCentChainIntervalRetry(),
+	)
+
 	return nil
 }
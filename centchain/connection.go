@@ -0,0 +1,420 @@
+package centchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gsrpc "github.com/centrifuge/go-substrate-rpc-client/v4"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/parser"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/retriever"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/state"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+)
+
+const (
+	ErrNoNodeURLs           = errors.Error("no centchain node URLs provided")
+	ErrNoHealthyNode        = errors.Error("couldn't connect to any centchain node")
+	ErrNodeHealthCheck      = errors.Error("centchain node health check failed")
+	ErrMetadataRefresh      = errors.Error("couldn't refresh metadata")
+	ErrConnectionNotStarted = errors.Error("centchain connection not established")
+)
+
+// connection holds the APIs of a connection to a single chain node.
+type connection struct {
+	sapi           substrateAPI
+	eventRetriever retriever.EventRetriever
+	close          func()
+}
+
+// connectFn establishes a connection to the chain node at the provided URL.
+type connectFn func(url string) (*connection, error)
+
+// defaultConnectFn connects to a chain node using gsrpc.
+func defaultConnectFn(url string) (*connection, error) {
+	sapi, err := gsrpc.NewSubstrateAPI(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	eventRetriever, err := retriever.NewDefaultEventRetriever(state.NewEventProvider(sapi.RPC.State), sapi.RPC.State)
+
+	if err != nil {
+		sapi.Client.Close()
+
+		return nil, err
+	}
+
+	return &connection{
+		sapi:           &defaultSubstrateAPI{sapi},
+		eventRetriever: eventRetriever,
+		close:          sapi.Client.Close,
+	}, nil
+}
+
+// ConnectionManager maintains a connection to one of the configured chain nodes.
+//
+// The connection is health checked periodically and whenever a read fails, if the node
+// is not healthy the manager fails over to the next node that can be reached. Subscriptions
+// made on a closed connection are terminated, and are expected to be re-established by the
+// subscriber through the manager.
+//
+// The latest metadata is cached and refreshed whenever a runtime upgrade is detected
+// via the runtime version.
+type ConnectionManager struct {
+	urls                []string
+	healthCheckInterval time.Duration
+	connectFn           connectFn
+
+	connMu sync.RWMutex
+	conn   *connection
+	urlIdx int
+
+	metaMu  sync.Mutex
+	meta    *types.Metadata
+	specVer types.U32
+}
+
+// NewConnectionManager returns a new ConnectionManager for the provided URLs, in order of preference.
+func NewConnectionManager(urls []string, healthCheckInterval time.Duration) (*ConnectionManager, error) {
+	if len(urls) == 0 {
+		return nil, ErrNoNodeURLs
+	}
+
+	return &ConnectionManager{
+		urls:                urls,
+		healthCheckInterval: healthCheckInterval,
+		connectFn:           defaultConnectFn,
+		urlIdx:              -1,
+	}, nil
+}
+
+// Connect establishes the initial connection, trying the URLs in order of preference.
+func (m *ConnectionManager) Connect() error {
+	m.connMu.Lock()
+	defer m.connMu.Unlock()
+
+	return m.reconnect(0)
+}
+
+// Name returns the name of the connection manager service.
+func (m *ConnectionManager) Name() string {
+	return "CentChainConnectionManager"
+}
+
+// Start health checks the connection periodically until the context is done.
+func (m *ConnectionManager) Start(ctx context.Context, wg *sync.WaitGroup, _ chan<- error) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(m.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Stopping connection manager: %s", ctx.Err())
+
+			m.close()
+
+			return
+		case <-ticker.C:
+			if err := m.checkHealth(); err != nil {
+				log.Errorf("Centchain connection health check failed: %s", err)
+			}
+		}
+	}
+}
+
+// CurrentURL returns the URL of the node that is currently connected.
+func (m *ConnectionManager) CurrentURL() string {
+	m.connMu.RLock()
+	defer m.connMu.RUnlock()
+
+	if m.urlIdx < 0 {
+		return ""
+	}
+
+	return m.urls[m.urlIdx]
+}
+
+// reconnect connects to the first reachable URL starting from the provided index,
+// it must be called while holding the connection write lock.
+func (m *ConnectionManager) reconnect(startIdx int) error {
+	for i := 0; i < len(m.urls); i++ {
+		idx := (startIdx + i) % len(m.urls)
+		url := m.urls[idx]
+
+		conn, err := m.connectFn(url)
+
+		if err != nil {
+			log.Errorf("Couldn't connect to centchain node %s: %s", url, err)
+
+			continue
+		}
+
+		if err := checkConnectionHealth(conn); err != nil {
+			log.Errorf("Centchain node %s is not healthy: %s", url, err)
+
+			conn.close()
+
+			continue
+		}
+
+		if m.conn != nil {
+			m.conn.close()
+		}
+
+		log.Infof("Connected to centchain node %s", url)
+
+		m.conn = conn
+		m.urlIdx = idx
+
+		m.metaMu.Lock()
+		m.meta = nil
+		m.metaMu.Unlock()
+
+		return nil
+	}
+
+	return ErrNoHealthyNode
+}
+
+func (m *ConnectionManager) close() {
+	m.connMu.Lock()
+	defer m.connMu.Unlock()
+
+	if m.conn == nil {
+		return
+	}
+
+	m.conn.close()
+	m.conn = nil
+	m.urlIdx = -1
+}
+
+func (m *ConnectionManager) current() (*connection, error) {
+	m.connMu.RLock()
+	defer m.connMu.RUnlock()
+
+	if m.conn == nil {
+		return nil, ErrConnectionNotStarted
+	}
+
+	return m.conn, nil
+}
+
+// checkHealth checks the current connection and refreshes the metadata if a runtime upgrade is detected.
+// If the node is not healthy, the manager fails over to the next reachable node.
+func (m *ConnectionManager) checkHealth() error {
+	conn, err := m.current()
+
+	if err != nil {
+		return err
+	}
+
+	rv, err := conn.sapi.GetRuntimeVersionLatest()
+
+	if err != nil {
+		log.Errorf("Centchain node %s is not healthy, failing over: %s", m.CurrentURL(), err)
+
+		return m.failoverFrom(conn)
+	}
+
+	return m.refreshMetadata(conn, rv)
+}
+
+func checkConnectionHealth(conn *connection) error {
+	if _, err := conn.sapi.GetRuntimeVersionLatest(); err != nil {
+		return errors.NewTypedError(ErrNodeHealthCheck, err)
+	}
+
+	return nil
+}
+
+// withFailover executes the provided function on the current connection, if it fails and
+// the node is not healthy, the function is executed once more after failing over.
+func withFailover[T any](m *ConnectionManager, fn func(conn *connection) (T, error)) (T, error) {
+	conn, err := m.current()
+
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	res, err := fn(conn)
+
+	if err == nil {
+		return res, nil
+	}
+
+	if healthErr := checkConnectionHealth(conn); healthErr == nil {
+		return res, err
+	}
+
+	log.Errorf("Centchain node %s is not healthy, failing over", m.CurrentURL())
+
+	if failoverErr := m.failoverFrom(conn); failoverErr != nil {
+		return res, err
+	}
+
+	conn, currentErr := m.current()
+
+	if currentErr != nil {
+		return res, err
+	}
+
+	return fn(conn)
+}
+
+// failoverFrom reconnects to the next reachable node if the provided connection is still the current one,
+// so that concurrent failures on the same connection trigger a single failover. The failing node is
+// retried last, in case it is the only one that can be reached.
+func (m *ConnectionManager) failoverFrom(conn *connection) error {
+	m.connMu.Lock()
+	defer m.connMu.Unlock()
+
+	if m.conn != conn {
+		return nil
+	}
+
+	return m.reconnect(m.urlIdx + 1)
+}
+
+func (m *ConnectionManager) GetMetadataLatest() (*types.Metadata, error) {
+	m.metaMu.Lock()
+	meta := m.meta
+	m.metaMu.Unlock()
+
+	if meta != nil {
+		return meta, nil
+	}
+
+	if _, err := m.GetRuntimeVersionLatest(); err != nil {
+		return nil, err
+	}
+
+	m.metaMu.Lock()
+	defer m.metaMu.Unlock()
+
+	if m.meta == nil {
+		return nil, ErrMetadataRefresh
+	}
+
+	return m.meta, nil
+}
+
+// GetRuntimeVersionLatest returns the latest runtime version, the cached metadata is
+// refreshed if the spec version changed.
+func (m *ConnectionManager) GetRuntimeVersionLatest() (*types.RuntimeVersion, error) {
+	return withFailover(m, func(conn *connection) (*types.RuntimeVersion, error) {
+		rv, err := conn.sapi.GetRuntimeVersionLatest()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := m.refreshMetadata(conn, rv); err != nil {
+			return nil, err
+		}
+
+		return rv, nil
+	})
+}
+
+func (m *ConnectionManager) refreshMetadata(conn *connection, rv *types.RuntimeVersion) error {
+	m.metaMu.Lock()
+	defer m.metaMu.Unlock()
+
+	if m.meta != nil && m.specVer == rv.SpecVersion {
+		return nil
+	}
+
+	if m.meta != nil {
+		log.Infof("Runtime upgrade detected, spec version %d -> %d", m.specVer, rv.SpecVersion)
+	}
+
+	meta, err := conn.sapi.GetMetadataLatest()
+
+	if err != nil {
+		return errors.NewTypedError(ErrMetadataRefresh, err)
+	}
+
+	m.meta = meta
+	m.specVer = rv.SpecVersion
+
+	return nil
+}
+
+func (m *ConnectionManager) Call(result interface{}, method string, args ...interface{}) error {
+	_, err := withFailover(m, func(conn *connection) (struct{}, error) {
+		return struct{}{}, conn.sapi.Call(result, method, args...)
+	})
+
+	return err
+}
+
+func (m *ConnectionManager) GetBlockHash(blockNumber uint64) (types.Hash, error) {
+	return withFailover(m, func(conn *connection) (types.Hash, error) {
+		return conn.sapi.GetBlockHash(blockNumber)
+	})
+}
+
+func (m *ConnectionManager) GetBlockLatest() (*types.SignedBlock, error) {
+	return withFailover(m, func(conn *connection) (*types.SignedBlock, error) {
+		return conn.sapi.GetBlockLatest()
+	})
+}
+
+// SubmitExtrinsic submits the extrinsic on the current connection, it is not resubmitted after a failover
+// since the extrinsic might have reached the transaction pool of the failing node.
+func (m *ConnectionManager) SubmitExtrinsic(ext types.Extrinsic) (types.Hash, error) {
+	conn, err := m.current()
+
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	return conn.sapi.SubmitExtrinsic(ext)
+}
+
+func (m *ConnectionManager) GetStorageLatest(key types.StorageKey, target interface{}) (bool, error) {
+	return withFailover(m, func(conn *connection) (bool, error) {
+		return conn.sapi.GetStorageLatest(key, target)
+	})
+}
+
+func (m *ConnectionManager) GetStorage(key types.StorageKey, target interface{}, blockHash types.Hash) error {
+	_, err := withFailover(m, func(conn *connection) (struct{}, error) {
+		return struct{}{}, conn.sapi.GetStorage(key, target, blockHash)
+	})
+
+	return err
+}
+
+func (m *ConnectionManager) GetBlock(blockHash types.Hash) (*types.SignedBlock, error) {
+	return withFailover(m, func(conn *connection) (*types.SignedBlock, error) {
+		return conn.sapi.GetBlock(blockHash)
+	})
+}
+
+func (m *ConnectionManager) GetPendingExtrinsics() ([]types.Extrinsic, error) {
+	return withFailover(m, func(conn *connection) ([]types.Extrinsic, error) {
+		return conn.sapi.GetPendingExtrinsics()
+	})
+}
+
+func (m *ConnectionManager) SubscribeFinalizedHeads() (HeadsSubscription, error) {
+	return withFailover(m, func(conn *connection) (HeadsSubscription, error) {
+		return conn.sapi.SubscribeFinalizedHeads()
+	})
+}
+
+// GetEvents retrieves the events of the provided block using the event retriever of the current connection.
+func (m *ConnectionManager) GetEvents(blockHash types.Hash) ([]*parser.Event, error) {
+	return withFailover(m, func(conn *connection) ([]*parser.Event, error) {
+		return conn.eventRetriever.GetEvents(blockHash)
+	})
+}
@@ -0,0 +1,230 @@
+//go:build unit
+
+package centchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/retriever"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConnection struct {
+	sapiMock           *SubstrateAPIMock
+	eventRetrieverMock *retriever.EventRetrieverMock
+	closed             bool
+}
+
+func getTestConnectionManager(t *testing.T, urls ...string) (*ConnectionManager, map[string]*testConnection) {
+	connectionManager, err := NewConnectionManager(urls, time.Second)
+	assert.NoError(t, err)
+
+	connections := make(map[string]*testConnection)
+
+	for _, url := range urls {
+		connections[url] = &testConnection{
+			sapiMock:           NewSubstrateAPIMock(t),
+			eventRetrieverMock: retriever.NewEventRetrieverMock(t),
+		}
+	}
+
+	connectionManager.connectFn = func(url string) (*connection, error) {
+		testConn, ok := connections[url]
+
+		if !ok {
+			return nil, errors.New("unreachable")
+		}
+
+		return &connection{
+			sapi:           testConn.sapiMock,
+			eventRetriever: testConn.eventRetrieverMock,
+			close: func() {
+				testConn.closed = true
+			},
+		}, nil
+	}
+
+	return connectionManager, connections
+}
+
+func TestNewConnectionManager_NoURLs(t *testing.T) {
+	connectionManager, err := NewConnectionManager(nil, time.Second)
+	assert.ErrorIs(t, err, ErrNoNodeURLs)
+	assert.Nil(t, connectionManager)
+}
+
+func TestConnectionManager_Connect(t *testing.T) {
+	connectionManager, connections := getTestConnectionManager(t, "url1", "url2")
+
+	// Unhealthy node should be skipped.
+	connections["url1"].sapiMock.On("GetRuntimeVersionLatest").
+		Return(nil, errors.New("error")).
+		Once()
+
+	connections["url2"].sapiMock.On("GetRuntimeVersionLatest").
+		Return(&types.RuntimeVersion{}, nil).
+		Once()
+
+	err := connectionManager.Connect()
+	assert.NoError(t, err)
+	assert.Equal(t, "url2", connectionManager.CurrentURL())
+	assert.True(t, connections["url1"].closed)
+	assert.False(t, connections["url2"].closed)
+}
+
+func TestConnectionManager_Connect_NoHealthyNode(t *testing.T) {
+	connectionManager, connections := getTestConnectionManager(t, "url1")
+
+	connections["url1"].sapiMock.On("GetRuntimeVersionLatest").
+		Return(nil, errors.New("error")).
+		Once()
+
+	err := connectionManager.Connect()
+	assert.ErrorIs(t, err, ErrNoHealthyNode)
+	assert.Equal(t, "", connectionManager.CurrentURL())
+
+	_, err = connectionManager.GetBlockHash(0)
+	assert.ErrorIs(t, err, ErrConnectionNotStarted)
+}
+
+func TestConnectionManager_Failover(t *testing.T) {
+	connectionManager, connections := getTestConnectionManager(t, "url1", "url2")
+
+	conn1 := connections["url1"]
+	conn2 := connections["url2"]
+
+	conn1.sapiMock.On("GetRuntimeVersionLatest").
+		Return(&types.RuntimeVersion{}, nil).
+		Once()
+
+	err := connectionManager.Connect()
+	assert.NoError(t, err)
+	assert.Equal(t, "url1", connectionManager.CurrentURL())
+
+	blockHash := types.NewHash(utils.RandomSlice(32))
+
+	// Read error on a healthy node is returned without failing over.
+	conn1.sapiMock.On("GetBlockHash", uint64(1)).
+		Return(types.Hash{}, errors.New("error")).
+		Once()
+
+	conn1.sapiMock.On("GetRuntimeVersionLatest").
+		Return(&types.RuntimeVersion{}, nil).
+		Once()
+
+	_, err = connectionManager.GetBlockHash(1)
+	assert.Error(t, err)
+	assert.Equal(t, "url1", connectionManager.CurrentURL())
+
+	// Read error on an unhealthy node is retried on the next node.
+	conn1.sapiMock.On("GetBlockHash", uint64(1)).
+		Return(types.Hash{}, errors.New("error")).
+		Once()
+
+	conn1.sapiMock.On("GetRuntimeVersionLatest").
+		Return(nil, errors.New("error")).
+		Once()
+
+	conn2.sapiMock.On("GetRuntimeVersionLatest").
+		Return(&types.RuntimeVersion{}, nil).
+		Once()
+
+	conn2.sapiMock.On("GetBlockHash", uint64(1)).
+		Return(blockHash, nil).
+		Once()
+
+	res, err := connectionManager.GetBlockHash(1)
+	assert.NoError(t, err)
+	assert.Equal(t, blockHash, res)
+	assert.Equal(t, "url2", connectionManager.CurrentURL())
+	assert.True(t, conn1.closed)
+
+	// Extrinsics are not resubmitted after a failover.
+	conn2.sapiMock.On("SubmitExtrinsic", types.Extrinsic{}).
+		Return(types.Hash{}, errors.New("error")).
+		Once()
+
+	_, err = connectionManager.SubmitExtrinsic(types.Extrinsic{})
+	assert.Error(t, err)
+}
+
+func TestConnectionManager_checkHealth(t *testing.T) {
+	connectionManager, connections := getTestConnectionManager(t, "url1", "url2")
+
+	conn1 := connections["url1"]
+	conn2 := connections["url2"]
+
+	conn1.sapiMock.On("GetRuntimeVersionLatest").
+		Return(&types.RuntimeVersion{}, nil).
+		Once()
+
+	err := connectionManager.Connect()
+	assert.NoError(t, err)
+
+	conn1.sapiMock.On("GetRuntimeVersionLatest").
+		Return(nil, errors.New("error")).
+		Once()
+
+	conn2.sapiMock.On("GetRuntimeVersionLatest").
+		Return(&types.RuntimeVersion{}, nil).
+		Once()
+
+	err = connectionManager.checkHealth()
+	assert.NoError(t, err)
+	assert.Equal(t, "url2", connectionManager.CurrentURL())
+}
+
+func TestConnectionManager_GetMetadataLatest(t *testing.T) {
+	connectionManager, connections := getTestConnectionManager(t, "url1")
+
+	conn := connections["url1"]
+
+	conn.sapiMock.On("GetRuntimeVersionLatest").
+		Return(&types.RuntimeVersion{SpecVersion: 1}, nil).
+		Times(3)
+
+	err := connectionManager.Connect()
+	assert.NoError(t, err)
+
+	meta := types.NewMetadataV14()
+
+	conn.sapiMock.On("GetMetadataLatest").
+		Return(meta, nil).
+		Once()
+
+	res, err := connectionManager.GetMetadataLatest()
+	assert.NoError(t, err)
+	assert.Equal(t, meta, res)
+
+	// Metadata should be cached.
+	res, err = connectionManager.GetMetadataLatest()
+	assert.NoError(t, err)
+	assert.Equal(t, meta, res)
+
+	// Same spec version, metadata should not be refreshed.
+	err = connectionManager.checkHealth()
+	assert.NoError(t, err)
+
+	// Runtime upgrade, metadata should be refreshed.
+	conn.sapiMock.On("GetRuntimeVersionLatest").
+		Return(&types.RuntimeVersion{SpecVersion: 2}, nil).
+		Once()
+
+	upgradedMeta := types.NewMetadataV14()
+	upgradedMeta.Version = 15
+
+	conn.sapiMock.On("GetMetadataLatest").
+		Return(upgradedMeta, nil).
+		Once()
+
+	err = connectionManager.checkHealth()
+	assert.NoError(t, err)
+
+	res, err = connectionManager.GetMetadataLatest()
+	assert.NoError(t, err)
+	assert.Equal(t, upgradedMeta, res)
+}
@@ -0,0 +1,249 @@
+package centchain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/parser"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/retriever"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/centrifuge/pod/errors"
+)
+
+const (
+	ErrHeadsSubscription       = errors.Error("couldn't subscribe to finalized heads")
+	ErrHeadsSubscriptionClosed = errors.Error("finalized heads subscription closed")
+)
+
+const (
+	AnchorPalletName      = "Anchor"
+	KeystorePalletName    = "Keystore"
+	ProxyPalletName       = "Proxy"
+	UniquesPalletName     = "Uniques"
+	LoansPalletName       = "Loans"
+	PermissionsPalletName = "Permissions"
+	SystemPalletName      = "System"
+)
+
+// DefaultEventPallets holds the names of the pallets whose events are dispatched by default.
+var DefaultEventPallets = []string{
+	AnchorPalletName,
+	KeystorePalletName,
+	ProxyPalletName,
+	UniquesPalletName,
+	LoansPalletName,
+	PermissionsPalletName,
+	SystemPalletName,
+}
+
+// Event holds a decoded event that was emitted in a finalized block.
+type Event struct {
+	BlockNumber types.BlockNumber
+	BlockHash   types.Hash
+
+	Name   string
+	Fields registry.DecodedFields
+	Phase  *types.Phase
+}
+
+// PalletName returns the name of the pallet that emitted the event.
+func (e *Event) PalletName() string {
+	return strings.SplitN(e.Name, ".", 2)[0]
+}
+
+// EventName returns the name of the event without the pallet prefix.
+func (e *Event) EventName() string {
+	parts := strings.SplitN(e.Name, ".", 2)
+
+	if len(parts) != 2 {
+		return e.Name
+	}
+
+	return parts[1]
+}
+
+//go:generate mockery --name HeadsSubscription --structname HeadsSubscriptionMock --filename heads_subscription_mock.go --inpackage
+
+// HeadsSubscription is the subscription used for receiving finalized headers,
+// it is satisfied by the gsrpc FinalizedHeadsSubscription.
+type HeadsSubscription interface {
+	Chan() <-chan types.Header
+	Err() <-chan error
+	Unsubscribe()
+}
+
+// EventListener follows the finalized blocks of the chain and dispatches the
+// events of the configured pallets.
+type EventListener struct {
+	sapi            substrateAPI
+	eventRetriever  retriever.EventRetriever
+	eventDispatcher dispatcher.Dispatcher[*Event]
+	pallets         map[string]struct{}
+	retryInterval   time.Duration
+
+	mu                  sync.Mutex
+	lastProcessedNumber types.BlockNumber
+}
+
+// NewEventListener returns a new EventListener that dispatches the events of the provided pallets.
+func NewEventListener(
+	sapi substrateAPI,
+	eventRetriever retriever.EventRetriever,
+	eventDispatcher dispatcher.Dispatcher[*Event],
+	pallets []string,
+	retryInterval time.Duration,
+) *EventListener {
+	palletMap := make(map[string]struct{})
+
+	for _, pallet := range pallets {
+		palletMap[pallet] = struct{}{}
+	}
+
+	return &EventListener{
+		sapi:            sapi,
+		eventRetriever:  eventRetriever,
+		eventDispatcher: eventDispatcher,
+		pallets:         palletMap,
+		retryInterval:   retryInterval,
+	}
+}
+
+// Name returns the name of the event listener service.
+func (l *EventListener) Name() string {
+	return "CentChainEventListener"
+}
+
+// Start subscribes to finalized heads and dispatches the events found in each block.
+// The subscription is re-established after the retry interval whenever it fails.
+func (l *EventListener) Start(ctx context.Context, wg *sync.WaitGroup, _ chan<- error) {
+	defer wg.Done()
+
+	for {
+		err := l.listen(ctx)
+
+		if ctx.Err() != nil {
+			log.Infof("Stopping event listener: %s", ctx.Err())
+			return
+		}
+
+		log.Errorf("Event listener error, retrying in %s: %s", l.retryInterval, err)
+
+		select {
+		case <-ctx.Done():
+			log.Infof("Stopping event listener: %s", ctx.Err())
+			return
+		case <-time.After(l.retryInterval):
+		}
+	}
+}
+
+// LastProcessedBlock returns the number of the last block whose events were dispatched.
+func (l *EventListener) LastProcessedBlock() types.BlockNumber {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.lastProcessedNumber
+}
+
+func (l *EventListener) listen(ctx context.Context) error {
+	sub, err := l.sapi.SubscribeFinalizedHeads()
+
+	if err != nil {
+		return errors.NewTypedError(ErrHeadsSubscription, err)
+	}
+
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			if err == nil {
+				return ErrHeadsSubscriptionClosed
+			}
+
+			return err
+		case header, ok := <-sub.Chan():
+			if !ok {
+				return ErrHeadsSubscriptionClosed
+			}
+
+			if err := l.processHeader(ctx, header); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// processHeader dispatches the events of all the blocks between the last processed block
+// and the block of the provided header, so that no block is skipped when the subscription
+// lags behind or is re-established.
+func (l *EventListener) processHeader(ctx context.Context, header types.Header) error {
+	from := header.Number
+
+	if last := l.LastProcessedBlock(); last != 0 && last < header.Number {
+		from = last + 1
+	}
+
+	for number := from; number <= header.Number; number++ {
+		if err := l.processBlock(ctx, number); err != nil {
+			return fmt.Errorf("couldn't process block %d: %w", number, err)
+		}
+
+		l.mu.Lock()
+		l.lastProcessedNumber = number
+		l.mu.Unlock()
+	}
+
+	return nil
+}
+
+func (l *EventListener) processBlock(ctx context.Context, number types.BlockNumber) error {
+	blockHash, err := l.sapi.GetBlockHash(uint64(number))
+
+	if err != nil {
+		return fmt.Errorf("block hash retrieval: %w", err)
+	}
+
+	events, err := l.eventRetriever.GetEvents(blockHash)
+
+	if err != nil {
+		return fmt.Errorf("event retrieval: %w", err)
+	}
+
+	for _, event := range events {
+		if !l.isTracked(event) {
+			continue
+		}
+
+		chainEvent := &Event{
+			BlockNumber: number,
+			BlockHash:   blockHash,
+			Name:        event.Name,
+			Fields:      event.Fields,
+			Phase:       event.Phase,
+		}
+
+		log.Debugf("Dispatching event %s from block %d", event.Name, number)
+
+		if err := l.eventDispatcher.Dispatch(ctx, chainEvent); err != nil {
+			return fmt.Errorf("event dispatch: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (l *EventListener) isTracked(event *parser.Event) bool {
+	palletName := strings.SplitN(event.Name, ".", 2)[0]
+
+	_, ok := l.pallets[palletName]
+
+	return ok
+}
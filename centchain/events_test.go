@@ -0,0 +1,219 @@
+//go:build unit
+
+package centchain
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/parser"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry/retriever"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEvent_Names(t *testing.T) {
+	event := &Event{Name: "Keystore.KeyRevoked"}
+
+	assert.Equal(t, KeystorePalletName, event.PalletName())
+	assert.Equal(t, "KeyRevoked", event.EventName())
+
+	event = &Event{Name: "Keystore"}
+
+	assert.Equal(t, KeystorePalletName, event.PalletName())
+	assert.Equal(t, "Keystore", event.EventName())
+}
+
+func TestEventListener_processHeader(t *testing.T) {
+	substrateAPIMock := NewSubstrateAPIMock(t)
+	eventRetrieverMock := retriever.NewEventRetrieverMock(t)
+	dispatcherMock := dispatcher.NewDispatcherMock[*Event](t)
+
+	listener := NewEventListener(
+		substrateAPIMock,
+		eventRetrieverMock,
+		dispatcherMock,
+		[]string{KeystorePalletName},
+		time.Second,
+	)
+
+	ctx := context.Background()
+
+	blockHash := types.NewHash(utils.RandomSlice(32))
+
+	substrateAPIMock.On("GetBlockHash", uint64(11)).
+		Return(blockHash, nil).
+		Once()
+
+	keystoreEvent := &parser.Event{
+		Name:  "Keystore.KeyRevoked",
+		Phase: &types.Phase{IsApplyExtrinsic: true, AsApplyExtrinsic: 1},
+	}
+
+	events := []*parser.Event{
+		{Name: "System.ExtrinsicSuccess"},
+		keystoreEvent,
+	}
+
+	eventRetrieverMock.On("GetEvents", blockHash).
+		Return(events, nil).
+		Once()
+
+	dispatcherMock.On("Dispatch", ctx, &Event{
+		BlockNumber: 11,
+		BlockHash:   blockHash,
+		Name:        keystoreEvent.Name,
+		Fields:      keystoreEvent.Fields,
+		Phase:       keystoreEvent.Phase,
+	}).Return(nil).Once()
+
+	err := listener.processHeader(ctx, types.Header{Number: 11})
+	assert.NoError(t, err)
+	assert.Equal(t, types.BlockNumber(11), listener.LastProcessedBlock())
+
+	// Skipped blocks are processed.
+	for _, number := range []uint64{12, 13} {
+		substrateAPIMock.On("GetBlockHash", number).
+			Return(blockHash, nil).
+			Once()
+	}
+
+	eventRetrieverMock.On("GetEvents", blockHash).
+		Return(nil, nil).
+		Times(2)
+
+	err = listener.processHeader(ctx, types.Header{Number: 13})
+	assert.NoError(t, err)
+	assert.Equal(t, types.BlockNumber(13), listener.LastProcessedBlock())
+}
+
+func TestEventListener_processHeader_Errors(t *testing.T) {
+	substrateAPIMock := NewSubstrateAPIMock(t)
+	eventRetrieverMock := retriever.NewEventRetrieverMock(t)
+	dispatcherMock := dispatcher.NewDispatcherMock[*Event](t)
+
+	listener := NewEventListener(
+		substrateAPIMock,
+		eventRetrieverMock,
+		dispatcherMock,
+		DefaultEventPallets,
+		time.Second,
+	)
+
+	ctx := context.Background()
+
+	// Block hash error
+	substrateAPIMock.On("GetBlockHash", uint64(1)).
+		Return(types.Hash{}, errors.New("error")).
+		Once()
+
+	err := listener.processHeader(ctx, types.Header{Number: 1})
+	assert.Error(t, err)
+	assert.Equal(t, types.BlockNumber(0), listener.LastProcessedBlock())
+
+	// Event retrieval error
+	blockHash := types.NewHash(utils.RandomSlice(32))
+
+	substrateAPIMock.On("GetBlockHash", uint64(1)).
+		Return(blockHash, nil).
+		Once()
+
+	eventRetrieverMock.On("GetEvents", blockHash).
+		Return(nil, errors.New("error")).
+		Once()
+
+	err = listener.processHeader(ctx, types.Header{Number: 1})
+	assert.Error(t, err)
+	assert.Equal(t, types.BlockNumber(0), listener.LastProcessedBlock())
+
+	// Dispatch error
+	substrateAPIMock.On("GetBlockHash", uint64(1)).
+		Return(blockHash, nil).
+		Once()
+
+	eventRetrieverMock.On("GetEvents", blockHash).
+		Return([]*parser.Event{{Name: "Uniques.Transferred"}}, nil).
+		Once()
+
+	dispatcherMock.On("Dispatch", ctx, mock.IsType(&Event{})).
+		Return(errors.New("error")).
+		Once()
+
+	err = listener.processHeader(ctx, types.Header{Number: 1})
+	assert.Error(t, err)
+	assert.Equal(t, types.BlockNumber(0), listener.LastProcessedBlock())
+}
+
+func TestEventListener_Start(t *testing.T) {
+	substrateAPIMock := NewSubstrateAPIMock(t)
+	eventRetrieverMock := retriever.NewEventRetrieverMock(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventDispatcher := dispatcher.NewDispatcher[*Event](ctx)
+
+	listener := NewEventListener(
+		substrateAPIMock,
+		eventRetrieverMock,
+		eventDispatcher,
+		DefaultEventPallets,
+		10*time.Millisecond,
+	)
+
+	// First subscription attempt fails, the listener should retry.
+	substrateAPIMock.On("SubscribeFinalizedHeads").
+		Return(nil, errors.New("error")).
+		Once()
+
+	headsChan := make(chan types.Header, 1)
+	errChan := make(chan error)
+
+	subscriptionMock := NewHeadsSubscriptionMock(t)
+	subscriptionMock.On("Chan").Return((<-chan types.Header)(headsChan))
+	subscriptionMock.On("Err").Return((<-chan error)(errChan))
+	subscriptionMock.On("Unsubscribe").Once()
+
+	substrateAPIMock.On("SubscribeFinalizedHeads").
+		Return(subscriptionMock, nil).
+		Once()
+
+	blockHash := types.NewHash(utils.RandomSlice(32))
+
+	substrateAPIMock.On("GetBlockHash", uint64(5)).
+		Return(blockHash, nil).
+		Once()
+
+	eventRetrieverMock.On("GetEvents", blockHash).
+		Return([]*parser.Event{{Name: "Anchor.Committed"}}, nil).
+		Once()
+
+	sub, err := eventDispatcher.Subscribe(ctx)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go listener.Start(ctx, &wg, make(chan error))
+
+	headsChan <- types.Header{Number: 5}
+
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not dispatched")
+	case event := <-sub:
+		assert.Equal(t, "Anchor.Committed", event.Name)
+		assert.Equal(t, types.BlockNumber(5), event.BlockNumber)
+		assert.Equal(t, blockHash, event.BlockHash)
+	}
+
+	cancel()
+
+	wg.Wait()
+}
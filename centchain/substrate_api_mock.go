@@ -225,6 +225,29 @@ func (_m *SubstrateAPIMock) SubmitExtrinsic(ext types.Extrinsic) (types.Hash, er
 	return r0, r1
 }
 
+// SubscribeFinalizedHeads provides a mock function with given fields:
+func (_m *SubstrateAPIMock) SubscribeFinalizedHeads() (HeadsSubscription, error) {
+	ret := _m.Called()
+
+	var r0 HeadsSubscription
+	if rf, ok := ret.Get(0).(func() HeadsSubscription); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(HeadsSubscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type NewSubstrateAPIMockT interface {
 	mock.TestingT
 	Cleanup(func())
@@ -16,6 +16,29 @@ type AccountMock struct {
 	mock.Mock
 }
 
+// DecryptMsg provides a mock function with given fields: msg
+func (_m *AccountMock) DecryptMsg(msg []byte) ([]byte, error) {
+	ret := _m.Called(msg)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FromJSON provides a mock function with given fields: json
 func (_m *AccountMock) FromJSON(json []byte) error {
 	ret := _m.Called(json)
@@ -30,6 +53,22 @@ func (_m *AccountMock) FromJSON(json []byte) error {
 	return r0
 }
 
+// GetEncryptionPublicKey provides a mock function with given fields:
+func (_m *AccountMock) GetEncryptionPublicKey() []byte {
+	ret := _m.Called()
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	return r0
+}
+
 // GetIdentity provides a mock function with given fields:
 func (_m *AccountMock) GetIdentity() *types.AccountID {
 	ret := _m.Called()
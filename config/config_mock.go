@@ -3,6 +3,8 @@
 package config
 
 import (
+	big "math/big"
+
 	reflect "reflect"
 
 	mock "github.com/stretchr/testify/mock"
@@ -45,6 +47,20 @@ func (_m *ConfigurationMock) GetBootstrapPeers() []string {
 	return r0
 }
 
+// GetCentChainAnchorBatchWindow provides a mock function with given fields:
+func (_m *ConfigurationMock) GetCentChainAnchorBatchWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // GetCentChainAnchorLifespan provides a mock function with given fields:
 func (_m *ConfigurationMock) GetCentChainAnchorLifespan() time.Duration {
 	ret := _m.Called()
@@ -59,6 +75,34 @@ func (_m *ConfigurationMock) GetCentChainAnchorLifespan() time.Duration {
 	return r0
 }
 
+// GetCentChainCacheTTL provides a mock function with given fields:
+func (_m *ConfigurationMock) GetCentChainCacheTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetCentChainHealthCheckInterval provides a mock function with given fields:
+func (_m *ConfigurationMock) GetCentChainHealthCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // GetCentChainIntervalRetry provides a mock function with given fields:
 func (_m *ConfigurationMock) GetCentChainIntervalRetry() time.Duration {
 	ret := _m.Called()
@@ -73,6 +117,22 @@ func (_m *ConfigurationMock) GetCentChainIntervalRetry() time.Duration {
 	return r0
 }
 
+// GetCentChainLowBalanceThreshold provides a mock function with given fields:
+func (_m *ConfigurationMock) GetCentChainLowBalanceThreshold() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // GetCentChainMaxRetries provides a mock function with given fields:
 func (_m *ConfigurationMock) GetCentChainMaxRetries() int {
 	ret := _m.Called()
@@ -101,6 +161,22 @@ func (_m *ConfigurationMock) GetCentChainNodeURL() string {
 	return r0
 }
 
+// GetCentChainNodeURLs provides a mock function with given fields:
+func (_m *ConfigurationMock) GetCentChainNodeURLs() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // GetConfigStoragePath provides a mock function with given fields:
 func (_m *ConfigurationMock) GetConfigStoragePath() string {
 	ret := _m.Called()
@@ -157,6 +233,20 @@ func (_m *ConfigurationMock) GetIPFSPinningServiceURL() string {
 	return r0
 }
 
+// GetMetricsAddress provides a mock function with given fields:
+func (_m *ConfigurationMock) GetMetricsAddress() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GetNetworkID provides a mock function with given fields:
 func (_m *ConfigurationMock) GetNetworkID() uint32 {
 	ret := _m.Called()
@@ -199,6 +289,132 @@ func (_m *ConfigurationMock) GetNumWorkers() int {
 	return r0
 }
 
+// GetP2PAddressBookTTL provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PAddressBookTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetP2PBanDuration provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PBanDuration() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetP2PBanThreshold provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PBanThreshold() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PChunkSize provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PChunkSize() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PChunkedTransferTimeout provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PChunkedTransferTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetP2PChunkingThreshold provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PChunkingThreshold() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PConnMgrGracePeriod provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PConnMgrGracePeriod() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetP2PConnMgrHighWater provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PConnMgrHighWater() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PConnMgrLowWater provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PConnMgrLowWater() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // GetP2PConnectionTimeout provides a mock function with given fields:
 func (_m *ConfigurationMock) GetP2PConnectionTimeout() time.Duration {
 	ret := _m.Called()
@@ -213,6 +429,34 @@ func (_m *ConfigurationMock) GetP2PConnectionTimeout() time.Duration {
 	return r0
 }
 
+// GetP2PDeliveryExpiry provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PDeliveryExpiry() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetP2PDeliveryMaxBackoff provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PDeliveryMaxBackoff() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // GetP2PExternalIP provides a mock function with given fields:
 func (_m *ConfigurationMock) GetP2PExternalIP() string {
 	ret := _m.Called()
@@ -227,6 +471,20 @@ func (_m *ConfigurationMock) GetP2PExternalIP() string {
 	return r0
 }
 
+// GetP2PIdentityRateLimit provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PIdentityRateLimit() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // GetP2PKeyPair provides a mock function with given fields:
 func (_m *ConfigurationMock) GetP2PKeyPair() (string, string) {
 	ret := _m.Called()
@@ -248,6 +506,118 @@ func (_m *ConfigurationMock) GetP2PKeyPair() (string, string) {
 	return r0, r1
 }
 
+// GetP2PMaxChunkedBytes provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PMaxChunkedBytes() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PMaxChunkedBytesPerPeer provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PMaxChunkedBytesPerPeer() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PMaxChunkedDocumentSize provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PMaxChunkedDocumentSize() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PMaxChunkedTransfers provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PMaxChunkedTransfers() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PMaxChunkedTransfersPerPeer provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PMaxChunkedTransfersPerPeer() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PMaxConcurrentRequests provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PMaxConcurrentRequests() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PMaxMessageSize provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PMaxMessageSize() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PPeerRateLimit provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PPeerRateLimit() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // GetP2PPort provides a mock function with given fields:
 func (_m *ConfigurationMock) GetP2PPort() int {
 	ret := _m.Called()
@@ -262,6 +632,36 @@ func (_m *ConfigurationMock) GetP2PPort() int {
 	return r0
 }
 
+// GetP2PQUICPort provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PQUICPort() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetP2PRelayPeers provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PRelayPeers() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // GetP2PResponseDelay provides a mock function with given fields:
 func (_m *ConfigurationMock) GetP2PResponseDelay() time.Duration {
 	ret := _m.Called()
@@ -276,6 +676,78 @@ func (_m *ConfigurationMock) GetP2PResponseDelay() time.Duration {
 	return r0
 }
 
+// GetP2PSignatureCollectionWindow provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PSignatureCollectionWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetP2PSignatureRetryInterval provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PSignatureRetryInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetP2PStaticPeers provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PStaticPeers() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// GetP2PSyncInterval provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PSyncInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetP2PWebSocketPort provides a mock function with given fields:
+func (_m *ConfigurationMock) GetP2PWebSocketPort() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // GetPodAdminSecretSeed provides a mock function with given fields:
 func (_m *ConfigurationMock) GetPodAdminSecretSeed() string {
 	ret := _m.Called()
@@ -402,6 +874,104 @@ func (_m *ConfigurationMock) IsDebugLogEnabled() bool {
 	return r0
 }
 
+// IsP2PAutoNATServiceEnabled provides a mock function with given fields:
+func (_m *ConfigurationMock) IsP2PAutoNATServiceEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsP2PEncryptionEnabled provides a mock function with given fields:
+func (_m *ConfigurationMock) IsP2PEncryptionEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsP2PHolePunchingEnabled provides a mock function with given fields:
+func (_m *ConfigurationMock) IsP2PHolePunchingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsP2PQUICEnabled provides a mock function with given fields:
+func (_m *ConfigurationMock) IsP2PQUICEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsP2PRelayEnabled provides a mock function with given fields:
+func (_m *ConfigurationMock) IsP2PRelayEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsP2PRelayServiceEnabled provides a mock function with given fields:
+func (_m *ConfigurationMock) IsP2PRelayServiceEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsP2PWebSocketEnabled provides a mock function with given fields:
+func (_m *ConfigurationMock) IsP2PWebSocketEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsPProfEnabled provides a mock function with given fields:
 func (_m *ConfigurationMock) IsPProfEnabled() bool {
 	ret := _m.Called()
@@ -9,6 +9,7 @@ import (
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/crypto"
+	"github.com/centrifuge/pod/errors"
 	libp2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
 )
 
@@ -39,12 +40,19 @@ func (n *PodAdmin) FromJSON(data []byte) error {
 	return json.Unmarshal(data, n)
 }
 
+const (
+	ErrEncryptionKeysNotFound = errors.Error("account has no document encryption keys")
+)
+
 type Account struct {
 	Identity *types.AccountID `json:"identity" swaggertype:"string"`
 
 	SigningPublicKey  []byte
 	SigningPrivateKey []byte
 
+	EncryptionPublicKey  []byte
+	EncryptionPrivateKey []byte
+
 	WebhookURL       string `json:"webhook_url"`
 	PrecommitEnabled bool   `json:"precommit_enabled"`
 }
@@ -53,6 +61,8 @@ func NewAccount(
 	identity *types.AccountID,
 	signingPublicKey libp2pcrypto.PubKey,
 	signingPrivateKey libp2pcrypto.PrivKey,
+	encryptionPublicKey []byte,
+	encryptionPrivateKey []byte,
 	webhookURL string,
 	precommitEnabled bool,
 ) (config.Account, error) {
@@ -69,11 +79,13 @@ func NewAccount(
 	}
 
 	return &Account{
-		Identity:          identity,
-		SigningPublicKey:  signingPublicKeyRaw,
-		SigningPrivateKey: signingPrivateKeyRaw,
-		WebhookURL:        webhookURL,
-		PrecommitEnabled:  precommitEnabled,
+		Identity:             identity,
+		SigningPublicKey:     signingPublicKeyRaw,
+		SigningPrivateKey:    signingPrivateKeyRaw,
+		EncryptionPublicKey:  encryptionPublicKey,
+		EncryptionPrivateKey: encryptionPrivateKey,
+		WebhookURL:           webhookURL,
+		PrecommitEnabled:     precommitEnabled,
 	}, nil
 }
 
@@ -85,6 +97,10 @@ func (acc *Account) GetSigningPublicKey() []byte {
 	return acc.SigningPublicKey
 }
 
+func (acc *Account) GetEncryptionPublicKey() []byte {
+	return acc.EncryptionPublicKey
+}
+
 func (acc *Account) GetWebhookURL() string {
 	return acc.WebhookURL
 }
@@ -111,6 +127,15 @@ func (acc *Account) SignMsg(msg []byte) (*coredocumentpb.Signature, error) {
 	}, nil
 }
 
+// DecryptMsg decrypts a message that was encrypted for the encryption key
+func (acc *Account) DecryptMsg(msg []byte) ([]byte, error) {
+	if len(acc.EncryptionPublicKey) == 0 || len(acc.EncryptionPrivateKey) == 0 {
+		return nil, ErrEncryptionKeysNotFound
+	}
+
+	return crypto.DecryptMessage(acc.EncryptionPublicKey, acc.EncryptionPrivateKey, msg, crypto.CurveX25519)
+}
+
 // Type Returns the underlying type of the Account
 func (acc *Account) Type() reflect.Type {
 	return reflect.TypeOf(acc)
@@ -0,0 +1,38 @@
+//go:build unit
+
+package configstore
+
+import (
+	"testing"
+
+	"github.com/centrifuge/pod/crypto"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccount_DecryptMsg(t *testing.T) {
+	pub, priv, err := crypto.GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	acc := &Account{
+		EncryptionPublicKey:  pub,
+		EncryptionPrivateKey: priv,
+	}
+
+	msg := utils.RandomSlice(32)
+
+	encryptedMsg, err := crypto.EncryptMessage(acc.GetEncryptionPublicKey(), msg, crypto.CurveX25519)
+	assert.NoError(t, err)
+
+	res, err := acc.DecryptMsg(encryptedMsg)
+	assert.NoError(t, err)
+	assert.Equal(t, msg, res)
+}
+
+func TestAccount_DecryptMsg_MissingEncryptionKeys(t *testing.T) {
+	acc := &Account{}
+
+	res, err := acc.DecryptMsg(utils.RandomSlice(32))
+	assert.ErrorIs(t, err, ErrEncryptionKeysNotFound)
+	assert.Nil(t, res)
+}
@@ -2,6 +2,7 @@ package configstore
 
 import (
 	"encoding/json"
+	"math/big"
 	"reflect"
 	"time"
 
@@ -10,34 +11,74 @@ import (
 
 // NodeConfig exposes configs specific to the node
 type NodeConfig struct {
-	StoragePath             string
-	ConfigStoragePath       string
-	P2PPort                 int
-	P2PExternalIP           string
-	P2PConnectionTimeout    time.Duration
-	P2PResponseDelay        time.Duration
-	P2PPublicKey            string
-	P2PPrivateKey           string
-	ServerPort              int
-	ServerAddress           string
-	NumWorkers              int
-	WorkerWaitTimeMS        int
-	TaskValidDuration       time.Duration
-	NetworkString           string
-	BootstrapPeers          []string
-	NetworkID               uint32
-	PprofEnabled            bool
-	DebugLogEnabled         bool
-	AuthenticationEnabled   bool
-	CentChainNodeURL        string
-	CentChainIntervalRetry  time.Duration
-	CentChainMaxRetries     int
-	CentChainAnchorLifespan time.Duration
-	IPFSPinningServiceName  string
-	IPFSPinningServiceURL   string
-	IPFSPinningServiceAuth  string
-	PodOperatorSecretSeed   string
-	PodAdminSecretSeed      string
+	StoragePath                   string
+	ConfigStoragePath             string
+	P2PPort                       int
+	P2PExternalIP                 string
+	P2PConnectionTimeout          time.Duration
+	P2PResponseDelay              time.Duration
+	P2PSignatureCollectionWindow  time.Duration
+	P2PSignatureRetryInterval     time.Duration
+	P2PDeliveryMaxBackoff         time.Duration
+	P2PDeliveryExpiry             time.Duration
+	P2PSyncInterval               time.Duration
+	P2PAddressBookTTL             time.Duration
+	P2PStaticPeers                map[string]string
+	P2PConnMgrLowWater            int
+	P2PConnMgrHighWater           int
+	P2PConnMgrGracePeriod         time.Duration
+	P2PRelayEnabled               bool
+	P2PRelayPeers                 []string
+	P2PRelayServiceEnabled        bool
+	P2PHolePunchingEnabled        bool
+	P2PAutoNATServiceEnabled      bool
+	P2PQUICEnabled                bool
+	P2PQUICPort                   int
+	P2PWebSocketEnabled           bool
+	P2PWebSocketPort              int
+	P2PPeerRateLimit              int
+	P2PIdentityRateLimit          int
+	P2PMaxMessageSize             int
+	P2PMaxConcurrentRequests      int
+	P2PBanThreshold               int
+	P2PBanDuration                time.Duration
+	P2PEncryptionEnabled          bool
+	P2PChunkingThreshold          int
+	P2PChunkSize                  int
+	P2PMaxChunkedDocumentSize     int
+	P2PMaxChunkedTransfersPerPeer int
+	P2PMaxChunkedBytesPerPeer     int
+	P2PMaxChunkedTransfers        int
+	P2PMaxChunkedBytes            int
+	P2PChunkedTransferTimeout     time.Duration
+	P2PPublicKey                  string
+	P2PPrivateKey                 string
+	ServerPort                    int
+	ServerAddress                 string
+	MetricsAddress                string
+	NumWorkers                    int
+	WorkerWaitTimeMS              int
+	TaskValidDuration             time.Duration
+	NetworkString                 string
+	BootstrapPeers                []string
+	NetworkID                     uint32
+	PprofEnabled                  bool
+	DebugLogEnabled               bool
+	AuthenticationEnabled         bool
+	CentChainNodeURL              string
+	CentChainNodeURLs             []string
+	CentChainHealthCheckInterval  time.Duration
+	CentChainIntervalRetry        time.Duration
+	CentChainMaxRetries           int
+	CentChainAnchorLifespan       time.Duration
+	CentChainCacheTTL             time.Duration
+	CentChainLowBalanceThreshold  *big.Int
+	CentChainAnchorBatchWindow    time.Duration
+	IPFSPinningServiceName        string
+	IPFSPinningServiceURL         string
+	IPFSPinningServiceAuth        string
+	PodOperatorSecretSeed         string
+	PodAdminSecretSeed            string
 }
 
 // GetStoragePath refer the interface
@@ -70,6 +111,176 @@ func (nc *NodeConfig) GetP2PResponseDelay() time.Duration {
 	return nc.P2PResponseDelay
 }
 
+// GetP2PSignatureCollectionWindow refer the interface
+func (nc *NodeConfig) GetP2PSignatureCollectionWindow() time.Duration {
+	return nc.P2PSignatureCollectionWindow
+}
+
+// GetP2PSignatureRetryInterval refer the interface
+func (nc *NodeConfig) GetP2PSignatureRetryInterval() time.Duration {
+	return nc.P2PSignatureRetryInterval
+}
+
+// GetP2PDeliveryMaxBackoff refer the interface
+func (nc *NodeConfig) GetP2PDeliveryMaxBackoff() time.Duration {
+	return nc.P2PDeliveryMaxBackoff
+}
+
+// GetP2PDeliveryExpiry refer the interface
+func (nc *NodeConfig) GetP2PDeliveryExpiry() time.Duration {
+	return nc.P2PDeliveryExpiry
+}
+
+// GetP2PSyncInterval refer the interface
+func (nc *NodeConfig) GetP2PSyncInterval() time.Duration {
+	return nc.P2PSyncInterval
+}
+
+// GetP2PAddressBookTTL refer the interface
+func (nc *NodeConfig) GetP2PAddressBookTTL() time.Duration {
+	return nc.P2PAddressBookTTL
+}
+
+// GetP2PStaticPeers refer the interface
+func (nc *NodeConfig) GetP2PStaticPeers() map[string]string {
+	return nc.P2PStaticPeers
+}
+
+// GetP2PConnMgrLowWater refer the interface
+func (nc *NodeConfig) GetP2PConnMgrLowWater() int {
+	return nc.P2PConnMgrLowWater
+}
+
+// GetP2PConnMgrHighWater refer the interface
+func (nc *NodeConfig) GetP2PConnMgrHighWater() int {
+	return nc.P2PConnMgrHighWater
+}
+
+// GetP2PConnMgrGracePeriod refer the interface
+func (nc *NodeConfig) GetP2PConnMgrGracePeriod() time.Duration {
+	return nc.P2PConnMgrGracePeriod
+}
+
+// IsP2PRelayEnabled refer the interface
+func (nc *NodeConfig) IsP2PRelayEnabled() bool {
+	return nc.P2PRelayEnabled
+}
+
+// GetP2PRelayPeers refer the interface
+func (nc *NodeConfig) GetP2PRelayPeers() []string {
+	return nc.P2PRelayPeers
+}
+
+// IsP2PRelayServiceEnabled refer the interface
+func (nc *NodeConfig) IsP2PRelayServiceEnabled() bool {
+	return nc.P2PRelayServiceEnabled
+}
+
+// IsP2PHolePunchingEnabled refer the interface
+func (nc *NodeConfig) IsP2PHolePunchingEnabled() bool {
+	return nc.P2PHolePunchingEnabled
+}
+
+// IsP2PAutoNATServiceEnabled refer the interface
+func (nc *NodeConfig) IsP2PAutoNATServiceEnabled() bool {
+	return nc.P2PAutoNATServiceEnabled
+}
+
+// IsP2PQUICEnabled refer the interface
+func (nc *NodeConfig) IsP2PQUICEnabled() bool {
+	return nc.P2PQUICEnabled
+}
+
+// GetP2PQUICPort refer the interface
+func (nc *NodeConfig) GetP2PQUICPort() int {
+	return nc.P2PQUICPort
+}
+
+// IsP2PWebSocketEnabled refer the interface
+func (nc *NodeConfig) IsP2PWebSocketEnabled() bool {
+	return nc.P2PWebSocketEnabled
+}
+
+// GetP2PWebSocketPort refer the interface
+func (nc *NodeConfig) GetP2PWebSocketPort() int {
+	return nc.P2PWebSocketPort
+}
+
+// GetP2PPeerRateLimit refer the interface
+func (nc *NodeConfig) GetP2PPeerRateLimit() int {
+	return nc.P2PPeerRateLimit
+}
+
+// GetP2PIdentityRateLimit refer the interface
+func (nc *NodeConfig) GetP2PIdentityRateLimit() int {
+	return nc.P2PIdentityRateLimit
+}
+
+// GetP2PMaxMessageSize refer the interface
+func (nc *NodeConfig) GetP2PMaxMessageSize() int {
+	return nc.P2PMaxMessageSize
+}
+
+// GetP2PMaxConcurrentRequests refer the interface
+func (nc *NodeConfig) GetP2PMaxConcurrentRequests() int {
+	return nc.P2PMaxConcurrentRequests
+}
+
+// GetP2PBanThreshold refer the interface
+func (nc *NodeConfig) GetP2PBanThreshold() int {
+	return nc.P2PBanThreshold
+}
+
+// GetP2PBanDuration refer the interface
+func (nc *NodeConfig) GetP2PBanDuration() time.Duration {
+	return nc.P2PBanDuration
+}
+
+// IsP2PEncryptionEnabled refer the interface
+func (nc *NodeConfig) IsP2PEncryptionEnabled() bool {
+	return nc.P2PEncryptionEnabled
+}
+
+// GetP2PChunkingThreshold refer the interface
+func (nc *NodeConfig) GetP2PChunkingThreshold() int {
+	return nc.P2PChunkingThreshold
+}
+
+// GetP2PChunkSize refer the interface
+func (nc *NodeConfig) GetP2PChunkSize() int {
+	return nc.P2PChunkSize
+}
+
+// GetP2PMaxChunkedDocumentSize refer the interface
+func (nc *NodeConfig) GetP2PMaxChunkedDocumentSize() int {
+	return nc.P2PMaxChunkedDocumentSize
+}
+
+// GetP2PMaxChunkedTransfersPerPeer refer the interface
+func (nc *NodeConfig) GetP2PMaxChunkedTransfersPerPeer() int {
+	return nc.P2PMaxChunkedTransfersPerPeer
+}
+
+// GetP2PMaxChunkedBytesPerPeer refer the interface
+func (nc *NodeConfig) GetP2PMaxChunkedBytesPerPeer() int {
+	return nc.P2PMaxChunkedBytesPerPeer
+}
+
+// GetP2PMaxChunkedTransfers refer the interface
+func (nc *NodeConfig) GetP2PMaxChunkedTransfers() int {
+	return nc.P2PMaxChunkedTransfers
+}
+
+// GetP2PMaxChunkedBytes refer the interface
+func (nc *NodeConfig) GetP2PMaxChunkedBytes() int {
+	return nc.P2PMaxChunkedBytes
+}
+
+// GetP2PChunkedTransferTimeout refer the interface
+func (nc *NodeConfig) GetP2PChunkedTransferTimeout() time.Duration {
+	return nc.P2PChunkedTransferTimeout
+}
+
 // GetServerPort refer the interface
 func (nc *NodeConfig) GetServerPort() int {
 	return nc.ServerPort
@@ -115,6 +326,16 @@ func (nc *NodeConfig) GetCentChainNodeURL() string {
 	return nc.CentChainNodeURL
 }
 
+// GetCentChainNodeURLs returns the URLs of the CentChain Nodes.
+func (nc *NodeConfig) GetCentChainNodeURLs() []string {
+	return nc.CentChainNodeURLs
+}
+
+// GetCentChainHealthCheckInterval returns the interval between CentChain Node health checks.
+func (nc *NodeConfig) GetCentChainHealthCheckInterval() time.Duration {
+	return nc.CentChainHealthCheckInterval
+}
+
 // GetCentChainIntervalRetry returns duration to wait between retries.
 func (nc *NodeConfig) GetCentChainIntervalRetry() time.Duration {
 	return nc.CentChainIntervalRetry
@@ -130,11 +351,31 @@ func (nc *NodeConfig) GetCentChainAnchorLifespan() time.Duration {
 	return nc.CentChainAnchorLifespan
 }
 
+// GetCentChainAnchorBatchWindow returns the anchor commit batch window.
+func (nc *NodeConfig) GetCentChainAnchorBatchWindow() time.Duration {
+	return nc.CentChainAnchorBatchWindow
+}
+
+// GetCentChainLowBalanceThreshold returns the pod operator low balance threshold.
+func (nc *NodeConfig) GetCentChainLowBalanceThreshold() *big.Int {
+	return nc.CentChainLowBalanceThreshold
+}
+
+// GetCentChainCacheTTL returns the TTL of the cached chain reads.
+func (nc *NodeConfig) GetCentChainCacheTTL() time.Duration {
+	return nc.CentChainCacheTTL
+}
+
 // GetP2PKeyPair refer the interface
 func (nc *NodeConfig) GetP2PKeyPair() (pub, priv string) {
 	return nc.P2PPublicKey, nc.P2PPrivateKey
 }
 
+// GetMetricsAddress refer the interface
+func (nc *NodeConfig) GetMetricsAddress() string {
+	return nc.MetricsAddress
+}
+
 // IsPProfEnabled refer the interface
 func (nc *NodeConfig) IsPProfEnabled() bool {
 	return nc.PprofEnabled
@@ -190,33 +431,73 @@ func NewNodeConfig(c config.Configuration) config.Configuration {
 	p2pPub, p2pPriv := c.GetP2PKeyPair()
 
 	return &NodeConfig{
-		AuthenticationEnabled:   c.IsAuthenticationEnabled(),
-		StoragePath:             c.GetStoragePath(),
-		ConfigStoragePath:       c.GetConfigStoragePath(),
-		P2PPort:                 c.GetP2PPort(),
-		P2PExternalIP:           c.GetP2PExternalIP(),
-		P2PConnectionTimeout:    c.GetP2PConnectionTimeout(),
-		P2PResponseDelay:        c.GetP2PResponseDelay(),
-		P2PPublicKey:            p2pPub,
-		P2PPrivateKey:           p2pPriv,
-		ServerPort:              c.GetServerPort(),
-		ServerAddress:           c.GetServerAddress(),
-		NumWorkers:              c.GetNumWorkers(),
-		WorkerWaitTimeMS:        c.GetWorkerWaitTimeMS(),
-		TaskValidDuration:       c.GetTaskValidDuration(),
-		NetworkString:           c.GetNetworkString(),
-		BootstrapPeers:          c.GetBootstrapPeers(),
-		NetworkID:               c.GetNetworkID(),
-		PprofEnabled:            c.IsPProfEnabled(),
-		DebugLogEnabled:         c.IsDebugLogEnabled(),
-		CentChainMaxRetries:     c.GetCentChainMaxRetries(),
-		CentChainIntervalRetry:  c.GetCentChainIntervalRetry(),
-		CentChainAnchorLifespan: c.GetCentChainAnchorLifespan(),
-		CentChainNodeURL:        c.GetCentChainNodeURL(),
-		IPFSPinningServiceName:  c.GetIPFSPinningServiceName(),
-		IPFSPinningServiceURL:   c.GetIPFSPinningServiceURL(),
-		IPFSPinningServiceAuth:  c.GetIPFSPinningServiceAuth(),
-		PodOperatorSecretSeed:   c.GetPodOperatorSecretSeed(),
-		PodAdminSecretSeed:      c.GetPodAdminSecretSeed(),
+		AuthenticationEnabled:         c.IsAuthenticationEnabled(),
+		StoragePath:                   c.GetStoragePath(),
+		ConfigStoragePath:             c.GetConfigStoragePath(),
+		P2PPort:                       c.GetP2PPort(),
+		P2PExternalIP:                 c.GetP2PExternalIP(),
+		P2PConnectionTimeout:          c.GetP2PConnectionTimeout(),
+		P2PResponseDelay:              c.GetP2PResponseDelay(),
+		P2PSignatureCollectionWindow:  c.GetP2PSignatureCollectionWindow(),
+		P2PSignatureRetryInterval:     c.GetP2PSignatureRetryInterval(),
+		P2PDeliveryMaxBackoff:         c.GetP2PDeliveryMaxBackoff(),
+		P2PDeliveryExpiry:             c.GetP2PDeliveryExpiry(),
+		P2PSyncInterval:               c.GetP2PSyncInterval(),
+		P2PAddressBookTTL:             c.GetP2PAddressBookTTL(),
+		P2PStaticPeers:                c.GetP2PStaticPeers(),
+		P2PConnMgrLowWater:            c.GetP2PConnMgrLowWater(),
+		P2PConnMgrHighWater:           c.GetP2PConnMgrHighWater(),
+		P2PConnMgrGracePeriod:         c.GetP2PConnMgrGracePeriod(),
+		P2PRelayEnabled:               c.IsP2PRelayEnabled(),
+		P2PRelayPeers:                 c.GetP2PRelayPeers(),
+		P2PRelayServiceEnabled:        c.IsP2PRelayServiceEnabled(),
+		P2PHolePunchingEnabled:        c.IsP2PHolePunchingEnabled(),
+		P2PAutoNATServiceEnabled:      c.IsP2PAutoNATServiceEnabled(),
+		P2PQUICEnabled:                c.IsP2PQUICEnabled(),
+		P2PQUICPort:                   c.GetP2PQUICPort(),
+		P2PWebSocketEnabled:           c.IsP2PWebSocketEnabled(),
+		P2PWebSocketPort:              c.GetP2PWebSocketPort(),
+		P2PPeerRateLimit:              c.GetP2PPeerRateLimit(),
+		P2PIdentityRateLimit:          c.GetP2PIdentityRateLimit(),
+		P2PMaxMessageSize:             c.GetP2PMaxMessageSize(),
+		P2PMaxConcurrentRequests:      c.GetP2PMaxConcurrentRequests(),
+		P2PBanThreshold:               c.GetP2PBanThreshold(),
+		P2PBanDuration:                c.GetP2PBanDuration(),
+		P2PEncryptionEnabled:          c.IsP2PEncryptionEnabled(),
+		P2PChunkingThreshold:          c.GetP2PChunkingThreshold(),
+		P2PChunkSize:                  c.GetP2PChunkSize(),
+		P2PMaxChunkedDocumentSize:     c.GetP2PMaxChunkedDocumentSize(),
+		P2PMaxChunkedTransfersPerPeer: c.GetP2PMaxChunkedTransfersPerPeer(),
+		P2PMaxChunkedBytesPerPeer:     c.GetP2PMaxChunkedBytesPerPeer(),
+		P2PMaxChunkedTransfers:        c.GetP2PMaxChunkedTransfers(),
+		P2PMaxChunkedBytes:            c.GetP2PMaxChunkedBytes(),
+		P2PChunkedTransferTimeout:     c.GetP2PChunkedTransferTimeout(),
+		P2PPublicKey:                  p2pPub,
+		P2PPrivateKey:                 p2pPriv,
+		ServerPort:                    c.GetServerPort(),
+		ServerAddress:                 c.GetServerAddress(),
+		MetricsAddress:                c.GetMetricsAddress(),
+		NumWorkers:                    c.GetNumWorkers(),
+		WorkerWaitTimeMS:              c.GetWorkerWaitTimeMS(),
+		TaskValidDuration:             c.GetTaskValidDuration(),
+		NetworkString:                 c.GetNetworkString(),
+		BootstrapPeers:                c.GetBootstrapPeers(),
+		NetworkID:                     c.GetNetworkID(),
+		PprofEnabled:                  c.IsPProfEnabled(),
+		DebugLogEnabled:               c.IsDebugLogEnabled(),
+		CentChainMaxRetries:           c.GetCentChainMaxRetries(),
+		CentChainIntervalRetry:        c.GetCentChainIntervalRetry(),
+		CentChainAnchorLifespan:       c.GetCentChainAnchorLifespan(),
+		CentChainCacheTTL:             c.GetCentChainCacheTTL(),
+		CentChainLowBalanceThreshold:  c.GetCentChainLowBalanceThreshold(),
+		CentChainAnchorBatchWindow:    c.GetCentChainAnchorBatchWindow(),
+		CentChainNodeURL:              c.GetCentChainNodeURL(),
+		CentChainNodeURLs:             c.GetCentChainNodeURLs(),
+		CentChainHealthCheckInterval:  c.GetCentChainHealthCheckInterval(),
+		IPFSPinningServiceName:        c.GetIPFSPinningServiceName(),
+		IPFSPinningServiceURL:         c.GetIPFSPinningServiceURL(),
+		IPFSPinningServiceAuth:        c.GetIPFSPinningServiceAuth(),
+		PodOperatorSecretSeed:         c.GetPodOperatorSecretSeed(),
+		PodAdminSecretSeed:            c.GetPodAdminSecretSeed(),
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/url"
 	"os"
 	"reflect"
@@ -41,6 +42,65 @@ var allowedURLScheme = map[string]struct{}{
 
 const (
 	defaultURLScheme = "https"
+
+	defaultCentChainCacheTTL = time.Minute
+
+	defaultCentChainHealthCheckInterval = 10 * time.Second
+
+	defaultCentChainAnchorBatchWindow = time.Second
+
+	defaultP2PSignatureCollectionWindow = 10 * time.Minute
+
+	defaultP2PSignatureRetryInterval = 30 * time.Second
+
+	defaultP2PDeliveryMaxBackoff = time.Hour
+
+	defaultP2PDeliveryExpiry = 7 * 24 * time.Hour
+
+	defaultP2PSyncInterval = 15 * time.Minute
+
+	defaultP2PAddressBookTTL = 24 * time.Hour
+
+	defaultP2PConnMgrLowWater = 100
+
+	defaultP2PConnMgrHighWater = 400
+
+	defaultP2PConnMgrGracePeriod = time.Minute
+
+	defaultP2PQUICPort = 38202
+
+	defaultP2PWebSocketPort = 38203
+
+	defaultP2PPeerRateLimit = 300
+
+	defaultP2PIdentityRateLimit = 120
+
+	defaultP2PMaxMessageSize = 1 << 24 // 16 MB
+
+	defaultP2PMaxConcurrentRequests = 32
+
+	defaultP2PBanThreshold = 5
+
+	defaultP2PBanDuration = time.Hour
+
+	defaultP2PChunkingThreshold = 1 << 23 // 8 MB
+
+	defaultP2PChunkSize = 1 << 22 // 4 MB
+
+	defaultP2PMaxChunkedDocumentSize = 1 << 28 // 256 MB
+
+	defaultP2PMaxChunkedTransfersPerPeer = 4
+
+	defaultP2PMaxChunkedBytesPerPeer = 1 << 29 // 512 MB
+
+	defaultP2PMaxChunkedTransfers = 64
+
+	defaultP2PMaxChunkedBytes = 1 << 30 // 1 GB
+
+	defaultP2PChunkedTransferTimeout = 10 * time.Minute
+
+	// defaultCentChainLowBalanceThreshold is 10 CFG.
+	defaultCentChainLowBalanceThreshold = "10000000000000000000"
 )
 
 //go:generate mockery --name Configuration --structname ConfigurationMock --filename config_mock.go --inpackage
@@ -55,8 +115,43 @@ type Configuration interface {
 	GetP2PExternalIP() string
 	GetP2PConnectionTimeout() time.Duration
 	GetP2PResponseDelay() time.Duration
+	GetP2PSignatureCollectionWindow() time.Duration
+	GetP2PSignatureRetryInterval() time.Duration
+	GetP2PDeliveryMaxBackoff() time.Duration
+	GetP2PDeliveryExpiry() time.Duration
+	GetP2PSyncInterval() time.Duration
+	GetP2PAddressBookTTL() time.Duration
+	GetP2PStaticPeers() map[string]string
+	GetP2PConnMgrLowWater() int
+	GetP2PConnMgrHighWater() int
+	GetP2PConnMgrGracePeriod() time.Duration
+	IsP2PRelayEnabled() bool
+	GetP2PRelayPeers() []string
+	IsP2PRelayServiceEnabled() bool
+	IsP2PHolePunchingEnabled() bool
+	IsP2PAutoNATServiceEnabled() bool
+	IsP2PQUICEnabled() bool
+	GetP2PQUICPort() int
+	IsP2PWebSocketEnabled() bool
+	GetP2PWebSocketPort() int
+	GetP2PPeerRateLimit() int
+	GetP2PIdentityRateLimit() int
+	GetP2PMaxMessageSize() int
+	GetP2PMaxConcurrentRequests() int
+	GetP2PBanThreshold() int
+	GetP2PBanDuration() time.Duration
+	IsP2PEncryptionEnabled() bool
+	GetP2PChunkingThreshold() int
+	GetP2PChunkSize() int
+	GetP2PMaxChunkedDocumentSize() int
+	GetP2PMaxChunkedTransfersPerPeer() int
+	GetP2PMaxChunkedBytesPerPeer() int
+	GetP2PMaxChunkedTransfers() int
+	GetP2PMaxChunkedBytes() int
+	GetP2PChunkedTransferTimeout() time.Duration
 	GetServerPort() int
 	GetServerAddress() string
+	GetMetricsAddress() string
 	GetNumWorkers() int
 	GetWorkerWaitTimeMS() int
 	GetTaskValidDuration() time.Duration
@@ -75,7 +170,12 @@ type Configuration interface {
 	GetCentChainIntervalRetry() time.Duration
 	GetCentChainMaxRetries() int
 	GetCentChainNodeURL() string
+	GetCentChainNodeURLs() []string
+	GetCentChainHealthCheckInterval() time.Duration
 	GetCentChainAnchorLifespan() time.Duration
+	GetCentChainCacheTTL() time.Duration
+	GetCentChainLowBalanceThreshold() *big.Int
+	GetCentChainAnchorBatchWindow() time.Duration
 
 	GetIPFSPinningServiceName() string
 	GetIPFSPinningServiceURL() string
@@ -134,6 +234,185 @@ func (c *configuration) GetP2PResponseDelay() time.Duration {
 	return c.getDuration("p2p.responseDelay")
 }
 
+// GetP2PSignatureCollectionWindow returns the duration for which missing signatures are requested
+// when the document has a signature policy.
+func (c *configuration) GetP2PSignatureCollectionWindow() time.Duration {
+	return c.getDurationOrDefault("p2p.signatureCollectionWindow", defaultP2PSignatureCollectionWindow)
+}
+
+// GetP2PSignatureRetryInterval returns the duration between two signature requests sent to the missing signers.
+func (c *configuration) GetP2PSignatureRetryInterval() time.Duration {
+	return c.getDurationOrDefault("p2p.signatureRetryInterval", defaultP2PSignatureRetryInterval)
+}
+
+// GetP2PDeliveryMaxBackoff returns the maximum duration between two delivery attempts of an anchored document.
+func (c *configuration) GetP2PDeliveryMaxBackoff() time.Duration {
+	return c.getDurationOrDefault("p2p.deliveryMaxBackoff", defaultP2PDeliveryMaxBackoff)
+}
+
+// GetP2PDeliveryExpiry returns the duration after which the delivery of an anchored document is abandoned.
+func (c *configuration) GetP2PDeliveryExpiry() time.Duration {
+	return c.getDurationOrDefault("p2p.deliveryExpiry", defaultP2PDeliveryExpiry)
+}
+
+// GetP2PSyncInterval returns the interval at which the shared documents are synced with the collaborators.
+func (c *configuration) GetP2PSyncInterval() time.Duration {
+	return c.getDurationOrDefault("p2p.syncInterval", defaultP2PSyncInterval)
+}
+
+// GetP2PAddressBookTTL returns the duration for which the resolved addresses of a peer are used
+// before they are looked up again in the DHT.
+func (c *configuration) GetP2PAddressBookTTL() time.Duration {
+	return c.getDurationOrDefault("p2p.addressBookTTL", defaultP2PAddressBookTTL)
+}
+
+// GetP2PStaticPeers returns the statically configured peers, mapping account IDs to multiaddresses.
+func (c *configuration) GetP2PStaticPeers() map[string]string {
+	return cast.ToStringMapString(c.get("p2p.staticPeers"))
+}
+
+// GetP2PConnMgrLowWater returns the number of connections that the connection manager trims down to.
+func (c *configuration) GetP2PConnMgrLowWater() int {
+	return c.getIntOrDefault("p2p.connMgr.lowWater", defaultP2PConnMgrLowWater)
+}
+
+// GetP2PConnMgrHighWater returns the number of connections above which the connection manager trims connections.
+func (c *configuration) GetP2PConnMgrHighWater() int {
+	return c.getIntOrDefault("p2p.connMgr.highWater", defaultP2PConnMgrHighWater)
+}
+
+// GetP2PConnMgrGracePeriod returns the duration during which new connections are not trimmed.
+func (c *configuration) GetP2PConnMgrGracePeriod() time.Duration {
+	return c.getDurationOrDefault("p2p.connMgr.gracePeriod", defaultP2PConnMgrGracePeriod)
+}
+
+// IsP2PRelayEnabled returns true if the node should advertise relayed addresses and use relays
+// when it is not publicly reachable.
+func (c *configuration) IsP2PRelayEnabled() bool {
+	return c.getBool("p2p.relay.enabled")
+}
+
+// GetP2PRelayPeers returns the multiaddresses of the configured circuit relays.
+func (c *configuration) GetP2PRelayPeers() []string {
+	return cast.ToStringSlice(c.get("p2p.relay.peers"))
+}
+
+// IsP2PRelayServiceEnabled returns true if the node should act as a circuit relay for other nodes
+// when it is publicly reachable.
+func (c *configuration) IsP2PRelayServiceEnabled() bool {
+	return c.getBool("p2p.relay.service")
+}
+
+// IsP2PHolePunchingEnabled returns true if the node should attempt to upgrade relayed connections
+// to direct connections.
+func (c *configuration) IsP2PHolePunchingEnabled() bool {
+	return c.getBool("p2p.holePunching")
+}
+
+// IsP2PAutoNATServiceEnabled returns true if the node should help other nodes determine their reachability.
+func (c *configuration) IsP2PAutoNATServiceEnabled() bool {
+	return c.getBool("p2p.autoNATService")
+}
+
+// IsP2PQUICEnabled returns true if the node should listen and dial using the QUIC transport.
+func (c *configuration) IsP2PQUICEnabled() bool {
+	return c.getBool("p2p.quic.enabled")
+}
+
+// GetP2PQUICPort returns the UDP port on which the QUIC transport listens.
+func (c *configuration) GetP2PQUICPort() int {
+	return c.getIntOrDefault("p2p.quic.port", defaultP2PQUICPort)
+}
+
+// IsP2PWebSocketEnabled returns true if the node should listen and dial using the WebSocket transport.
+func (c *configuration) IsP2PWebSocketEnabled() bool {
+	return c.getBool("p2p.websocket.enabled")
+}
+
+// GetP2PWebSocketPort returns the TCP port on which the WebSocket transport listens.
+func (c *configuration) GetP2PWebSocketPort() int {
+	return c.getIntOrDefault("p2p.websocket.port", defaultP2PWebSocketPort)
+}
+
+// GetP2PPeerRateLimit returns the number of requests per minute that are accepted from a single peer.
+func (c *configuration) GetP2PPeerRateLimit() int {
+	return c.getIntOrDefault("p2p.limits.peerRequestsPerMinute", defaultP2PPeerRateLimit)
+}
+
+// GetP2PIdentityRateLimit returns the number of requests per minute that are accepted from a single identity.
+func (c *configuration) GetP2PIdentityRateLimit() int {
+	return c.getIntOrDefault("p2p.limits.identityRequestsPerMinute", defaultP2PIdentityRateLimit)
+}
+
+// GetP2PMaxMessageSize returns the maximum size in bytes of a received p2p message.
+func (c *configuration) GetP2PMaxMessageSize() int {
+	return c.getIntOrDefault("p2p.limits.maxMessageSize", defaultP2PMaxMessageSize)
+}
+
+// GetP2PMaxConcurrentRequests returns the maximum number of p2p requests that are handled concurrently.
+func (c *configuration) GetP2PMaxConcurrentRequests() int {
+	return c.getIntOrDefault("p2p.limits.maxConcurrentRequests", defaultP2PMaxConcurrentRequests)
+}
+
+// GetP2PBanThreshold returns the number of documents with invalid signatures that a peer can send
+// during the ban duration before it is banned.
+func (c *configuration) GetP2PBanThreshold() int {
+	return c.getIntOrDefault("p2p.limits.banThreshold", defaultP2PBanThreshold)
+}
+
+// GetP2PBanDuration returns the duration for which a peer is banned.
+func (c *configuration) GetP2PBanDuration() time.Duration {
+	return c.getDurationOrDefault("p2p.limits.banDuration", defaultP2PBanDuration)
+}
+
+// IsP2PEncryptionEnabled returns true if the anchored documents sent to other nodes should be encrypted
+// for the receiver.
+func (c *configuration) IsP2PEncryptionEnabled() bool {
+	return c.getBool("p2p.encryption.enabled")
+}
+
+// GetP2PChunkingThreshold returns the size in bytes above which the anchored documents are sent in chunks.
+func (c *configuration) GetP2PChunkingThreshold() int {
+	return c.getIntOrDefault("p2p.chunking.threshold", defaultP2PChunkingThreshold)
+}
+
+// GetP2PChunkSize returns the maximum size in bytes of the chunks of a document.
+func (c *configuration) GetP2PChunkSize() int {
+	return c.getIntOrDefault("p2p.chunking.chunkSize", defaultP2PChunkSize)
+}
+
+// GetP2PMaxChunkedDocumentSize returns the maximum size in bytes of a document received in chunks.
+func (c *configuration) GetP2PMaxChunkedDocumentSize() int {
+	return c.getIntOrDefault("p2p.chunking.maxDocumentSize", defaultP2PMaxChunkedDocumentSize)
+}
+
+// GetP2PMaxChunkedTransfersPerPeer returns the maximum number of chunked transfers in progress for a peer.
+func (c *configuration) GetP2PMaxChunkedTransfersPerPeer() int {
+	return c.getIntOrDefault("p2p.chunking.maxTransfersPerPeer", defaultP2PMaxChunkedTransfersPerPeer)
+}
+
+// GetP2PMaxChunkedBytesPerPeer returns the maximum size in bytes of the documents received in chunks
+// from a peer at the same time.
+func (c *configuration) GetP2PMaxChunkedBytesPerPeer() int {
+	return c.getIntOrDefault("p2p.chunking.maxBufferedBytesPerPeer", defaultP2PMaxChunkedBytesPerPeer)
+}
+
+// GetP2PMaxChunkedTransfers returns the maximum number of chunked transfers in progress for all the peers.
+func (c *configuration) GetP2PMaxChunkedTransfers() int {
+	return c.getIntOrDefault("p2p.chunking.maxTransfers", defaultP2PMaxChunkedTransfers)
+}
+
+// GetP2PMaxChunkedBytes returns the maximum size in bytes of the documents received in chunks
+// from all the peers at the same time.
+func (c *configuration) GetP2PMaxChunkedBytes() int {
+	return c.getIntOrDefault("p2p.chunking.maxBufferedBytes", defaultP2PMaxChunkedBytes)
+}
+
+// GetP2PChunkedTransferTimeout returns the duration after which an incomplete chunked transfer is discarded.
+func (c *configuration) GetP2PChunkedTransferTimeout() time.Duration {
+	return c.getDurationOrDefault("p2p.chunking.transferTimeout", defaultP2PChunkedTransferTimeout)
+}
+
 // GetP2PKeyPair returns the P2P key pair.
 func (c *configuration) GetP2PKeyPair() (pub, priv string) {
 	return c.getString("keys.p2p.publicKey"), c.getString("keys.p2p.privateKey")
@@ -149,6 +428,11 @@ func (c *configuration) GetServerAddress() string {
 	return fmt.Sprintf("%s:%s", c.getString("nodeHostname"), c.getString("nodePort"))
 }
 
+// GetMetricsAddress returns the address of form host:port where the metrics are served, empty if disabled.
+func (c *configuration) GetMetricsAddress() string {
+	return c.getString("metrics.address")
+}
+
 // GetNumWorkers returns number of queue workers defined in the config.
 func (c *configuration) GetNumWorkers() int {
 	return c.getInt("queue.numWorkers")
@@ -168,6 +452,31 @@ func (c *configuration) GetCentChainNodeURL() string {
 	return c.getString("centChain.nodeURL")
 }
 
+// GetCentChainNodeURLs returns the URLs of the CentChain Nodes, in order of preference.
+// The primary node URL is always the first one.
+func (c *configuration) GetCentChainNodeURLs() []string {
+	urls := []string{c.GetCentChainNodeURL()}
+
+	if !c.isSet("centChain.fallbackNodeURLs") {
+		return urls
+	}
+
+	for _, url := range cast.ToStringSlice(c.get("centChain.fallbackNodeURLs")) {
+		if url == "" || url == urls[0] {
+			continue
+		}
+
+		urls = append(urls, url)
+	}
+
+	return urls
+}
+
+// GetCentChainHealthCheckInterval returns the interval between CentChain Node health checks.
+func (c *configuration) GetCentChainHealthCheckInterval() time.Duration {
+	return c.getDurationOrDefault("centChain.healthCheckInterval", defaultCentChainHealthCheckInterval)
+}
+
 // GetCentChainIntervalRetry returns duration to wait between retries.
 func (c *configuration) GetCentChainIntervalRetry() time.Duration {
 	return c.getDuration("centChain.intervalRetry")
@@ -183,6 +492,36 @@ func (c *configuration) GetCentChainAnchorLifespan() time.Duration {
 	return c.getDuration("centChain.anchorLifespan")
 }
 
+// GetCentChainCacheTTL returns the TTL of the cached chain reads, a zero value disables the cache.
+func (c *configuration) GetCentChainCacheTTL() time.Duration {
+	return c.getDurationOrDefault("centChain.cacheTTL", defaultCentChainCacheTTL)
+}
+
+// GetCentChainAnchorBatchWindow returns the duration for which anchor commits are collected before being
+// submitted in a single batch, a zero value disables the batching.
+func (c *configuration) GetCentChainAnchorBatchWindow() time.Duration {
+	return c.getDurationOrDefault("centChain.anchorBatchWindow", defaultCentChainAnchorBatchWindow)
+}
+
+// GetCentChainLowBalanceThreshold returns the pod operator balance below which a low balance notification is sent.
+func (c *configuration) GetCentChainLowBalanceThreshold() *big.Int {
+	threshold := defaultCentChainLowBalanceThreshold
+
+	if c.isSet("centChain.lowBalanceThreshold") {
+		threshold = c.getString("centChain.lowBalanceThreshold")
+	}
+
+	res, ok := new(big.Int).SetString(threshold, 10)
+
+	if !ok {
+		log.Warnf("Invalid low balance threshold '%s', using default", threshold)
+
+		res, _ = new(big.Int).SetString(defaultCentChainLowBalanceThreshold, 10)
+	}
+
+	return res
+}
+
 // GetNetworkString returns defined network the node is connected to.
 func (c *configuration) GetNetworkString() string {
 	return c.getString("centrifugeNetwork")
@@ -259,6 +598,15 @@ func (c *configuration) getInt(key string) int {
 	return cast.ToInt(c.get(key))
 }
 
+// getIntOrDefault returns value int associated with key or the provided default if the key is not set.
+func (c *configuration) getIntOrDefault(key string, def int) int {
+	if !c.isSet(key) {
+		return def
+	}
+
+	return c.getInt(key)
+}
+
 // getFloat returns value float associated with key.
 func (c *configuration) getFloat(key string) float64 {
 	return cast.ToFloat64(c.get(key))
@@ -274,6 +622,22 @@ func (c *configuration) getDuration(key string) time.Duration {
 	return cast.ToDuration(c.get(key))
 }
 
+// getDurationOrDefault returns value duration associated with key or the provided default if the key is not set.
+func (c *configuration) getDurationOrDefault(key string, def time.Duration) time.Duration {
+	if !c.isSet(key) {
+		return def
+	}
+
+	return c.getDuration(key)
+}
+
+// isSet returns true if a value is associated with key.
+func (c *configuration) isSet(key string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.v.IsSet(key)
+}
+
 // AccountConfig holds the account details.
 type AccountConfig struct {
 	Address  string
@@ -496,8 +860,10 @@ type Account interface {
 	GetIdentity() *types.AccountID
 
 	GetSigningPublicKey() []byte
+	GetEncryptionPublicKey() []byte
 
 	SignMsg(msg []byte) (*coredocumentpb.Signature, error)
+	DecryptMsg(msg []byte) ([]byte, error)
 
 	GetWebhookURL() string
 	GetPrecommitEnabled() bool
@@ -0,0 +1,81 @@
+package crypto
+
+import (
+	"crypto/rand"
+
+	"github.com/centrifuge/pod/errors"
+	"golang.org/x/crypto/nacl/box"
+)
+
+const (
+	ErrInvalidEncryptionPublicKey  = errors.Error("invalid encryption public key")
+	ErrInvalidEncryptionPrivateKey = errors.Error("invalid encryption private key")
+	ErrMessageDecryption           = errors.Error("couldn't decrypt message")
+)
+
+// encryptionKeySize is the size of both the public and private X25519 keys.
+const encryptionKeySize = 32
+
+// GenerateEncryptionKeyPair generates a X25519 key pair used for encrypting messages.
+func GenerateEncryptionKeyPair() (publicKey, privateKey []byte, err error) {
+	pub, priv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pub[:], priv[:], nil
+}
+
+// EncryptMessage encrypts the message for the owner of the public key, using the curveType provided.
+//
+// The message is sealed in an anonymous NaCl box that can only be opened with the matching private key.
+func EncryptMessage(publicKey, message []byte, curveType CurveType) ([]byte, error) {
+	switch curveType {
+	case CurveX25519:
+		recipient, err := toEncryptionKey(publicKey, ErrInvalidEncryptionPublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return box.SealAnonymous(nil, message, recipient, rand.Reader)
+	default:
+		return nil, errors.New("curve %s not supported", curveType)
+	}
+}
+
+// DecryptMessage decrypts a message encrypted with EncryptMessage, using the key pair as the curveType provided.
+func DecryptMessage(publicKey, privateKey, message []byte, curveType CurveType) ([]byte, error) {
+	switch curveType {
+	case CurveX25519:
+		pub, err := toEncryptionKey(publicKey, ErrInvalidEncryptionPublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		priv, err := toEncryptionKey(privateKey, ErrInvalidEncryptionPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		res, ok := box.OpenAnonymous(nil, message, pub, priv)
+		if !ok {
+			return nil, ErrMessageDecryption
+		}
+
+		return res, nil
+	default:
+		return nil, errors.New("curve %s not supported", curveType)
+	}
+}
+
+func toEncryptionKey(key []byte, invalidKeyErr error) (*[encryptionKeySize]byte, error) {
+	if len(key) != encryptionKeySize {
+		return nil, invalidKeyErr
+	}
+
+	var res [encryptionKeySize]byte
+
+	copy(res[:], key)
+
+	return &res, nil
+}
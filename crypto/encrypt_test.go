@@ -0,0 +1,107 @@
+//go:build unit
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/curve25519"
+)
+
+func TestGenerateEncryptionKeyPair(t *testing.T) {
+	pub, priv, err := GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+	assert.Len(t, pub, 32)
+	assert.Len(t, priv, 32)
+
+	expectedPub, err := curve25519.X25519(priv, curve25519.Basepoint)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPub, pub)
+}
+
+func TestEncryptDecryptMessage(t *testing.T) {
+	pub, priv, err := GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	message := utils.RandomSlice(64)
+
+	encrypted, err := EncryptMessage(pub, message, CurveX25519)
+	assert.NoError(t, err)
+	assert.NotEqual(t, message, encrypted)
+
+	decrypted, err := DecryptMessage(pub, priv, encrypted, CurveX25519)
+	assert.NoError(t, err)
+	assert.Equal(t, message, decrypted)
+
+	// The message is encrypted with a different ephemeral key every time.
+	encryptedAgain, err := EncryptMessage(pub, message, CurveX25519)
+	assert.NoError(t, err)
+	assert.NotEqual(t, encrypted, encryptedAgain)
+}
+
+func TestDecryptMessage_WrongKey(t *testing.T) {
+	pub, _, err := GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	encrypted, err := EncryptMessage(pub, utils.RandomSlice(64), CurveX25519)
+	assert.NoError(t, err)
+
+	otherPub, otherPriv, err := GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	decrypted, err := DecryptMessage(otherPub, otherPriv, encrypted, CurveX25519)
+	assert.ErrorIs(t, err, ErrMessageDecryption)
+	assert.Nil(t, decrypted)
+}
+
+func TestDecryptMessage_TamperedMessage(t *testing.T) {
+	pub, priv, err := GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	encrypted, err := EncryptMessage(pub, utils.RandomSlice(64), CurveX25519)
+	assert.NoError(t, err)
+
+	encrypted[len(encrypted)-1] ^= 0xff
+
+	decrypted, err := DecryptMessage(pub, priv, encrypted, CurveX25519)
+	assert.ErrorIs(t, err, ErrMessageDecryption)
+	assert.Nil(t, decrypted)
+
+	decrypted, err = DecryptMessage(pub, priv, encrypted[:10], CurveX25519)
+	assert.ErrorIs(t, err, ErrMessageDecryption)
+	assert.Nil(t, decrypted)
+}
+
+func TestEncryptMessage_InvalidPublicKey(t *testing.T) {
+	encrypted, err := EncryptMessage(utils.RandomSlice(31), utils.RandomSlice(64), CurveX25519)
+	assert.ErrorIs(t, err, ErrInvalidEncryptionPublicKey)
+	assert.Nil(t, encrypted)
+}
+
+func TestDecryptMessage_InvalidKeys(t *testing.T) {
+	pub, priv, err := GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	decrypted, err := DecryptMessage(utils.RandomSlice(33), priv, utils.RandomSlice(64), CurveX25519)
+	assert.ErrorIs(t, err, ErrInvalidEncryptionPublicKey)
+	assert.Nil(t, decrypted)
+
+	decrypted, err = DecryptMessage(pub, utils.RandomSlice(64), utils.RandomSlice(64), CurveX25519)
+	assert.ErrorIs(t, err, ErrInvalidEncryptionPrivateKey)
+	assert.Nil(t, decrypted)
+}
+
+func TestEncryptMessageUnsupportedType(t *testing.T) {
+	pub, priv, err := GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	encrypted, err := EncryptMessage(pub, pub, CurveEd25519)
+	assert.Error(t, err)
+	assert.Empty(t, encrypted)
+
+	decrypted, err := DecryptMessage(pub, priv, pub, "rsa")
+	assert.Error(t, err)
+	assert.Empty(t, decrypted)
+}
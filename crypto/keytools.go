@@ -14,6 +14,9 @@ const CurveEd25519 CurveType = "ed25519"
 // CurveSr25519 Constants shared within subfolders
 const CurveSr25519 CurveType = "sr25519"
 
+// CurveX25519 Constants shared within subfolders
+const CurveX25519 CurveType = "x25519"
+
 // ObtainP2PKeypair obtains a key pair from given file paths
 func ObtainP2PKeypair(pubKeyFile, privKeyFile string) (priv crypto.PrivKey, pub crypto.PubKey, err error) {
 	// Create the signing key for the host
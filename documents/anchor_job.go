@@ -8,9 +8,12 @@ import (
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/gocelery/v2"
+	"github.com/centrifuge/pod/balance"
+	"github.com/centrifuge/pod/centchain"
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/contextutil"
 	"github.com/centrifuge/pod/jobs"
+	"github.com/centrifuge/pod/pallets/anchors"
 )
 
 func init() {
@@ -150,3 +153,16 @@ func initiateAnchorJob(
 
 	return job.ID, nil
 }
+
+// getAnchorJobCallProviderFn returns a provider for the commit call submitted by the anchor job,
+// the placeholder anchor arguments are only used for estimating the fee of the job.
+func getAnchorJobCallProviderFn(anchorLifespan time.Duration) balance.JobCallProviderFn {
+	return func(_ *types.AccountID) centchain.CallProviderFn {
+		return anchors.GetCommitCallProviderFn(
+			anchors.AnchorID{},
+			anchors.DocumentRoot{},
+			[32]byte{},
+			time.Now().UTC().Add(anchorLifespan),
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package attachments
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"reflect"
+
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+)
+
+const (
+	// MaxAttachmentSize is the max size of the file of an attachment. The content of an attachment is sent to
+	// the collaborators in a single P2P message, so room is left for the envelopes within the message size limit
+	// of the messenger (32 MB).
+	MaxAttachmentSize = 31 * 1024 * 1024
+)
+
+// Blob holds the content of a file attached to documents.
+type Blob struct {
+	// Hash is the sha256 hash of the data, it identifies the blob.
+	Hash []byte `json:"hash"`
+	// MIMEType is the MIME type of the file.
+	MIMEType string `json:"mime_type"`
+	// Data is the content of the file.
+	Data []byte `json:"data"`
+	// CID is the IPFS content identifier of the blob, if it was pinned.
+	CID string `json:"cid,omitempty"`
+}
+
+// NewBlob returns a blob holding the data.
+func NewBlob(data []byte, mimeType string) (*Blob, error) {
+	if len(data) > MaxAttachmentSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	hash := sha256.Sum256(data)
+
+	blob := &Blob{
+		Hash:     hash[:],
+		MIMEType: mimeType,
+		Data:     data,
+	}
+
+	if _, err := blob.Attachment(); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+// Attachment returns the attachment descriptor of the blob, which is recorded in the documents.
+func (b *Blob) Attachment() (documents.Attachment, error) {
+	att, err := documents.NewAttachment(b.Hash, uint64(len(b.Data)), b.MIMEType)
+	if err != nil {
+		return att, errors.NewTypedError(ErrInvalidAttachment, err)
+	}
+
+	return att, nil
+}
+
+// JSON marshals Blob to json bytes.
+func (b *Blob) JSON() ([]byte, error) {
+	return json.Marshal(b)
+}
+
+// FromJSON loads json bytes to Blob.
+func (b *Blob) FromJSON(data []byte) error {
+	return json.Unmarshal(data, b)
+}
+
+// Type returns the type of Blob.
+func (b *Blob) Type() reflect.Type {
+	return reflect.TypeOf(b)
+}
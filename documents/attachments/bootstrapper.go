@@ -0,0 +1,26 @@
+package attachments
+
+import (
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage"
+)
+
+const (
+	// BootstrappedStore is the key to the attachment blob store in the bootstrap context.
+	BootstrappedStore = "BootstrappedAttachmentStore"
+)
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+type Bootstrapper struct{}
+
+// Bootstrap initialises the attachment blob store.
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	db, ok := ctx[storage.BootstrappedDB].(storage.Repository)
+	if !ok {
+		return errors.New("db repository not initialised")
+	}
+
+	ctx[BootstrappedStore] = NewStore(db)
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package attachments
+
+import "github.com/centrifuge/pod/errors"
+
+const (
+	ErrInvalidAttachment      = errors.Error("invalid attachment")
+	ErrAttachmentTooLarge     = errors.Error("attachment too large")
+	ErrAttachmentNotFound     = errors.Error("attachment not found")
+	ErrAttachmentPersistence  = errors.Error("couldn't persist attachment")
+	ErrAttachmentPinning      = errors.Error("couldn't pin attachment")
+	ErrAttachmentIntegrity    = errors.Error("attachment doesn't match its hash or size")
+	ErrAttachmentNotAvailable = errors.Error("attachment not available from the collaborators")
+	ErrNotAnAttachment        = errors.Error("attribute is not an attachment")
+)
@@ -0,0 +1,183 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/ipfs"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	logging "github.com/ipfs/go-log"
+)
+
+var log = logging.Logger("attachments")
+
+//go:generate mockery --name Service --structname ServiceMock --filename service_mock.go --inpackage
+
+// Service handles the files attached to documents. The files are stored off-document, the documents only
+// record their hash, size and MIME type in attachment attributes.
+type Service interface {
+	// Upload stores the file for the account in the context and returns its blob.
+	// The blob is also pinned to IPFS if pin is true.
+	Upload(ctx context.Context, data []byte, mimeType string, pin bool) (*Blob, error)
+
+	// Download returns the file of the attachment attribute with the label, from the latest version of the document.
+	// The file is retrieved from the collaborators of the document if it's not stored locally.
+	Download(ctx context.Context, documentID []byte, label string) (*Blob, error)
+}
+
+type service struct {
+	store          Store
+	docSrv         documents.Service
+	p2pClient      documents.Client
+	pinningService ipfs.PinningServiceClient
+}
+
+// NewService returns a Service that stores the files in the provided store.
+func NewService(
+	store Store,
+	docSrv documents.Service,
+	p2pClient documents.Client,
+	pinningService ipfs.PinningServiceClient,
+) Service {
+	return &service{
+		store:          store,
+		docSrv:         docSrv,
+		p2pClient:      p2pClient,
+		pinningService: pinningService,
+	}
+}
+
+// pinnedBlob is the IPFS representation of a blob.
+type pinnedBlob struct {
+	MIMEType string `json:"mime_type"`
+	Data     []byte `json:"data"`
+}
+
+func (s *service) Upload(ctx context.Context, data []byte, mimeType string, pin bool) (*Blob, error) {
+	identity, err := contextutil.Identity(ctx)
+	if err != nil {
+		return nil, errors.ErrContextIdentityRetrieval
+	}
+
+	blob, err := NewBlob(data, mimeType)
+	if err != nil {
+		return nil, err
+	}
+
+	if pin {
+		res, err := s.pinningService.PinData(ctx, &ipfs.PinRequest{
+			CIDVersion: 1,
+			Data: pinnedBlob{
+				MIMEType: blob.MIMEType,
+				Data:     blob.Data,
+			},
+			Metadata: map[string]string{
+				"hash": hexutil.Encode(blob.Hash),
+			},
+		})
+
+		if err != nil {
+			log.Errorf("Couldn't pin attachment: %s", err)
+
+			return nil, errors.NewTypedError(ErrAttachmentPinning, err)
+		}
+
+		blob.CID = res.CID
+	}
+
+	if err := s.store.Put(identity, blob); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+func (s *service) Download(ctx context.Context, documentID []byte, label string) (*Blob, error) {
+	identity, err := contextutil.Identity(ctx)
+	if err != nil {
+		return nil, errors.ErrContextIdentityRetrieval
+	}
+
+	doc, err := s.docSrv.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	attr, err := documents.FindAttribute(doc, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if attr.Value.Type != documents.AttrAttachment {
+		return nil, ErrNotAnAttachment
+	}
+
+	att := attr.Value.Attachment
+
+	if blob, err := s.store.Get(identity, att.Hash); err == nil {
+		return blob, nil
+	}
+
+	blob, err := s.fetchBlob(ctx, identity, doc, att)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Put(identity, blob); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+// fetchBlob retrieves the blob of the attachment from the collaborators of the document, the first blob
+// that matches the attachment is returned.
+func (s *service) fetchBlob(
+	ctx context.Context,
+	identity *types.AccountID,
+	doc documents.Document,
+	att documents.Attachment,
+) (*Blob, error) {
+	collaborators, err := doc.GetCollaborators(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, collaborator := range append(collaborators.ReadWriteCollaborators, collaborators.ReadCollaborators...) {
+		data, err := s.p2pClient.GetAttachmentRequest(ctx, collaborator, doc.ID(), att.Hash)
+		if err != nil {
+			log.Warnf("Couldn't retrieve attachment from collaborator %s: %s", collaborator.ToHexString(), err)
+
+			continue
+		}
+
+		if err := validateBlobData(data, att); err != nil {
+			log.Warnf("Invalid attachment received from collaborator %s: %s", collaborator.ToHexString(), err)
+
+			continue
+		}
+
+		return &Blob{
+			Hash:     att.Hash,
+			MIMEType: att.MIMEType,
+			Data:     data,
+		}, nil
+	}
+
+	return nil, ErrAttachmentNotAvailable
+}
+
+func validateBlobData(data []byte, att documents.Attachment) error {
+	hash := sha256.Sum256(data)
+
+	if uint64(len(data)) != att.Size || !bytes.Equal(hash[:], att.Hash) {
+		return ErrAttachmentIntegrity
+	}
+
+	return nil
+}
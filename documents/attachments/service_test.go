@@ -0,0 +1,265 @@
+//go:build unit
+
+package attachments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/ipfs"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	genericUtils "github.com/centrifuge/pod/testingutils/generic"
+	"github.com/centrifuge/pod/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_Upload(t *testing.T) {
+	srv, mocks := getServiceWithMocks(t)
+
+	ctx, identity := getTestContext(t)
+
+	data := utils.RandomSlice(64)
+
+	genericUtils.GetMock[*StoreMock](mocks).On("Put", identity, mock.Anything).
+		Return(nil).
+		Times(2)
+
+	blob, err := srv.Upload(ctx, data, "application/pdf", false)
+	assert.NoError(t, err)
+	assert.Equal(t, data, blob.Data)
+	assert.Equal(t, "application/pdf", blob.MIMEType)
+	assert.Empty(t, blob.CID)
+
+	genericUtils.GetMock[*ipfs.PinningServiceClientMock](mocks).On("PinData", ctx, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(*ipfs.PinRequest)
+			assert.Equal(t, 1, req.CIDVersion)
+			assert.Equal(t, pinnedBlob{MIMEType: "application/pdf", Data: data}, req.Data)
+			assert.Equal(t, hexutil.Encode(blob.Hash), req.Metadata["hash"])
+		}).
+		Return(&ipfs.PinResponse{CID: "cid"}, nil).
+		Once()
+
+	blob, err = srv.Upload(ctx, data, "application/pdf", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "cid", blob.CID)
+}
+
+func TestService_Upload_Errors(t *testing.T) {
+	srv, mocks := getServiceWithMocks(t)
+
+	// No identity in context.
+	_, err := srv.Upload(context.Background(), utils.RandomSlice(64), "application/pdf", false)
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+
+	ctx, identity := getTestContext(t)
+
+	_, err = srv.Upload(ctx, utils.RandomSlice(64), "", false)
+	assert.True(t, errors.IsOfType(ErrInvalidAttachment, err))
+
+	_, err = srv.Upload(ctx, make([]byte, MaxAttachmentSize+1), "application/pdf", false)
+	assert.ErrorIs(t, err, ErrAttachmentTooLarge)
+
+	genericUtils.GetMock[*ipfs.PinningServiceClientMock](mocks).On("PinData", ctx, mock.Anything).
+		Return(nil, errors.New("error")).
+		Once()
+
+	_, err = srv.Upload(ctx, utils.RandomSlice(64), "application/pdf", true)
+	assert.True(t, errors.IsOfType(ErrAttachmentPinning, err))
+
+	genericUtils.GetMock[*StoreMock](mocks).On("Put", identity, mock.Anything).
+		Return(ErrAttachmentPersistence).
+		Once()
+
+	_, err = srv.Upload(ctx, utils.RandomSlice(64), "application/pdf", false)
+	assert.ErrorIs(t, err, ErrAttachmentPersistence)
+}
+
+func TestService_Download_Local(t *testing.T) {
+	srv, mocks := getServiceWithMocks(t)
+
+	ctx, identity := getTestContext(t)
+
+	blob, err := NewBlob(utils.RandomSlice(64), "application/pdf")
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	docMock := getTestDocument(t, blob)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On("GetCurrentVersion", ctx, documentID).
+		Return(docMock, nil).
+		Once()
+
+	genericUtils.GetMock[*StoreMock](mocks).On("Get", identity, blob.Hash).
+		Return(blob, nil).
+		Once()
+
+	res, err := srv.Download(ctx, documentID, "invoice_pdf")
+	assert.NoError(t, err)
+	assert.Equal(t, blob, res)
+}
+
+func TestService_Download_Remote(t *testing.T) {
+	srv, mocks := getServiceWithMocks(t)
+
+	ctx, identity := getTestContext(t)
+
+	blob, err := NewBlob(utils.RandomSlice(64), "application/pdf")
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	docMock := getTestDocument(t, blob)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On("GetCurrentVersion", ctx, documentID).
+		Return(docMock, nil).
+		Once()
+
+	genericUtils.GetMock[*StoreMock](mocks).On("Get", identity, blob.Hash).
+		Return(nil, ErrAttachmentNotFound).
+		Once()
+
+	unreachable, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	dishonest, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	honest, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	docMock.On("GetCollaborators", identity).
+		Return(documents.CollaboratorsAccess{
+			ReadWriteCollaborators: []*types.AccountID{unreachable},
+			ReadCollaborators:      []*types.AccountID{dishonest, honest},
+		}, nil).
+		Once()
+
+	docMock.On("ID").Return(documentID)
+
+	genericUtils.GetMock[*documents.ClientMock](mocks).On("GetAttachmentRequest", ctx, unreachable, documentID, blob.Hash).
+		Return(nil, errors.New("error")).
+		Once()
+
+	genericUtils.GetMock[*documents.ClientMock](mocks).On("GetAttachmentRequest", ctx, dishonest, documentID, blob.Hash).
+		Return(utils.RandomSlice(64), nil).
+		Once()
+
+	genericUtils.GetMock[*documents.ClientMock](mocks).On("GetAttachmentRequest", ctx, honest, documentID, blob.Hash).
+		Return(blob.Data, nil).
+		Once()
+
+	genericUtils.GetMock[*StoreMock](mocks).On("Put", identity, blob).
+		Return(nil).
+		Once()
+
+	res, err := srv.Download(ctx, documentID, "invoice_pdf")
+	assert.NoError(t, err)
+	assert.Equal(t, blob, res)
+}
+
+func TestService_Download_Errors(t *testing.T) {
+	srv, mocks := getServiceWithMocks(t)
+
+	documentID := utils.RandomSlice(32)
+
+	// No identity in context.
+	_, err := srv.Download(context.Background(), documentID, "invoice_pdf")
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+
+	ctx, identity := getTestContext(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On("GetCurrentVersion", ctx, documentID).
+		Return(nil, documents.ErrDocumentNotFound).
+		Once()
+
+	_, err = srv.Download(ctx, documentID, "invoice_pdf")
+	assert.ErrorIs(t, err, documents.ErrDocumentNotFound)
+
+	blob, err := NewBlob(utils.RandomSlice(64), "application/pdf")
+	assert.NoError(t, err)
+
+	docMock := getTestDocument(t, blob)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On("GetCurrentVersion", ctx, documentID).
+		Return(docMock, nil)
+
+	// The attribute is not an attachment.
+	_, err = srv.Download(ctx, documentID, "title")
+	assert.ErrorIs(t, err, ErrNotAnAttachment)
+
+	// No collaborator provides the attachment.
+	genericUtils.GetMock[*StoreMock](mocks).On("Get", identity, blob.Hash).
+		Return(nil, ErrAttachmentNotFound).
+		Once()
+
+	collaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	docMock.On("GetCollaborators", identity).
+		Return(documents.CollaboratorsAccess{
+			ReadWriteCollaborators: []*types.AccountID{collaborator},
+		}, nil).
+		Once()
+
+	docMock.On("ID").Return(documentID)
+
+	genericUtils.GetMock[*documents.ClientMock](mocks).On("GetAttachmentRequest", ctx, collaborator, documentID, blob.Hash).
+		Return(nil, errors.New("error")).
+		Once()
+
+	_, err = srv.Download(ctx, documentID, "invoice_pdf")
+	assert.ErrorIs(t, err, ErrAttachmentNotAvailable)
+}
+
+func getTestContext(t *testing.T) (context.Context, *types.AccountID) {
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	return contextutil.WithAccount(context.Background(), accountMock), identity
+}
+
+// getTestDocument returns a document mock holding an attachment attribute of the blob labelled `invoice_pdf`,
+// and a string attribute labelled `title`.
+func getTestDocument(t *testing.T, blob *Blob) *documents.DocumentMock {
+	att, err := blob.Attachment()
+	assert.NoError(t, err)
+
+	attachmentAttr, err := documents.NewAttachmentAttribute("invoice_pdf", att)
+	assert.NoError(t, err)
+
+	titleAttr, err := documents.NewStringAttribute("title", documents.AttrString, "invoice")
+	assert.NoError(t, err)
+
+	docMock := documents.NewDocumentMock(t)
+	docMock.On("GetAttribute", attachmentAttr.Key).Return(attachmentAttr, nil).Maybe()
+	docMock.On("GetAttribute", titleAttr.Key).Return(titleAttr, nil).Maybe()
+
+	return docMock
+}
+
+func getServiceWithMocks(t *testing.T) (Service, []any) {
+	storeMock := NewStoreMock(t)
+	docSrvMock := documents.NewServiceMock(t)
+	p2pClientMock := documents.NewClientMock(t)
+	pinningServiceMock := ipfs.NewPinningServiceClientMock(t)
+
+	srv := NewService(storeMock, docSrvMock, p2pClientMock, pinningServiceMock)
+
+	return srv, []any{
+		storeMock,
+		docSrvMock,
+		p2pClientMock,
+		pinningServiceMock,
+	}
+}
@@ -0,0 +1,73 @@
+package attachments
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// blobPrefix is the DB prefix of the attachment blobs.
+	blobPrefix = "attachment_blob_"
+)
+
+//go:generate mockery --name Store --structname StoreMock --filename store_mock.go --inpackage
+
+// Store stores the attachment blobs of the accounts of the node.
+type Store interface {
+	// Put stores the blob for the account, replacing the blob with the same hash if any.
+	Put(accountID *types.AccountID, blob *Blob) error
+
+	// Get returns the blob with the provided hash stored for the account.
+	Get(accountID *types.AccountID, hash []byte) (*Blob, error)
+}
+
+type store struct {
+	db storage.Repository
+}
+
+// NewStore returns a Store that persists the blobs in the provided DB.
+func NewStore(db storage.Repository) Store {
+	db.Register(new(Blob))
+
+	return &store{
+		db: db,
+	}
+}
+
+func (s *store) Put(accountID *types.AccountID, blob *Blob) error {
+	key := getBlobKey(accountID, blob.Hash)
+
+	var err error
+
+	if s.db.Exists(key) {
+		err = s.db.Update(key, blob)
+	} else {
+		err = s.db.Create(key, blob)
+	}
+
+	if err != nil {
+		return errors.NewTypedError(ErrAttachmentPersistence, err)
+	}
+
+	return nil
+}
+
+func (s *store) Get(accountID *types.AccountID, hash []byte) (*Blob, error) {
+	model, err := s.db.Get(getBlobKey(accountID, hash))
+	if err != nil {
+		return nil, errors.NewTypedError(ErrAttachmentNotFound, err)
+	}
+
+	blob, ok := model.(*Blob)
+	if !ok {
+		return nil, errors.NewTypedError(ErrAttachmentNotFound, errors.New("invalid blob type"))
+	}
+
+	return blob, nil
+}
+
+func getBlobKey(accountID *types.AccountID, hash []byte) []byte {
+	return []byte(blobPrefix + accountID.ToHexString() + "_" + hexutil.Encode(hash))
+}
@@ -0,0 +1,73 @@
+//go:build unit
+
+package attachments
+
+import (
+	"os"
+	"testing"
+
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage/leveldb"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testStoreStoragePattern = "attachment-store-*"
+)
+
+func getTestStore(t *testing.T) Store {
+	randomStoragePath, err := testingcommons.GetRandomTestStoragePath(testStoreStoragePattern)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = os.RemoveAll(randomStoragePath)
+	})
+
+	db, err := leveldb.NewLevelDBStorage(randomStoragePath)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return NewStore(leveldb.NewLevelDBRepository(db))
+}
+
+func TestStore(t *testing.T) {
+	store := getTestStore(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	otherAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	blob, err := NewBlob(utils.RandomSlice(128), "application/pdf")
+	assert.NoError(t, err)
+
+	_, err = store.Get(accountID, blob.Hash)
+	assert.True(t, errors.IsOfType(ErrAttachmentNotFound, err))
+
+	err = store.Put(accountID, blob)
+	assert.NoError(t, err)
+
+	res, err := store.Get(accountID, blob.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, blob, res)
+
+	// Storing the blob again replaces it.
+	blob.CID = "cid"
+
+	err = store.Put(accountID, blob)
+	assert.NoError(t, err)
+
+	res, err = store.Get(accountID, blob.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, blob, res)
+
+	// The blobs are stored per account.
+	_, err = store.Get(otherAccountID, blob.Hash)
+	assert.True(t, errors.IsOfType(ErrAttachmentNotFound, err))
+}
@@ -0,0 +1,11 @@
+//go:build integration || testworld
+
+package attachments
+
+func (b Bootstrapper) TestBootstrap(context map[string]interface{}) error {
+	return b.Bootstrap(context)
+}
+
+func (Bootstrapper) TestTearDown() error {
+	return nil
+}
@@ -1,7 +1,11 @@
 package documents
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -53,6 +57,21 @@ const (
 	// AttrMonetary is the monetary attribute type
 	AttrMonetary AttributeType = "monetary"
 
+	// AttrReference is the attribute type that references another document
+	AttrReference AttributeType = "reference"
+
+	// AttrAttachment is the attribute type of a file that is stored off-document.
+	// Only the hash, size and MIME type of the file are part of the document.
+	AttrAttachment AttributeType = "attachment"
+
+	// AttrList is the attribute type for an ordered list of values.
+	// Every element is stored as a separate attribute labelled `label[index]`.
+	AttrList AttributeType = "list"
+
+	// AttrMap is the attribute type for a map of string keys to values.
+	// Every element is stored as a separate attribute labelled `label.key`.
+	AttrMap AttributeType = "map"
+
 	// MonetaryToken is the monetary type for tokens
 	MonetaryToken MonetaryType = "token"
 )
@@ -60,13 +79,29 @@ const (
 // isAttrTypeAllowed checks if the given attribute type is implemented and returns its `reflect.Type` if allowed.
 func isAttrTypeAllowed(attr AttributeType) bool {
 	switch attr {
-	case AttrInt256, AttrDecimal, AttrString, AttrBytes, AttrTimestamp, AttrSigned, AttrMonetary:
+	case AttrInt256, AttrDecimal, AttrString, AttrBytes, AttrTimestamp, AttrSigned, AttrMonetary, AttrReference, AttrAttachment, AttrList, AttrMap:
 		return true
 	default:
 		return false
 	}
 }
 
+// isAttrElementTypeAllowed checks if the given attribute type can be used for the elements of a list or map.
+// Signed values are bound to their own attribute label and cannot be nested.
+func isAttrElementTypeAllowed(attr AttributeType) bool {
+	return isAttrTypeAllowed(attr) && attr != AttrSigned
+}
+
+// ListElementLabel returns the label of the list element at the given index.
+func ListElementLabel(label string, index int) string {
+	return fmt.Sprintf("%s[%d]", label, index)
+}
+
+// MapElementLabel returns the label of the map element with the given key.
+func MapElementLabel(label, key string) string {
+	return label + "." + key
+}
+
 // AttrKey represents a sha256 hash of a attribute label given by a user.
 type AttrKey [32]byte
 
@@ -143,16 +178,210 @@ func (m Monetary) String() string {
 	return fmt.Sprintf("%s %s%s", m.Value.String(), mID, chStr)
 }
 
+// Reference is a custom attribute type that references another document.
+// VersionID and DocumentRoot are optional and pin the reference to a specific anchored version of the document.
+type Reference struct {
+	DocumentID   []byte
+	VersionID    []byte
+	DocumentRoot []byte
+}
+
+// NewReference creates a new reference to the document.
+// versionID and documentRoot can be empty. A document root can only be pinned together with the version.
+func NewReference(documentID, versionID, documentRoot []byte) (ref Reference, err error) {
+	if len(documentID) != referenceFieldLength {
+		return ref, errors.NewTypedError(ErrWrongAttrFormat, errors.New("invalid referenced document ID"))
+	}
+
+	if len(versionID) != 0 && len(versionID) != referenceFieldLength {
+		return ref, errors.NewTypedError(ErrWrongAttrFormat, errors.New("invalid referenced version ID"))
+	}
+
+	if len(documentRoot) != 0 && (len(documentRoot) != referenceFieldLength || len(versionID) == 0) {
+		return ref, errors.NewTypedError(ErrWrongAttrFormat, errors.New("invalid referenced document root"))
+	}
+
+	return Reference{DocumentID: documentID, VersionID: versionID, DocumentRoot: documentRoot}, nil
+}
+
+// ReferenceFromBytes decodes the reference from its byte representation.
+func ReferenceFromBytes(b []byte) (Reference, error) {
+	if len(b) != 3*referenceFieldLength {
+		return Reference{}, errors.NewTypedError(ErrWrongAttrFormat, errors.New("invalid reference length"))
+	}
+
+	unpinned := make([]byte, referenceFieldLength)
+	var fields [3][]byte
+	for i := range fields {
+		f := b[i*referenceFieldLength : (i+1)*referenceFieldLength]
+		if i > 0 && bytes.Equal(f, unpinned) {
+			continue
+		}
+
+		fields[i] = append([]byte(nil), f...)
+	}
+
+	return NewReference(fields[0], fields[1], fields[2])
+}
+
+// Bytes returns the byte representation of the reference.
+// The version ID and document root are zero padded if the reference is not pinned.
+func (r Reference) Bytes() []byte {
+	b := make([]byte, 3*referenceFieldLength)
+	for i, f := range [][]byte{r.DocumentID, r.VersionID, r.DocumentRoot} {
+		copy(b[i*referenceFieldLength:], f)
+	}
+
+	return b
+}
+
+// AnchoredVersion returns the version of the referenced document that must be anchored.
+// The first version of the document shares its ID with the document.
+func (r Reference) AnchoredVersion() []byte {
+	if len(r.VersionID) != 0 {
+		return r.VersionID
+	}
+
+	return r.DocumentID
+}
+
+// String returns the readable representation of the reference
+func (r Reference) String() string {
+	if len(r.VersionID) == 0 {
+		return hexutil.Encode(r.DocumentID)
+	}
+
+	return fmt.Sprintf("%s@%s", hexutil.Encode(r.DocumentID), hexutil.Encode(r.VersionID))
+}
+
+// Attachment is a custom attribute type that describes a file stored off-document.
+// The file is identified by the sha256 hash of its content.
+type Attachment struct {
+	Hash     []byte
+	Size     uint64
+	MIMEType string
+}
+
+// NewAttachment creates a new attachment descriptor.
+func NewAttachment(hash []byte, size uint64, mimeType string) (att Attachment, err error) {
+	if len(hash) != attachmentHashLength {
+		return att, errors.NewTypedError(ErrWrongAttrFormat, errors.New("invalid attachment hash"))
+	}
+
+	if strings.TrimSpace(mimeType) == "" {
+		return att, errors.NewTypedError(ErrWrongAttrFormat, errors.New("empty attachment MIME type"))
+	}
+
+	return Attachment{Hash: hash, Size: size, MIMEType: mimeType}, nil
+}
+
+// AttachmentFromBytes decodes the attachment from its byte representation.
+func AttachmentFromBytes(b []byte) (Attachment, error) {
+	if len(b) <= attachmentHashLength+8 {
+		return Attachment{}, errors.NewTypedError(ErrWrongAttrFormat, errors.New("invalid attachment length"))
+	}
+
+	return NewAttachment(
+		append([]byte(nil), b[:attachmentHashLength]...),
+		binary.BigEndian.Uint64(b[attachmentHashLength:]),
+		string(b[attachmentHashLength+8:]),
+	)
+}
+
+// Bytes returns the byte representation of the attachment, which is the hash, followed by the
+// big endian size and the MIME type.
+func (a Attachment) Bytes() []byte {
+	b := make([]byte, attachmentHashLength+8+len(a.MIMEType))
+	copy(b, a.Hash)
+	binary.BigEndian.PutUint64(b[attachmentHashLength:], a.Size)
+	copy(b[attachmentHashLength+8:], a.MIMEType)
+
+	return b
+}
+
+// String returns the readable representation of the attachment
+func (a Attachment) String() string {
+	return fmt.Sprintf("%s (%s, %d bytes)", hexutil.Encode(a.Hash), a.MIMEType, a.Size)
+}
+
 // AttrVal represents a strongly typed value of an attribute
 type AttrVal struct {
-	Type      AttributeType
-	Int256    *Int256
-	Decimal   *Decimal
-	Str       string
-	Bytes     []byte
-	Timestamp *timestamppb.Timestamp
-	Signed    Signed
-	Monetary  Monetary
+	Type       AttributeType
+	Int256     *Int256
+	Decimal    *Decimal
+	Str        string
+	Bytes      []byte
+	Timestamp  *timestamppb.Timestamp
+	Signed     Signed
+	Monetary   Monetary
+	Reference  Reference
+	Attachment Attachment
+	List       []AttrVal
+	Map        map[string]AttrVal
+}
+
+// validateElements checks recursively that the elements of list and map values are of allowed types.
+func (attrVal AttrVal) validateElements() error {
+	var elems []AttrVal
+	switch attrVal.Type {
+	case AttrList:
+		elems = attrVal.List
+	case AttrMap:
+		for k, v := range attrVal.Map {
+			if strings.TrimSpace(k) == "" {
+				return errors.NewTypedError(ErrWrongAttrFormat, errors.New("empty map key"))
+			}
+
+			elems = append(elems, v)
+		}
+	default:
+		return nil
+	}
+
+	for _, elem := range elems {
+		if !isAttrElementTypeAllowed(elem.Type) {
+			return ErrNotValidAttrType
+		}
+
+		if err := elem.validateElements(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// plainValue returns the value in a form that can be encoded to JSON.
+// Scalar values are represented by their string, lists and maps by their plain elements.
+func (attrVal AttrVal) plainValue() (interface{}, error) {
+	switch attrVal.Type {
+	case AttrList:
+		list := make([]interface{}, len(attrVal.List))
+		for i, elem := range attrVal.List {
+			v, err := elem.plainValue()
+			if err != nil {
+				return nil, err
+			}
+
+			list[i] = v
+		}
+
+		return list, nil
+	case AttrMap:
+		m := make(map[string]interface{}, len(attrVal.Map))
+		for k, elem := range attrVal.Map {
+			v, err := elem.plainValue()
+			if err != nil {
+				return nil, err
+			}
+
+			m[k] = v
+		}
+
+		return m, nil
+	default:
+		return attrVal.String()
+	}
 }
 
 // ToBytes encodes attribute value into bytes.
@@ -169,6 +398,10 @@ func (attrVal AttrVal) ToBytes() ([]byte, error) {
 		return attrVal.Bytes, nil
 	case AttrTimestamp:
 		return byteutils.TimestampToBytes(attrVal.Timestamp, maxTimeByteLength)
+	case AttrReference:
+		return attrVal.Reference.Bytes(), nil
+	case AttrAttachment:
+		return attrVal.Attachment.Bytes(), nil
 	default:
 		return nil, ErrNotValidAttrType
 	}
@@ -224,6 +457,20 @@ func (attrVal AttrVal) String() (str string, err error) {
 		str = attrVal.Signed.String()
 	case AttrMonetary:
 		str = attrVal.Monetary.String()
+	case AttrReference:
+		str = attrVal.Reference.String()
+	case AttrAttachment:
+		str = attrVal.Attachment.String()
+	case AttrList, AttrMap:
+		var v interface{}
+		v, err = attrVal.plainValue()
+		if err != nil {
+			return str, err
+		}
+
+		var b []byte
+		b, err = json.Marshal(v)
+		str = string(b)
 	}
 
 	return str, err
@@ -255,6 +502,205 @@ func NewStringAttribute(keyLabel string, attrType AttributeType, value string) (
 	}, nil
 }
 
+// NewReferenceAttribute creates a new attribute that references another document.
+func NewReferenceAttribute(keyLabel string, ref Reference) (attr Attribute, err error) {
+	attrKey, err := AttrKeyFromLabel(keyLabel)
+	if err != nil {
+		return attr, err
+	}
+
+	ref, err = NewReference(ref.DocumentID, ref.VersionID, ref.DocumentRoot)
+	if err != nil {
+		return attr, err
+	}
+
+	return Attribute{
+		KeyLabel: keyLabel,
+		Key:      attrKey,
+		Value:    AttrVal{Type: AttrReference, Reference: ref},
+	}, nil
+}
+
+// NewAttachmentAttribute creates a new attribute that describes a file stored off-document.
+func NewAttachmentAttribute(keyLabel string, att Attachment) (attr Attribute, err error) {
+	attrKey, err := AttrKeyFromLabel(keyLabel)
+	if err != nil {
+		return attr, err
+	}
+
+	att, err = NewAttachment(att.Hash, att.Size, att.MIMEType)
+	if err != nil {
+		return attr, err
+	}
+
+	return Attribute{
+		KeyLabel: keyLabel,
+		Key:      attrKey,
+		Value:    AttrVal{Type: AttrAttachment, Attachment: att},
+	}, nil
+}
+
+// attachments returns the attachments held by the value, including the ones held by list and map elements.
+func (attrVal AttrVal) attachments() (atts []Attachment) {
+	switch attrVal.Type {
+	case AttrAttachment:
+		return []Attachment{attrVal.Attachment}
+	case AttrList:
+		for _, elem := range attrVal.List {
+			atts = append(atts, elem.attachments()...)
+		}
+	case AttrMap:
+		for _, k := range sortedMapKeys(attrVal.Map) {
+			atts = append(atts, attrVal.Map[k].attachments()...)
+		}
+	}
+
+	return atts
+}
+
+// HasAttachment checks if the document holds an attachment attribute with the given content hash.
+func HasAttachment(doc Document, hash []byte) bool {
+	for _, attr := range doc.GetAttributes() {
+		for _, att := range attr.Value.attachments() {
+			if bytes.Equal(att.Hash, hash) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// references returns the references held by the value, including the ones held by list and map elements.
+func (attrVal AttrVal) references() (refs []Reference) {
+	switch attrVal.Type {
+	case AttrReference:
+		return []Reference{attrVal.Reference}
+	case AttrList:
+		for _, elem := range attrVal.List {
+			refs = append(refs, elem.references()...)
+		}
+	case AttrMap:
+		for _, k := range sortedMapKeys(attrVal.Map) {
+			refs = append(refs, attrVal.Map[k].references()...)
+		}
+	}
+
+	return refs
+}
+
+// NewListAttribute creates a new list attribute with the given elements.
+func NewListAttribute(keyLabel string, elems []AttrVal) (attr Attribute, err error) {
+	return newCompositeAttribute(keyLabel, AttrVal{Type: AttrList, List: elems})
+}
+
+// NewMapAttribute creates a new map attribute with the given elements.
+func NewMapAttribute(keyLabel string, elems map[string]AttrVal) (attr Attribute, err error) {
+	return newCompositeAttribute(keyLabel, AttrVal{Type: AttrMap, Map: elems})
+}
+
+func newCompositeAttribute(keyLabel string, attrVal AttrVal) (attr Attribute, err error) {
+	attrKey, err := AttrKeyFromLabel(keyLabel)
+	if err != nil {
+		return attr, err
+	}
+
+	if err := attrVal.validateElements(); err != nil {
+		return attr, err
+	}
+
+	return Attribute{
+		KeyLabel: keyLabel,
+		Key:      attrKey,
+		Value:    attrVal,
+	}, nil
+}
+
+// elements returns the elements of a list or map attribute as separate attributes.
+// Map elements are returned sorted by their key. Scalar attributes have no elements.
+func (attr Attribute) elements() (elems []Attribute, err error) {
+	var labels []string
+	var vals []AttrVal
+	switch attr.Value.Type {
+	case AttrList:
+		for i, v := range attr.Value.List {
+			labels = append(labels, ListElementLabel(attr.KeyLabel, i))
+			vals = append(vals, v)
+		}
+	case AttrMap:
+		for _, k := range sortedMapKeys(attr.Value.Map) {
+			labels = append(labels, MapElementLabel(attr.KeyLabel, k))
+			vals = append(vals, attr.Value.Map[k])
+		}
+	}
+
+	for i, label := range labels {
+		key, err := AttrKeyFromLabel(label)
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, Attribute{KeyLabel: label, Key: key, Value: vals[i]})
+	}
+
+	return elems, nil
+}
+
+// FindAttribute returns the attribute of the document with the given label.
+// The label can also refer to an element of a list or map attribute.
+func FindAttribute(doc Document, label string) (attr Attribute, err error) {
+	key, err := AttrKeyFromLabel(label)
+	if err != nil {
+		return attr, err
+	}
+
+	if attr, err = doc.GetAttribute(key); err == nil {
+		return attr, nil
+	}
+
+	for _, attr := range doc.GetAttributes() {
+		if elem, ok := findAttributeElement(attr, key); ok {
+			return elem, nil
+		}
+	}
+
+	return attr, errors.NewTypedError(ErrCDAttribute, errors.New("attribute does not exist"))
+}
+
+func findAttributeElement(attr Attribute, key AttrKey) (Attribute, bool) {
+	elems, err := attr.elements()
+	if err != nil {
+		return Attribute{}, false
+	}
+
+	for _, elem := range elems {
+		if elem.Key == key {
+			return elem, true
+		}
+
+		if nested, ok := findAttributeElement(elem, key); ok {
+			return nested, true
+		}
+	}
+
+	return Attribute{}, false
+}
+
+// referenceProofField returns the name of the proof field that holds the value of the reference attribute.
+func referenceProofField(key AttrKey) string {
+	return fmt.Sprintf("%s.attributes[%s].byte_val", CDTreePrefix, key.String())
+}
+
+func sortedMapKeys(m map[string]AttrVal) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
 // NewMonetaryAttribute creates new instance of Monetary Attribute
 func NewMonetaryAttribute(keyLabel string, value *Decimal, chainID []byte, id string) (attr Attribute, err error) {
 	if value == nil {
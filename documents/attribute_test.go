@@ -15,6 +15,7 @@ import (
 	"github.com/centrifuge/pod/utils"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestAttribute_isAttrTypeAllowed(t *testing.T) {
@@ -319,3 +320,201 @@ func TestGenerateDocumentSignatureProofField(t *testing.T) {
 	signatureSender := fmt.Sprintf("%s.signatures[%s]", SignaturesTreePrefix, signerId)
 	fmt.Println("SignatureSender", signatureSender)
 }
+
+func TestNewListAttribute(t *testing.T) {
+	item, err := NewMapAttribute("item", map[string]AttrVal{
+		"description": {Type: AttrString, Str: "widget"},
+		"amount":      {Type: AttrString, Str: "10"},
+	})
+	assert.NoError(t, err)
+
+	attr, err := NewListAttribute("line_items", []AttrVal{item.Value, {Type: AttrString, Str: "note"}})
+	assert.NoError(t, err)
+	assert.Equal(t, AttrList, attr.Value.Type)
+	assert.Len(t, attr.Value.List, 2)
+
+	key, err := AttrKeyFromLabel("line_items")
+	assert.NoError(t, err)
+	assert.Equal(t, key, attr.Key)
+
+	str, err := attr.Value.String()
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"amount":"10","description":"widget"},"note"]`, str)
+
+	elems, err := attr.elements()
+	assert.NoError(t, err)
+	assert.Len(t, elems, 2)
+	assert.Equal(t, "line_items[0]", elems[0].KeyLabel)
+	assert.Equal(t, "line_items[1]", elems[1].KeyLabel)
+	key, err = AttrKeyFromLabel("line_items[1]")
+	assert.NoError(t, err)
+	assert.Equal(t, key, elems[1].Key)
+
+	// empty label
+	_, err = NewListAttribute("", nil)
+	assert.True(t, errors.IsOfType(ErrEmptyAttrLabel, err))
+
+	// signed elements are not allowed
+	_, err = NewListAttribute("line_items", []AttrVal{{Type: AttrSigned}})
+	assert.True(t, errors.IsOfType(ErrNotValidAttrType, err))
+
+	// nested invalid element
+	_, err = NewListAttribute("line_items", []AttrVal{{Type: AttrList, List: []AttrVal{{Type: "some type"}}}})
+	assert.True(t, errors.IsOfType(ErrNotValidAttrType, err))
+}
+
+func TestNewMapAttribute(t *testing.T) {
+	dec, err := NewDecimal("100.5")
+	assert.NoError(t, err)
+
+	attr, err := NewMapAttribute("schedule", map[string]AttrVal{
+		"first":  {Type: AttrDecimal, Decimal: dec},
+		"second": {Type: AttrList, List: []AttrVal{{Type: AttrString, Str: "a"}}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, AttrMap, attr.Value.Type)
+
+	str, err := attr.Value.String()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"first":"100.5","second":["a"]}`, str)
+
+	elems, err := attr.elements()
+	assert.NoError(t, err)
+	assert.Len(t, elems, 2)
+	assert.Equal(t, "schedule.first", elems[0].KeyLabel)
+	assert.Equal(t, "schedule.second", elems[1].KeyLabel)
+
+	// empty key
+	_, err = NewMapAttribute("schedule", map[string]AttrVal{" ": {Type: AttrString}})
+	assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+
+	// signed elements are not allowed
+	_, err = NewMapAttribute("schedule", map[string]AttrVal{"first": {Type: AttrSigned}})
+	assert.True(t, errors.IsOfType(ErrNotValidAttrType, err))
+}
+
+func TestNewReferenceAttribute(t *testing.T) {
+	docID := utils.RandomSlice(32)
+	versionID := utils.RandomSlice(32)
+	root := utils.RandomSlice(32)
+
+	ref, err := NewReference(docID, versionID, root)
+	assert.NoError(t, err)
+	assert.Equal(t, versionID, ref.AnchoredVersion())
+	assert.Equal(t, hexutil.Encode(docID)+"@"+hexutil.Encode(versionID), ref.String())
+
+	b := ref.Bytes()
+	assert.Len(t, b, 96)
+	ref1, err := ReferenceFromBytes(b)
+	assert.NoError(t, err)
+	assert.Equal(t, ref, ref1)
+
+	// unpinned reference
+	ref, err = NewReference(docID, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, docID, ref.AnchoredVersion())
+	assert.Equal(t, hexutil.Encode(docID), ref.String())
+	ref1, err = ReferenceFromBytes(ref.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, ref, ref1)
+
+	attr, err := NewReferenceAttribute("invoice", ref)
+	assert.NoError(t, err)
+	assert.Equal(t, AttrReference, attr.Value.Type)
+	val, err := attr.Value.ToBytes()
+	assert.NoError(t, err)
+	assert.Equal(t, ref.Bytes(), val)
+
+	// invalid references
+	for _, r := range []Reference{
+		{DocumentID: utils.RandomSlice(31)},
+		{DocumentID: docID, VersionID: utils.RandomSlice(31)},
+		{DocumentID: docID, DocumentRoot: root},
+		{DocumentID: docID, VersionID: versionID, DocumentRoot: utils.RandomSlice(31)},
+	} {
+		_, err = NewReferenceAttribute("invoice", r)
+		assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+	}
+
+	_, err = ReferenceFromBytes(utils.RandomSlice(95))
+	assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+}
+
+func TestNewAttachmentAttribute(t *testing.T) {
+	hash := utils.RandomSlice(32)
+
+	att, err := NewAttachment(hash, 1024, "application/pdf")
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%s (application/pdf, 1024 bytes)", hexutil.Encode(hash)), att.String())
+
+	b := att.Bytes()
+	assert.Len(t, b, 32+8+len("application/pdf"))
+	att1, err := AttachmentFromBytes(b)
+	assert.NoError(t, err)
+	assert.Equal(t, att, att1)
+
+	attr, err := NewAttachmentAttribute("invoice_pdf", att)
+	assert.NoError(t, err)
+	assert.Equal(t, AttrAttachment, attr.Value.Type)
+	val, err := attr.Value.ToBytes()
+	assert.NoError(t, err)
+	assert.Equal(t, b, val)
+
+	// invalid attachments
+	for _, a := range []Attachment{
+		{Hash: utils.RandomSlice(31), MIMEType: "application/pdf"},
+		{Hash: hash},
+		{Hash: hash, MIMEType: " "},
+	} {
+		_, err = NewAttachmentAttribute("invoice_pdf", a)
+		assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+	}
+
+	_, err = AttachmentFromBytes(utils.RandomSlice(40))
+	assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+}
+
+func TestHasAttachment(t *testing.T) {
+	att, err := NewAttachment(utils.RandomSlice(32), 10, "image/png")
+	assert.NoError(t, err)
+
+	list, err := NewListAttribute("images", []AttrVal{
+		{Type: AttrString, Str: "first"},
+		{Type: AttrAttachment, Attachment: att},
+	})
+	assert.NoError(t, err)
+
+	docMock := NewDocumentMock(t)
+	docMock.On("GetAttributes").Return([]Attribute{list})
+
+	assert.True(t, HasAttachment(docMock, att.Hash))
+	assert.False(t, HasAttachment(docMock, utils.RandomSlice(32)))
+}
+
+func TestFindAttribute(t *testing.T) {
+	ref, err := NewReference(utils.RandomSlice(32), nil, nil)
+	assert.NoError(t, err)
+
+	list, err := NewListAttribute("refs", []AttrVal{
+		{Type: AttrString, Str: "first"},
+		{Type: AttrMap, Map: map[string]AttrVal{"invoice": {Type: AttrReference, Reference: ref}}},
+	})
+	assert.NoError(t, err)
+
+	docMock := NewDocumentMock(t)
+	docMock.On("GetAttribute", list.Key).Return(list, nil)
+	docMock.On("GetAttribute", mock.Anything).Return(Attribute{}, ErrCDAttribute)
+	docMock.On("GetAttributes").Return([]Attribute{list})
+
+	attr, err := FindAttribute(docMock, "refs")
+	assert.NoError(t, err)
+	assert.Equal(t, list, attr)
+
+	attr, err = FindAttribute(docMock, "refs[1].invoice")
+	assert.NoError(t, err)
+	assert.Equal(t, "refs[1].invoice", attr.KeyLabel)
+	assert.Equal(t, ref, attr.Value.Reference)
+
+	_, err = FindAttribute(docMock, "refs[2]")
+	assert.True(t, errors.IsOfType(ErrCDAttribute, err))
+}
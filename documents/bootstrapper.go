@@ -1,6 +1,7 @@
 package documents
 
 import (
+	"github.com/centrifuge/pod/balance"
 	"github.com/centrifuge/pod/bootstrap"
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/errors"
@@ -117,5 +118,9 @@ func (PostBootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		repo:      repo,
 	})
 
+	if balanceSrv, ok := ctx[balance.BootstrappedBalanceService].(balance.Service); ok {
+		balanceSrv.RegisterJobCall(anchorJob, getAnchorJobCallProviderFn(cfg.GetCentChainAnchorLifespan()))
+	}
+
 	return nil
 }
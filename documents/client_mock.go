@@ -18,6 +18,52 @@ type ClientMock struct {
 	mock.Mock
 }
 
+// GetAttachmentRequest provides a mock function with given fields: ctx, collaborator, documentID, hash
+func (_m *ClientMock) GetAttachmentRequest(ctx context.Context, collaborator *types.AccountID, documentID []byte, hash []byte) ([]byte, error) {
+	ret := _m.Called(ctx, collaborator, documentID, hash)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AccountID, []byte, []byte) []byte); ok {
+		r0 = rf(ctx, collaborator, documentID, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.AccountID, []byte, []byte) error); ok {
+		r1 = rf(ctx, collaborator, documentID, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeliveryStatus provides a mock function with given fields: ctx, documentID, versionID
+func (_m *ClientMock) GetDeliveryStatus(ctx context.Context, documentID []byte, versionID []byte) ([]*DeliveryStatus, error) {
+	ret := _m.Called(ctx, documentID, versionID)
+
+	var r0 []*DeliveryStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, []byte) []*DeliveryStatus); ok {
+		r0 = rf(ctx, documentID, versionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*DeliveryStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, []byte) error); ok {
+		r1 = rf(ctx, documentID, versionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDocumentRequest provides a mock function with given fields: ctx, documentOwner, in
 func (_m *ClientMock) GetDocumentRequest(ctx context.Context, documentOwner *types.AccountID, in *p2ppb.GetDocumentRequest) (*p2ppb.GetDocumentResponse, error) {
 	ret := _m.Called(ctx, documentOwner, in)
@@ -41,6 +87,29 @@ func (_m *ClientMock) GetDocumentRequest(ctx context.Context, documentOwner *typ
 	return r0, r1
 }
 
+// GetLatestVersionRequest provides a mock function with given fields: ctx, collaborator, in
+func (_m *ClientMock) GetLatestVersionRequest(ctx context.Context, collaborator *types.AccountID, in *p2ppb.GetDocumentRequest) (*p2ppb.GetDocumentResponse, error) {
+	ret := _m.Called(ctx, collaborator, in)
+
+	var r0 *p2ppb.GetDocumentResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AccountID, *p2ppb.GetDocumentRequest) *p2ppb.GetDocumentResponse); ok {
+		r0 = rf(ctx, collaborator, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*p2ppb.GetDocumentResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.AccountID, *p2ppb.GetDocumentRequest) error); ok {
+		r1 = rf(ctx, collaborator, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSignaturesForDocument provides a mock function with given fields: ctx, model
 func (_m *ClientMock) GetSignaturesForDocument(ctx context.Context, model Document) ([]*coredocumentpb.Signature, []error, error) {
 	ret := _m.Called(ctx, model)
@@ -73,6 +142,52 @@ func (_m *ClientMock) GetSignaturesForDocument(ctx context.Context, model Docume
 	return r0, r1, r2
 }
 
+// GetSignaturesFromCollaborators provides a mock function with given fields: ctx, model, collaborators
+func (_m *ClientMock) GetSignaturesFromCollaborators(ctx context.Context, model Document, collaborators []*types.AccountID) ([]*coredocumentpb.Signature, []error, error) {
+	ret := _m.Called(ctx, model, collaborators)
+
+	var r0 []*coredocumentpb.Signature
+	if rf, ok := ret.Get(0).(func(context.Context, Document, []*types.AccountID) []*coredocumentpb.Signature); ok {
+		r0 = rf(ctx, model, collaborators)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coredocumentpb.Signature)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func(context.Context, Document, []*types.AccountID) []error); ok {
+		r1 = rf(ctx, model, collaborators)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, Document, []*types.AccountID) error); ok {
+		r2 = rf(ctx, model, collaborators)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// QueueAnchoredDocument provides a mock function with given fields: ctx, receiverID, in
+func (_m *ClientMock) QueueAnchoredDocument(ctx context.Context, receiverID *types.AccountID, in *p2ppb.AnchorDocumentRequest) error {
+	ret := _m.Called(ctx, receiverID, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AccountID, *p2ppb.AnchorDocumentRequest) error); ok {
+		r0 = rf(ctx, receiverID, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendAnchoredDocument provides a mock function with given fields: ctx, receiverID, in
 func (_m *ClientMock) SendAnchoredDocument(ctx context.Context, receiverID *types.AccountID, in *p2ppb.AnchorDocumentRequest) (*p2ppb.AnchorDocumentResponse, error) {
 	ret := _m.Called(ctx, receiverID, in)
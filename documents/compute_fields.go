@@ -3,13 +3,21 @@ package documents
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
 	"time"
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
 	"github.com/centrifuge/pod/errors"
+	ethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/hashicorp/golang-lru/simplelru"
 	logging "github.com/ipfs/go-log"
-	"github.com/perlin-network/life/exec"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
 )
 
 var computeLog = logging.Logger("compute_fields")
@@ -24,82 +32,469 @@ const (
 	// ErrComputeFieldsComputeNotFound is a sentinel error when WASM doesn't expose 'compute' function
 	ErrComputeFieldsComputeNotFound = errors.Error("'compute' function not exported")
 
-	// computeFieldsTimeout is the max time we let the WASM computation to be run.
-	computeFieldsTimeout = time.Second * 20
+	// ErrComputeFieldsOutOfGas is a sentinel error when the WASM execution exceeds the gas limit
+	ErrComputeFieldsOutOfGas = errors.Error("compute fields execution ran out of gas")
+
+	// ErrComputeFieldsExecution is a sentinel error when the WASM execution fails
+	ErrComputeFieldsExecution = errors.Error("compute fields execution failed")
+
+	// ErrComputeFieldsHostFunction is a sentinel error when the WASM imports an unknown host function
+	ErrComputeFieldsHostFunction = errors.Error("unknown compute fields host function")
+
+	// ErrComputeFieldsOutputType is a sentinel error when the output type of the rule is not supported
+	ErrComputeFieldsOutputType = errors.Error("compute fields output type not supported")
+
+	// ErrComputeFieldsInvalidResult is a sentinel error when the WASM result can't be decoded as the output type
+	ErrComputeFieldsInvalidResult = errors.Error("invalid compute fields result")
+
+	// computeFieldsGasLimit is the max amount of gas a single WASM execution can use.
+	// Each executed instruction costs one unit of gas, host function calls cost computeFieldsHostCallGas
+	// plus one unit of gas for each byte that they copy.
+	computeFieldsGasLimit uint64 = 100_000_000
+
+	// computeFieldsHostCallGas is the gas charged for each call of a host function.
+	computeFieldsHostCallGas uint64 = 1_000
+
+	// computeFieldsModuleCacheSize is the max number of compiled WASMs that are cached.
+	computeFieldsModuleCacheSize = 64
+
+	// computeFieldsMemoryLimitPages is the max number of 64KiB memory pages a WASM can use.
+	computeFieldsMemoryLimitPages = 256
+
+	// computeFieldsHostModule is the module name of the host functions imported by the WASM.
+	computeFieldsHostModule = "env"
+
+	// computeFieldsErrorLabelSuffix is appended to the target attribute label to get the label of
+	// the attribute that holds the execution error of a compute fields rule.
+	computeFieldsErrorLabelSuffix = "_compute_error"
 )
 
-// fetchComputeFunctions checks WASM if the required exported fields are present
+// fixedPointOne is the representation of 1 in the 18 decimals fixed point numbers used by the host functions.
+var fixedPointOne = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// computeFieldsFeatures are the WASM features supported by compute fields.
+const computeFieldsFeatures = api.CoreFeaturesV1 |
+	api.CoreFeatureSignExtensionOps |
+	api.CoreFeatureNonTrappingFloatToIntConversion |
+	api.CoreFeatureMultiValue
+
+// ComputeFieldsErrorLabel returns the label of the attribute that holds the execution error of the
+// compute fields rule with the target attribute label.
+func ComputeFieldsErrorLabel(targetField string) string {
+	return targetField + computeFieldsErrorLabelSuffix
+}
+
+// ComputeFieldsExecution is the outcome of a compute fields WASM execution.
+type ComputeFieldsExecution struct {
+	// Result is the value computed by the WASM, it's the zero value of the output type if the execution failed.
+	Result AttrVal
+
+	// GasUsed is the amount of gas used by the execution.
+	GasUsed uint64
+
+	// Err is the error that caused the execution to fail.
+	Err error
+}
+
+// computeModuleCache compiles the compute fields WASMs and caches the compiled modules by the hash of the WASM,
+// so that a WASM used by several documents is compiled once. The least recently used modules are evicted once
+// the cache holds size modules.
+//
+// Evicted modules are closed and cannot be instantiated anymore, so modules are only instantiated by the cache
+// while holding the lock. The instances keep working once their module is closed.
+type computeModuleCache struct {
+	mu      sync.Mutex
+	size    int
+	runtime wazero.Runtime
+	modules *simplelru.LRU
+}
+
+var computeModules = &computeModuleCache{
+	size: computeFieldsModuleCacheSize,
+}
+
+// get returns the compiled module of the WASM. The WASM is validated before it's metered and compiled, so that
+// it cannot refer to the gas global added by the metering.
+func (c *computeModuleCache) get(wasm []byte) (wazero.CompiledModule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.load(wasm)
+}
+
+// instantiate returns a new instance of the compiled module of the WASM, without calling its start functions.
+func (c *computeModuleCache) instantiate(ctx context.Context, wasm []byte) (api.Module, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	compiled, err := c.load(wasm)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.runtime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().WithName("").WithStartFunctions())
+}
+
+// load returns the compiled module of the WASM from the cache, or compiles it. The lock must be held.
+func (c *computeModuleCache) load(wasm []byte) (wazero.CompiledModule, error) {
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(wasm)
+	if compiled, ok := c.modules.Get(hash); ok {
+		return compiled.(wazero.CompiledModule), nil
+	}
+
+	ctx := context.Background()
+
+	original, err := c.runtime.CompileModule(ctx, wasm)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = original.Close(ctx)
+
+	metered, err := meterWASM(wasm)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := c.runtime.CompileModule(ctx, metered)
+	if err != nil {
+		return nil, err
+	}
+
+	c.modules.Add(hash, compiled)
+	return compiled, nil
+}
+
+// init creates the module cache and the runtime together with the host module, if they are not created yet.
+func (c *computeModuleCache) init() error {
+	if c.runtime != nil {
+		return nil
+	}
+
+	modules, err := simplelru.NewLRU(c.size, func(_ interface{}, compiled interface{}) {
+		_ = compiled.(wazero.CompiledModule).Close(context.Background())
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	runtime := wazero.NewRuntimeWithConfig(
+		ctx,
+		wazero.NewRuntimeConfig().
+			WithCoreFeatures(computeFieldsFeatures).
+			WithMemoryLimitPages(computeFieldsMemoryLimitPages),
+	)
+
+	_, err = runtime.NewHostModuleBuilder(computeFieldsHostModule).
+		NewFunctionBuilder().WithFunc(hostGetAttribute).Export("get_attribute").
+		NewFunctionBuilder().WithFunc(hostFixedPointOp(fixedMul)).Export("fixed_mul").
+		NewFunctionBuilder().WithFunc(hostFixedPointOp(fixedDiv)).Export("fixed_div").
+		NewFunctionBuilder().WithFunc(hostAnchoredTime).Export("anchored_time").
+		Instantiate(ctx)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return err
+	}
+
+	c.modules = modules
+	c.runtime = runtime
+	return nil
+}
+
+// computeEnv is the environment of a single WASM execution, that is used by the host functions that can be
+// imported by a compute fields WASM from the `env` module.
+//
+// The numbers passed between the host and the WASM are 32 byte big endian 2's complement signed integers.
+// Fixed point numbers have 18 decimals. Every call costs computeFieldsHostCallGas, get_attribute also costs
+// one unit of gas for each byte of the label and of the value copied.
+//
+//	get_attribute(label_ptr: i32, label_len: i32, out_ptr: i32, out_len: i32) -> i32
+//	    Copies up to out_len bytes of the value of the input attribute with the label to out_ptr and returns
+//	    the length of the value. Returns -1 if the attribute is not an input of the rule.
+//	fixed_mul(a_ptr: i32, b_ptr: i32, out_ptr: i32) -> i32
+//	    Writes a*b to out_ptr. Returns 0 on success and -1 on overflow.
+//	fixed_div(a_ptr: i32, b_ptr: i32, out_ptr: i32) -> i32
+//	    Writes a/b to out_ptr. Returns 0 on success and -1 on division by zero or overflow.
+//	anchored_time() -> i64
+//	    Returns the unix timestamp, in seconds, of the document version being anchored.
+type computeEnv struct {
+	attributes   map[string]computeAttribute
+	anchoredTime int64
+
+	// err is the error of a host function that stopped the execution.
+	err error
+}
+
+type computeEnvKey struct{}
+
+func newComputeEnv(attributes []computeAttribute, anchoredTime time.Time) *computeEnv {
+	env := &computeEnv{
+		attributes: make(map[string]computeAttribute),
+	}
+
+	for _, attr := range attributes {
+		env.attributes[attr.Key] = attr
+	}
+
+	if !anchoredTime.IsZero() {
+		env.anchoredTime = anchoredTime.Unix()
+	}
+
+	return env
+}
+
+// isComputeFieldsHostFunction checks if the function is provided by the host module.
+func isComputeFieldsHostFunction(module, name string) bool {
+	if module != computeFieldsHostModule {
+		return false
+	}
+
+	switch name {
+	case "get_attribute", "fixed_mul", "fixed_div", "anchored_time":
+		return true
+	default:
+		return false
+	}
+}
+
+// memory returns the slice of the module memory at ptr with length size.
+// If the slice is out of the memory bounds, the execution is stopped.
+func (e *computeEnv) memory(m api.Module, ptr, size uint32) []byte {
+	var b []byte
+	var ok bool
+	if mem := m.Memory(); mem != nil {
+		b, ok = mem.Read(ptr, size)
+	}
+
+	if !ok {
+		e.err = errors.NewTypedError(ErrComputeFieldsExecution, errors.New("memory access out of bounds"))
+		panic(e.err)
+	}
+
+	return b
+}
+
+// chargeGas subtracts the cost of the host function from the gas of the module.
+// If the module runs out of gas, the execution is stopped.
+func (e *computeEnv) chargeGas(m api.Module, cost uint64) {
+	gas := m.ExportedGlobal(computeFieldsGasGlobal).(api.MutableGlobal)
+
+	remaining := int64(gas.Get()) - int64(cost)
+	gas.Set(uint64(remaining))
+
+	if remaining < 0 {
+		e.err = ErrComputeFieldsOutOfGas
+		panic(e.err)
+	}
+}
+
+func hostGetAttribute(ctx context.Context, m api.Module, labelPtr, labelLen, outPtr, outLen uint32) int32 {
+	env := ctx.Value(computeEnvKey{}).(*computeEnv)
+	env.chargeGas(m, computeFieldsHostCallGas+uint64(labelLen))
+	label := env.memory(m, labelPtr, labelLen)
+
+	attr, ok := env.attributes[string(label)]
+	if !ok {
+		return -1
+	}
+
+	value := attr.Value
+	if attr.Type == AttrSigned.String() {
+		value = attr.Signed.Value
+	}
+
+	out := env.memory(m, outPtr, outLen)
+	if len(value) < len(out) {
+		out = out[:len(value)]
+	}
+
+	env.chargeGas(m, uint64(len(out)))
+	copy(out, value)
+	return int32(len(value))
+}
+
+func hostAnchoredTime(ctx context.Context, m api.Module) int64 {
+	env := ctx.Value(computeEnvKey{}).(*computeEnv)
+	env.chargeGas(m, computeFieldsHostCallGas)
+	return env.anchoredTime
+}
+
+func fixedMul(a, b *big.Int) (*big.Int, bool) {
+	return new(big.Int).Quo(new(big.Int).Mul(a, b), fixedPointOne), true
+}
+
+func fixedDiv(a, b *big.Int) (*big.Int, bool) {
+	if b.Sign() == 0 {
+		return nil, false
+	}
+
+	return new(big.Int).Quo(new(big.Int).Mul(a, fixedPointOne), b), true
+}
+
+// hostFixedPointOp returns a host function that applies the operation to the fixed point numbers.
+func hostFixedPointOp(op func(a, b *big.Int) (*big.Int, bool)) func(context.Context, api.Module, uint32, uint32, uint32) int32 {
+	return func(ctx context.Context, m api.Module, aPtr, bPtr, outPtr uint32) int32 {
+		env := ctx.Value(computeEnvKey{}).(*computeEnv)
+		env.chargeGas(m, computeFieldsHostCallGas)
+		a := ethmath.S256(new(big.Int).SetBytes(env.memory(m, aPtr, 32)))
+		b := ethmath.S256(new(big.Int).SetBytes(env.memory(m, bPtr, 32)))
+		out := env.memory(m, outPtr, 32)
+
+		res, ok := op(a, b)
+		if !ok || !isValidInt256(*res) {
+			return -1
+		}
+
+		copy(out, ethmath.PaddedBigBytes(ethmath.U256(res), 32))
+		return 0
+	}
+}
+
+// fetchComputeFunctions compiles the WASM and checks if the required exported fields are present
 // `allocate`: allocate function to allocate the required bytes on WASM
 // `compute`: compute function to compute the 32byte value from the passed attributes
-// and returns both functions along with the VM instance
-func fetchComputeFunctions(wasm []byte) (i *exec.VirtualMachine, allocate, compute int, err error) {
-	i, err = exec.NewVirtualMachine(wasm, exec.VMConfig{}, &exec.NopResolver{}, nil)
+// and that the imported functions are provided by the host.
+func fetchComputeFunctions(wasm []byte) (compiled wazero.CompiledModule, err error) {
+	compiled, err = computeModules.get(wasm)
 	if err != nil {
-		return i, allocate, compute, errors.AppendError(nil, ErrComputeFieldsInvalidWASM)
+		return nil, errors.AppendError(nil, ErrComputeFieldsInvalidWASM)
 	}
 
-	allocate, ok := i.GetFunctionExport("allocate")
-	if !ok {
+	exports := compiled.ExportedFunctions()
+	if _, ok := exports["allocate"]; !ok {
 		err = errors.AppendError(err, ErrComputeFieldsAllocateNotFound)
 	}
 
-	compute, ok = i.GetFunctionExport("compute")
-	if !ok {
+	if _, ok := exports["compute"]; !ok {
 		err = errors.AppendError(err, ErrComputeFieldsComputeNotFound)
 	}
 
-	return i, allocate, compute, err
+	for _, fn := range compiled.ImportedFunctions() {
+		module, name, _ := fn.Import()
+		if !isComputeFieldsHostFunction(module, name) {
+			err = errors.AppendError(err, errors.NewTypedError(ErrComputeFieldsHostFunction, errors.New("%s.%s", module, name)))
+		}
+	}
+
+	return compiled, err
 }
 
 // executeWASM encodes the passed attributes and executes WASM.
-// returns a 32byte value. If the WASM exits with an error, returns a zero 32byte value
-// execution is allowed to run for upto timeout. Once the timeout is reached, VM is stopped and returned a zero value.
-func executeWASM(wasm []byte, attributes []Attribute, timeout time.Duration) (result [32]byte) {
-	i, allocate, compute, err := fetchComputeFunctions(wasm)
+// The attributes are also available to the WASM through the host functions, together with the anchored time.
+// Execution is allowed to use up to gasLimit gas.
+// The result is decoded as the output type.
+// If the execution fails, the returned result is the zero value of the output type and the error describes the failure.
+func executeWASM(
+	wasm []byte,
+	attributes []Attribute,
+	anchoredTime time.Time,
+	outputType AttributeType,
+	gasLimit uint64,
+) (execution ComputeFieldsExecution) {
+	zero, err := computeFieldsZeroValue(outputType)
 	if err != nil {
-		computeLog.Error(err)
-		return result
+		execution.Err = err
+		return execution
 	}
 
+	defer func() {
+		if execution.Err != nil {
+			execution.Result = zero
+			computeLog.Error(execution.Err)
+		}
+	}()
+
 	cattrs, err := toComputeFieldsAttributes(attributes)
 	if err != nil {
-		computeLog.Error(err)
-		return result
+		execution.Err = errors.NewTypedError(ErrComputeFieldsExecution, err)
+		return execution
+	}
+
+	_, err = fetchComputeFunctions(wasm)
+	if err != nil {
+		execution.Err = errors.NewTypedError(ErrComputeFieldsExecution, err)
+		return execution
 	}
 
 	var buf bytes.Buffer
 	enc := scale.NewEncoder(&buf)
 	err = enc.Encode(cattrs)
 	if err != nil {
-		computeLog.Error(err)
-		return result
+		execution.Err = errors.NewTypedError(ErrComputeFieldsExecution, err)
+		return execution
+	}
+
+	env := newComputeEnv(cattrs, anchoredTime)
+	ctx := context.WithValue(context.Background(), computeEnvKey{}, env)
+
+	mod, err := computeModules.instantiate(ctx, wasm)
+	if err != nil {
+		execution.Err = errors.NewTypedError(ErrComputeFieldsExecution, err)
+		return execution
+	}
+
+	defer mod.Close(ctx)
+
+	if gasLimit > math.MaxInt64 {
+		gasLimit = math.MaxInt64
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), timeout)
-	defer cancelFunc()
+	gas := mod.ExportedGlobal(computeFieldsGasGlobal).(api.MutableGlobal)
+	gas.Set(gasLimit)
+
+	defer func() {
+		remaining := int64(gas.Get())
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		execution.GasUsed = gasLimit - uint64(remaining)
+	}()
 
 	// allocate memory
-	res, err := i.Run(ctx, allocate, int64(buf.Len()))
+	res, err := mod.ExportedFunction("allocate").Call(ctx, uint64(buf.Len()))
 	if err != nil {
-		computeLog.Errorf("failed to execute 'allocate': %v", err)
-		return
+		execution.Err = toComputeFieldsExecutionError("allocate", env, gas, err)
+		return execution
 	}
 
 	// copy encoded attributes to memory
-	mem := i.Memory[res:]
-	copy(mem, buf.Bytes())
+	ptr := uint32(res[0])
+	if mod.Memory() == nil || !mod.Memory().Write(ptr, buf.Bytes()) {
+		execution.Err = errors.NewTypedError(ErrComputeFieldsExecution, errors.New("'allocate' returned an invalid pointer"))
+		return execution
+	}
 
 	// execute compute
-	res, err = i.Run(ctx, compute, res, int64(buf.Len()))
+	res, err = mod.ExportedFunction("compute").Call(ctx, uint64(ptr), uint64(buf.Len()))
 	if err != nil {
-		computeLog.Errorf("failed to execute 'compute': %v", err)
-		return
+		execution.Err = toComputeFieldsExecutionError("compute", env, gas, err)
+		return execution
 	}
 
-	// copy result from the wasm
-	d := i.Memory[res : res+32]
-	copy(result[:], d)
-	return result
+	// decode result from the wasm
+	execution.Result, execution.Err = decodeComputeFieldsResult(mod.Memory(), uint32(res[0]), outputType)
+	return execution
+}
+
+// toComputeFieldsExecutionError converts the error returned by the runtime when calling the function.
+// Only the first line of the error is kept, since the rest is the stack trace.
+func toComputeFieldsExecutionError(function string, env *computeEnv, gas api.Global, err error) error {
+	if env.err != nil {
+		return env.err
+	}
+
+	if int64(gas.Get()) < 0 {
+		return ErrComputeFieldsOutOfGas
+	}
+
+	msg := strings.SplitN(err.Error(), "\n", 2)[0]
+	return errors.NewTypedError(ErrComputeFieldsExecution, errors.New("failed to execute '%s': %s", function, msg))
 }
 
 type computeSigned struct {
@@ -154,13 +549,15 @@ func toComputeFieldsAttribute(attr Attribute) (cattr computeAttribute, err error
 	return cattr, err
 }
 
-// ExecuteComputeFields executes all the compute fields and updates the document with target attributes
-// each WASM is executed at a max of timeout duration.
-func (cd *CoreDocument) ExecuteComputeFields(timeout time.Duration) error {
+// ExecuteComputeFields executes all the compute fields and updates the document with target attributes.
+// Each WASM execution can use up to gasLimit gas. If an execution fails, the target attribute holds a zero value
+// and the error is stored in the attribute labelled with ComputeFieldsErrorLabel of the target label.
+func (cd *CoreDocument) ExecuteComputeFields(gasLimit uint64) error {
 	computeFieldsRules := cd.GetComputeFieldsRules()
+	anchoredTime := computeFieldsAnchoredTime(cd.Timestamp())
 
 	for _, computeField := range computeFieldsRules {
-		targetAttr, err := executeComputeField(computeField, cd.Attributes, timeout)
+		targetAttr, execution, err := executeComputeField(computeField, cd.Attributes, anchoredTime, gasLimit)
 		if err != nil {
 			return err
 		}
@@ -169,19 +566,94 @@ func (cd *CoreDocument) ExecuteComputeFields(timeout time.Duration) error {
 		if err != nil {
 			return err
 		}
+
+		errorKey, err := AttrKeyFromLabel(ComputeFieldsErrorLabel(targetAttr.KeyLabel))
+		if err != nil {
+			return err
+		}
+
+		if execution.Err == nil {
+			if cd.AttributeExists(errorKey) {
+				if _, err := cd.DeleteAttribute(errorKey, false, nil); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		errorAttr, err := computeFieldsErrorAttribute(targetAttr.KeyLabel, execution.Err)
+		if err != nil {
+			return err
+		}
+
+		_, err = cd.AddAttributes(CollaboratorsAccess{}, false, nil, errorAttr)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func executeComputeField(rule *coredocumentpb.TransitionRule, attributes map[AttrKey]Attribute, timeout time.Duration) (result Attribute, err error) {
+// DryRunComputeFields executes the WASM with the attributes of the document labelled with fields,
+// without adding a compute fields rule or updating the document. The current time is used as the anchored time.
+func DryRunComputeFields(doc Document, wasm []byte, fields []string, outputType AttributeType) (ComputeFieldsExecution, error) {
+	if _, err := fetchComputeFunctions(wasm); err != nil {
+		return ComputeFieldsExecution{}, err
+	}
+
+	if !isComputeFieldsOutputTypeAllowed(outputType) {
+		return ComputeFieldsExecution{}, errors.NewTypedError(ErrComputeFieldsOutputType, errors.New("type: %s", outputType))
+	}
+
+	var attrs []Attribute
+
+	for _, field := range fields {
+		key, err := AttrKeyFromLabel(field)
+		if err != nil {
+			return ComputeFieldsExecution{}, err
+		}
+
+		attr, err := doc.GetAttribute(key)
+		if err != nil {
+			continue
+		}
+
+		attrs = append(attrs, attr)
+	}
+
+	return executeWASM(wasm, attrs, time.Now().UTC(), outputType, computeFieldsGasLimit), nil
+}
+
+// computeFieldsAnchoredTime returns the timestamp of the document version that is being anchored,
+// or the zero time if the timestamp is not valid.
+func computeFieldsAnchoredTime(t time.Time, err error) time.Time {
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// computeFieldsErrorAttribute returns the attribute that holds the execution error of the compute fields rule.
+func computeFieldsErrorAttribute(targetField string, execErr error) (Attribute, error) {
+	return NewStringAttribute(ComputeFieldsErrorLabel(targetField), AttrString, execErr.Error())
+}
+
+func executeComputeField(
+	rule *coredocumentpb.TransitionRule,
+	attributes map[AttrKey]Attribute,
+	anchoredTime time.Time,
+	gasLimit uint64,
+) (result Attribute, execution ComputeFieldsExecution, err error) {
 	var attrs []Attribute
 
 	// filter attributes
 	for _, attr := range rule.ComputeFields {
 		key, err := AttrKeyFromBytes(attr)
 		if err != nil {
-			return result, err
+			return result, execution, err
 		}
 
 		fa, ok := attributes[key]
@@ -193,21 +665,18 @@ func executeComputeField(rule *coredocumentpb.TransitionRule, attributes map[Att
 	}
 
 	// execute WASM
-	r := executeWASM(rule.ComputeCode, attrs, timeout)
+	execution = executeWASM(rule.ComputeCode, attrs, anchoredTime, ComputeFieldsOutputType(rule), gasLimit)
 
 	// set result into the target attribute
 	targetKey, err := AttrKeyFromLabel(string(rule.ComputeTargetField))
 	if err != nil {
-		return result, err
+		return result, execution, err
 	}
 
 	result = Attribute{
 		KeyLabel: string(rule.ComputeTargetField),
 		Key:      targetKey,
-		Value: AttrVal{
-			Type:  AttrBytes,
-			Bytes: r[:],
-		},
+		Value:    execution.Result,
 	}
-	return result, nil
+	return result, execution, nil
 }
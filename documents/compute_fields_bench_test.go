@@ -0,0 +1,90 @@
+//go:build unit
+
+package documents
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	pathUtils "github.com/centrifuge/pod/testingutils/path"
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+	"github.com/stretchr/testify/assert"
+)
+
+// The benchmarks compare the per-execution latency of compute fields on the wazero runtime against the
+// life VM that was previously used, with the same WASM and attributes.
+//
+//	go test -tags unit -run ^$ -bench ExecuteWASM ./documents/
+
+func BenchmarkExecuteWASM(b *testing.B) {
+	wasm, attrs := getBenchmarkComputeFields(b)
+
+	// compile the module before the benchmark, like it happens for a WASM that is already in use
+	_, err := fetchComputeFunctions(wasm)
+	assert.NoError(b, err)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		execution := executeWASM(wasm, attrs, time.Time{}, "", computeFieldsGasLimit)
+		assert.NoError(b, execution.Err)
+	}
+}
+
+func BenchmarkExecuteWASM_Uncached(b *testing.B) {
+	wasm, attrs := getBenchmarkComputeFields(b)
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		computeModules.mu.Lock()
+		if computeModules.modules != nil {
+			computeModules.modules.Purge()
+		}
+		computeModules.mu.Unlock()
+		b.StartTimer()
+
+		execution := executeWASM(wasm, attrs, time.Time{}, "", computeFieldsGasLimit)
+		assert.NoError(b, execution.Err)
+	}
+}
+
+func BenchmarkExecuteWASM_Life(b *testing.B) {
+	wasm, attrs := getBenchmarkComputeFields(b)
+
+	cattrs, err := toComputeFieldsAttributes(attrs)
+	assert.NoError(b, err)
+
+	var buf bytes.Buffer
+	assert.NoError(b, scale.NewEncoder(&buf).Encode(cattrs))
+
+	for i := 0; i < b.N; i++ {
+		vm, err := exec.NewVirtualMachine(
+			wasm,
+			exec.VMConfig{GasLimit: computeFieldsGasLimit},
+			&exec.NopResolver{},
+			&compiler.SimpleGasPolicy{GasPerInstruction: 1},
+		)
+		assert.NoError(b, err)
+
+		allocate, _ := vm.GetFunctionExport("allocate")
+		compute, _ := vm.GetFunctionExport("compute")
+
+		ptr, err := vm.Run(context.Background(), allocate, int64(buf.Len()))
+		assert.NoError(b, err)
+
+		copy(vm.Memory[ptr:], buf.Bytes())
+
+		_, err = vm.Run(context.Background(), compute, ptr, int64(buf.Len()))
+		assert.NoError(b, err)
+	}
+}
+
+func getBenchmarkComputeFields(b *testing.B) ([]byte, []Attribute) {
+	wasm := wasmLoader(b, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"))
+
+	return wasm, getValidComputeFieldAttrs(b)
+}
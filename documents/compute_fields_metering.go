@@ -0,0 +1,544 @@
+package documents
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/centrifuge/pod/errors"
+)
+
+const (
+	// computeFieldsGasGlobal is the name of the exported global that holds the remaining gas of an execution.
+	computeFieldsGasGlobal = "centrifuge_gas"
+
+	wasmSectionCustom    = 0
+	wasmSectionImport    = 2
+	wasmSectionGlobal    = 6
+	wasmSectionExport    = 7
+	wasmSectionCode      = 10
+	wasmSectionDataCount = 12
+
+	wasmExternalGlobal = 3
+
+	wasmValueTypeI64 = 0x7e
+	wasmBlockTypeNil = 0x40
+
+	wasmOpUnreachable = 0x00
+	wasmOpBlock       = 0x02
+	wasmOpLoop        = 0x03
+	wasmOpIf          = 0x04
+	wasmOpElse        = 0x05
+	wasmOpEnd         = 0x0b
+	wasmOpBr          = 0x0c
+	wasmOpBrIf        = 0x0d
+	wasmOpBrTable     = 0x0e
+	wasmOpReturn      = 0x0f
+	wasmOpGlobalGet   = 0x23
+	wasmOpGlobalSet   = 0x24
+	wasmOpI64Const    = 0x42
+	wasmOpI64LtS      = 0x53
+	wasmOpI64Sub      = 0x7d
+	wasmOpMiscPrefix  = 0xfc
+)
+
+// wasmMagic is the preamble of a WASM binary, magic number followed by version 1.
+var wasmMagic = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+type wasmSection struct {
+	id   byte
+	body []byte
+}
+
+// meterWASM instruments the WASM so that every executed instruction consumes one unit of gas.
+//
+// The gas is held by an exported mutable i64 global named computeFieldsGasGlobal. Instructions are charged at the
+// start of every straight-line sequence of instructions, and the execution traps once the gas is negative.
+func meterWASM(wasm []byte) ([]byte, error) {
+	sections, err := decodeWASMSections(wasm)
+	if err != nil {
+		return nil, err
+	}
+
+	gasGlobal, err := countWASMGlobals(sections)
+	if err != nil {
+		return nil, err
+	}
+
+	// i64, mutable, initialised with `i64.const 0`
+	gasGlobalEntry := []byte{wasmValueTypeI64, 0x01, wasmOpI64Const, 0x00, wasmOpEnd}
+	sections, err = appendWASMSectionEntry(sections, wasmSectionGlobal, gasGlobalEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	gasExportEntry := append(encodeWASMName(computeFieldsGasGlobal), wasmExternalGlobal)
+	gasExportEntry = append(gasExportEntry, encodeULEB128(uint64(gasGlobal))...)
+	sections, err = appendWASMSectionEntry(sections, wasmSectionExport, gasExportEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, section := range sections {
+		if section.id != wasmSectionCode {
+			continue
+		}
+
+		sections[i].body, err = meterWASMCode(section.body, gasGlobal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.Write(wasmMagic)
+	for _, section := range sections {
+		buf.WriteByte(section.id)
+		buf.Write(encodeULEB128(uint64(len(section.body))))
+		buf.Write(section.body)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decodeWASMSections(wasm []byte) ([]wasmSection, error) {
+	if !bytes.HasPrefix(wasm, wasmMagic) {
+		return nil, errors.New("invalid WASM preamble")
+	}
+
+	r := &wasmReader{b: wasm, pos: len(wasmMagic)}
+
+	var sections []wasmSection
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := r.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+
+		sections = append(sections, wasmSection{id: id, body: body})
+	}
+
+	return sections, nil
+}
+
+// countWASMGlobals returns the number of imported and defined globals, which is the index of the next global.
+func countWASMGlobals(sections []wasmSection) (uint32, error) {
+	var count uint32
+
+	for _, section := range sections {
+		r := &wasmReader{b: section.body}
+
+		switch section.id {
+		case wasmSectionImport:
+			n, err := r.u32()
+			if err != nil {
+				return 0, err
+			}
+
+			for i := uint32(0); i < n; i++ {
+				kind, err := r.skipWASMImport()
+				if err != nil {
+					return 0, err
+				}
+
+				if kind == wasmExternalGlobal {
+					count++
+				}
+			}
+		case wasmSectionGlobal:
+			n, err := r.u32()
+			if err != nil {
+				return 0, err
+			}
+
+			count += n
+		}
+	}
+
+	return count, nil
+}
+
+// appendWASMSectionEntry appends the entry to the vector of the section with the id.
+// The section is created if it's not present.
+func appendWASMSectionEntry(sections []wasmSection, id byte, entry []byte) ([]wasmSection, error) {
+	for i, section := range sections {
+		if section.id != id {
+			continue
+		}
+
+		r := &wasmReader{b: section.body}
+		n, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+
+		body := encodeULEB128(uint64(n) + 1)
+		body = append(body, section.body[r.pos:]...)
+		sections[i].body = append(body, entry...)
+		return sections, nil
+	}
+
+	newSection := wasmSection{id: id, body: append([]byte{0x01}, entry...)}
+
+	// sections are ordered by id, except for the data count section which comes before the code section
+	order := func(id byte) int {
+		if id == wasmSectionDataCount {
+			return wasmSectionCode
+		}
+
+		return int(id) * 2
+	}
+
+	for i, section := range sections {
+		if section.id != wasmSectionCustom && order(section.id) > order(id) {
+			return append(sections[:i], append([]wasmSection{newSection}, sections[i:]...)...), nil
+		}
+	}
+
+	return append(sections, newSection), nil
+}
+
+func meterWASMCode(code []byte, gasGlobal uint32) ([]byte, error) {
+	r := &wasmReader{b: code}
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+
+	res := encodeULEB128(uint64(n))
+	for i := uint32(0); i < n; i++ {
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := r.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+
+		body, err = meterWASMFunction(body, gasGlobal)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, encodeULEB128(uint64(len(body)))...)
+		res = append(res, body...)
+	}
+
+	return res, nil
+}
+
+func meterWASMFunction(body []byte, gasGlobal uint32) ([]byte, error) {
+	r := &wasmReader{b: body}
+
+	// skip the locals
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < n; i++ {
+		if _, err := r.u32(); err != nil {
+			return nil, err
+		}
+
+		if _, err := r.byte(); err != nil {
+			return nil, err
+		}
+	}
+
+	res := append([]byte{}, body[:r.pos]...)
+	segmentStart, cost := r.pos, 0
+
+	for !r.done() {
+		op, err := r.skipWASMInstruction()
+		if err != nil {
+			return nil, err
+		}
+
+		cost++
+
+		switch op {
+		case wasmOpBlock, wasmOpLoop, wasmOpIf, wasmOpElse, wasmOpEnd,
+			wasmOpBr, wasmOpBrIf, wasmOpBrTable, wasmOpReturn, wasmOpUnreachable:
+			res = append(res, wasmGasCharge(gasGlobal, cost)...)
+			res = append(res, body[segmentStart:r.pos]...)
+			segmentStart, cost = r.pos, 0
+		}
+	}
+
+	if cost > 0 {
+		return nil, errors.New("function body doesn't terminate with 'end'")
+	}
+
+	return res, nil
+}
+
+// wasmGasCharge returns the instructions that subtract the cost from the gas global and trap if it's negative.
+func wasmGasCharge(gasGlobal uint32, cost int) []byte {
+	global := encodeULEB128(uint64(gasGlobal))
+
+	var b []byte
+	b = append(append(b, wasmOpGlobalGet), global...)
+	b = append(append(b, wasmOpI64Const), encodeSLEB128(int64(cost))...)
+	b = append(b, wasmOpI64Sub)
+	b = append(append(b, wasmOpGlobalSet), global...)
+	b = append(append(b, wasmOpGlobalGet), global...)
+	b = append(b, wasmOpI64Const, 0x00, wasmOpI64LtS)
+	b = append(b, wasmOpIf, wasmBlockTypeNil, wasmOpUnreachable, wasmOpEnd)
+	return b
+}
+
+func encodeWASMName(name string) []byte {
+	return append(encodeULEB128(uint64(len(name))), name...)
+}
+
+func encodeULEB128(v uint64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(b, c)
+		}
+
+		b = append(b, c|0x80)
+	}
+}
+
+func encodeSLEB128(v int64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+
+		b = append(b, c|0x80)
+	}
+}
+
+// wasmReader decodes the parts of a WASM binary that are required for metering.
+type wasmReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *wasmReader) done() bool {
+	return r.pos >= len(r.b)
+}
+
+func (r *wasmReader) byte() (byte, error) {
+	if r.done() {
+		return 0, errors.New("unexpected end of WASM")
+	}
+
+	b := r.b[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *wasmReader) bytes(n int) ([]byte, error) {
+	if n < 0 || r.pos+n > len(r.b) {
+		return nil, errors.New("unexpected end of WASM")
+	}
+
+	b := r.b[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *wasmReader) u32() (uint32, error) {
+	v, n := binary.Uvarint(r.b[r.pos:])
+	if n <= 0 || v > 0xffffffff {
+		return 0, errors.New("invalid WASM integer")
+	}
+
+	r.pos += n
+	return uint32(v), nil
+}
+
+// skipLEB128 skips a signed or unsigned LEB128 integer.
+func (r *wasmReader) skipLEB128() error {
+	for {
+		b, err := r.byte()
+		if err != nil {
+			return err
+		}
+
+		if b&0x80 == 0 {
+			return nil
+		}
+	}
+}
+
+func (r *wasmReader) skipWASMImport() (kind byte, err error) {
+	for i := 0; i < 2; i++ {
+		n, err := r.u32()
+		if err != nil {
+			return 0, err
+		}
+
+		if _, err := r.bytes(int(n)); err != nil {
+			return 0, err
+		}
+	}
+
+	kind, err = r.byte()
+	if err != nil {
+		return 0, err
+	}
+
+	switch kind {
+	case 0x00: // function
+		_, err = r.u32()
+	case 0x01: // table
+		if _, err = r.byte(); err != nil {
+			return 0, err
+		}
+
+		err = r.skipWASMLimits()
+	case 0x02: // memory
+		err = r.skipWASMLimits()
+	case wasmExternalGlobal:
+		_, err = r.bytes(2)
+	default:
+		err = errors.New("invalid WASM import kind: %d", kind)
+	}
+
+	return kind, err
+}
+
+func (r *wasmReader) skipWASMLimits() error {
+	flag, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.u32(); err != nil {
+		return err
+	}
+
+	if flag&0x01 != 0 {
+		_, err = r.u32()
+	}
+
+	return err
+}
+
+// skipWASMInstruction skips the instruction and its immediates, and returns its opcode.
+func (r *wasmReader) skipWASMInstruction() (op byte, err error) {
+	op, err = r.byte()
+	if err != nil {
+		return op, err
+	}
+
+	switch {
+	case op == wasmOpBlock || op == wasmOpLoop || op == wasmOpIf:
+		// block type is either the empty type, a value type or a type index
+		bt, err := r.byte()
+		if err != nil {
+			return op, err
+		}
+
+		if bt != wasmBlockTypeNil && (bt < 0x6f || bt > 0x7f) {
+			r.pos--
+			err = r.skipLEB128()
+		}
+
+		return op, err
+	case op == wasmOpBr || op == wasmOpBrIf || op == 0x10 || (op >= 0x20 && op <= 0x26) || op == 0xd2:
+		// br, br_if, call, local.*, global.*, table.get, table.set, ref.func
+		return op, r.skipLEB128()
+	case op == wasmOpBrTable:
+		n, err := r.u32()
+		if err != nil {
+			return op, err
+		}
+
+		for i := uint32(0); i <= n; i++ {
+			if err := r.skipLEB128(); err != nil {
+				return op, err
+			}
+		}
+
+		return op, nil
+	case op == 0x11 || (op >= 0x28 && op <= 0x3e):
+		// call_indirect, loads and stores
+		if err := r.skipLEB128(); err != nil {
+			return op, err
+		}
+
+		return op, r.skipLEB128()
+	case op == 0x1c:
+		// select with value types
+		n, err := r.u32()
+		if err != nil {
+			return op, err
+		}
+
+		_, err = r.bytes(int(n))
+		return op, err
+	case op == 0x3f || op == 0x40 || op == 0x41 || op == wasmOpI64Const:
+		// memory.size, memory.grow, i32.const, i64.const
+		return op, r.skipLEB128()
+	case op == 0x43:
+		_, err = r.bytes(4)
+		return op, err
+	case op == 0x44:
+		_, err = r.bytes(8)
+		return op, err
+	case op == 0xd0:
+		_, err = r.byte()
+		return op, err
+	case op <= 0x01 || op == wasmOpElse || op == wasmOpEnd || op == wasmOpReturn ||
+		op == 0x1a || op == 0x1b || (op >= 0x45 && op <= 0xc4) || op == 0xd1:
+		return op, nil
+	case op == wasmOpMiscPrefix:
+		return op, r.skipWASMMiscInstruction()
+	default:
+		return op, errors.New("unsupported WASM instruction: 0x%x", op)
+	}
+}
+
+// skipWASMMiscInstruction skips the immediates of the instructions with the 0xfc prefix.
+func (r *wasmReader) skipWASMMiscInstruction() error {
+	subOp, err := r.u32()
+	if err != nil {
+		return err
+	}
+
+	var immediates int
+	switch {
+	case subOp <= 7:
+		// saturating truncations
+		immediates = 0
+	case subOp == 9 || subOp == 11 || subOp == 13 || subOp == 15 || subOp == 16 || subOp == 17:
+		// data.drop, memory.fill, elem.drop, table.grow, table.size, table.fill
+		immediates = 1
+	case subOp == 8 || subOp == 10 || subOp == 12 || subOp == 14:
+		// memory.init, memory.copy, table.init, table.copy
+		immediates = 2
+	default:
+		return errors.New("unsupported WASM instruction: 0xfc %d", subOp)
+	}
+
+	for i := 0; i < immediates; i++ {
+		if err := r.skipLEB128(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+//go:build unit
+
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/pod/errors"
+	pathUtils "github.com/centrifuge/pod/testingutils/path"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/tetratelabs/wazero/api"
+)
+
+func Test_meterWASM(t *testing.T) {
+	wasm := wasmLoader(t, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/host_functions.wasm"))
+
+	metered, err := meterWASM(wasm)
+	assert.NoError(t, err)
+
+	sections, err := decodeWASMSections(metered)
+	assert.NoError(t, err)
+
+	var ids []byte
+	for _, section := range sections {
+		ids = append(ids, section.id)
+	}
+
+	// the global section is added in order
+	assert.Equal(t, []byte{1, 2, 3, 5, wasmSectionGlobal, wasmSectionExport, wasmSectionCode, 11}, ids)
+
+	globals, err := countWASMGlobals(sections)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), globals)
+
+	export := append(encodeWASMName(computeFieldsGasGlobal), wasmExternalGlobal, 0x00)
+	assert.True(t, bytes.HasSuffix(sections[5].body, export))
+
+	// invalid preamble
+	_, err = meterWASM(utils.RandomSlice(32))
+	assert.Error(t, err)
+
+	// truncated WASM
+	_, err = meterWASM(wasm[:len(wasm)-1])
+	assert.Error(t, err)
+}
+
+func Test_meterWASMFunction(t *testing.T) {
+	gasGlobal := uint32(2)
+
+	// no locals, `i32.const 1; drop; end`
+	body := []byte{0x00, 0x41, 0x01, 0x1a, wasmOpEnd}
+
+	res, err := meterWASMFunction(body, gasGlobal)
+	assert.NoError(t, err)
+
+	expected := append([]byte{0x00}, wasmGasCharge(gasGlobal, 3)...)
+	expected = append(expected, 0x41, 0x01, 0x1a, wasmOpEnd)
+	assert.Equal(t, expected, res)
+
+	// `loop; br 0; end; end`, the loop body is charged on every iteration
+	body = []byte{0x00, wasmOpLoop, wasmBlockTypeNil, wasmOpBr, 0x00, wasmOpEnd, wasmOpEnd}
+
+	res, err = meterWASMFunction(body, gasGlobal)
+	assert.NoError(t, err)
+
+	expected = append([]byte{0x00}, wasmGasCharge(gasGlobal, 1)...)
+	expected = append(expected, wasmOpLoop, wasmBlockTypeNil)
+	expected = append(expected, wasmGasCharge(gasGlobal, 1)...)
+	expected = append(expected, wasmOpBr, 0x00)
+	expected = append(expected, wasmGasCharge(gasGlobal, 1)...)
+	expected = append(expected, wasmOpEnd)
+	expected = append(expected, wasmGasCharge(gasGlobal, 1)...)
+	expected = append(expected, wasmOpEnd)
+	assert.Equal(t, expected, res)
+
+	// unsupported SIMD instruction
+	_, err = meterWASMFunction([]byte{0x00, 0xfd, 0x00, wasmOpEnd}, gasGlobal)
+	assert.Error(t, err)
+
+	// missing end
+	_, err = meterWASMFunction([]byte{0x00, 0x41, 0x01}, gasGlobal)
+	assert.Error(t, err)
+}
+
+func Test_encodeLEB128(t *testing.T) {
+	for _, v := range []uint64{0, 1, 127, 128, 624485, 1 << 40} {
+		n, size := binary.Uvarint(encodeULEB128(v))
+		assert.Equal(t, v, n)
+		assert.Equal(t, len(encodeULEB128(v)), size)
+	}
+
+	assert.Equal(t, []byte{0x3f}, encodeSLEB128(63))
+	assert.Equal(t, []byte{0xc0, 0x00}, encodeSLEB128(64))
+	assert.Equal(t, []byte{0x7f}, encodeSLEB128(-1))
+	assert.Equal(t, []byte{0xc0, 0xbb, 0x78}, encodeSLEB128(-123456))
+}
+
+func Test_executeWASM_GasMetering(t *testing.T) {
+	wasm := wasmLoader(t, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"))
+	attrs := getValidComputeFieldAttrs(t)
+
+	execution := executeWASM(wasm, attrs, time.Time{}, "", computeFieldsGasLimit)
+	assert.NoError(t, execution.Err)
+	assert.NotZero(t, execution.GasUsed)
+
+	// the gas usage is deterministic
+	gasUsed := execution.GasUsed
+
+	execution = executeWASM(wasm, attrs, time.Time{}, "", gasUsed)
+	assert.NoError(t, execution.Err)
+	assert.Equal(t, gasUsed, execution.GasUsed)
+
+	execution = executeWASM(wasm, attrs, time.Time{}, "", gasUsed-1)
+	assert.True(t, errors.IsOfType(ErrComputeFieldsOutOfGas, execution.Err))
+	assert.Equal(t, gasUsed-1, execution.GasUsed)
+	assert.Equal(t, make([]byte, 32), execution.Result.Bytes)
+}
+
+func Test_computeModuleCache_GasGlobalReference(t *testing.T) {
+	// `i32.const 0; end`
+	_, err := computeModules.get(computeTestWASM([]byte{0x41, 0x00, wasmOpEnd}))
+	assert.NoError(t, err)
+
+	// `global.get 0; drop; i32.const 0; end`, the global is the gas global once the WASM is metered
+	_, err = computeModules.get(computeTestWASM([]byte{wasmOpGlobalGet, 0x00, 0x1a, 0x41, 0x00, wasmOpEnd}))
+	assert.Error(t, err)
+}
+
+func Test_executeWASM_HostFunctionGas(t *testing.T) {
+	// `i32.const 0; i32.const 0; i32.const 0; call 0; drop; i32.const 0; end`
+	wasm := computeHostTestWASM([]byte{0x41, 0x00, 0x41, 0x00, 0x41, 0x00, 0x10, 0x00, 0x1a, 0x41, 0x00, wasmOpEnd})
+
+	execution := executeWASM(wasm, getValidComputeFieldAttrs(t), time.Time{}, "", computeFieldsGasLimit)
+	assert.NoError(t, execution.Err)
+	assert.Greater(t, execution.GasUsed, computeFieldsHostCallGas)
+
+	// `loop; i32.const 0; i32.const 0; i32.const 0; call 0; drop; br 0; end; i32.const 0; end`
+	wasm = computeHostTestWASM([]byte{
+		wasmOpLoop, wasmBlockTypeNil, 0x41, 0x00, 0x41, 0x00, 0x41, 0x00, 0x10, 0x00, 0x1a, wasmOpBr, 0x00, wasmOpEnd,
+		0x41, 0x00, wasmOpEnd,
+	})
+
+	gasLimit := 100 * computeFieldsHostCallGas
+
+	execution = executeWASM(wasm, getValidComputeFieldAttrs(t), time.Time{}, "", gasLimit)
+	assert.True(t, errors.IsOfType(ErrComputeFieldsOutOfGas, execution.Err))
+	assert.Equal(t, gasLimit, execution.GasUsed)
+	assert.Equal(t, make([]byte, 32), execution.Result.Bytes)
+}
+
+func Test_computeModuleCache_Eviction(t *testing.T) {
+	cache := &computeModuleCache{size: 1}
+
+	wasm := wasmLoader(t, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"))
+
+	compiled, err := cache.get(wasm)
+	assert.NoError(t, err)
+
+	defer cache.runtime.Close(context.Background())
+
+	cached, err := cache.get(wasm)
+	assert.NoError(t, err)
+	assert.True(t, compiled == cached)
+
+	_, err = cache.get(computeTestWASM([]byte{0x41, 0x00, wasmOpEnd}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cache.modules.Len())
+
+	// the least recently used module was evicted
+	cached, err = cache.get(wasm)
+	assert.NoError(t, err)
+	assert.False(t, compiled == cached)
+}
+
+func Test_computeModuleCache_InstantiateEvicted(t *testing.T) {
+	cache := &computeModuleCache{size: 1}
+
+	ctx := context.Background()
+	wasm := computeTestWASM([]byte{0x41, 0x00, wasmOpEnd})
+
+	mod, err := cache.instantiate(ctx, wasm)
+	assert.NoError(t, err)
+
+	defer cache.runtime.Close(ctx)
+
+	// evict the module of the instance
+	_, err = cache.instantiate(ctx, computeTestWASM([]byte{0x41, 0x01, wasmOpEnd}))
+	assert.NoError(t, err)
+
+	// the instance keeps working
+	mod.ExportedGlobal(computeFieldsGasGlobal).(api.MutableGlobal).Set(computeFieldsHostCallGas)
+
+	res, err := mod.ExportedFunction("compute").Call(ctx, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{0}, res)
+
+	assert.NoError(t, mod.Close(ctx))
+
+	// the evicted module is compiled again
+	mod, err = cache.instantiate(ctx, wasm)
+	assert.NoError(t, err)
+	assert.NoError(t, mod.Close(ctx))
+}
+
+// computeTestWASM returns a WASM that exports the memory, an `allocate` function returning 0,
+// and a `compute` function with the body.
+func computeTestWASM(computeBody []byte) []byte {
+	return newComputeTestWASM(computeBody, false)
+}
+
+// computeHostTestWASM returns the same WASM as computeTestWASM, with the `fixed_mul` host function
+// imported as function 0.
+func computeHostTestWASM(computeBody []byte) []byte {
+	return newComputeTestWASM(computeBody, true)
+}
+
+func newComputeTestWASM(computeBody []byte, importFixedMul bool) []byte {
+	section := func(id byte, body ...byte) []byte {
+		return append(append([]byte{id}, encodeULEB128(uint64(len(body)))...), body...)
+	}
+
+	var imports []byte
+	var functionIndex byte
+	if importFixedMul {
+		imports = append(append([]byte{0x01}, encodeWASMName(computeFieldsHostModule)...), encodeWASMName("fixed_mul")...)
+		imports = append(imports, 0x00, 0x02)
+		functionIndex = 1
+	}
+
+	exports := []byte{0x03}
+	exports = append(append(exports, encodeWASMName("allocate")...), 0x00, functionIndex)
+	exports = append(append(exports, encodeWASMName("compute")...), 0x00, functionIndex+1)
+	exports = append(append(exports, encodeWASMName("memory")...), 0x02, 0x00)
+
+	computeFunction := append([]byte{0x00}, computeBody...)
+	code := []byte{0x02, 0x04, 0x00, 0x41, 0x00, wasmOpEnd}
+	code = append(append(code, encodeULEB128(uint64(len(computeFunction)))...), computeFunction...)
+
+	wasm := append([]byte{}, wasmMagic...)
+	// (i32) -> i32, (i32, i32) -> i32, (i32, i32, i32) -> i32
+	wasm = append(wasm, section(1,
+		0x03, 0x60, 0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7f, 0x60, 0x03, 0x7f, 0x7f, 0x7f, 0x01, 0x7f,
+	)...)
+	if imports != nil {
+		wasm = append(wasm, section(wasmSectionImport, imports...)...)
+	}
+	wasm = append(wasm, section(3, 0x02, 0x00, 0x01)...)
+	wasm = append(wasm, section(5, 0x01, 0x00, 0x01)...)
+	wasm = append(wasm, section(wasmSectionExport, exports...)...)
+	return append(wasm, section(wasmSectionCode, code...)...)
+}
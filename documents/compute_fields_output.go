@@ -0,0 +1,244 @@
+package documents
+
+import (
+	"encoding/binary"
+	"io"
+	"math/big"
+	"time"
+	"unicode/utf8"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/pod/errors"
+	ethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/shopspring/decimal"
+	"github.com/tetratelabs/wazero/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// computeFieldsLegacyResultLength is the length of the raw result of rules that don't declare an output type.
+const computeFieldsLegacyResultLength = 32
+
+// isComputeFieldsOutputTypeAllowed checks if the attribute type can be declared as the output type of a
+// compute fields rule. An empty output type stores the raw 32 byte result as a bytes attribute.
+//
+// The pointer returned by `compute` points to the SCALE encoded value of the output type:
+//
+//	bytes:     Vec<u8>
+//	string:    Vec<u8> holding a UTF-8 string
+//	integer:   [u8; 32] big endian 2's complement signed integer
+//	decimal:   [u8; 32] big endian 2's complement fixed point number with 18 decimals
+//	timestamp: i64 unix timestamp in seconds
+//	monetary:  ([u8; 32] decimal value, bool is token, Vec<u8> currency ID, Vec<u8> chain ID)
+func isComputeFieldsOutputTypeAllowed(outputType AttributeType) bool {
+	switch outputType {
+	case "", AttrBytes, AttrString, AttrInt256, AttrDecimal, AttrTimestamp, AttrMonetary:
+		return true
+	default:
+		return false
+	}
+}
+
+// ComputeFieldsOutputType returns the output type declared by the compute fields rule.
+// It's empty if the rule doesn't declare an output type.
+func ComputeFieldsOutputType(rule *coredocumentpb.TransitionRule) AttributeType {
+	if rule.GetOutputType() == coredocumentpb.AttributeType_ATTRIBUTE_TYPE_INVALID {
+		return ""
+	}
+
+	return getAttributeTypeFromProtocolType(rule.GetOutputType())
+}
+
+// computeFieldsProtocolOutputType returns the protobuf output type of a compute fields rule.
+func computeFieldsProtocolOutputType(outputType AttributeType) coredocumentpb.AttributeType {
+	if outputType == "" {
+		return coredocumentpb.AttributeType_ATTRIBUTE_TYPE_INVALID
+	}
+
+	return getProtocolAttributeType(outputType)
+}
+
+// computeFieldsZeroValue returns the value of the target attribute when the execution fails.
+func computeFieldsZeroValue(outputType AttributeType) (AttrVal, error) {
+	switch outputType {
+	case "":
+		return AttrVal{Type: AttrBytes, Bytes: make([]byte, computeFieldsLegacyResultLength)}, nil
+	case AttrBytes:
+		return AttrVal{Type: AttrBytes, Bytes: []byte{}}, nil
+	case AttrString:
+		return AttrVal{Type: AttrString}, nil
+	case AttrInt256:
+		i, err := NewInt256("0")
+		return AttrVal{Type: AttrInt256, Int256: i}, err
+	case AttrDecimal:
+		d, err := NewDecimal("0")
+		return AttrVal{Type: AttrDecimal, Decimal: d}, err
+	case AttrTimestamp:
+		return AttrVal{Type: AttrTimestamp, Timestamp: timestamppb.New(time.Unix(0, 0))}, nil
+	case AttrMonetary:
+		d, err := NewDecimal("0")
+		return AttrVal{Type: AttrMonetary, Monetary: Monetary{Value: d}}, err
+	default:
+		return AttrVal{}, errors.NewTypedError(ErrComputeFieldsOutputType, errors.New("type: %s", outputType))
+	}
+}
+
+// wasmMemoryReader reads the WASM memory sequentially, starting at offset.
+type wasmMemoryReader struct {
+	mem    api.Memory
+	offset uint32
+}
+
+func (r *wasmMemoryReader) Read(p []byte) (int, error) {
+	remaining := r.remaining()
+	if remaining == 0 {
+		return 0, io.EOF
+	}
+
+	n := uint32(len(p))
+	if n > remaining {
+		n = remaining
+	}
+
+	b, _ := r.mem.Read(r.offset, n)
+	copy(p, b)
+	r.offset += n
+	return int(n), nil
+}
+
+func (r *wasmMemoryReader) remaining() uint32 {
+	if r.offset >= r.mem.Size() {
+		return 0
+	}
+
+	return r.mem.Size() - r.offset
+}
+
+func (r *wasmMemoryReader) readFixed(size uint32) ([]byte, error) {
+	b := make([]byte, size)
+	_, err := io.ReadFull(r, b)
+	return b, err
+}
+
+func (r *wasmMemoryReader) readVec() ([]byte, error) {
+	n, err := scale.NewDecoder(r).DecodeUintCompact()
+	if err != nil {
+		return nil, err
+	}
+
+	// the length is checked before allocating, since it's controlled by the WASM
+	if !n.IsUint64() || n.Uint64() > uint64(r.remaining()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return r.readFixed(uint32(n.Uint64()))
+}
+
+func (r *wasmMemoryReader) readBool() (bool, error) {
+	b, err := r.readFixed(1)
+	if err != nil {
+		return false, err
+	}
+
+	switch b[0] {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	default:
+		return false, errors.New("invalid bool")
+	}
+}
+
+func (r *wasmMemoryReader) readDecimal() (*Decimal, error) {
+	b, err := r.readFixed(32)
+	if err != nil {
+		return nil, err
+	}
+
+	i := ethmath.S256(new(big.Int).SetBytes(b))
+	return NewDecimal(decimal.NewFromBigInt(i, -decimalPrecision).String())
+}
+
+// decodeComputeFieldsResult decodes the value of the output type at ptr from the WASM memory.
+func decodeComputeFieldsResult(mem api.Memory, ptr uint32, outputType AttributeType) (attrVal AttrVal, err error) {
+	r := &wasmMemoryReader{mem: mem, offset: ptr}
+	attrVal.Type = outputType
+
+	switch outputType {
+	case "":
+		attrVal.Type = AttrBytes
+		attrVal.Bytes, err = r.readFixed(computeFieldsLegacyResultLength)
+	case AttrBytes:
+		attrVal.Bytes, err = r.readVec()
+	case AttrString:
+		var b []byte
+		b, err = r.readVec()
+		if err == nil && !utf8.Valid(b) {
+			err = errors.New("invalid UTF-8 string")
+		}
+
+		attrVal.Str = string(b)
+	case AttrInt256:
+		var b []byte
+		b, err = r.readFixed(32)
+		if err == nil {
+			attrVal.Int256, err = Int256FromBytes(b)
+		}
+	case AttrDecimal:
+		attrVal.Decimal, err = r.readDecimal()
+	case AttrTimestamp:
+		var b []byte
+		b, err = r.readFixed(8)
+		if err == nil {
+			attrVal.Timestamp = timestamppb.New(time.Unix(int64(binary.LittleEndian.Uint64(b)), 0))
+			err = attrVal.Timestamp.CheckValid()
+		}
+	case AttrMonetary:
+		attrVal.Monetary, err = r.readMonetary()
+	default:
+		err = errors.NewTypedError(ErrComputeFieldsOutputType, errors.New("type: %s", outputType))
+	}
+
+	if err != nil {
+		return AttrVal{}, errors.NewTypedError(ErrComputeFieldsInvalidResult, err)
+	}
+
+	return attrVal, nil
+}
+
+func (r *wasmMemoryReader) readMonetary() (m Monetary, err error) {
+	m.Value, err = r.readDecimal()
+	if err != nil {
+		return m, err
+	}
+
+	token, err := r.readBool()
+	if err != nil {
+		return m, err
+	}
+
+	if token {
+		m.Type = MonetaryToken
+	}
+
+	m.ID, err = r.readVec()
+	if err != nil {
+		return m, err
+	}
+
+	if len(m.ID) > monetaryIDLength {
+		return m, errors.New("monetary ID exceeds %d bytes", monetaryIDLength)
+	}
+
+	m.ChainID, err = r.readVec()
+	if err != nil {
+		return m, err
+	}
+
+	if len(m.ChainID) > monetaryChainIDLength {
+		return m, errors.New("monetary chain ID exceeds %d bytes", monetaryChainIDLength)
+	}
+
+	return m, nil
+}
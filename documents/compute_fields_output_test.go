@@ -0,0 +1,214 @@
+//go:build unit
+
+package documents
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/pod/errors"
+	pathUtils "github.com/centrifuge/pod/testingutils/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// getComputeFieldsTestMemory returns the memory of a module that only exports a single page of memory.
+func getComputeFieldsTestMemory(t *testing.T) api.Memory {
+	ctx := context.Background()
+	runtime := wazero.NewRuntime(ctx)
+	t.Cleanup(func() {
+		assert.NoError(t, runtime.Close(ctx))
+	})
+
+	wasm := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		// memory section with a single page
+		0x05, 0x03, 0x01, 0x00, 0x01,
+		// export section with the memory
+		0x07, 0x0a, 0x01, 0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00,
+	}
+
+	mod, err := runtime.Instantiate(ctx, wasm)
+	assert.NoError(t, err)
+	return mod.Memory()
+}
+
+func Test_isComputeFieldsOutputTypeAllowed(t *testing.T) {
+	for _, outputType := range []AttributeType{"", AttrBytes, AttrString, AttrInt256, AttrDecimal, AttrTimestamp, AttrMonetary} {
+		assert.True(t, isComputeFieldsOutputTypeAllowed(outputType))
+
+		zero, err := computeFieldsZeroValue(outputType)
+		assert.NoError(t, err)
+
+		if outputType != "" {
+			assert.Equal(t, outputType, zero.Type)
+		}
+
+		// the zero value must be storable in the document
+		_, err = toProtocolAttribute(Attribute{KeyLabel: "result", Value: zero})
+		assert.NoError(t, err)
+	}
+
+	for _, outputType := range []AttributeType{AttrSigned, AttrReference, AttrAttachment, AttrList, AttrMap, "unknown"} {
+		assert.False(t, isComputeFieldsOutputTypeAllowed(outputType))
+
+		_, err := computeFieldsZeroValue(outputType)
+		assert.True(t, errors.IsOfType(ErrComputeFieldsOutputType, err))
+	}
+
+	rule := &coredocumentpb.TransitionRule{OutputType: coredocumentpb.AttributeType_ATTRIBUTE_TYPE_DECIMAL}
+	assert.Equal(t, AttrDecimal, ComputeFieldsOutputType(rule))
+
+	rule = &coredocumentpb.TransitionRule{}
+	assert.Equal(t, AttributeType(""), ComputeFieldsOutputType(rule))
+
+	for _, outputType := range []AttributeType{"", AttrBytes, AttrString, AttrInt256, AttrDecimal, AttrTimestamp, AttrMonetary} {
+		rule = &coredocumentpb.TransitionRule{OutputType: computeFieldsProtocolOutputType(outputType)}
+		assert.Equal(t, outputType, ComputeFieldsOutputType(rule))
+	}
+}
+
+func Test_decodeComputeFieldsResult(t *testing.T) {
+	mem := getComputeFieldsTestMemory(t)
+
+	// -1.5 as a fixed point number with 18 decimals
+	fixed, err := NewInt256("-1500000000000000000")
+	assert.NoError(t, err)
+	fixedBytes := fixed.Bytes()
+
+	timestamp := make([]byte, 8)
+	binary.LittleEndian.PutUint64(timestamp, 1666000000)
+
+	monetary := append(fixedBytes[:], 0x00, 0x0c, 'U', 'S', 'D', 0x10, 0x00, 0x00, 0x00, 0x01)
+
+	legacy := make([]byte, 32)
+	legacy[31] = 0x01
+
+	dec, err := NewDecimal("-1.5")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		outputType AttributeType
+		encoded    []byte
+		result     AttrVal
+		err        bool
+	}{
+		{
+			outputType: "",
+			encoded:    legacy,
+			result:     AttrVal{Type: AttrBytes, Bytes: legacy},
+		},
+		{
+			outputType: AttrBytes,
+			encoded:    []byte{0x0c, 0x01, 0x02, 0x03},
+			result:     AttrVal{Type: AttrBytes, Bytes: []byte{0x01, 0x02, 0x03}},
+		},
+		{
+			outputType: AttrString,
+			encoded:    []byte{0x14, 'h', 'e', 'l', 'l', 'o'},
+			result:     AttrVal{Type: AttrString, Str: "hello"},
+		},
+		{
+			outputType: AttrInt256,
+			encoded:    fixedBytes[:],
+			result:     AttrVal{Type: AttrInt256, Int256: fixed},
+		},
+		{
+			outputType: AttrDecimal,
+			encoded:    fixedBytes[:],
+			result:     AttrVal{Type: AttrDecimal, Decimal: dec},
+		},
+		{
+			outputType: AttrMonetary,
+			encoded:    monetary,
+			result: AttrVal{Type: AttrMonetary, Monetary: Monetary{
+				Value:   dec,
+				ID:      []byte("USD"),
+				ChainID: []byte{0x00, 0x00, 0x00, 0x01},
+			}},
+		},
+		// invalid UTF-8 string
+		{
+			outputType: AttrString,
+			encoded:    []byte{0x04, 0xff},
+			err:        true,
+		},
+		// invalid monetary bool
+		{
+			outputType: AttrMonetary,
+			encoded:    append(fixedBytes[:], 0x02, 0x00, 0x00),
+			err:        true,
+		},
+		// monetary chain ID too long
+		{
+			outputType: AttrMonetary,
+			encoded:    append(fixedBytes[:], 0x00, 0x00, 0x14, 0x01, 0x02, 0x03, 0x04, 0x05),
+			err:        true,
+		},
+		// unsupported output type
+		{
+			outputType: AttrList,
+			err:        true,
+		},
+	}
+
+	for _, test := range tests {
+		assert.True(t, mem.Write(0, test.encoded))
+
+		res, err := decodeComputeFieldsResult(mem, 0, test.outputType)
+		if test.err {
+			assert.True(t, errors.IsOfType(ErrComputeFieldsInvalidResult, err))
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.result, res, test.outputType)
+	}
+
+	// timestamps are unix seconds
+	assert.True(t, mem.Write(0, timestamp))
+	res, err := decodeComputeFieldsResult(mem, 0, AttrTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1666000000, 0).UTC(), res.Timestamp.AsTime())
+
+	// the vector length exceeds the memory
+	assert.True(t, mem.Write(0, []byte{0x03, 0x00, 0x00, 0x01}))
+	_, err = decodeComputeFieldsResult(mem, 0, AttrBytes)
+	assert.True(t, errors.IsOfType(ErrComputeFieldsInvalidResult, err))
+
+	// the fixed size value exceeds the memory
+	_, err = decodeComputeFieldsResult(mem, mem.Size()-16, AttrInt256)
+	assert.True(t, errors.IsOfType(ErrComputeFieldsInvalidResult, err))
+
+	_, err = decodeComputeFieldsResult(mem, mem.Size()+1, "")
+	assert.True(t, errors.IsOfType(ErrComputeFieldsInvalidResult, err))
+}
+
+func Test_executeWASM_OutputType(t *testing.T) {
+	wasm := wasmLoader(t, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"))
+	attrs := getValidComputeFieldAttrs(t)
+
+	// the 32 byte result of simple_average is decoded as an integer
+	execution := executeWASM(wasm, attrs, time.Time{}, AttrInt256, computeFieldsGasLimit)
+	assert.NoError(t, execution.Err)
+
+	expected, err := Int256FromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7, 0xd0})
+	assert.NoError(t, err)
+	assert.Equal(t, AttrVal{Type: AttrInt256, Int256: expected}, execution.Result)
+
+	// the execution fails, the result is the zero value of the output type
+	execution = executeWASM(wasm, attrs, time.Time{}, AttrDecimal, 100)
+	assert.True(t, errors.IsOfType(ErrComputeFieldsOutOfGas, execution.Err))
+
+	zero, err := computeFieldsZeroValue(AttrDecimal)
+	assert.NoError(t, err)
+	assert.Equal(t, zero, execution.Result)
+
+	// unsupported output type
+	execution = executeWASM(wasm, attrs, time.Time{}, AttrList, computeFieldsGasLimit)
+	assert.True(t, errors.IsOfType(ErrComputeFieldsOutputType, execution.Err))
+}
@@ -3,7 +3,9 @@
 package documents
 
 import (
+	"encoding/binary"
 	"io/ioutil"
+	"math/big"
 	"testing"
 	"time"
 
@@ -14,7 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func wasmLoader(t *testing.T, wasm string) []byte {
+func wasmLoader(t testing.TB, wasm string) []byte {
 	if wasm == "" {
 		return utils.RandomSlice(32)
 	}
@@ -50,7 +52,7 @@ func Test_fetchComputeFunctions(t *testing.T) {
 
 	for _, test := range tests {
 		wasm := wasmLoader(t, test.wasm)
-		_, _, _, err := fetchComputeFunctions(wasm)
+		_, err := fetchComputeFunctions(wasm)
 		assert.Equal(t, err, test.err)
 	}
 }
@@ -65,7 +67,7 @@ func getInvalidComputeFieldAttrs(t *testing.T) []Attribute {
 	return []Attribute{attr1}
 }
 
-func getValidComputeFieldAttrs(t *testing.T) []Attribute {
+func getValidComputeFieldAttrs(t testing.TB) []Attribute {
 	attr1, err := NewStringAttribute("test", AttrInt256, "1000")
 	assert.NoError(t, err)
 
@@ -120,22 +122,26 @@ func Test_executeWASM(t *testing.T) {
 		wasm   string
 		attrs  []Attribute
 		result [32]byte
+		err    error
 	}{
 		// invalid WASM
 		{
 			wasm: pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/without_allocate.wasm"),
+			err:  ErrComputeFieldsExecution,
 		},
 
 		// invalid Attributes
 		{
 			wasm:  pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"),
 			attrs: getInvalidComputeFieldAttrs(t),
+			err:   ErrComputeFieldsExecution,
 		},
 
-		// exceeded timeout
+		// exceeded gas limit
 		{
 			wasm:  pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/long_running.wasm"),
 			attrs: getValidComputeFieldAttrs(t),
+			err:   ErrComputeFieldsOutOfGas,
 		},
 
 		// success
@@ -149,9 +155,100 @@ func Test_executeWASM(t *testing.T) {
 
 	for _, test := range tests {
 		wasm := wasmLoader(t, test.wasm)
-		result := executeWASM(wasm, test.attrs, time.Second*10)
-		assert.Equal(t, test.result, result)
+		execution := executeWASM(wasm, test.attrs, time.Time{}, "", 1_000_000)
+		assert.Equal(t, AttrVal{Type: AttrBytes, Bytes: test.result[:]}, execution.Result)
+
+		if test.err == nil {
+			assert.NoError(t, execution.Err)
+			assert.NotZero(t, execution.GasUsed)
+			continue
+		}
+
+		assert.True(t, errors.IsOfType(test.err, execution.Err))
+	}
+}
+
+func Test_executeWASM_HostFunctions(t *testing.T) {
+	wasm := wasmLoader(t, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/host_functions.wasm"))
+
+	price, err := NewStringAttribute("price", AttrInt256, "2500000000000000000")
+	assert.NoError(t, err)
+
+	quantity, err := NewStringAttribute("quantity", AttrInt256, "4000000000000000000")
+	assert.NoError(t, err)
+
+	anchoredTime := time.Unix(1666000000, 0)
+
+	// the WASM writes price*quantity and overwrites the first 8 bytes with the little endian anchored time
+	execution := executeWASM(wasm, []Attribute{price, quantity}, anchoredTime, "", computeFieldsGasLimit)
+	assert.NoError(t, execution.Err)
+
+	expected, err := NewInt256("10000000000000000000")
+	assert.NoError(t, err)
+
+	result := expected.Bytes()
+	binary.LittleEndian.PutUint64(result[:8], uint64(anchoredTime.Unix()))
+	assert.Equal(t, result[:], execution.Result.Bytes)
+
+	// missing attributes are read as -1 length, leaving the operands zero
+	execution = executeWASM(wasm, []Attribute{price}, anchoredTime, "", computeFieldsGasLimit)
+	assert.NoError(t, execution.Err)
+
+	result = [32]byte{}
+	binary.LittleEndian.PutUint64(result[:8], uint64(anchoredTime.Unix()))
+	assert.Equal(t, result[:], execution.Result.Bytes)
+
+	// simple_average doesn't import host functions
+	execution = executeWASM(
+		wasmLoader(t, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm")),
+		getValidComputeFieldAttrs(t),
+		anchoredTime,
+		"",
+		computeFieldsGasLimit,
+	)
+	assert.NoError(t, execution.Err)
+}
+
+func Test_fixedPointOperations(t *testing.T) {
+	a, ok := new(big.Int).SetString("1500000000000000000", 10)
+	assert.True(t, ok)
+
+	b, ok := new(big.Int).SetString("-3000000000000000000", 10)
+	assert.True(t, ok)
+
+	res, ok := fixedMul(a, b)
+	assert.True(t, ok)
+	assert.Equal(t, "-4500000000000000000", res.String())
+
+	res, ok = fixedDiv(a, b)
+	assert.True(t, ok)
+	assert.Equal(t, "-500000000000000000", res.String())
+
+	_, ok = fixedDiv(a, big.NewInt(0))
+	assert.False(t, ok)
+}
+
+func Test_isComputeFieldsHostFunction(t *testing.T) {
+	for _, name := range []string{"get_attribute", "fixed_mul", "fixed_div", "anchored_time"} {
+		assert.True(t, isComputeFieldsHostFunction(computeFieldsHostModule, name))
 	}
+
+	assert.False(t, isComputeFieldsHostFunction(computeFieldsHostModule, "unknown"))
+	assert.False(t, isComputeFieldsHostFunction("unknown", "get_attribute"))
+}
+
+func Test_computeModuleCache(t *testing.T) {
+	wasm := wasmLoader(t, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"))
+
+	compiled, err := computeModules.get(wasm)
+	assert.NoError(t, err)
+
+	cached, err := computeModules.get(append([]byte{}, wasm...))
+	assert.NoError(t, err)
+	assert.True(t, compiled == cached)
+
+	_, err = computeModules.get(utils.RandomSlice(32))
+	assert.Error(t, err)
 }
 
 func TestCoreDocument_ExecuteComputeFields(t *testing.T) {
@@ -163,15 +260,14 @@ func TestCoreDocument_ExecuteComputeFields(t *testing.T) {
 	assert.Len(t, cd.Attributes, 3)
 
 	// no compute field rule exists
-	timeout := time.Second * 10
-	err = cd.ExecuteComputeFields(timeout)
+	err = cd.ExecuteComputeFields(computeFieldsGasLimit)
 	assert.NoError(t, err)
 	assert.Len(t, cd.Attributes, 3)
 
 	// add compute field rule
 	wasmPath := pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm")
 	wasm := wasmLoader(t, wasmPath)
-	_, err = cd.AddComputeFieldsRule(wasm, []string{"test", "test2", "test3"}, "result")
+	_, err = cd.AddComputeFieldsRule(wasm, []string{"test", "test2", "test3"}, "result", "")
 	assert.NoError(t, err)
 	assert.Len(t, cd.Document.TransitionRules, 1)
 	assert.Len(t, cd.Attributes, 3)
@@ -182,7 +278,7 @@ func TestCoreDocument_ExecuteComputeFields(t *testing.T) {
 	_, err = cd.GetAttribute(targetKey)
 	assert.Error(t, err)
 
-	err = cd.ExecuteComputeFields(timeout)
+	err = cd.ExecuteComputeFields(computeFieldsGasLimit)
 	assert.NoError(t, err)
 	assert.Len(t, cd.Attributes, 4)
 
@@ -196,4 +292,60 @@ func TestCoreDocument_ExecuteComputeFields(t *testing.T) {
 			Bytes: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7, 0xd0},
 		},
 	})
+
+	// the execution runs out of gas, the error is stored on the document
+	errorKey, err := AttrKeyFromLabel(ComputeFieldsErrorLabel("result"))
+	assert.NoError(t, err)
+
+	err = cd.ExecuteComputeFields(100)
+	assert.NoError(t, err)
+	assert.Len(t, cd.Attributes, 5)
+
+	attr, err = cd.GetAttribute(targetKey)
+	assert.NoError(t, err)
+	assert.Equal(t, make([]byte, 32), attr.Value.Bytes)
+
+	attr, err = cd.GetAttribute(errorKey)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrComputeFieldsOutOfGas.Error(), attr.Value.Str)
+
+	// the error is removed once the execution succeeds
+	err = cd.ExecuteComputeFields(computeFieldsGasLimit)
+	assert.NoError(t, err)
+	assert.Len(t, cd.Attributes, 4)
+	assert.False(t, cd.AttributeExists(errorKey))
+}
+
+func TestDryRunComputeFields(t *testing.T) {
+	attrs := getValidComputeFieldAttrs(t)
+
+	doc := NewDocumentMock(t)
+	for _, attr := range attrs {
+		doc.On("GetAttribute", attr.Key).Return(attr, nil).Once()
+	}
+
+	missingKey, err := AttrKeyFromLabel("missing")
+	assert.NoError(t, err)
+
+	doc.On("GetAttribute", missingKey).Return(Attribute{}, ErrCDAttribute).Once()
+
+	wasm := wasmLoader(t, pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"))
+
+	execution, err := DryRunComputeFields(doc, wasm, []string{"test", "test2", "test3", "missing"}, "")
+	assert.NoError(t, err)
+	assert.NoError(t, execution.Err)
+	assert.NotZero(t, execution.GasUsed)
+	assert.Equal(
+		t,
+		[]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7, 0xd0},
+		execution.Result.Bytes,
+	)
+
+	// invalid WASM
+	_, err = DryRunComputeFields(doc, utils.RandomSlice(32), []string{"test"}, "")
+	assert.Equal(t, errors.AppendError(nil, ErrComputeFieldsInvalidWASM), err)
+
+	// unsupported output type
+	_, err = DryRunComputeFields(doc, wasm, []string{"test"}, AttrList)
+	assert.True(t, errors.IsOfType(ErrComputeFieldsOutputType, err))
 }
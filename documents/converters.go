@@ -3,6 +3,7 @@ package documents
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -22,6 +23,10 @@ const (
 	monetaryChainIDLength = 4
 	// monetaryIDLength is the fixed length of the byte representation of monetary ID
 	monetaryIDLength = 32
+	// referenceFieldLength is the fixed length of the document ID, version ID and document root of a reference
+	referenceFieldLength = 32
+	// attachmentHashLength is the fixed length of the content hash of an attachment
+	attachmentHashLength = 32
 )
 
 // BinaryAttachment represent a single file attached to invoice.
@@ -203,80 +208,148 @@ func FromProtocolPaymentDetails(pdetails []*commonpb.PaymentDetails) ([]*Payment
 
 // toProtocolAttributes convert model attributes to p2p attributes
 // since the protocol representation of attributes is a list, we will always sort the keys and then insert to the list.
+// List and map attributes are flattened so that every element becomes a separate protocol attribute.
 func toProtocolAttributes(attrs map[AttrKey]Attribute) (pattrs []*coredocumentpb.Attribute, err error) {
+	flattened := make(map[AttrKey]*coredocumentpb.Attribute)
+	for _, attr := range attrs {
+		fattrs, err := flattenAttribute(attr)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pattr := range fattrs {
+			key, err := AttrKeyFromBytes(pattr.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := flattened[key]; ok {
+				return nil, errors.NewTypedError(ErrAttrKeyCollision, errors.New("attribute label: %s", pattr.KeyLabel))
+			}
+
+			flattened[key] = pattr
+		}
+	}
+
 	var keys [][32]byte
-	for k := range attrs {
-		k := k
+	for k := range flattened {
 		keys = append(keys, k)
 	}
 
-	keys = byteutils.SortByte32Slice(keys)
-	for _, k := range keys {
-		attr := attrs[k]
-		if !isAttrTypeAllowed(attr.Value.Type) {
-			return nil, ErrNotValidAttrType
-		}
+	for _, k := range byteutils.SortByte32Slice(keys) {
+		pattrs = append(pattrs, flattened[k])
+	}
+
+	return pattrs, nil
+}
+
+// flattenAttribute converts the attribute and, recursively, all its elements to protocol attributes.
+func flattenAttribute(attr Attribute) ([]*coredocumentpb.Attribute, error) {
+	pattr, err := toProtocolAttribute(attr)
+	if err != nil {
+		return nil, err
+	}
 
-		pattr := &coredocumentpb.Attribute{
-			Key:      attr.Key[:],
-			KeyLabel: []byte(attr.KeyLabel),
-			Type:     getProtocolAttributeType(attr.Value.Type),
+	elems, err := attr.elements()
+	if err != nil {
+		return nil, err
+	}
+
+	pattrs := []*coredocumentpb.Attribute{pattr}
+	for _, elem := range elems {
+		if !isAttrElementTypeAllowed(elem.Value.Type) {
+			return nil, ErrNotValidAttrType
 		}
 
-		switch attr.Value.Type {
-		case AttrInt256:
-			b := attr.Value.Int256.Bytes()
-			pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: b[:]}
-		case AttrDecimal:
-			b, err := attr.Value.Decimal.Bytes()
-			if err != nil {
-				return nil, err
-			}
-			pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: b}
-		case AttrString:
-			pattr.Value = &coredocumentpb.Attribute_StrVal{StrVal: attr.Value.Str}
-		case AttrBytes:
-			pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: attr.Value.Bytes}
-		case AttrTimestamp:
-			b, err := byteutils.TimestampToBytes(attr.Value.Timestamp, maxTimeByteLength)
-			if err != nil {
-				return nil, err
-			}
-			pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: b}
-		case AttrSigned:
-			signed := attr.Value.Signed
-			pattr.Value = &coredocumentpb.Attribute_SignedVal{
-				SignedVal: &coredocumentpb.Signed{
-					DocVersion: signed.DocumentVersion,
-					Value:      signed.Value,
-					Signature:  signed.Signature,
-					PublicKey:  signed.PublicKey,
-					Identity:   signed.Identity[:],
-					Type:       getProtocolAttributeType(signed.Type),
-				},
-			}
-		case AttrMonetary:
-			monetary := attr.Value.Monetary
-			decBytes, err := monetary.Value.Bytes()
-			if err != nil {
-				return nil, err
-			}
-			pattr.Value = &coredocumentpb.Attribute_MonetaryVal{
-				MonetaryVal: &coredocumentpb.Monetary{
-					Type:  getProtocolMonetaryType(monetary.Type),
-					Value: decBytes,
-					Chain: append(make([]byte, monetaryChainIDLength-len(monetary.ChainID)), monetary.ChainID...),
-					Id:    append(make([]byte, monetaryIDLength-len(monetary.ID)), monetary.ID...),
-				},
-			}
+		eattrs, err := flattenAttribute(elem)
+		if err != nil {
+			return nil, err
 		}
 
-		pattrs = append(pattrs, pattr)
+		pattrs = append(pattrs, eattrs...)
 	}
 
 	return pattrs, nil
 }
 
+// toProtocolAttribute converts a single model attribute to a p2p attribute.
+// List attributes hold the number of elements and map attributes hold the sorted element keys.
+func toProtocolAttribute(attr Attribute) (*coredocumentpb.Attribute, error) {
+	if !isAttrTypeAllowed(attr.Value.Type) {
+		return nil, ErrNotValidAttrType
+	}
+
+	pattr := &coredocumentpb.Attribute{
+		Key:      attr.Key[:],
+		KeyLabel: []byte(attr.KeyLabel),
+		Type:     getProtocolAttributeType(attr.Value.Type),
+	}
+
+	switch attr.Value.Type {
+	case AttrInt256:
+		b := attr.Value.Int256.Bytes()
+		pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: b[:]}
+	case AttrDecimal:
+		b, err := attr.Value.Decimal.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: b}
+	case AttrString:
+		pattr.Value = &coredocumentpb.Attribute_StrVal{StrVal: attr.Value.Str}
+	case AttrBytes:
+		pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: attr.Value.Bytes}
+	case AttrTimestamp:
+		b, err := byteutils.TimestampToBytes(attr.Value.Timestamp, maxTimeByteLength)
+		if err != nil {
+			return nil, err
+		}
+		pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: b}
+	case AttrSigned:
+		signed := attr.Value.Signed
+		pattr.Value = &coredocumentpb.Attribute_SignedVal{
+			SignedVal: &coredocumentpb.Signed{
+				DocVersion: signed.DocumentVersion,
+				Value:      signed.Value,
+				Signature:  signed.Signature,
+				PublicKey:  signed.PublicKey,
+				Identity:   signed.Identity[:],
+				Type:       getProtocolAttributeType(signed.Type),
+			},
+		}
+	case AttrReference:
+		pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: attr.Value.Reference.Bytes()}
+	case AttrAttachment:
+		pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: attr.Value.Attachment.Bytes()}
+	case AttrList:
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, uint64(len(attr.Value.List)))
+		pattr.Value = &coredocumentpb.Attribute_ByteVal{ByteVal: b}
+	case AttrMap:
+		b, err := json.Marshal(sortedMapKeys(attr.Value.Map))
+		if err != nil {
+			return nil, err
+		}
+		pattr.Value = &coredocumentpb.Attribute_StrVal{StrVal: string(b)}
+	case AttrMonetary:
+		monetary := attr.Value.Monetary
+		decBytes, err := monetary.Value.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		pattr.Value = &coredocumentpb.Attribute_MonetaryVal{
+			MonetaryVal: &coredocumentpb.Monetary{
+				Type:  getProtocolMonetaryType(monetary.Type),
+				Value: decBytes,
+				Chain: append(make([]byte, monetaryChainIDLength-len(monetary.ChainID)), monetary.ChainID...),
+				Id:    append(make([]byte, monetaryIDLength-len(monetary.ID)), monetary.ID...),
+			},
+		}
+	}
+
+	return pattr, nil
+}
+
 const attributeProtocolPrefix = "ATTRIBUTE_TYPE_"
 
 func getProtocolAttributeType(attrType AttributeType) coredocumentpb.AttributeType {
@@ -306,7 +379,84 @@ func getMonetaryTypeFromProtocolType(mType []byte) MonetaryType {
 }
 
 // fromProtocolAttributes converts protocol attribute list to model attribute map
+// The elements of list and map attributes are folded back into their parent attribute.
 func fromProtocolAttributes(pattrs []*coredocumentpb.Attribute) (map[AttrKey]Attribute, error) {
+	flattened, err := fromFlattenedProtocolAttributes(pattrs)
+	if err != nil {
+		return nil, err
+	}
+
+	elemKeys := make(map[AttrKey]struct{})
+	for _, attr := range flattened {
+		elems, err := attr.elements()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, elem := range elems {
+			elemKeys[elem.Key] = struct{}{}
+		}
+	}
+
+	m := make(map[AttrKey]Attribute)
+	for key, attr := range flattened {
+		if _, ok := elemKeys[key]; ok {
+			continue
+		}
+
+		attr.Value, err = foldAttributeElements(flattened, attr)
+		if err != nil {
+			return nil, err
+		}
+
+		m[key] = attr
+	}
+
+	return m, nil
+}
+
+// foldAttributeElements returns the value of the attribute with the elements of list and map values
+// resolved from the flattened attributes.
+func foldAttributeElements(flattened map[AttrKey]Attribute, attr Attribute) (AttrVal, error) {
+	elems, err := attr.elements()
+	if err != nil {
+		return AttrVal{}, err
+	}
+
+	vals := make([]AttrVal, len(elems))
+	for i, elem := range elems {
+		eattr, ok := flattened[elem.Key]
+		if !ok {
+			return AttrVal{}, errors.NewTypedError(ErrWrongAttrFormat, errors.New("missing element %s", elem.KeyLabel))
+		}
+
+		if !isAttrElementTypeAllowed(eattr.Value.Type) {
+			return AttrVal{}, ErrNotValidAttrType
+		}
+
+		vals[i], err = foldAttributeElements(flattened, eattr)
+		if err != nil {
+			return AttrVal{}, err
+		}
+	}
+
+	val := attr.Value
+	switch val.Type {
+	case AttrList:
+		val.List = vals
+	case AttrMap:
+		val.Map = make(map[string]AttrVal, len(vals))
+		for i, k := range sortedMapKeys(attr.Value.Map) {
+			val.Map[k] = vals[i]
+		}
+	}
+
+	return val, nil
+}
+
+// fromFlattenedProtocolAttributes converts protocol attributes to model attributes without folding the elements
+// of list and map attributes. The values of list and map attributes only hold placeholders for their elements.
+func fromFlattenedProtocolAttributes(pattrs []*coredocumentpb.Attribute) (map[AttrKey]Attribute, error) {
 	m := make(map[AttrKey]Attribute)
 	for _, pattr := range pattrs {
 		attrKey, err := AttrKeyFromBytes(pattr.Key)
@@ -320,6 +470,11 @@ func fromProtocolAttributes(pattrs []*coredocumentpb.Attribute) (map[AttrKey]Att
 			KeyLabel: string(pattr.KeyLabel),
 		}
 
+		// every list element is a separate attribute, so a list cannot be longer than the attribute list.
+		if b := pattr.GetByteVal(); attrType == AttrList && len(b) == 8 && binary.BigEndian.Uint64(b) > uint64(len(pattrs)) {
+			return nil, errors.NewTypedError(ErrWrongAttrFormat, errors.New("invalid list length"))
+		}
+
 		attr.Value, err = attrValFromProtocolAttribute(attrType, pattr)
 		if err != nil {
 			return nil, err
@@ -375,6 +530,25 @@ func attrValFromProtocolAttribute(attrType AttributeType, attribute *coredocumen
 			Type:            getAttributeTypeFromProtocolType(val.Type),
 			Signature:       val.Signature,
 		}
+	case AttrReference:
+		attrVal.Reference, err = ReferenceFromBytes(attribute.GetByteVal())
+	case AttrAttachment:
+		attrVal.Attachment, err = AttachmentFromBytes(attribute.GetByteVal())
+	case AttrList:
+		b := attribute.GetByteVal()
+		if len(b) != 8 {
+			return attrVal, errors.NewTypedError(ErrWrongAttrFormat, errors.New("invalid list length"))
+		}
+		attrVal.List = make([]AttrVal, binary.BigEndian.Uint64(b))
+	case AttrMap:
+		var keys []string
+		if err := json.Unmarshal([]byte(attribute.GetStrVal()), &keys); err != nil {
+			return attrVal, errors.NewTypedError(ErrWrongAttrFormat, err)
+		}
+		attrVal.Map = make(map[string]AttrVal, len(keys))
+		for _, k := range keys {
+			attrVal.Map[k] = AttrVal{}
+		}
 	case AttrMonetary:
 		val := attribute.GetMonetaryVal()
 		dec, err := DecimalFromBytes(val.Value)
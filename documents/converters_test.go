@@ -261,3 +261,114 @@ func TestAttributes_monetary(t *testing.T) {
 	}
 
 }
+
+func TestAttributes_listAndMap(t *testing.T) {
+	amount, err := NewInt256("1000")
+	assert.NoError(t, err)
+
+	item := AttrVal{Type: AttrMap, Map: map[string]AttrVal{
+		"description": {Type: AttrString, Str: "widget"},
+		"amount":      {Type: AttrInt256, Int256: amount},
+	}}
+
+	list, err := NewListAttribute("line_items", []AttrVal{item, {Type: AttrBytes, Bytes: []byte{1, 2}}})
+	assert.NoError(t, err)
+
+	str, err := NewStringAttribute("comment", AttrString, "some comment")
+	assert.NoError(t, err)
+
+	attrs := map[AttrKey]Attribute{list.Key: list, str.Key: str}
+	pattrs, err := toProtocolAttributes(attrs)
+	assert.NoError(t, err)
+	// comment, line_items, line_items[0], line_items[0].amount, line_items[0].description, line_items[1]
+	assert.Len(t, pattrs, 6)
+
+	labels := make(map[string]*coredocumentpb.Attribute)
+	for _, pattr := range pattrs {
+		labels[string(pattr.KeyLabel)] = pattr
+		key, err := AttrKeyFromLabel(string(pattr.KeyLabel))
+		assert.NoError(t, err)
+		assert.Equal(t, key[:], pattr.Key)
+	}
+
+	assert.Equal(t, coredocumentpb.AttributeType_ATTRIBUTE_TYPE_LIST, labels["line_items"].Type)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 2}, labels["line_items"].GetByteVal())
+	assert.Equal(t, coredocumentpb.AttributeType_ATTRIBUTE_TYPE_MAP, labels["line_items[0]"].Type)
+	assert.Equal(t, `["amount","description"]`, labels["line_items[0]"].GetStrVal())
+	assert.Equal(t, "widget", labels["line_items[0].description"].GetStrVal())
+	assert.Equal(t, coredocumentpb.AttributeType_ATTRIBUTE_TYPE_BYTES, labels["line_items[1]"].Type)
+
+	attrs1, err := fromProtocolAttributes(pattrs)
+	assert.NoError(t, err)
+	assert.Equal(t, attrs, attrs1)
+
+	pattrs1, err := toProtocolAttributes(attrs1)
+	assert.NoError(t, err)
+	assert.Equal(t, pattrs, pattrs1)
+
+	// attribute key collides with a list element
+	elem, err := NewStringAttribute("line_items[1]", AttrString, "collision")
+	assert.NoError(t, err)
+	attrs1[elem.Key] = elem
+	_, err = toProtocolAttributes(attrs1)
+	assert.True(t, errors.IsOfType(ErrAttrKeyCollision, err))
+
+	// missing element
+	var missing []*coredocumentpb.Attribute
+	for _, pattr := range pattrs {
+		if string(pattr.KeyLabel) != "line_items[1]" {
+			missing = append(missing, pattr)
+		}
+	}
+	_, err = fromProtocolAttributes(missing)
+	assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+
+	// list length exceeding the attributes
+	labels["line_items"].Value = &coredocumentpb.Attribute_ByteVal{ByteVal: []byte{0xff, 0, 0, 0, 0, 0, 0, 0}}
+	_, err = fromProtocolAttributes(pattrs)
+	assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+}
+
+func TestAttributes_attachment(t *testing.T) {
+	att, err := NewAttachment(utils.RandomSlice(32), 2048, "application/pdf")
+	assert.NoError(t, err)
+
+	attr, err := NewAttachmentAttribute("invoice_pdf", att)
+	assert.NoError(t, err)
+
+	pattrs, err := toProtocolAttributes(map[AttrKey]Attribute{attr.Key: attr})
+	assert.NoError(t, err)
+	assert.Len(t, pattrs, 1)
+	assert.Equal(t, coredocumentpb.AttributeType_ATTRIBUTE_TYPE_ATTACHMENT, pattrs[0].Type)
+	assert.Equal(t, att.Bytes(), pattrs[0].GetByteVal())
+
+	attrs, err := fromProtocolAttributes(pattrs)
+	assert.NoError(t, err)
+	assert.Equal(t, attr, attrs[attr.Key])
+
+	pattrs[0].Value = &coredocumentpb.Attribute_ByteVal{ByteVal: utils.RandomSlice(32)}
+	_, err = fromProtocolAttributes(pattrs)
+	assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+}
+
+func TestAttributes_reference(t *testing.T) {
+	ref, err := NewReference(utils.RandomSlice(32), utils.RandomSlice(32), nil)
+	assert.NoError(t, err)
+
+	attr, err := NewReferenceAttribute("invoice", ref)
+	assert.NoError(t, err)
+
+	pattrs, err := toProtocolAttributes(map[AttrKey]Attribute{attr.Key: attr})
+	assert.NoError(t, err)
+	assert.Len(t, pattrs, 1)
+	assert.Equal(t, coredocumentpb.AttributeType_ATTRIBUTE_TYPE_REFERENCE, pattrs[0].Type)
+	assert.Equal(t, ref.Bytes(), pattrs[0].GetByteVal())
+
+	attrs, err := fromProtocolAttributes(pattrs)
+	assert.NoError(t, err)
+	assert.Equal(t, attr, attrs[attr.Key])
+
+	pattrs[0].Value = &coredocumentpb.Attribute_ByteVal{ByteVal: utils.RandomSlice(32)}
+	_, err = fromProtocolAttributes(pattrs)
+	assert.True(t, errors.IsOfType(ErrWrongAttrFormat, err))
+}
@@ -126,6 +126,9 @@ type CoreDocument struct {
 	// Status represents document status.
 	Status Status
 
+	// SignaturePolicy holds the signatures that are required before the document is anchored.
+	SignaturePolicy *SignaturePolicy
+
 	Document *coredocumentpb.CoreDocument
 }
 
@@ -322,6 +325,16 @@ func (cd *CoreDocument) SetStatus(st Status) error {
 	return nil
 }
 
+// GetSignaturePolicy returns the signature policy of the document.
+func (cd *CoreDocument) GetSignaturePolicy() *SignaturePolicy {
+	return cd.SignaturePolicy
+}
+
+// SetSignaturePolicy sets the signature policy of the document.
+func (cd *CoreDocument) SetSignaturePolicy(policy *SignaturePolicy) {
+	cd.SignaturePolicy = policy
+}
+
 // AppendSignatures appends signatures to core document.
 func (cd *CoreDocument) AppendSignatures(signs ...*coredocumentpb.Signature) {
 	if cd.Document.SignatureData == nil {
@@ -354,7 +367,7 @@ func (cd *CoreDocument) Patch(documentPrefix []byte, collaborators Collaborators
 	wcs := collaborators.ReadWriteCollaborators
 	rcs = append(rcs, wcs...)
 
-	ncd := &CoreDocument{Document: cdp, Status: Pending}
+	ncd := &CoreDocument{Document: cdp, Status: Pending, SignaturePolicy: cd.SignaturePolicy}
 	ncd.addCollaboratorsToReadSignRules(rcs)
 	ncd.addCollaboratorsToTransitionRules(documentPrefix, wcs)
 	// TODO convert it back to override when we have implemented add/delete for attributes in API
@@ -399,7 +412,7 @@ func (cd *CoreDocument) PrepareNewVersion(documentPrefix []byte, collaborators C
 		return nil, errors.NewTypedError(ErrCDNewVersion, err)
 	}
 
-	ncd := &CoreDocument{Document: cdp, Status: Pending}
+	ncd := &CoreDocument{Document: cdp, Status: Pending, SignaturePolicy: cd.SignaturePolicy}
 	ncd.addCollaboratorsToReadSignRules(rcs)
 	ncd.addCollaboratorsToTransitionRules(documentPrefix, wcs)
 	p2pAttrs, attrs, err := updateAttributes(cd.Document.Attributes, attrs)
@@ -1139,6 +1152,46 @@ func (cd *CoreDocument) UpdateRole(rk []byte, collabs []*types.AccountID) (*core
 	return r, nil
 }
 
+// GetRoles returns a copy of all the roles of the document.
+func (cd *CoreDocument) GetRoles() []*coredocumentpb.Role {
+	var roles []*coredocumentpb.Role
+	for _, role := range cd.Document.Roles {
+		roles = append(roles, &coredocumentpb.Role{
+			RoleKey:       copyBytes(role.RoleKey),
+			Collaborators: copyByteSlice(role.Collaborators),
+			Nfts:          copyByteSlice(role.Nfts),
+		})
+	}
+
+	return roles
+}
+
+// DeleteRole deletes the role associated with key from the document.
+// The role is removed from the read and transition rules as well, rules that are left without roles are deleted.
+// The roles that are the only role of the default read sign or edit rules cannot be deleted, since the document
+// couldn't be signed or updated by its collaborators anymore.
+func (cd *CoreDocument) DeleteRole(key []byte) error {
+	if _, err := cd.GetRole(key); err != nil {
+		return err
+	}
+
+	if cd.isOnlyRoleOfDefaultRules(key) {
+		return ErrDefaultRulesRole
+	}
+
+	for i, r := range cd.Document.Roles {
+		if bytes.Equal(r.RoleKey, key) {
+			cd.Document.Roles = append(cd.Document.Roles[:i], cd.Document.Roles[i+1:]...)
+			break
+		}
+	}
+
+	cd.deleteRoleFromReadRules(key)
+	cd.deleteRoleFromTransitionRules(key)
+	cd.Modified = true
+	return nil
+}
+
 func get32ByteKey(key string) ([]byte, error) {
 	key = strings.TrimSpace(key)
 	if key == "" {
@@ -3033,6 +3033,154 @@ func TestCoreDocument_getWriteCollaborators_Error(t *testing.T) {
 	assert.Len(t, res, 0)
 }
 
+func TestCoreDocument_GetRoles(t *testing.T) {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	roles := cd.GetRoles()
+
+	role, err := cd.AddRole("role", []*types.AccountID{accountID})
+	assert.NoError(t, err)
+
+	res := cd.GetRoles()
+	assert.Len(t, res, len(roles)+1)
+	assert.Equal(t, role, res[len(res)-1])
+
+	// the roles are copies
+	res[len(res)-1].Collaborators[0] = utils.RandomSlice(32)
+	res[len(res)-1].RoleKey = utils.RandomSlice(32)
+	assert.Equal(t, [][]byte{accountID.ToBytes()}, role.Collaborators)
+	assert.Equal(t, role, cd.GetRoles()[len(res)-1])
+}
+
+func TestCoreDocument_GetReadRules(t *testing.T) {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	cd, err := NewCoreDocument(CompactProperties("test"), CollaboratorsAccess{
+		ReadCollaborators: []*types.AccountID{accountID},
+	}, nil)
+	assert.NoError(t, err)
+
+	rules := cd.GetReadRules()
+	assert.Len(t, rules, 1)
+	assert.Equal(t, cd.Document.ReadRules[0].Roles, rules[0].Roles)
+	assert.Equal(t, coredocumentpb.Action_ACTION_READ_SIGN, rules[0].Action)
+
+	// the rules are copies
+	roleKey := copyBytes(rules[0].Roles[0])
+	rules[0].Roles[0][0]++
+	rules[0].Action = coredocumentpb.Action_ACTION_READ
+	assert.Equal(t, [][]byte{roleKey}, cd.GetReadRules()[0].Roles)
+	assert.Equal(t, coredocumentpb.Action_ACTION_READ_SIGN, cd.GetReadRules()[0].Action)
+}
+
+func TestCoreDocument_DeleteRole_DefaultRulesRole(t *testing.T) {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	cd, err := NewCoreDocument(CompactProperties("test"), CollaboratorsAccess{
+		ReadWriteCollaborators: []*types.AccountID{accountID},
+	}, nil)
+	assert.NoError(t, err)
+
+	readRules := cd.GetReadRules()
+	transitionRules := len(cd.Document.TransitionRules)
+
+	// the roles of the collaborators back the read sign and edit rules
+	for _, role := range cd.GetRoles() {
+		err = cd.DeleteRole(role.RoleKey)
+		assert.ErrorIs(t, err, ErrDefaultRulesRole)
+	}
+
+	assert.Equal(t, readRules, cd.GetReadRules())
+	assert.Len(t, cd.Document.TransitionRules, transitionRules)
+
+	// the role can be deleted once the default rules are shared with another role
+	otherRoleKey := utils.RandomSlice(32)
+	_, err = cd.AddRole(hexutil.Encode(otherRoleKey), []*types.AccountID{accountID})
+	assert.NoError(t, err)
+
+	for _, rule := range cd.Document.TransitionRules {
+		rule.Roles = append(rule.Roles, otherRoleKey)
+	}
+
+	for _, rule := range cd.Document.ReadRules {
+		rule.Roles = append(rule.Roles, otherRoleKey)
+	}
+
+	for _, role := range cd.GetRoles() {
+		if bytes.Equal(role.RoleKey, otherRoleKey) {
+			continue
+		}
+
+		assert.NoError(t, cd.DeleteRole(role.RoleKey))
+	}
+
+	err = cd.DeleteRole(otherRoleKey)
+	assert.ErrorIs(t, err, ErrDefaultRulesRole)
+}
+
+func TestCoreDocument_DeleteRole(t *testing.T) {
+	cd, rule, roleKey := setupRules(t)
+
+	validationRule, err := cd.AddValidationRule(`new("test1") > 0`)
+	assert.NoError(t, err)
+
+	// the default rules and a read rule are shared with another role
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	otherRoleKey := utils.RandomSlice(32)
+	_, err = cd.AddRole(hexutil.Encode(otherRoleKey), []*types.AccountID{accountID})
+	assert.NoError(t, err)
+
+	cd.addDefaultRules(otherRoleKey)
+
+	readRules := len(cd.GetReadRules())
+	cd.addNewReadRule(roleKey, coredocumentpb.Action_ACTION_READ)
+	cd.Document.ReadRules = append(cd.Document.ReadRules, &coredocumentpb.ReadRule{
+		Action: coredocumentpb.Action_ACTION_READ_SIGN,
+		Roles:  [][]byte{roleKey, otherRoleKey},
+	})
+
+	cd.Modified = false
+	err = cd.DeleteRole(roleKey)
+	assert.NoError(t, err)
+	assert.True(t, cd.Modified)
+
+	_, err = cd.GetRole(roleKey)
+	assert.ErrorIs(t, err, ErrRoleNotExist)
+
+	_, err = cd.GetRole(otherRoleKey)
+	assert.NoError(t, err)
+
+	// the attribute rule is deleted, the default rules and the validation rule are kept
+	assert.True(t, roleNotExists(cd, roleKey))
+	assert.Len(t, cd.Document.TransitionRules, 8)
+
+	_, err = cd.GetTransitionRule(rule.RuleKey)
+	assert.ErrorIs(t, err, ErrTransitionRuleMissing)
+
+	_, err = cd.GetTransitionRule(validationRule.RuleKey)
+	assert.NoError(t, err)
+
+	// the read rule with the role only is deleted
+	assert.Len(t, cd.GetReadRules(), readRules+1)
+	assert.Equal(t, [][]byte{otherRoleKey}, cd.GetReadRules()[readRules].Roles)
+
+	// role doesn't exist
+	err = cd.DeleteRole(roleKey)
+	assert.ErrorIs(t, err, ErrRoleNotExist)
+
+	// invalid role key
+	err = cd.DeleteRole(utils.RandomSlice(31))
+	assert.ErrorIs(t, err, ErrInvalidRoleKey)
+}
+
 func TestNewRoleWithCollaborators(t *testing.T) {
 	accountID1, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
@@ -3722,3 +3870,37 @@ func TestGet32ByteKey(t *testing.T) {
 		})
 	}
 }
+
+func TestCoreDocument_CreateProofs_ListElement(t *testing.T) {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+
+	list, err := NewListAttribute("line_items", []AttrVal{
+		{Type: AttrString, Str: "first"},
+		{Type: AttrString, Str: "second"},
+	})
+	assert.NoError(t, err)
+	cd, err = cd.AddAttributes(CollaboratorsAccess{}, false, nil, list)
+	assert.NoError(t, err)
+
+	dataLeaves := []proofs.LeafNode{
+		{
+			Property: proofs.Property{
+				Text:    "name.test1",
+				Compact: utils.RandomSlice(32),
+			},
+			Value:  utils.RandomSlice(32),
+			Salt:   utils.RandomSlice(32),
+			Hash:   utils.RandomSlice(32),
+			Hashed: true,
+		},
+	}
+
+	elemKey, err := AttrKeyFromLabel(ListElementLabel("line_items", 1))
+	assert.NoError(t, err)
+	field := fmt.Sprintf("%s.attributes[%s].str_val", CDTreePrefix, elemKey.String())
+	res, err := cd.CreateProofs("doc", dataLeaves, []string{field})
+	assert.NoError(t, err)
+	assert.Len(t, res.FieldProofs, 1)
+	assert.Equal(t, []byte("second"), res.FieldProofs[0].Value)
+}
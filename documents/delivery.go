@@ -0,0 +1,31 @@
+package documents
+
+import (
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// DeliveryState represents the state of the delivery of an anchored document to a collaborator.
+type DeliveryState string
+
+const (
+	// DeliveryStatePending is the state of a delivery that is retried until the collaborator can be reached.
+	DeliveryStatePending DeliveryState = "pending"
+
+	// DeliveryStateDelivered is the state of a delivery that was accepted by the collaborator.
+	DeliveryStateDelivered DeliveryState = "delivered"
+
+	// DeliveryStateFailed is the state of a delivery that was abandoned.
+	DeliveryStateFailed DeliveryState = "failed"
+)
+
+// DeliveryStatus holds the status of the delivery of an anchored document version to a collaborator.
+type DeliveryStatus struct {
+	Recipient   *types.AccountID
+	State       DeliveryState
+	Attempts    int
+	LastAttempt time.Time
+	NextAttempt time.Time
+	LastError   string
+}
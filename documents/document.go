@@ -136,6 +136,12 @@ type Document interface {
 	// SetStatus set the status of the document.
 	SetStatus(st Status) error
 
+	// GetSignaturePolicy returns the signature policy of the document, nil if the document has no policy.
+	GetSignaturePolicy() *SignaturePolicy
+
+	// SetSignaturePolicy sets the signature policy of the document.
+	SetSignaturePolicy(policy *SignaturePolicy)
+
 	// RemoveCollaborators removes collaborators from the current document.
 	RemoveCollaborators(collaboratorAccountIDs []*types.AccountID) error
 
@@ -148,6 +154,15 @@ type Document interface {
 	// UpdateRole updates existing role with provided collaborators
 	UpdateRole(rk []byte, collabs []*types.AccountID) (*coredocumentpb.Role, error)
 
+	// GetRoles returns all the roles of the document.
+	GetRoles() []*coredocumentpb.Role
+
+	// DeleteRole deletes the role and removes it from the read and transition rules.
+	DeleteRole(key []byte) error
+
+	// GetReadRules returns all the read rules of the document.
+	GetReadRules() []*coredocumentpb.ReadRule
+
 	// AddTransitionRuleForAttribute creates a new transition rule to edit an attribute.
 	// The access is only given to the roleKey which is expected to be present already.
 	AddTransitionRuleForAttribute(roleID []byte, key AttrKey) (*coredocumentpb.TransitionRule, error)
@@ -155,6 +170,9 @@ type Document interface {
 	// GetTransitionRule returns the transition rule associated with ruleID in the document.
 	GetTransitionRule(ruleID []byte) (*coredocumentpb.TransitionRule, error)
 
+	// GetTransitionRules returns all the transition rules of the document with the attribute labels decoded.
+	GetTransitionRules() []DecodedTransitionRule
+
 	// DeleteTransitionRule deletes the rule associated with ruleID.
 	DeleteTransitionRule(ruleID []byte) error
 
@@ -162,14 +180,20 @@ type Document interface {
 	CalculateTransitionRulesFingerprint() ([]byte, error)
 
 	// AddComputeFieldsRule adds a new compute field rule
-	AddComputeFieldsRule(wasm []byte, fields []string, targetField string) (*coredocumentpb.TransitionRule, error)
+	AddComputeFieldsRule(wasm []byte, fields []string, targetField string, outputType AttributeType) (*coredocumentpb.TransitionRule, error)
 
 	// ExecuteComputeFields executes all the compute fields and updates the document with target attributes.
-	ExecuteComputeFields(timeout time.Duration) error
+	ExecuteComputeFields(gasLimit uint64) error
 
 	// GetComputeFieldsRules returns all the compute fields rules from the document.
 	GetComputeFieldsRules() []*coredocumentpb.TransitionRule
 
+	// AddValidationRule adds a new validation rule with the expression.
+	AddValidationRule(expression string) (*coredocumentpb.TransitionRule, error)
+
+	// GetValidationRules returns all the validation rules from the document.
+	GetValidationRules() []*coredocumentpb.TransitionRule
+
 	// DeriveFromCreatePayload loads the payload into self.
 	DeriveFromCreatePayload(ctx context.Context, payload CreatePayload) error
 
@@ -190,6 +214,9 @@ type CreatePayload struct {
 	Collaborators CollaboratorsAccess
 	Attributes    map[AttrKey]Attribute
 	Data          []byte
+
+	// SignaturePolicy is optional, the existing policy is kept when updating a document if nil.
+	SignaturePolicy *SignaturePolicy
 }
 
 // UpdatePayload holds the scheme, CollaboratorsAccess, Attributes, Data and document identifier.
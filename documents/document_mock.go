@@ -71,13 +71,13 @@ func (_m *DocumentMock) AddAttributes(ca CollaboratorsAccess, prepareNewVersion
 	return r0
 }
 
-// AddComputeFieldsRule provides a mock function with given fields: wasm, fields, targetField
-func (_m *DocumentMock) AddComputeFieldsRule(wasm []byte, fields []string, targetField string) (*coredocumentpb.TransitionRule, error) {
-	ret := _m.Called(wasm, fields, targetField)
+// AddComputeFieldsRule provides a mock function with given fields: wasm, fields, targetField, outputType
+func (_m *DocumentMock) AddComputeFieldsRule(wasm []byte, fields []string, targetField string, outputType AttributeType) (*coredocumentpb.TransitionRule, error) {
+	ret := _m.Called(wasm, fields, targetField, outputType)
 
 	var r0 *coredocumentpb.TransitionRule
-	if rf, ok := ret.Get(0).(func([]byte, []string, string) *coredocumentpb.TransitionRule); ok {
-		r0 = rf(wasm, fields, targetField)
+	if rf, ok := ret.Get(0).(func([]byte, []string, string, AttributeType) *coredocumentpb.TransitionRule); ok {
+		r0 = rf(wasm, fields, targetField, outputType)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*coredocumentpb.TransitionRule)
@@ -85,8 +85,8 @@ func (_m *DocumentMock) AddComputeFieldsRule(wasm []byte, fields []string, targe
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]byte, []string, string) error); ok {
-		r1 = rf(wasm, fields, targetField)
+	if rf, ok := ret.Get(1).(func([]byte, []string, string, AttributeType) error); ok {
+		r1 = rf(wasm, fields, targetField, outputType)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -159,6 +159,29 @@ func (_m *DocumentMock) AddUpdateLog(accountID *types.AccountID) {
 	_m.Called(accountID)
 }
 
+// AddValidationRule provides a mock function with given fields: expression
+func (_m *DocumentMock) AddValidationRule(expression string) (*coredocumentpb.TransitionRule, error) {
+	ret := _m.Called(expression)
+
+	var r0 *coredocumentpb.TransitionRule
+	if rf, ok := ret.Get(0).(func(string) *coredocumentpb.TransitionRule); ok {
+		r0 = rf(expression)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coredocumentpb.TransitionRule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(expression)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AppendSignatures provides a mock function with given fields: signatures
 func (_m *DocumentMock) AppendSignatures(signatures ...*coredocumentpb.Signature) {
 	_va := make([]interface{}, len(signatures))
@@ -382,6 +405,20 @@ func (_m *DocumentMock) DeleteAttribute(key AttrKey, prepareNewVersion bool) err
 	return r0
 }
 
+// DeleteRole provides a mock function with given fields: key
+func (_m *DocumentMock) DeleteRole(key []byte) error {
+	ret := _m.Called(key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteTransitionRule provides a mock function with given fields: ruleID
 func (_m *DocumentMock) DeleteTransitionRule(ruleID []byte) error {
 	ret := _m.Called(ruleID)
@@ -461,13 +498,13 @@ func (_m *DocumentMock) DocumentType() string {
 	return r0
 }
 
-// ExecuteComputeFields provides a mock function with given fields: timeout
-func (_m *DocumentMock) ExecuteComputeFields(timeout time.Duration) error {
-	ret := _m.Called(timeout)
+// ExecuteComputeFields provides a mock function with given fields: gasLimit
+func (_m *DocumentMock) ExecuteComputeFields(gasLimit uint64) error {
+	ret := _m.Called(gasLimit)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(time.Duration) error); ok {
-		r0 = rf(timeout)
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(gasLimit)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -601,6 +638,22 @@ func (_m *DocumentMock) GetData() interface{} {
 	return r0
 }
 
+// GetReadRules provides a mock function with given fields:
+func (_m *DocumentMock) GetReadRules() []*coredocumentpb.ReadRule {
+	ret := _m.Called()
+
+	var r0 []*coredocumentpb.ReadRule
+	if rf, ok := ret.Get(0).(func() []*coredocumentpb.ReadRule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coredocumentpb.ReadRule)
+		}
+	}
+
+	return r0
+}
+
 // GetRole provides a mock function with given fields: key
 func (_m *DocumentMock) GetRole(key []byte) (*coredocumentpb.Role, error) {
 	ret := _m.Called(key)
@@ -624,6 +677,38 @@ func (_m *DocumentMock) GetRole(key []byte) (*coredocumentpb.Role, error) {
 	return r0, r1
 }
 
+// GetRoles provides a mock function with given fields:
+func (_m *DocumentMock) GetRoles() []*coredocumentpb.Role {
+	ret := _m.Called()
+
+	var r0 []*coredocumentpb.Role
+	if rf, ok := ret.Get(0).(func() []*coredocumentpb.Role); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coredocumentpb.Role)
+		}
+	}
+
+	return r0
+}
+
+// GetSignaturePolicy provides a mock function with given fields:
+func (_m *DocumentMock) GetSignaturePolicy() *SignaturePolicy {
+	ret := _m.Called()
+
+	var r0 *SignaturePolicy
+	if rf, ok := ret.Get(0).(func() *SignaturePolicy); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SignaturePolicy)
+		}
+	}
+
+	return r0
+}
+
 // GetSignerCollaborators provides a mock function with given fields: filterIDs
 func (_m *DocumentMock) GetSignerCollaborators(filterIDs ...*types.AccountID) ([]*types.AccountID, error) {
 	_va := make([]interface{}, len(filterIDs))
@@ -690,6 +775,38 @@ func (_m *DocumentMock) GetTransitionRule(ruleID []byte) (*coredocumentpb.Transi
 	return r0, r1
 }
 
+// GetTransitionRules provides a mock function with given fields:
+func (_m *DocumentMock) GetTransitionRules() []DecodedTransitionRule {
+	ret := _m.Called()
+
+	var r0 []DecodedTransitionRule
+	if rf, ok := ret.Get(0).(func() []DecodedTransitionRule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DecodedTransitionRule)
+		}
+	}
+
+	return r0
+}
+
+// GetValidationRules provides a mock function with given fields:
+func (_m *DocumentMock) GetValidationRules() []*coredocumentpb.TransitionRule {
+	ret := _m.Called()
+
+	var r0 []*coredocumentpb.TransitionRule
+	if rf, ok := ret.Get(0).(func() []*coredocumentpb.TransitionRule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coredocumentpb.TransitionRule)
+		}
+	}
+
+	return r0
+}
+
 // ID provides a mock function with given fields:
 func (_m *DocumentMock) ID() []byte {
 	ret := _m.Called()
@@ -893,6 +1010,11 @@ func (_m *DocumentMock) Scheme() string {
 	return r0
 }
 
+// SetSignaturePolicy provides a mock function with given fields: policy
+func (_m *DocumentMock) SetSignaturePolicy(policy *SignaturePolicy) {
+	_m.Called(policy)
+}
+
 // SetStatus provides a mock function with given fields: st
 func (_m *DocumentMock) SetStatus(st Status) error {
 	ret := _m.Called(st)
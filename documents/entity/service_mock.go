@@ -66,6 +66,29 @@ func (_m *ServiceMock) CreateProofs(ctx context.Context, documentID []byte, fiel
 	return r0, r1
 }
 
+// CreateReferenceProofs provides a mock function with given fields: ctx, documentID, attrLabel, fields, referencedFields
+func (_m *ServiceMock) CreateReferenceProofs(ctx context.Context, documentID []byte, attrLabel string, fields []string, referencedFields []string) (*documents.ReferenceProof, error) {
+	ret := _m.Called(ctx, documentID, attrLabel, fields, referencedFields)
+
+	var r0 *documents.ReferenceProof
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, []string, []string) *documents.ReferenceProof); ok {
+		r0 = rf(ctx, documentID, attrLabel, fields, referencedFields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*documents.ReferenceProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, string, []string, []string) error); ok {
+		r1 = rf(ctx, documentID, attrLabel, fields, referencedFields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateProofsForVersion provides a mock function with given fields: ctx, documentID, version, fields
 func (_m *ServiceMock) CreateProofsForVersion(ctx context.Context, documentID []byte, version []byte, fields []string) (*documents.DocumentProof, error) {
 	ret := _m.Called(ctx, documentID, version, fields)
@@ -158,6 +181,20 @@ func (_m *ServiceMock) DeriveFromCoreDocument(cd *coredocumentpb.CoreDocument) (
 	return r0, r1
 }
 
+// FastForwardDocument provides a mock function with given fields: ctx, doc, collaborator
+func (_m *ServiceMock) FastForwardDocument(ctx context.Context, doc documents.Document, collaborator *types.AccountID) error {
+	ret := _m.Called(ctx, doc, collaborator)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, documents.Document, *types.AccountID) error); ok {
+		r0 = rf(ctx, doc, collaborator)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetCurrentVersion provides a mock function with given fields: ctx, documentID
 func (_m *ServiceMock) GetCurrentVersion(ctx context.Context, documentID []byte) (documents.Document, error) {
 	ret := _m.Called(ctx, documentID)
@@ -204,6 +241,29 @@ func (_m *ServiceMock) GetEntityByRelationship(ctx context.Context, relationship
 	return r0, r1
 }
 
+// GetLatestDocuments provides a mock function with given fields: ctx
+func (_m *ServiceMock) GetLatestDocuments(ctx context.Context) ([]documents.Document, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []documents.Document
+	if rf, ok := ret.Get(0).(func(context.Context) []documents.Document); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]documents.Document)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVersion provides a mock function with given fields: ctx, documentID, version
 func (_m *ServiceMock) GetVersion(ctx context.Context, documentID []byte, version []byte) (documents.Document, error) {
 	ret := _m.Called(ctx, documentID, version)
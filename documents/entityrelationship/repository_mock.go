@@ -88,6 +88,29 @@ func (_m *repositoryMock) Get(accountID []byte, id []byte) (documents.Document,
 	return r0, r1
 }
 
+// GetAllLatest provides a mock function with given fields: accountID
+func (_m *repositoryMock) GetAllLatest(accountID []byte) ([]documents.Document, error) {
+	ret := _m.Called(accountID)
+
+	var r0 []documents.Document
+	if rf, ok := ret.Get(0).(func([]byte) []documents.Document); ok {
+		r0 = rf(accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]documents.Document)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLatest provides a mock function with given fields: accountID, docID
 func (_m *repositoryMock) GetLatest(accountID []byte, docID []byte) (documents.Document, error) {
 	ret := _m.Called(accountID, docID)
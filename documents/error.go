@@ -26,6 +26,9 @@ const (
 	// ErrDocumentInvalid must only be used when the reason for invalidity is impossible to determine or the invalidity is caused by validation errors
 	ErrDocumentInvalid = errors.Error("document is invalid")
 
+	// ErrDocumentSignatureInvalid must be used when the cryptographic verification of a document signature fails
+	ErrDocumentSignatureInvalid = errors.Error("document signature is invalid")
+
 	// ErrDocumentTimestampInvalid is used when the document timestamp is invalid.
 	ErrDocumentTimestampInvalid = errors.Error("document timestamp is invalid")
 
@@ -149,6 +152,16 @@ const (
 	// ErrInvalidAttrTimestamp is a sentinel error when the attribute timestamp is invalid
 	ErrInvalidAttrTimestamp = errors.Error("invalid attribute timestamp")
 
+	// ErrReferencedDocumentNotAnchored is a sentinel error when the version referenced by an attribute is not anchored
+	ErrReferencedDocumentNotAnchored = errors.Error("referenced document version is not anchored")
+
+	// ErrReferencedDocumentRootMismatch is a sentinel error when the document root pinned by a reference
+	// does not match the anchored one
+	ErrReferencedDocumentRootMismatch = errors.Error("referenced document root does not match the anchored root")
+
+	// ErrAttrKeyCollision is a sentinel error when an attribute key collides with the key of a list or map element
+	ErrAttrKeyCollision = errors.Error("attribute key collides with a list or map element")
+
 	// ErrDocumentValidation must be used when document validation fails
 	ErrDocumentValidation = errors.Error("document validation failure")
 
@@ -158,6 +171,9 @@ const (
 	// ErrRoleExist must be used when role exist in the document.
 	ErrRoleExist = errors.Error("role already exists")
 
+	// ErrDefaultRulesRole must be used when a role that is the only role of a default rule is deleted.
+	ErrDefaultRulesRole = errors.Error("role is the only role of a default rule")
+
 	// ErrEmptyRoleKey must be used when role key is empty
 	ErrEmptyRoleKey = errors.Error("empty role key")
 
@@ -265,4 +281,11 @@ const (
 
 	// ErrDocumentPatch is sent when the document cannot be patched
 	ErrDocumentPatch = errors.Error("couldn't patch document")
+
+	// ErrInvalidSignaturePolicy is sent when the signature policy of a document is invalid
+	ErrInvalidSignaturePolicy = errors.Error("invalid signature policy")
+
+	// ErrSignaturePolicyNotSatisfied is sent when the signatures required by the signature policy
+	// are not collected during the signature collection window
+	ErrSignaturePolicyNotSatisfied = errors.Error("signature policy not satisfied")
 )
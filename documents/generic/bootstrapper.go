@@ -4,6 +4,12 @@ import (
 	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
 	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage"
+)
+
+const (
+	// BootstrappedSchemaRegistry is the key to the schema registry of generic documents in the bootstrap context.
+	BootstrappedSchemaRegistry = "BootstrappedGenericSchemaRegistry"
 )
 
 // Bootstrapper implements bootstrap.Bootstrapper.
@@ -27,8 +33,15 @@ func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 	}
 	repo.Register(&Generic{})
 
+	db, ok := ctx[storage.BootstrappedDB].(storage.Repository)
+	if !ok {
+		return errors.New("db repository not initialised")
+	}
+
+	schemaRegistry := NewSchemaRegistry(db)
+
 	// register service
-	srv := NewService(docSrv)
+	srv := NewService(docSrv, schemaRegistry)
 
 	err := registry.Register(documenttypes.GenericDataTypeUrl, srv)
 	if err != nil {
@@ -40,5 +53,7 @@ func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("failed to register generic doc service: %v", err)
 	}
 
+	ctx[BootstrappedSchemaRegistry] = schemaRegistry
+
 	return nil
 }
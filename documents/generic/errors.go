@@ -0,0 +1,11 @@
+package generic
+
+import "github.com/centrifuge/pod/errors"
+
+const (
+	ErrInvalidSchema     = errors.Error("invalid schema")
+	ErrSchemaNotFound    = errors.Error("schema not found")
+	ErrSchemaPersistence = errors.Error("couldn't persist schema")
+	ErrInvalidSchemaRef  = errors.Error("invalid schema reference")
+	ErrSchemaValidation  = errors.Error("document doesn't satisfy its schema")
+)
@@ -0,0 +1,326 @@
+package generic
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/centrifuge/pod/crypto"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// SchemaAttributeLabel is the label of the string attribute that holds the ID of the schema of a generic document.
+	SchemaAttributeLabel = "_schema"
+)
+
+// SchemaAttribute defines the constraints of a single attribute of a schema.
+type SchemaAttribute struct {
+	Label    string                  `json:"label"`
+	Type     documents.AttributeType `json:"type" enums:"integer,decimal,string,bytes,timestamp,signed,monetary"`
+	Required bool                    `json:"required,omitempty"`
+
+	// Currencies are the allowed currencies of a monetary attribute, either a fiat code or a hex encoded token address.
+	Currencies []string `json:"currencies,omitempty"`
+
+	// Enum holds the allowed values of a string attribute.
+	Enum []string `json:"enum,omitempty"`
+
+	// Pattern is a regular expression that the value of a string attribute must match.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Min and Max are the inclusive bounds of an integer, decimal or monetary attribute.
+	Min string `json:"min,omitempty"`
+	Max string `json:"max,omitempty"`
+}
+
+// Schema defines the attributes of a generic document.
+//
+// The ID of a schema is derived from its definition, so that the nodes that register the same definition
+// can validate the documents that reference it.
+type Schema struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Attributes []SchemaAttribute `json:"attributes"`
+
+	// Strict disallows the attributes that are not defined in the schema.
+	Strict bool `json:"strict,omitempty"`
+}
+
+// JSON marshals Schema to json bytes.
+func (s *Schema) JSON() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// FromJSON loads json bytes to Schema.
+func (s *Schema) FromJSON(data []byte) error {
+	return json.Unmarshal(data, s)
+}
+
+// Type returns the type of Schema.
+func (s *Schema) Type() reflect.Type {
+	return reflect.TypeOf(s)
+}
+
+// computeID returns the hex encoded sha256 hash of the definition of the schema.
+func (s *Schema) computeID() (string, error) {
+	definition := *s
+	definition.ID = ""
+
+	b, err := json.Marshal(definition)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := crypto.Sha256Hash(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hexutil.Encode(hash), nil
+}
+
+// validateDefinition checks that the attributes of the schema are consistent.
+func (s *Schema) validateDefinition() (err error) {
+	if strings.TrimSpace(s.Name) == "" {
+		err = errors.AppendError(err, errors.New("schema name not set"))
+	}
+
+	labels := make(map[string]struct{})
+
+	for _, attr := range s.Attributes {
+		if strings.TrimSpace(attr.Label) == "" {
+			err = errors.AppendError(err, errors.New("attribute label not set"))
+			continue
+		}
+
+		if attr.Label == SchemaAttributeLabel {
+			err = errors.AppendError(err, errors.New("attribute label %s is reserved", attr.Label))
+			continue
+		}
+
+		if _, ok := labels[attr.Label]; ok {
+			err = errors.AppendError(err, errors.New("attribute %s defined more than once", attr.Label))
+			continue
+		}
+
+		labels[attr.Label] = struct{}{}
+
+		if attrErr := attr.validateDefinition(); attrErr != nil {
+			err = errors.AppendError(err, errors.New("attribute %s: %v", attr.Label, attrErr))
+		}
+	}
+
+	return err
+}
+
+func (a SchemaAttribute) validateDefinition() error {
+	switch a.Type {
+	case documents.AttrInt256, documents.AttrDecimal, documents.AttrString, documents.AttrBytes,
+		documents.AttrTimestamp, documents.AttrSigned, documents.AttrMonetary, documents.AttrReference,
+		documents.AttrAttachment, documents.AttrList, documents.AttrMap:
+	default:
+		return errors.New("unknown attribute type '%s'", a.Type)
+	}
+
+	if len(a.Currencies) > 0 && a.Type != documents.AttrMonetary {
+		return errors.New("currencies can only be set for monetary attributes")
+	}
+
+	if (len(a.Enum) > 0 || a.Pattern != "") && a.Type != documents.AttrString {
+		return errors.New("enum and pattern can only be set for string attributes")
+	}
+
+	if a.Pattern != "" {
+		if _, err := regexp.Compile(a.Pattern); err != nil {
+			return errors.New("invalid pattern: %v", err)
+		}
+	}
+
+	if a.Min == "" && a.Max == "" {
+		return nil
+	}
+
+	switch a.Type {
+	case documents.AttrInt256, documents.AttrDecimal, documents.AttrMonetary:
+	default:
+		return errors.New("min and max can only be set for integer, decimal and monetary attributes")
+	}
+
+	min, max, err := a.bounds()
+	if err != nil {
+		return err
+	}
+
+	if min != nil && max != nil && min.GreaterThan(*max) {
+		return errors.New("min is greater than max")
+	}
+
+	return nil
+}
+
+// bounds parses the range of the attribute, a nil bound is not set.
+func (a SchemaAttribute) bounds() (min *decimal.Decimal, max *decimal.Decimal, err error) {
+	if a.Min != "" {
+		d, err := decimal.NewFromString(a.Min)
+		if err != nil {
+			return nil, nil, errors.New("invalid min: %v", err)
+		}
+
+		min = &d
+	}
+
+	if a.Max != "" {
+		d, err := decimal.NewFromString(a.Max)
+		if err != nil {
+			return nil, nil, errors.New("invalid max: %v", err)
+		}
+
+		max = &d
+	}
+
+	return min, max, nil
+}
+
+// Validate checks that the attributes satisfy the schema.
+func (s *Schema) Validate(attrs []documents.Attribute) (err error) {
+	attrMap := make(map[string]documents.Attribute)
+
+	for _, attr := range attrs {
+		attrMap[attr.KeyLabel] = attr
+	}
+
+	for _, schemaAttr := range s.Attributes {
+		attr, ok := attrMap[schemaAttr.Label]
+		if !ok {
+			if schemaAttr.Required {
+				err = errors.AppendError(err, errors.New("attribute %s is required", schemaAttr.Label))
+			}
+
+			continue
+		}
+
+		if attrErr := schemaAttr.validateValue(attr.Value); attrErr != nil {
+			err = errors.AppendError(err, errors.New("attribute %s: %v", schemaAttr.Label, attrErr))
+		}
+
+		delete(attrMap, schemaAttr.Label)
+	}
+
+	if !s.Strict {
+		return err
+	}
+
+	for label := range attrMap {
+		if label == SchemaAttributeLabel {
+			continue
+		}
+
+		err = errors.AppendError(err, errors.New("attribute %s is not defined in the schema", label))
+	}
+
+	return err
+}
+
+func (a SchemaAttribute) validateValue(value documents.AttrVal) error {
+	if value.Type != a.Type {
+		return errors.New("expected type %s but got %s", a.Type, value.Type)
+	}
+
+	switch a.Type {
+	case documents.AttrString:
+		return a.validateString(value.Str)
+	case documents.AttrInt256:
+		return a.validateRange(value.Int256.String())
+	case documents.AttrDecimal:
+		return a.validateRange(value.Decimal.String())
+	case documents.AttrMonetary:
+		if err := a.validateCurrency(value.Monetary); err != nil {
+			return err
+		}
+
+		return a.validateRange(value.Monetary.Value.String())
+	default:
+		return nil
+	}
+}
+
+func (a SchemaAttribute) validateString(value string) error {
+	if len(a.Enum) > 0 {
+		found := false
+
+		for _, allowed := range a.Enum {
+			if value == allowed {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return errors.New("value '%s' is not one of %v", value, a.Enum)
+		}
+	}
+
+	if a.Pattern != "" {
+		matched, err := regexp.MatchString(a.Pattern, value)
+		if err != nil {
+			return errors.New("invalid pattern: %v", err)
+		}
+
+		if !matched {
+			return errors.New("value '%s' doesn't match pattern %s", value, a.Pattern)
+		}
+	}
+
+	return nil
+}
+
+func (a SchemaAttribute) validateCurrency(monetary documents.Monetary) error {
+	if len(a.Currencies) == 0 {
+		return nil
+	}
+
+	currency := string(monetary.ID)
+	if monetary.Type == documents.MonetaryToken {
+		currency = hexutil.Encode(monetary.ID)
+	}
+
+	for _, allowed := range a.Currencies {
+		// Token addresses are hex encoded, their case is not significant.
+		if strings.EqualFold(currency, allowed) {
+			return nil
+		}
+	}
+
+	return errors.New("currency %s is not one of %v", currency, a.Currencies)
+}
+
+func (a SchemaAttribute) validateRange(value string) error {
+	min, max, err := a.bounds()
+	if err != nil {
+		return err
+	}
+
+	if min == nil && max == nil {
+		return nil
+	}
+
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return errors.New("invalid number: %v", err)
+	}
+
+	if min != nil && d.LessThan(*min) {
+		return errors.New("value %s is less than %s", value, a.Min)
+	}
+
+	if max != nil && d.GreaterThan(*max) {
+		return errors.New("value %s is greater than %s", value, a.Max)
+	}
+
+	return nil
+}
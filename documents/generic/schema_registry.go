@@ -0,0 +1,108 @@
+package generic
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage"
+)
+
+const (
+	// schemaPrefix is the DB prefix of the schemas.
+	schemaPrefix = "generic_schema_"
+)
+
+//go:generate mockery --name SchemaRegistry --structname SchemaRegistryMock --filename schema_registry_mock.go --inpackage
+
+// SchemaRegistry stores the schemas registered by the accounts of the node.
+type SchemaRegistry interface {
+	// RegisterSchema validates and stores the schema for the account, and returns it with its ID.
+	// Registering a schema that is already registered is a no-op.
+	RegisterSchema(accountID *types.AccountID, schema *Schema) (*Schema, error)
+
+	// GetSchema returns the schema with the provided ID registered by the account.
+	GetSchema(accountID *types.AccountID, schemaID string) (*Schema, error)
+
+	// GetSchemas returns all the schemas registered by the account.
+	GetSchemas(accountID *types.AccountID) ([]*Schema, error)
+}
+
+type schemaRegistry struct {
+	db storage.Repository
+}
+
+// NewSchemaRegistry returns a SchemaRegistry that persists the schemas in the provided DB.
+func NewSchemaRegistry(db storage.Repository) SchemaRegistry {
+	db.Register(new(Schema))
+
+	return &schemaRegistry{
+		db: db,
+	}
+}
+
+func (r *schemaRegistry) RegisterSchema(accountID *types.AccountID, schema *Schema) (*Schema, error) {
+	if err := schema.validateDefinition(); err != nil {
+		return nil, errors.NewTypedError(ErrInvalidSchema, err)
+	}
+
+	schemaID, err := schema.computeID()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrInvalidSchema, err)
+	}
+
+	res := *schema
+	res.ID = schemaID
+
+	key := getSchemaKey(accountID, schemaID)
+
+	if r.db.Exists(key) {
+		return &res, nil
+	}
+
+	if err := r.db.Create(key, &res); err != nil {
+		return nil, errors.NewTypedError(ErrSchemaPersistence, err)
+	}
+
+	return &res, nil
+}
+
+func (r *schemaRegistry) GetSchema(accountID *types.AccountID, schemaID string) (*Schema, error) {
+	model, err := r.db.Get(getSchemaKey(accountID, schemaID))
+	if err != nil {
+		return nil, errors.NewTypedError(ErrSchemaNotFound, err)
+	}
+
+	schema, ok := model.(*Schema)
+	if !ok {
+		return nil, errors.NewTypedError(ErrSchemaNotFound, errors.New("invalid schema type"))
+	}
+
+	return schema, nil
+}
+
+func (r *schemaRegistry) GetSchemas(accountID *types.AccountID) ([]*Schema, error) {
+	models, err := r.db.GetAllByPrefix(getSchemaAccountPrefix(accountID))
+	if err != nil {
+		return nil, err
+	}
+
+	var schemas []*Schema
+
+	for _, model := range models {
+		schema, ok := model.(*Schema)
+		if !ok {
+			continue
+		}
+
+		schemas = append(schemas, schema)
+	}
+
+	return schemas, nil
+}
+
+func getSchemaAccountPrefix(accountID *types.AccountID) string {
+	return schemaPrefix + accountID.ToHexString() + "_"
+}
+
+func getSchemaKey(accountID *types.AccountID, schemaID string) []byte {
+	return []byte(getSchemaAccountPrefix(accountID) + schemaID)
+}
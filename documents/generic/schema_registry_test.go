@@ -0,0 +1,97 @@
+//go:build unit
+
+package generic
+
+import (
+	"os"
+	"testing"
+
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage/leveldb"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testSchemaRegistryStoragePattern = "generic-schema-registry-*"
+)
+
+func getTestSchemaRegistry(t *testing.T) SchemaRegistry {
+	randomStoragePath, err := testingcommons.GetRandomTestStoragePath(testSchemaRegistryStoragePattern)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = os.RemoveAll(randomStoragePath)
+	})
+
+	db, err := leveldb.NewLevelDBStorage(randomStoragePath)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return NewSchemaRegistry(leveldb.NewLevelDBRepository(db))
+}
+
+func TestSchemaRegistry(t *testing.T) {
+	registry := getTestSchemaRegistry(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	otherAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	schemas, err := registry.GetSchemas(accountID)
+	assert.NoError(t, err)
+	assert.Empty(t, schemas)
+
+	schema, err := registry.RegisterSchema(accountID, getTestSchema())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, schema.ID)
+
+	expectedID, err := getTestSchema().computeID()
+	assert.NoError(t, err)
+	assert.Equal(t, expectedID, schema.ID)
+
+	// Registering the same schema again is a no-op.
+	res, err := registry.RegisterSchema(accountID, getTestSchema())
+	assert.NoError(t, err)
+	assert.Equal(t, schema, res)
+
+	res, err = registry.GetSchema(accountID, schema.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, schema, res)
+
+	strictSchema := getTestSchema()
+	strictSchema.Strict = true
+
+	strictSchema, err = registry.RegisterSchema(accountID, strictSchema)
+	assert.NoError(t, err)
+	assert.NotEqual(t, schema.ID, strictSchema.ID)
+
+	schemas, err = registry.GetSchemas(accountID)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []*Schema{schema, strictSchema}, schemas)
+
+	// The schemas are registered per account.
+	res, err = registry.GetSchema(otherAccountID, schema.ID)
+	assert.True(t, errors.IsOfType(ErrSchemaNotFound, err))
+	assert.Nil(t, res)
+
+	schemas, err = registry.GetSchemas(otherAccountID)
+	assert.NoError(t, err)
+	assert.Empty(t, schemas)
+}
+
+func TestSchemaRegistry_RegisterSchema_InvalidSchema(t *testing.T) {
+	registry := getTestSchemaRegistry(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	res, err := registry.RegisterSchema(accountID, &Schema{})
+	assert.True(t, errors.IsOfType(ErrInvalidSchema, err))
+	assert.Nil(t, res)
+}
@@ -0,0 +1,304 @@
+//go:build unit
+
+package generic
+
+import (
+	"testing"
+
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestSchema() *Schema {
+	return &Schema{
+		Name: "invoice",
+		Attributes: []SchemaAttribute{
+			{
+				Label:    "number",
+				Type:     documents.AttrString,
+				Required: true,
+				Pattern:  "^INV-[0-9]+$",
+			},
+			{
+				Label: "status",
+				Type:  documents.AttrString,
+				Enum:  []string{"draft", "paid"},
+			},
+			{
+				Label:      "amount",
+				Type:       documents.AttrMonetary,
+				Required:   true,
+				Currencies: []string{"USD", "EUR"},
+				Min:        "0",
+			},
+			{
+				Label: "line_items",
+				Type:  documents.AttrInt256,
+				Min:   "1",
+				Max:   "100",
+			},
+			{
+				Label: "due_date",
+				Type:  documents.AttrTimestamp,
+			},
+		},
+	}
+}
+
+func getTestSchemaAttributes(t *testing.T) []documents.Attribute {
+	number, err := documents.NewStringAttribute("number", documents.AttrString, "INV-1")
+	assert.NoError(t, err)
+
+	status, err := documents.NewStringAttribute("status", documents.AttrString, "paid")
+	assert.NoError(t, err)
+
+	value, err := documents.NewDecimal("100.5")
+	assert.NoError(t, err)
+
+	amount, err := documents.NewMonetaryAttribute("amount", value, nil, "USD")
+	assert.NoError(t, err)
+
+	lineItems, err := documents.NewStringAttribute("line_items", documents.AttrInt256, "3")
+	assert.NoError(t, err)
+
+	return []documents.Attribute{number, status, amount, lineItems}
+}
+
+func TestSchema_ComputeID(t *testing.T) {
+	schema := getTestSchema()
+
+	id, err := schema.computeID()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	// The ID doesn't depend on the ID that is already set.
+	schema.ID = id
+
+	res, err := schema.computeID()
+	assert.NoError(t, err)
+	assert.Equal(t, id, res)
+
+	schema.Strict = true
+
+	res, err = schema.computeID()
+	assert.NoError(t, err)
+	assert.NotEqual(t, id, res)
+}
+
+func TestSchema_ValidateDefinition(t *testing.T) {
+	assert.NoError(t, getTestSchema().validateDefinition())
+
+	tests := []struct {
+		name   string
+		schema *Schema
+	}{
+		{
+			name:   "no name",
+			schema: &Schema{},
+		},
+		{
+			name: "no label",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Type: documents.AttrString}},
+			},
+		},
+		{
+			name: "reserved label",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Label: SchemaAttributeLabel, Type: documents.AttrString}},
+			},
+		},
+		{
+			name: "duplicate label",
+			schema: &Schema{
+				Name: "test",
+				Attributes: []SchemaAttribute{
+					{Label: "label", Type: documents.AttrString},
+					{Label: "label", Type: documents.AttrBytes},
+				},
+			},
+		},
+		{
+			name: "unknown type",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Label: "label", Type: "unknown"}},
+			},
+		},
+		{
+			name: "currencies of non monetary",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Label: "label", Type: documents.AttrDecimal, Currencies: []string{"USD"}}},
+			},
+		},
+		{
+			name: "enum of non string",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Label: "label", Type: documents.AttrBytes, Enum: []string{"a"}}},
+			},
+		},
+		{
+			name: "invalid pattern",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Label: "label", Type: documents.AttrString, Pattern: "("}},
+			},
+		},
+		{
+			name: "range of non number",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Label: "label", Type: documents.AttrString, Min: "1"}},
+			},
+		},
+		{
+			name: "invalid min",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Label: "label", Type: documents.AttrDecimal, Min: "a"}},
+			},
+		},
+		{
+			name: "min greater than max",
+			schema: &Schema{
+				Name:       "test",
+				Attributes: []SchemaAttribute{{Label: "label", Type: documents.AttrDecimal, Min: "2", Max: "1.5"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Error(t, test.schema.validateDefinition())
+		})
+	}
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema := getTestSchema()
+
+	attrs := getTestSchemaAttributes(t)
+
+	assert.NoError(t, schema.Validate(attrs))
+
+	// Attributes that are not defined in the schema are allowed unless the schema is strict.
+	extra, err := documents.NewStringAttribute("extra", documents.AttrString, "value")
+	assert.NoError(t, err)
+
+	schemaRef, err := documents.NewStringAttribute(SchemaAttributeLabel, documents.AttrString, "schema-id")
+	assert.NoError(t, err)
+
+	assert.NoError(t, schema.Validate(append(attrs, extra, schemaRef)))
+
+	schema.Strict = true
+
+	assert.NoError(t, schema.Validate(append(attrs, schemaRef)))
+	assert.Error(t, schema.Validate(append(attrs, extra)))
+}
+
+func TestSchema_Validate_Errors(t *testing.T) {
+	getAttr := func(label string, attrType documents.AttributeType, value string) documents.Attribute {
+		attr, err := documents.NewStringAttribute(label, attrType, value)
+		assert.NoError(t, err)
+
+		return attr
+	}
+
+	getMonetaryAttr := func(value, currency string) documents.Attribute {
+		dec, err := documents.NewDecimal(value)
+		assert.NoError(t, err)
+
+		attr, err := documents.NewMonetaryAttribute("amount", dec, nil, currency)
+		assert.NoError(t, err)
+
+		return attr
+	}
+
+	replaceAttr := func(attr documents.Attribute) []documents.Attribute {
+		var attrs []documents.Attribute
+
+		for _, a := range getTestSchemaAttributes(t) {
+			if a.KeyLabel != attr.KeyLabel {
+				attrs = append(attrs, a)
+			}
+		}
+
+		return append(attrs, attr)
+	}
+
+	tests := []struct {
+		name  string
+		attrs []documents.Attribute
+	}{
+		{
+			name:  "missing required attribute",
+			attrs: getTestSchemaAttributes(t)[1:],
+		},
+		{
+			name:  "wrong type",
+			attrs: replaceAttr(getAttr("number", documents.AttrBytes, hexutil.Encode(utils.RandomSlice(4)))),
+		},
+		{
+			name:  "pattern mismatch",
+			attrs: replaceAttr(getAttr("number", documents.AttrString, "1")),
+		},
+		{
+			name:  "value not in enum",
+			attrs: replaceAttr(getAttr("status", documents.AttrString, "overdue")),
+		},
+		{
+			name:  "integer less than min",
+			attrs: replaceAttr(getAttr("line_items", documents.AttrInt256, "0")),
+		},
+		{
+			name:  "integer greater than max",
+			attrs: replaceAttr(getAttr("line_items", documents.AttrInt256, "101")),
+		},
+		{
+			name:  "monetary less than min",
+			attrs: replaceAttr(getMonetaryAttr("-1", "USD")),
+		},
+		{
+			name:  "currency not allowed",
+			attrs: replaceAttr(getMonetaryAttr("1", "GBP")),
+		},
+	}
+
+	schema := getTestSchema()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Error(t, schema.Validate(test.attrs))
+		})
+	}
+}
+
+func TestSchema_Validate_TokenCurrency(t *testing.T) {
+	token := "0x9f8f72aa9304c8b593d555f12ef6589cc3a579a2"
+
+	schema := &Schema{
+		Name: "test",
+		Attributes: []SchemaAttribute{
+			{
+				Label:      "amount",
+				Type:       documents.AttrMonetary,
+				Currencies: []string{"0x9F8F72AA9304C8B593D555F12EF6589CC3A579A2"},
+			},
+		},
+	}
+
+	value, err := documents.NewDecimal("1")
+	assert.NoError(t, err)
+
+	amount, err := documents.NewMonetaryAttribute("amount", value, nil, token)
+	assert.NoError(t, err)
+	assert.Equal(t, documents.MonetaryToken, amount.Value.Monetary.Type)
+
+	assert.NoError(t, schema.Validate([]documents.Attribute{amount}))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/contextutil"
 	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
 )
@@ -12,14 +14,17 @@ import (
 // service always returns errors of type `errors.Error` or `errors.TypedError`
 type service struct {
 	documents.Service
+	schemaRegistry SchemaRegistry
 }
 
 // NewService returns the default implementation of the service.
 func NewService(
 	srv documents.Service,
+	schemaRegistry SchemaRegistry,
 ) documents.Service {
 	return service{
-		Service: srv,
+		Service:        srv,
+		schemaRegistry: schemaRegistry,
 	}
 }
 
@@ -40,6 +45,19 @@ func (s service) New(_ string) (documents.Document, error) {
 }
 
 // Validate takes care of document validation
-func (s service) Validate(_ context.Context, _ documents.Document, _ documents.Document) error {
-	return nil
+func (s service) Validate(ctx context.Context, doc documents.Document, old documents.Document) error {
+	identity, err := contextutil.Identity(ctx)
+	if err != nil {
+		return documents.ErrAccountNotFoundInContext
+	}
+
+	return schemaValidator(s.schemaRegistry, identity, false).Validate(old, doc)
+}
+
+// ReceivedDocumentValidator returns the validator of the generic documents received by the account over the p2p layer.
+//
+// The schemas are registered per account, the documents that reference a schema that the account didn't register
+// are accepted, since the receiver can't validate them.
+func (s service) ReceivedDocumentValidator(accountID *types.AccountID) documents.Validator {
+	return schemaValidator(s.schemaRegistry, accountID, true)
 }
@@ -7,8 +7,11 @@ import (
 	"testing"
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
 	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
 	"github.com/centrifuge/pod/utils"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -17,7 +20,7 @@ import (
 func TestService_DeriveFromCoreDocument(t *testing.T) {
 	documentServiceMock := documents.NewServiceMock(t)
 
-	srv := NewService(documentServiceMock)
+	srv := NewService(documentServiceMock, NewSchemaRegistryMock(t))
 
 	generic := getTestGeneric(t, documents.CollaboratorsAccess{}, nil)
 
@@ -54,7 +57,7 @@ func TestService_DeriveFromCoreDocument(t *testing.T) {
 func TestService_New(t *testing.T) {
 	documentServiceMock := documents.NewServiceMock(t)
 
-	srv := NewService(documentServiceMock)
+	srv := NewService(documentServiceMock, NewSchemaRegistryMock(t))
 
 	res, err := srv.New("")
 	assert.NoError(t, err)
@@ -67,18 +70,65 @@ func TestService_New(t *testing.T) {
 
 func TestService_Validate(t *testing.T) {
 	documentServiceMock := documents.NewServiceMock(t)
+	schemaRegistryMock := NewSchemaRegistryMock(t)
 
-	srv := NewService(documentServiceMock)
+	srv := NewService(documentServiceMock, schemaRegistryMock)
 
-	err := srv.Validate(nil, nil, nil)
+	accountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	err = srv.Validate(context.Background(), nil, nil)
-	assert.NoError(t, err)
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	err = srv.Validate(context.Background(), documents.NewDocumentMock(t), nil)
+	err = srv.Validate(ctx, getTestGeneric(t, documents.CollaboratorsAccess{}, nil), nil)
 	assert.NoError(t, err)
 
-	err = srv.Validate(context.Background(), documents.NewDocumentMock(t), documents.NewDocumentMock(t))
+	schema := getTestSchema()
+	schema.ID = "schema-id"
+
+	schemaRegistryMock.On("GetSchema", accountID, schema.ID).
+		Return(schema, nil).Once()
+
+	err = srv.Validate(ctx, getTestGenericWithSchema(t, schema.ID), nil)
+	assert.True(t, errors.IsOfType(ErrSchemaValidation, err))
+}
+
+func TestService_Validate_NoAccount(t *testing.T) {
+	srv := NewService(documents.NewServiceMock(t), NewSchemaRegistryMock(t))
+
+	err := srv.Validate(context.Background(), getTestGeneric(t, documents.CollaboratorsAccess{}, nil), nil)
+	assert.ErrorIs(t, err, documents.ErrAccountNotFoundInContext)
+}
+
+func TestService_ReceivedDocumentValidator(t *testing.T) {
+	schemaRegistryMock := NewSchemaRegistryMock(t)
+
+	srv := NewService(documents.NewServiceMock(t), schemaRegistryMock)
+
+	provider, ok := srv.(documents.ReceivedDocumentValidatorProvider)
+	assert.True(t, ok)
+
+	accountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
+
+	schema := getTestSchema()
+	schema.ID = "schema-id"
+
+	schemaRegistryMock.On("GetSchema", accountID, schema.ID).
+		Return(schema, nil).Once()
+
+	doc := getTestGenericWithSchema(t, schema.ID, getTestSchemaAttributes(t)...)
+
+	assert.NoError(t, provider.ReceivedDocumentValidator(accountID).Validate(nil, doc))
+
+	// Documents that reference a schema unknown to the receiver are accepted.
+	schemaRegistryMock.On("GetSchema", accountID, "unknown-schema-id").
+		Return(nil, errors.NewTypedError(ErrSchemaNotFound, errors.New("error"))).Once()
+
+	doc = getTestGenericWithSchema(t, "unknown-schema-id")
+
+	assert.NoError(t, provider.ReceivedDocumentValidator(accountID).Validate(nil, doc))
 }
@@ -0,0 +1,56 @@
+package generic
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	logging "github.com/ipfs/go-log"
+)
+
+var log = logging.Logger("generic")
+
+// schemaValidator validates the attributes of the document against the schema that it references, if any.
+// The schema must be registered by the account, unless skipUnknownSchema is set, in which case documents that
+// reference a schema that is not registered are accepted without validating their attributes.
+func schemaValidator(schemaRegistry SchemaRegistry, accountID *types.AccountID, skipUnknownSchema bool) documents.Validator {
+	return documents.ValidatorFunc(func(_, new documents.Document) error {
+		if new == nil {
+			return documents.ErrDocumentNil
+		}
+
+		schemaKey, err := documents.AttrKeyFromLabel(SchemaAttributeLabel)
+		if err != nil {
+			return err
+		}
+
+		if !new.AttributeExists(schemaKey) {
+			return nil
+		}
+
+		schemaAttr, err := new.GetAttribute(schemaKey)
+		if err != nil {
+			return errors.NewTypedError(ErrInvalidSchemaRef, err)
+		}
+
+		if schemaAttr.Value.Type != documents.AttrString {
+			return errors.NewTypedError(ErrInvalidSchemaRef, errors.New("schema attribute is not a string"))
+		}
+
+		schema, err := schemaRegistry.GetSchema(accountID, schemaAttr.Value.Str)
+		if err != nil {
+			if skipUnknownSchema && errors.IsOfType(ErrSchemaNotFound, err) {
+				log.Warnf("Skipping validation of document against unknown schema %s", schemaAttr.Value.Str)
+
+				return nil
+			}
+
+			return err
+		}
+
+		if err := schema.Validate(new.GetAttributes()); err != nil {
+			return errors.NewTypedError(ErrSchemaValidation, err)
+		}
+
+		return nil
+	})
+}
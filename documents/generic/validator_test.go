@@ -0,0 +1,114 @@
+//go:build unit
+
+package generic
+
+import (
+	"testing"
+
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestGenericWithSchema(t *testing.T, schemaID string, attrs ...documents.Attribute) *Generic {
+	schemaRef, err := documents.NewStringAttribute(SchemaAttributeLabel, documents.AttrString, schemaID)
+	assert.NoError(t, err)
+
+	attrMap := map[documents.AttrKey]documents.Attribute{
+		schemaRef.Key: schemaRef,
+	}
+
+	for _, attr := range attrs {
+		attrMap[attr.Key] = attr
+	}
+
+	return getTestGeneric(t, documents.CollaboratorsAccess{}, attrMap)
+}
+
+func TestSchemaValidator(t *testing.T) {
+	schemaRegistryMock := NewSchemaRegistryMock(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	validator := schemaValidator(schemaRegistryMock, accountID, false)
+
+	// No schema reference.
+	assert.NoError(t, validator.Validate(nil, getTestGeneric(t, documents.CollaboratorsAccess{}, nil)))
+
+	schema := getTestSchema()
+	schema.ID = "schema-id"
+
+	schemaRegistryMock.On("GetSchema", accountID, schema.ID).
+		Return(schema, nil).Twice()
+
+	doc := getTestGenericWithSchema(t, schema.ID, getTestSchemaAttributes(t)...)
+
+	assert.NoError(t, validator.Validate(nil, doc))
+
+	// Missing required attributes.
+	doc = getTestGenericWithSchema(t, schema.ID)
+
+	err = validator.Validate(nil, doc)
+	assert.True(t, errors.IsOfType(ErrSchemaValidation, err))
+}
+
+func TestSchemaValidator_NilDocument(t *testing.T) {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	err = schemaValidator(NewSchemaRegistryMock(t), accountID, false).Validate(nil, nil)
+	assert.ErrorIs(t, err, documents.ErrDocumentNil)
+}
+
+func TestSchemaValidator_InvalidSchemaReference(t *testing.T) {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	schemaRef, err := documents.NewStringAttribute(SchemaAttributeLabel, documents.AttrBytes, "0x01")
+	assert.NoError(t, err)
+
+	doc := getTestGeneric(t, documents.CollaboratorsAccess{}, map[documents.AttrKey]documents.Attribute{
+		schemaRef.Key: schemaRef,
+	})
+
+	err = schemaValidator(NewSchemaRegistryMock(t), accountID, false).Validate(nil, doc)
+	assert.True(t, errors.IsOfType(ErrInvalidSchemaRef, err))
+}
+
+func TestSchemaValidator_SchemaNotFound(t *testing.T) {
+	schemaRegistryMock := NewSchemaRegistryMock(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	schemaRegistryMock.On("GetSchema", accountID, "schema-id").
+		Return(nil, errors.NewTypedError(ErrSchemaNotFound, errors.New("error"))).Once()
+
+	err = schemaValidator(schemaRegistryMock, accountID, false).Validate(nil, getTestGenericWithSchema(t, "schema-id"))
+	assert.True(t, errors.IsOfType(ErrSchemaNotFound, err))
+}
+
+func TestSchemaValidator_SkipUnknownSchema(t *testing.T) {
+	schemaRegistryMock := NewSchemaRegistryMock(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	validator := schemaValidator(schemaRegistryMock, accountID, true)
+
+	schemaRegistryMock.On("GetSchema", accountID, "schema-id").
+		Return(nil, errors.NewTypedError(ErrSchemaNotFound, errors.New("error"))).Once()
+
+	assert.NoError(t, validator.Validate(nil, getTestGenericWithSchema(t, "schema-id")))
+
+	// Other registry errors are still returned.
+	registryErr := errors.New("error")
+
+	schemaRegistryMock.On("GetSchema", accountID, "other-schema-id").
+		Return(nil, registryErr).Once()
+
+	err = validator.Validate(nil, getTestGenericWithSchema(t, "other-schema-id"))
+	assert.ErrorIs(t, err, registryErr)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/centrifuge/pod/contextutil"
 	protocolIDDispatcher "github.com/centrifuge/pod/dispatcher"
 	. "github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/errors"
 	v2 "github.com/centrifuge/pod/identity/v2"
 	"github.com/centrifuge/pod/ipfs"
@@ -62,6 +63,7 @@ var integrationTestBootstrappers = []bootstrap.TestBootstrapper{
 	Bootstrapper{},
 	pending.Bootstrapper{},
 	&ipfs.TestBootstrapper{},
+	attachments.Bootstrapper{},
 	&nftv3.Bootstrapper{},
 	&p2p.Bootstrapper{},
 	PostBootstrapper{},
@@ -0,0 +1,51 @@
+package invoice
+
+import (
+	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	v2 "github.com/centrifuge/pod/identity/v2"
+)
+
+// Bootstrapper implements bootstrap.Bootstrapper.
+type Bootstrapper struct{}
+
+// Bootstrap sets the required storage and registers
+func (Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	registry, ok := ctx[documents.BootstrappedRegistry].(*documents.ServiceRegistry)
+	if !ok {
+		return errors.New("service registry not initialised")
+	}
+
+	docSrv, ok := ctx[documents.BootstrappedDocumentService].(documents.Service)
+	if !ok {
+		return errors.New("document service not initialised")
+	}
+
+	repo, ok := ctx[documents.BootstrappedDocumentRepository].(documents.Repository)
+	if !ok {
+		return errors.New("document db repository not initialised")
+	}
+
+	repo.Register(&Invoice{})
+
+	identityService, ok := ctx[v2.BootstrappedIdentityServiceV2].(v2.Service)
+	if !ok {
+		return errors.New("identity service v2 not initialised")
+	}
+
+	// register service
+	srv := NewService(docSrv, identityService)
+
+	err := registry.Register(documenttypes.InvoiceDataTypeUrl, srv)
+	if err != nil {
+		return errors.New("failed to register invoice service: %v", err)
+	}
+
+	err = registry.Register(Scheme, srv)
+	if err != nil {
+		return errors.New("failed to register invoice service: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/invoice/invoicepb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toProtoLineItems(items []LineItem) (pitems []*invoicepb.LineItem, err error) {
+	for _, item := range items {
+		decs, err := documents.DecimalsToBytes(item.Quantity, item.UnitPrice, item.NetAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		pitems = append(pitems, &invoicepb.LineItem{
+			ItemNumber:  item.ItemNumber,
+			Description: item.Description,
+			Quantity:    decs[0],
+			UnitPrice:   decs[1],
+			NetAmount:   decs[2],
+		})
+	}
+
+	return pitems, nil
+}
+
+func fromProtoLineItems(pitems []*invoicepb.LineItem) (items []LineItem, err error) {
+	for _, item := range pitems {
+		decs, err := documents.BytesToDecimals(item.Quantity, item.UnitPrice, item.NetAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, LineItem{
+			ItemNumber:  item.ItemNumber,
+			Description: item.Description,
+			Quantity:    decs[0],
+			UnitPrice:   decs[1],
+			NetAmount:   decs[2],
+		})
+	}
+
+	return items, nil
+}
+
+func toProtoTaxItems(items []TaxItem) (pitems []*invoicepb.TaxItem, err error) {
+	for _, item := range items {
+		decs, err := documents.DecimalsToBytes(item.TaxRate, item.TaxBaseAmount, item.TaxAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		pitems = append(pitems, &invoicepb.TaxItem{
+			ItemNumber:    item.ItemNumber,
+			Description:   item.Description,
+			TaxRate:       decs[0],
+			TaxBaseAmount: decs[1],
+			TaxAmount:     decs[2],
+		})
+	}
+
+	return pitems, nil
+}
+
+func fromProtoTaxItems(pitems []*invoicepb.TaxItem) (items []TaxItem, err error) {
+	for _, item := range pitems {
+		decs, err := documents.BytesToDecimals(item.TaxRate, item.TaxBaseAmount, item.TaxAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, TaxItem{
+			ItemNumber:    item.ItemNumber,
+			Description:   item.Description,
+			TaxRate:       decs[0],
+			TaxBaseAmount: decs[1],
+			TaxAmount:     decs[2],
+		})
+	}
+
+	return items, nil
+}
+
+// accountIDToBytes returns the bytes of the account ID, nil if the account ID is not set.
+func accountIDToBytes(accountID *types.AccountID) []byte {
+	if accountID == nil {
+		return nil
+	}
+
+	return accountID.ToBytes()
+}
+
+// accountIDFromBytes parses the account ID, nil if the bytes are empty.
+func accountIDFromBytes(b []byte) (*types.AccountID, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	accountIDs, err := documents.ParseAccountIDBytes(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountIDs[0], nil
+}
+
+func toProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func fromProtoTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
@@ -0,0 +1,92 @@
+//go:build unit
+
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverters_LineItems(t *testing.T) {
+	items := getTestData(t).LineItems
+	items = append(items, LineItem{ItemNumber: "3"})
+
+	pitems, err := toProtoLineItems(items)
+	assert.NoError(t, err)
+	assert.Len(t, pitems, len(items))
+	assert.Nil(t, pitems[2].Quantity)
+
+	res, err := fromProtoLineItems(pitems)
+	assert.NoError(t, err)
+	assert.Len(t, res, len(items))
+
+	for i, item := range items {
+		assert.Equal(t, item.ItemNumber, res[i].ItemNumber)
+		assert.Equal(t, item.Description, res[i].Description)
+		assert.Equal(t, item.Quantity.String(), res[i].Quantity.String())
+		assert.Equal(t, item.UnitPrice.String(), res[i].UnitPrice.String())
+		assert.Equal(t, item.NetAmount.String(), res[i].NetAmount.String())
+	}
+
+	pitems[0].UnitPrice = utils.RandomSlice(31)
+
+	res, err = fromProtoLineItems(pitems)
+	assert.ErrorIs(t, err, documents.ErrInvalidDecimal)
+	assert.Nil(t, res)
+}
+
+func TestConverters_TaxItems(t *testing.T) {
+	items := getTestData(t).TaxItems
+
+	pitems, err := toProtoTaxItems(items)
+	assert.NoError(t, err)
+	assert.Len(t, pitems, len(items))
+
+	res, err := fromProtoTaxItems(pitems)
+	assert.NoError(t, err)
+	assert.Len(t, res, len(items))
+	assert.Equal(t, items[0].TaxRate.String(), res[0].TaxRate.String())
+	assert.Equal(t, items[0].TaxBaseAmount.String(), res[0].TaxBaseAmount.String())
+	assert.Equal(t, items[0].TaxAmount.String(), res[0].TaxAmount.String())
+
+	pitems[0].TaxRate = utils.RandomSlice(31)
+
+	res, err = fromProtoTaxItems(pitems)
+	assert.ErrorIs(t, err, documents.ErrInvalidDecimal)
+	assert.Nil(t, res)
+}
+
+func TestConverters_AccountID(t *testing.T) {
+	assert.Nil(t, accountIDToBytes(nil))
+
+	res, err := accountIDFromBytes(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	res, err = accountIDFromBytes(accountIDToBytes(accountID))
+	assert.NoError(t, err)
+	assert.Equal(t, accountID, res)
+
+	res, err = accountIDFromBytes(utils.RandomSlice(31))
+	assert.True(t, errors.IsOfType(documents.ErrAccountIDBytesParsing, err))
+	assert.Nil(t, res)
+}
+
+func TestConverters_Timestamp(t *testing.T) {
+	assert.Nil(t, toProtoTimestamp(nil))
+	assert.Nil(t, fromProtoTimestamp(nil))
+
+	now := time.Now().UTC()
+
+	res := fromProtoTimestamp(toProtoTimestamp(&now))
+	assert.True(t, now.Equal(*res))
+}
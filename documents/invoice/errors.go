@@ -0,0 +1,9 @@
+package invoice
+
+import "github.com/centrifuge/pod/errors"
+
+const (
+	ErrInvoiceInvalidData    = errors.Error("invalid invoice data")
+	ErrInvoiceInvalidParty   = errors.Error("invalid invoice party")
+	ErrInvoiceTotalsMismatch = errors.Error("invoice totals are inconsistent")
+)
@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.20.1
+// source: documents/invoice/invoicepb/invoice.proto
+
+package invoicepb
+
+import (
+	_ "github.com/centrifuge/precise-proofs/proofs/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Invoice is the data of the invoice document.
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice number or reference number
+	Number string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	// invoice status, for example draft, unpaid or paid
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// identity of the party that issues the invoice
+	Sender []byte `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// identity of the party that receives the invoice
+	Recipient []byte `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// identity of the party that receives the payment, the sender if not set
+	Payee []byte `protobuf:"bytes,5,opt,name=payee,proto3" json:"payee,omitempty"`
+	// ISO 4217 code of the currency of the amounts
+	Currency    string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	DateCreated *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date_created,json=dateCreated,proto3" json:"date_created,omitempty"`
+	DateDue     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date_due,json=dateDue,proto3" json:"date_due,omitempty"`
+	LineItems   []*LineItem            `protobuf:"bytes,9,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	TaxItems    []*TaxItem             `protobuf:"bytes,10,rep,name=tax_items,json=taxItems,proto3" json:"tax_items,omitempty"`
+	// totals, encoded as 32 byte decimals
+	NetAmount   []byte `protobuf:"bytes,11,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	TaxAmount   []byte `protobuf:"bytes,12,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	GrossAmount []byte `protobuf:"bytes,13,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	Comment     string `protobuf:"bytes,14,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_documents_invoice_invoicepb_invoice_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_documents_invoice_invoicepb_invoice_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_documents_invoice_invoicepb_invoice_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invoice) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Invoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invoice) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *Invoice) GetRecipient() []byte {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *Invoice) GetPayee() []byte {
+	if x != nil {
+		return x.Payee
+	}
+	return nil
+}
+
+func (x *Invoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Invoice) GetDateCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateCreated
+	}
+	return nil
+}
+
+func (x *Invoice) GetDateDue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateDue
+	}
+	return nil
+}
+
+func (x *Invoice) GetLineItems() []*LineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Invoice) GetTaxItems() []*TaxItem {
+	if x != nil {
+		return x.TaxItems
+	}
+	return nil
+}
+
+func (x *Invoice) GetNetAmount() []byte {
+	if x != nil {
+		return x.NetAmount
+	}
+	return nil
+}
+
+func (x *Invoice) GetTaxAmount() []byte {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return nil
+}
+
+func (x *Invoice) GetGrossAmount() []byte {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return nil
+}
+
+func (x *Invoice) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// LineItem is a single billed item of the invoice.
+type LineItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemNumber  string `protobuf:"bytes,1,opt,name=item_number,json=itemNumber,proto3" json:"item_number,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity    []byte `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice   []byte `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// quantity multiplied by unit price
+	NetAmount []byte `protobuf:"bytes,5,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+}
+
+func (x *LineItem) Reset() {
+	*x = LineItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_documents_invoice_invoicepb_invoice_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineItem) ProtoMessage() {}
+
+func (x *LineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_documents_invoice_invoicepb_invoice_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineItem.ProtoReflect.Descriptor instead.
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return file_documents_invoice_invoicepb_invoice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LineItem) GetItemNumber() string {
+	if x != nil {
+		return x.ItemNumber
+	}
+	return ""
+}
+
+func (x *LineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LineItem) GetQuantity() []byte {
+	if x != nil {
+		return x.Quantity
+	}
+	return nil
+}
+
+func (x *LineItem) GetUnitPrice() []byte {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *LineItem) GetNetAmount() []byte {
+	if x != nil {
+		return x.NetAmount
+	}
+	return nil
+}
+
+// TaxItem is the tax applied to a part of the net amount of the invoice.
+type TaxItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemNumber  string `protobuf:"bytes,1,opt,name=item_number,json=itemNumber,proto3" json:"item_number,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// tax rate in percent
+	TaxRate       []byte `protobuf:"bytes,3,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxBaseAmount []byte `protobuf:"bytes,4,opt,name=tax_base_amount,json=taxBaseAmount,proto3" json:"tax_base_amount,omitempty"`
+	// tax base amount multiplied by the tax rate
+	TaxAmount []byte `protobuf:"bytes,5,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+}
+
+func (x *TaxItem) Reset() {
+	*x = TaxItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_documents_invoice_invoicepb_invoice_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaxItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxItem) ProtoMessage() {}
+
+func (x *TaxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_documents_invoice_invoicepb_invoice_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxItem.ProtoReflect.Descriptor instead.
+func (*TaxItem) Descriptor() ([]byte, []int) {
+	return file_documents_invoice_invoicepb_invoice_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaxItem) GetItemNumber() string {
+	if x != nil {
+		return x.ItemNumber
+	}
+	return ""
+}
+
+func (x *TaxItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaxItem) GetTaxRate() []byte {
+	if x != nil {
+		return x.TaxRate
+	}
+	return nil
+}
+
+func (x *TaxItem) GetTaxBaseAmount() []byte {
+	if x != nil {
+		return x.TaxBaseAmount
+	}
+	return nil
+}
+
+func (x *TaxItem) GetTaxAmount() []byte {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return nil
+}
+
+var File_documents_invoice_invoicepb_invoice_proto protoreflect.FileDescriptor
+
+var file_documents_invoice_invoicepb_invoice_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x70, 0x62, 0x2f, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x1a, 0x27, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x65, 0x2d, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d,
+	0x04, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xb0, 0xc1, 0xf5, 0x0a,
+	0x20, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xb0, 0xc1,
+	0xf5, 0x0a, 0x20, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xb0,
+	0xc1, 0xf5, 0x0a, 0x20, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x64,
+	0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x61, 0x74, 0x65, 0x44, 0x75, 0x65, 0x12, 0x30, 0x0a,
+	0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x2d, 0x0a, 0x09, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x78,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x74, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x24,
+	0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x05, 0xb0, 0xc1, 0xf5, 0x0a, 0x20, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xb0, 0xc1, 0xf5, 0x0a, 0x20, 0x52,
+	0x09, 0x74, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x72,
+	0x6f, 0x73, 0x73, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x05, 0xb0, 0xc1, 0xf5, 0x0a, 0x20, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xbc,
+	0x01, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x05, 0xb0, 0xc1, 0xf5, 0x0a, 0x20, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x24, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xb0, 0xc1, 0xf5, 0x0a, 0x20, 0x52, 0x09, 0x75, 0x6e,
+	0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xb0, 0xc1, 0xf5,
+	0x0a, 0x20, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc3, 0x01,
+	0x0a, 0x07, 0x54, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x74, 0x65, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x08,
+	0x74, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05,
+	0xb0, 0xc1, 0xf5, 0x0a, 0x20, 0x52, 0x07, 0x74, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2d,
+	0x0a, 0x0f, 0x74, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xb0, 0xc1, 0xf5, 0x0a, 0x20, 0x52, 0x0d,
+	0x74, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0a, 0x74, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x05, 0xb0, 0xc1, 0xf5, 0x0a, 0x20, 0x52, 0x09, 0x74, 0x61, 0x78, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x66, 0x75, 0x67, 0x65, 0x2f, 0x70, 0x6f, 0x64,
+	0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x70, 0x62, 0x3b, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_documents_invoice_invoicepb_invoice_proto_rawDescOnce sync.Once
+	file_documents_invoice_invoicepb_invoice_proto_rawDescData = file_documents_invoice_invoicepb_invoice_proto_rawDesc
+)
+
+func file_documents_invoice_invoicepb_invoice_proto_rawDescGZIP() []byte {
+	file_documents_invoice_invoicepb_invoice_proto_rawDescOnce.Do(func() {
+		file_documents_invoice_invoicepb_invoice_proto_rawDescData = protoimpl.X.CompressGZIP(file_documents_invoice_invoicepb_invoice_proto_rawDescData)
+	})
+	return file_documents_invoice_invoicepb_invoice_proto_rawDescData
+}
+
+var file_documents_invoice_invoicepb_invoice_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_documents_invoice_invoicepb_invoice_proto_goTypes = []interface{}{
+	(*Invoice)(nil),               // 0: invoice.Invoice
+	(*LineItem)(nil),              // 1: invoice.LineItem
+	(*TaxItem)(nil),               // 2: invoice.TaxItem
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_documents_invoice_invoicepb_invoice_proto_depIdxs = []int32{
+	3, // 0: invoice.Invoice.date_created:type_name -> google.protobuf.Timestamp
+	3, // 1: invoice.Invoice.date_due:type_name -> google.protobuf.Timestamp
+	1, // 2: invoice.Invoice.line_items:type_name -> invoice.LineItem
+	2, // 3: invoice.Invoice.tax_items:type_name -> invoice.TaxItem
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_documents_invoice_invoicepb_invoice_proto_init() }
+func file_documents_invoice_invoicepb_invoice_proto_init() {
+	if File_documents_invoice_invoicepb_invoice_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_documents_invoice_invoicepb_invoice_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_documents_invoice_invoicepb_invoice_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LineItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_documents_invoice_invoicepb_invoice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaxItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_documents_invoice_invoicepb_invoice_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_documents_invoice_invoicepb_invoice_proto_goTypes,
+		DependencyIndexes: file_documents_invoice_invoicepb_invoice_proto_depIdxs,
+		MessageInfos:      file_documents_invoice_invoicepb_invoice_proto_msgTypes,
+	}.Build()
+	File_documents_invoice_invoicepb_invoice_proto = out.File
+	file_documents_invoice_invoicepb_invoice_proto_rawDesc = nil
+	file_documents_invoice_invoicepb_invoice_proto_goTypes = nil
+	file_documents_invoice_invoicepb_invoice_proto_depIdxs = nil
+}
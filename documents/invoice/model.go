@@ -0,0 +1,454 @@
+package invoice
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/invoice/invoicepb"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/precise-proofs/proofs"
+	"github.com/jinzhu/copier"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	prefix string = "invoice"
+
+	// Scheme is invoice scheme.
+	Scheme = prefix
+)
+
+// tree prefixes for specific to documents use the second byte of a 4 byte slice by convention
+func compactPrefix() []byte { return []byte{0, 1, 0, 0} }
+
+// LineItem is a single billed item of the invoice.
+type LineItem struct {
+	ItemNumber  string             `json:"item_number"`
+	Description string             `json:"description"`
+	Quantity    *documents.Decimal `json:"quantity" swaggertype:"primitive,string"`
+	UnitPrice   *documents.Decimal `json:"unit_price" swaggertype:"primitive,string"`
+	NetAmount   *documents.Decimal `json:"net_amount" swaggertype:"primitive,string"`
+}
+
+// TaxItem is the tax applied to a part of the net amount of the invoice.
+type TaxItem struct {
+	ItemNumber    string             `json:"item_number"`
+	Description   string             `json:"description"`
+	TaxRate       *documents.Decimal `json:"tax_rate" swaggertype:"primitive,string"`
+	TaxBaseAmount *documents.Decimal `json:"tax_base_amount" swaggertype:"primitive,string"`
+	TaxAmount     *documents.Decimal `json:"tax_amount" swaggertype:"primitive,string"`
+}
+
+// Data represents the invoice data.
+type Data struct {
+	Number      string             `json:"number"`
+	Status      string             `json:"status"`
+	Sender      *types.AccountID   `json:"sender" swaggertype:"primitive,string"`
+	Recipient   *types.AccountID   `json:"recipient" swaggertype:"primitive,string"`
+	Payee       *types.AccountID   `json:"payee,omitempty" swaggertype:"primitive,string"`
+	Currency    string             `json:"currency"`
+	DateCreated *time.Time         `json:"date_created,omitempty" swaggertype:"primitive,string"`
+	DateDue     *time.Time         `json:"date_due,omitempty" swaggertype:"primitive,string"`
+	LineItems   []LineItem         `json:"line_items"`
+	TaxItems    []TaxItem          `json:"tax_items"`
+	NetAmount   *documents.Decimal `json:"net_amount" swaggertype:"primitive,string"`
+	TaxAmount   *documents.Decimal `json:"tax_amount" swaggertype:"primitive,string"`
+	GrossAmount *documents.Decimal `json:"gross_amount" swaggertype:"primitive,string"`
+	Comment     string             `json:"comment"`
+}
+
+// Invoice implements the documents.Document and keeps track of invoice related fields and state.
+type Invoice struct {
+	*documents.CoreDocument
+
+	Data Data
+}
+
+// createP2PProtobuf returns centrifuge protobuf specific invoice data.
+func (i *Invoice) createP2PProtobuf() (*invoicepb.Invoice, error) {
+	d := i.Data
+
+	totals, err := documents.DecimalsToBytes(d.NetAmount, d.TaxAmount, d.GrossAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	lineItems, err := toProtoLineItems(d.LineItems)
+	if err != nil {
+		return nil, err
+	}
+
+	taxItems, err := toProtoTaxItems(d.TaxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invoicepb.Invoice{
+		Number:      d.Number,
+		Status:      d.Status,
+		Sender:      accountIDToBytes(d.Sender),
+		Recipient:   accountIDToBytes(d.Recipient),
+		Payee:       accountIDToBytes(d.Payee),
+		Currency:    d.Currency,
+		DateCreated: toProtoTimestamp(d.DateCreated),
+		DateDue:     toProtoTimestamp(d.DateDue),
+		LineItems:   lineItems,
+		TaxItems:    taxItems,
+		NetAmount:   totals[0],
+		TaxAmount:   totals[1],
+		GrossAmount: totals[2],
+		Comment:     d.Comment,
+	}, nil
+}
+
+// loadFromP2PProtobuf loads the invoice from centrifuge protobuf invoice data.
+func (i *Invoice) loadFromP2PProtobuf(data *invoicepb.Invoice) error {
+	var d Data
+	var err error
+
+	if d.Sender, err = accountIDFromBytes(data.Sender); err != nil {
+		return err
+	}
+
+	if d.Recipient, err = accountIDFromBytes(data.Recipient); err != nil {
+		return err
+	}
+
+	if d.Payee, err = accountIDFromBytes(data.Payee); err != nil {
+		return err
+	}
+
+	totals, err := documents.BytesToDecimals(data.NetAmount, data.TaxAmount, data.GrossAmount)
+	if err != nil {
+		return err
+	}
+
+	if d.LineItems, err = fromProtoLineItems(data.LineItems); err != nil {
+		return err
+	}
+
+	if d.TaxItems, err = fromProtoTaxItems(data.TaxItems); err != nil {
+		return err
+	}
+
+	d.Number = data.Number
+	d.Status = data.Status
+	d.Currency = data.Currency
+	d.DateCreated = fromProtoTimestamp(data.DateCreated)
+	d.DateDue = fromProtoTimestamp(data.DateDue)
+	d.NetAmount, d.TaxAmount, d.GrossAmount = totals[0], totals[1], totals[2]
+	d.Comment = data.Comment
+	i.Data = d
+	return nil
+}
+
+// PackCoreDocument packs the Invoice into a CoreDocument.
+func (i *Invoice) PackCoreDocument() (cd *coredocumentpb.CoreDocument, err error) {
+	invoiceData, err := i.createP2PProtobuf()
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentDataMarshalling, err)
+	}
+
+	data, err := proto.Marshal(invoiceData)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentDataMarshalling, err)
+	}
+
+	embedData := &anypb.Any{
+		TypeUrl: i.DocumentType(),
+		Value:   data,
+	}
+
+	return i.CoreDocument.PackCoreDocument(embedData), nil
+}
+
+// UnpackCoreDocument unpacks the core document into Invoice.
+func (i *Invoice) UnpackCoreDocument(cd *coredocumentpb.CoreDocument) error {
+	if cd.EmbeddedData == nil ||
+		cd.EmbeddedData.TypeUrl != i.DocumentType() {
+		return documents.ErrDocumentConvertInvalidSchema
+	}
+
+	invoiceData := new(invoicepb.Invoice)
+	err := proto.Unmarshal(cd.EmbeddedData.Value, invoiceData)
+	if err != nil {
+		return errors.NewTypedError(documents.ErrDocumentDataUnmarshalling, err)
+	}
+
+	err = i.loadFromP2PProtobuf(invoiceData)
+	if err != nil {
+		return errors.NewTypedError(documents.ErrDocumentDataUnmarshalling, err)
+	}
+
+	i.CoreDocument, err = documents.NewCoreDocumentFromProtobuf(cd)
+
+	return err
+}
+
+// JSON marshals Invoice into a json bytes
+func (i *Invoice) JSON() ([]byte, error) {
+	return i.CoreDocument.MarshalJSON(i)
+}
+
+// FromJSON unmarshals the json bytes into Invoice
+func (i *Invoice) FromJSON(jsonData []byte) error {
+	if i.CoreDocument == nil {
+		i.CoreDocument = new(documents.CoreDocument)
+	}
+
+	return i.CoreDocument.UnmarshalJSON(jsonData, i)
+}
+
+// Type gives the Invoice type
+func (i *Invoice) Type() reflect.Type {
+	return reflect.TypeOf(i)
+}
+
+func (i *Invoice) getDataLeaves() ([]proofs.LeafNode, error) {
+	t, err := i.getRawDataTree()
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDataTree, err)
+	}
+
+	return t.GetLeaves(), nil
+}
+
+func (i *Invoice) getRawDataTree() (*proofs.DocumentTree, error) {
+	if i.CoreDocument == nil {
+		return nil, documents.ErrCoreDocumentNil
+	}
+
+	invoiceProto, err := i.createP2PProtobuf()
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDataTree, err)
+	}
+
+	t, err := i.CoreDocument.DefaultTreeWithPrefix(prefix, compactPrefix())
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDataTree, err)
+	}
+
+	err = t.AddLeavesFromDocument(invoiceProto)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDataTree, err)
+	}
+
+	return t, nil
+}
+
+// getDocumentDataTree creates precise-proofs data tree for the model
+func (i *Invoice) getDocumentDataTree() (tree *proofs.DocumentTree, err error) {
+	t, err := i.getRawDataTree()
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Generate()
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDataTree, err)
+	}
+
+	return t, nil
+}
+
+// CreateProofs generates proofs for given fields.
+func (i *Invoice) CreateProofs(fields []string) (prf *documents.DocumentProof, err error) {
+	dataLeaves, err := i.getDataLeaves()
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentProof, err)
+	}
+
+	return i.CoreDocument.CreateProofs(i.DocumentType(), dataLeaves, fields)
+}
+
+// DocumentType returns the invoice document type.
+func (*Invoice) DocumentType() string {
+	return documenttypes.InvoiceDataTypeUrl
+}
+
+// AddNFT adds NFT to the Invoice.
+func (i *Invoice) AddNFT(grantReadAccess bool, collectionID types.U64, itemID types.U128) error {
+	cd, err := i.CoreDocument.AddNFT(grantReadAccess, collectionID, itemID)
+	if err != nil {
+		return errors.NewTypedError(documents.ErrDocumentAddNFT, err)
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
+// CalculateSigningRoot calculates the signing root of the document.
+func (i *Invoice) CalculateSigningRoot() ([]byte, error) {
+	dataLeaves, err := i.getDataLeaves()
+	if err != nil {
+		return nil, err
+	}
+
+	return i.CoreDocument.CalculateSigningRoot(i.DocumentType(), dataLeaves)
+}
+
+// CalculateDocumentRoot calculates the document root
+func (i *Invoice) CalculateDocumentRoot() ([]byte, error) {
+	dataLeaves, err := i.getDataLeaves()
+	if err != nil {
+		return nil, err
+	}
+
+	return i.CoreDocument.CalculateDocumentRoot(i.DocumentType(), dataLeaves)
+}
+
+// CollaboratorCanUpdate checks if the collaborator can update the document.
+func (i *Invoice) CollaboratorCanUpdate(updated documents.Document, collaborator *types.AccountID) error {
+	newInvoice, ok := updated.(*Invoice)
+	if !ok {
+		return errors.NewTypedError(documents.ErrDocumentInvalidType, errors.New("expecting an invoice but got %T", updated))
+	}
+
+	// check the core document changes
+	err := i.CoreDocument.CollaboratorCanUpdate(newInvoice.CoreDocument, collaborator, i.DocumentType())
+	if err != nil {
+		return err
+	}
+
+	// check invoice specific changes
+	oldTree, err := i.getDocumentDataTree()
+	if err != nil {
+		return err
+	}
+
+	newTree, err := newInvoice.getDocumentDataTree()
+	if err != nil {
+		return err
+	}
+
+	rules := i.CoreDocument.TransitionRulesFor(collaborator)
+	cf := documents.GetChangedFields(oldTree, newTree)
+	return documents.ValidateTransitions(rules, cf)
+}
+
+// AddAttributes adds attributes to the Invoice model.
+func (i *Invoice) AddAttributes(ca documents.CollaboratorsAccess, prepareNewVersion bool, attrs ...documents.Attribute) error {
+	ncd, err := i.CoreDocument.AddAttributes(ca, prepareNewVersion, compactPrefix(), attrs...)
+	if err != nil {
+		return errors.NewTypedError(documents.ErrCDAttribute, err)
+	}
+
+	i.CoreDocument = ncd
+	return nil
+}
+
+// DeleteAttribute deletes the attribute from the model.
+func (i *Invoice) DeleteAttribute(key documents.AttrKey, prepareNewVersion bool) error {
+	ncd, err := i.CoreDocument.DeleteAttribute(key, prepareNewVersion, compactPrefix())
+	if err != nil {
+		return errors.NewTypedError(documents.ErrCDAttribute, err)
+	}
+
+	i.CoreDocument = ncd
+	return nil
+}
+
+// GetData returns invoice data
+func (i *Invoice) GetData() interface{} {
+	return i.Data
+}
+
+// loadData unmarshals json blob to Data.
+func loadData(data []byte, d *Data) error {
+	return json.Unmarshal(data, d)
+}
+
+// DeriveFromCreatePayload unpacks the invoice data from the Payload.
+func (i *Invoice) DeriveFromCreatePayload(_ context.Context, payload documents.CreatePayload) error {
+	var d Data
+	if err := loadData(payload.Data, &d); err != nil {
+		return errors.NewTypedError(ErrInvoiceInvalidData, err)
+	}
+
+	cd, err := documents.NewCoreDocument(compactPrefix(), payload.Collaborators, payload.Attributes)
+	if err != nil {
+		return errors.NewTypedError(documents.ErrCDCreate, err)
+	}
+
+	i.Data = d
+	i.CoreDocument = cd
+	return nil
+}
+
+// DeriveFromClonePayload unpacks the invoice data from the Payload
+// This method clones the transition rules and roles from a template document.
+func (i *Invoice) DeriveFromClonePayload(_ context.Context, m documents.Document) error {
+	d, err := m.PackCoreDocument()
+	if err != nil {
+		return errors.NewTypedError(documents.ErrDocumentPackingCoreDocument, err)
+	}
+
+	cd, err := documents.NewClonedDocument(d)
+	if err != nil {
+		return errors.NewTypedError(documents.ErrCDClone, err)
+	}
+
+	i.CoreDocument = cd
+	return nil
+}
+
+// DeriveFromUpdatePayload unpacks the update payload and prepares a new version.
+func (i *Invoice) DeriveFromUpdatePayload(_ context.Context, payload documents.UpdatePayload) (documents.Document, error) {
+	d, err := i.patch(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	ncd, err := i.CoreDocument.PrepareNewVersion(compactPrefix(), payload.Collaborators, payload.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Invoice{
+		Data:         d,
+		CoreDocument: ncd,
+	}, nil
+}
+
+func (i *Invoice) patch(payload documents.UpdatePayload) (Data, error) {
+	var d Data
+	err := copier.Copy(&d, &i.Data)
+	if err != nil {
+		return d, err
+	}
+
+	if err := loadData(payload.Data, &d); err != nil {
+		return d, errors.NewTypedError(ErrInvoiceInvalidData, err)
+	}
+
+	return d, nil
+}
+
+// Patch merges payload data into model
+func (i *Invoice) Patch(payload documents.UpdatePayload) error {
+	d, err := i.patch(payload)
+	if err != nil {
+		return err
+	}
+
+	ncd, err := i.CoreDocument.Patch(compactPrefix(), payload.Collaborators, payload.Attributes)
+	if err != nil {
+		return errors.NewTypedError(documents.ErrDocumentPatch, err)
+	}
+
+	i.Data = d
+	i.CoreDocument = ncd
+	return nil
+}
+
+// Scheme returns the invoice scheme.
+func (i *Invoice) Scheme() string {
+	return Scheme
+}
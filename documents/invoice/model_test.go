@@ -0,0 +1,463 @@
+//go:build unit
+
+package invoice
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/centrifuge-protobufs/documenttypes"
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/blake2b"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestInvoice_PackCoreDocument(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	cd, err := inv.PackCoreDocument()
+	assert.NoError(t, err)
+	assert.NotNil(t, cd)
+	assert.NotNil(t, cd.EmbeddedData)
+
+	invoiceData, err := inv.createP2PProtobuf()
+	assert.NoError(t, err)
+
+	data, err := proto.Marshal(invoiceData)
+	assert.NoError(t, err)
+
+	embedData := &anypb.Any{
+		TypeUrl: inv.DocumentType(),
+		Value:   data,
+	}
+	assert.Equal(t, embedData, cd.EmbeddedData)
+}
+
+func TestInvoice_UnpackCoreDocument(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	// No embedded data
+	err := inv.UnpackCoreDocument(&coredocumentpb.CoreDocument{})
+	assert.ErrorIs(t, err, documents.ErrDocumentConvertInvalidSchema)
+
+	// Invalid embedded data type
+	err = inv.UnpackCoreDocument(&coredocumentpb.CoreDocument{EmbeddedData: new(anypb.Any)})
+	assert.ErrorIs(t, err, documents.ErrDocumentConvertInvalidSchema)
+
+	// Invalid embedded data
+	err = inv.UnpackCoreDocument(&coredocumentpb.CoreDocument{
+		EmbeddedData: &anypb.Any{
+			Value:   utils.RandomSlice(32),
+			TypeUrl: documenttypes.InvoiceDataTypeUrl,
+		},
+	})
+	assert.True(t, errors.IsOfType(documents.ErrDocumentDataUnmarshalling, err))
+
+	invoiceData, err := inv.createP2PProtobuf()
+	assert.NoError(t, err)
+
+	// Invalid account ID bytes
+	invoiceData.Sender = utils.RandomSlice(31)
+
+	b, err := proto.Marshal(invoiceData)
+	assert.NoError(t, err)
+
+	err = inv.UnpackCoreDocument(&coredocumentpb.CoreDocument{
+		EmbeddedData: &anypb.Any{
+			Value:   b,
+			TypeUrl: documenttypes.InvoiceDataTypeUrl,
+		},
+	})
+	assert.True(t, errors.IsOfType(documents.ErrAccountIDBytesParsing, err))
+
+	// Invalid decimal bytes
+	invoiceData.Sender = utils.RandomSlice(32)
+	invoiceData.NetAmount = utils.RandomSlice(31)
+
+	b, err = proto.Marshal(invoiceData)
+	assert.NoError(t, err)
+
+	err = inv.UnpackCoreDocument(&coredocumentpb.CoreDocument{
+		EmbeddedData: &anypb.Any{
+			Value:   b,
+			TypeUrl: documenttypes.InvoiceDataTypeUrl,
+		},
+	})
+	assert.True(t, errors.IsOfType(documents.ErrDocumentDataUnmarshalling, err))
+
+	// Valid
+	cd, err := inv.PackCoreDocument()
+	assert.NoError(t, err)
+
+	newInv := new(Invoice)
+	err = newInv.UnpackCoreDocument(cd)
+	assert.NoError(t, err)
+
+	// Decimals are compared by their JSON representation since unpacking changes their internal exponent.
+	expectedData, err := json.Marshal(inv.Data)
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(newInv.Data)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(expectedData), string(data))
+}
+
+func TestInvoice_ToAndFromJSON(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	b, err := inv.JSON()
+	assert.NoError(t, err)
+
+	newInv := &Invoice{}
+	err = newInv.FromJSON(b)
+	assert.NoError(t, err)
+	assert.Equal(t, inv.Data.Number, newInv.Data.Number)
+	assert.Equal(t, inv.Data.GrossAmount.String(), newInv.Data.GrossAmount.String())
+}
+
+func TestInvoice_CreateProofs(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	res, err := inv.CreateProofs(
+		[]string{
+			"invoice.number",
+			"invoice.gross_amount",
+			"invoice.line_items[1].net_amount",
+			"invoice.date_due",
+			documents.CDTreePrefix + ".document_type",
+		},
+	)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Len(t, res.FieldProofs, 5)
+
+	dataRoot := calculateBasicDataRoot(t, inv)
+
+	nodeAndLeafHash, err := blake2b.New256(nil)
+	assert.NoError(t, err)
+
+	for _, fieldProof := range res.FieldProofs {
+		valid, err := documents.ValidateProof(fieldProof, dataRoot, nodeAndLeafHash, nodeAndLeafHash)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	assert.Equal(t, []byte(inv.Data.Number), res.FieldProofs[0].Value)
+
+	grossAmount, err := inv.Data.GrossAmount.Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, grossAmount, res.FieldProofs[1].Value)
+
+	lineItemNetAmount, err := inv.Data.LineItems[1].NetAmount.Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, lineItemNetAmount, res.FieldProofs[2].Value)
+
+	// Non-existing field
+	res, err = inv.CreateProofs([]string{"invalid-field"})
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+
+	// Nil CoreDocument
+	inv.CoreDocument = nil
+	res, err = inv.CreateProofs([]string{"invoice.number"})
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+}
+
+func TestInvoice_DocumentType(t *testing.T) {
+	inv := &Invoice{}
+	assert.Equal(t, documenttypes.InvoiceDataTypeUrl, inv.DocumentType())
+}
+
+func TestInvoice_AddNFT(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	collectionID := types.U64(1111)
+	itemID := types.NewU128(*big.NewInt(2222))
+
+	err := inv.AddNFT(true, collectionID, itemID)
+	assert.NoError(t, err)
+
+	err = inv.AddNFT(true, collectionID, itemID)
+	assert.NotNil(t, err)
+}
+
+func TestInvoice_CalculateRoots(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	res, err := inv.CalculateSigningRoot()
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	res, err = inv.CalculateDocumentRoot()
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	inv.CoreDocument = nil
+
+	res, err = inv.CalculateSigningRoot()
+	assert.True(t, errors.IsOfType(documents.ErrDataTree, err))
+	assert.Nil(t, res)
+
+	res, err = inv.CalculateDocumentRoot()
+	assert.True(t, errors.IsOfType(documents.ErrDataTree, err))
+	assert.Nil(t, res)
+}
+
+func TestInvoice_CollaboratorCanUpdate(t *testing.T) {
+	accountID1, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountID2, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	// accountID1 is the only one with write access.
+	inv1 := getTestInvoice(
+		t,
+		documents.CollaboratorsAccess{
+			ReadWriteCollaborators: []*types.AccountID{accountID1},
+		},
+		nil,
+	)
+
+	// Invalid document type
+	err = inv1.CollaboratorCanUpdate(documents.NewDocumentMock(t), accountID1)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentInvalidType, err))
+
+	data, err := json.Marshal(Data{Status: "paid"})
+	assert.NoError(t, err)
+
+	inv2, err := inv1.DeriveFromUpdatePayload(context.Background(), documents.UpdatePayload{
+		CreatePayload: documents.CreatePayload{
+			Data: data,
+		},
+	})
+	assert.NoError(t, err)
+
+	err = inv1.CollaboratorCanUpdate(inv2, accountID1)
+	assert.NoError(t, err)
+
+	err = inv1.CollaboratorCanUpdate(inv2, accountID2)
+	assert.Error(t, err)
+}
+
+func TestInvoice_GetData(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	assert.Equal(t, inv.Data, inv.GetData())
+}
+
+func TestInvoice_DeriveFromCreatePayload(t *testing.T) {
+	inv := &Invoice{}
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	testData := getTestData(t)
+
+	b, err := json.Marshal(testData)
+	assert.NoError(t, err)
+
+	payload := documents.CreatePayload{
+		Scheme: Scheme,
+		Collaborators: documents.CollaboratorsAccess{
+			ReadWriteCollaborators: []*types.AccountID{accountID},
+		},
+		Data: b,
+	}
+
+	err = inv.DeriveFromCreatePayload(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.True(t, inv.AccountCanRead(accountID))
+	assert.Equal(t, testData.Number, inv.Data.Number)
+	assert.Equal(t, testData.Sender, inv.Data.Sender)
+	assert.Equal(t, testData.NetAmount.String(), inv.Data.NetAmount.String())
+
+	// Invalid data
+	payload.Data = []byte(`{"net_amount": "invalid"}`)
+
+	err = inv.DeriveFromCreatePayload(context.Background(), payload)
+	assert.True(t, errors.IsOfType(ErrInvoiceInvalidData, err))
+
+	// Invalid attributes
+	attrKey := utils.RandomByte32()
+	payload.Data = b
+	payload.Attributes = map[documents.AttrKey]documents.Attribute{
+		attrKey: {
+			KeyLabel: "label",
+			Key:      attrKey,
+			Value: documents.AttrVal{
+				Type: "invalid_type",
+			},
+		},
+	}
+
+	err = inv.DeriveFromCreatePayload(context.Background(), payload)
+	assert.True(t, errors.IsOfType(documents.ErrCDCreate, err))
+}
+
+func TestInvoice_DeriveFromClonePayload(t *testing.T) {
+	inv1 := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+	inv2 := new(Invoice)
+
+	err := inv2.DeriveFromClonePayload(context.Background(), inv1)
+	assert.NoError(t, err)
+	assert.NotNil(t, inv2.CoreDocument)
+
+	documentMock := documents.NewDocumentMock(t)
+	documentMock.On("PackCoreDocument").Return(nil, errors.New("error")).Once()
+
+	err = inv2.DeriveFromClonePayload(context.Background(), documentMock)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentPackingCoreDocument, err))
+}
+
+func TestInvoice_DeriveFromUpdatePayload(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	data, err := json.Marshal(map[string]any{"status": "paid", "comment": "updated"})
+	assert.NoError(t, err)
+
+	res, err := inv.DeriveFromUpdatePayload(context.Background(), documents.UpdatePayload{
+		CreatePayload: documents.CreatePayload{
+			Data: data,
+		},
+	})
+	assert.NoError(t, err)
+
+	newInv, ok := res.(*Invoice)
+	assert.True(t, ok)
+	assert.Equal(t, "paid", newInv.Data.Status)
+	assert.Equal(t, "updated", newInv.Data.Comment)
+	assert.Equal(t, inv.Data.Number, newInv.Data.Number)
+	assert.Equal(t, inv.CurrentVersion(), newInv.PreviousVersion())
+
+	// Original data is not changed.
+	assert.Equal(t, "unpaid", inv.Data.Status)
+
+	// Invalid data
+	_, err = inv.DeriveFromUpdatePayload(context.Background(), documents.UpdatePayload{
+		CreatePayload: documents.CreatePayload{
+			Data: []byte("invalid"),
+		},
+	})
+	assert.True(t, errors.IsOfType(ErrInvoiceInvalidData, err))
+}
+
+func TestInvoice_Patch(t *testing.T) {
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	data, err := json.Marshal(map[string]any{"status": "paid"})
+	assert.NoError(t, err)
+
+	err = inv.Patch(documents.UpdatePayload{
+		CreatePayload: documents.CreatePayload{
+			Data: data,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "paid", inv.Data.Status)
+
+	err = inv.Patch(documents.UpdatePayload{
+		CreatePayload: documents.CreatePayload{
+			Data: []byte("invalid"),
+		},
+	})
+	assert.True(t, errors.IsOfType(ErrInvoiceInvalidData, err))
+}
+
+func TestInvoice_Scheme(t *testing.T) {
+	inv := &Invoice{}
+	assert.Equal(t, Scheme, inv.Scheme())
+}
+
+func getTestDecimal(t *testing.T, s string) *documents.Decimal {
+	dec, err := documents.NewDecimal(s)
+	assert.NoError(t, err)
+
+	return dec
+}
+
+func getTestData(t *testing.T) *Data {
+	sender, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	recipient, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	dateCreated := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+	dateDue := dateCreated.Add(30 * 24 * time.Hour)
+
+	return &Data{
+		Number:      "INV-1",
+		Status:      "unpaid",
+		Sender:      sender,
+		Recipient:   recipient,
+		Currency:    "EUR",
+		DateCreated: &dateCreated,
+		DateDue:     &dateDue,
+		LineItems: []LineItem{
+			{
+				ItemNumber:  "1",
+				Description: "consulting",
+				Quantity:    getTestDecimal(t, "10"),
+				UnitPrice:   getTestDecimal(t, "100.5"),
+				NetAmount:   getTestDecimal(t, "1005"),
+			},
+			{
+				ItemNumber:  "2",
+				Description: "travel",
+				Quantity:    getTestDecimal(t, "3"),
+				UnitPrice:   getTestDecimal(t, "33.333"),
+				NetAmount:   getTestDecimal(t, "100"),
+			},
+		},
+		TaxItems: []TaxItem{
+			{
+				ItemNumber:    "1",
+				Description:   "VAT",
+				TaxRate:       getTestDecimal(t, "19"),
+				TaxBaseAmount: getTestDecimal(t, "1105"),
+				TaxAmount:     getTestDecimal(t, "209.95"),
+			},
+		},
+		NetAmount:   getTestDecimal(t, "1105"),
+		TaxAmount:   getTestDecimal(t, "209.95"),
+		GrossAmount: getTestDecimal(t, "1314.95"),
+		Comment:     "comment",
+	}
+}
+
+func getTestInvoice(
+	t *testing.T,
+	collaboratorsAccess documents.CollaboratorsAccess,
+	attributes map[documents.AttrKey]documents.Attribute,
+) *Invoice {
+	cd, err := documents.NewCoreDocument(compactPrefix(), collaboratorsAccess, attributes)
+	assert.NoError(t, err)
+	assert.NotNil(t, cd)
+
+	return &Invoice{
+		CoreDocument: cd,
+		Data:         *getTestData(t),
+	}
+}
+
+func calculateBasicDataRoot(t *testing.T, inv *Invoice) []byte {
+	dataLeaves, err := inv.getDataLeaves()
+	assert.NoError(t, err)
+
+	tree, err := inv.CoreDocument.SigningDataTree(inv.DocumentType(), dataLeaves)
+	assert.NoError(t, err)
+
+	return tree.RootHash()
+}
@@ -0,0 +1,59 @@
+package invoice
+
+import (
+	"context"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	v2 "github.com/centrifuge/pod/identity/v2"
+)
+
+// service implements documents.Service and handles all invoice related persistence and validations
+// service always returns errors of type `errors.Error` or `errors.TypedError`
+type service struct {
+	documents.Service
+	identityService v2.Service
+}
+
+// NewService returns the default implementation of the invoice service.
+func NewService(
+	srv documents.Service,
+	identityService v2.Service,
+) documents.Service {
+	return service{
+		Service:         srv,
+		identityService: identityService,
+	}
+}
+
+// DeriveFromCoreDocument takes a core document model and returns an invoice
+func (s service) DeriveFromCoreDocument(cd *coredocumentpb.CoreDocument) (documents.Document, error) {
+	inv := new(Invoice)
+
+	err := inv.UnpackCoreDocument(cd)
+	if err != nil {
+		return nil, errors.NewTypedError(documents.ErrDocumentUnPackingCoreDocument, err)
+	}
+
+	return inv, nil
+}
+
+// New returns a new uninitialised Invoice.
+func (s service) New(_ string) (documents.Document, error) {
+	return new(Invoice), nil
+}
+
+// Validate takes care of invoice validation
+func (s service) Validate(_ context.Context, model documents.Document, old documents.Document) error {
+	return documents.ValidatorGroup{
+		fieldValidator(s.identityService),
+		totalsValidator(),
+	}.Validate(old, model)
+}
+
+// ReceivedDocumentValidator returns the validator of the invoices received by the account over the p2p layer.
+func (s service) ReceivedDocumentValidator(_ *types.AccountID) documents.Validator {
+	return totalsValidator()
+}
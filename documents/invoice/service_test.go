@@ -0,0 +1,92 @@
+//go:build unit
+
+package invoice
+
+import (
+	"context"
+	"testing"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	v2 "github.com/centrifuge/pod/identity/v2"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_DeriveFromCoreDocument(t *testing.T) {
+	srv := NewService(documents.NewServiceMock(t), v2.NewServiceMock(t))
+
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	cd, err := inv.PackCoreDocument()
+	assert.NoError(t, err)
+
+	res, err := srv.DeriveFromCoreDocument(cd)
+	assert.NoError(t, err)
+	assert.IsType(t, &Invoice{}, res)
+	assert.Equal(t, inv.Data.Number, res.(*Invoice).Data.Number)
+
+	// Invalid core document
+	cd.Attributes = []*coredocumentpb.Attribute{
+		{
+			// Invalid key.
+			Key: utils.RandomSlice(31),
+		},
+	}
+
+	res, err = srv.DeriveFromCoreDocument(cd)
+	assert.True(t, errors.IsOfType(documents.ErrDocumentUnPackingCoreDocument, err))
+	assert.Nil(t, res)
+}
+
+func TestService_New(t *testing.T) {
+	srv := NewService(documents.NewServiceMock(t), v2.NewServiceMock(t))
+
+	res, err := srv.New("")
+	assert.NoError(t, err)
+	assert.Equal(t, new(Invoice), res)
+}
+
+func TestService_Validate(t *testing.T) {
+	identityServiceMock := v2.NewServiceMock(t)
+
+	srv := NewService(documents.NewServiceMock(t), identityServiceMock)
+
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	identityServiceMock.On("ValidateAccount", inv.Data.Sender).
+		Return(nil)
+	identityServiceMock.On("ValidateAccount", inv.Data.Recipient).
+		Return(nil)
+
+	err := srv.Validate(context.Background(), inv, nil)
+	assert.NoError(t, err)
+
+	inv.Data.GrossAmount = getTestDecimal(t, "1")
+
+	err = srv.Validate(context.Background(), inv, nil)
+	assert.ErrorContains(t, err, ErrInvoiceTotalsMismatch.Error())
+}
+
+func TestService_ReceivedDocumentValidator(t *testing.T) {
+	srv := NewService(documents.NewServiceMock(t), v2.NewServiceMock(t))
+
+	provider, ok := srv.(documents.ReceivedDocumentValidatorProvider)
+	assert.True(t, ok)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	inv := getTestInvoice(t, documents.CollaboratorsAccess{}, nil)
+
+	// The parties are not validated for received invoices.
+	err = provider.ReceivedDocumentValidator(accountID).Validate(nil, inv)
+	assert.NoError(t, err)
+
+	inv.Data.NetAmount = getTestDecimal(t, "1")
+
+	err = provider.ReceivedDocumentValidator(accountID).Validate(nil, inv)
+	assert.True(t, errors.IsOfType(ErrInvoiceTotalsMismatch, err))
+}
@@ -0,0 +1,163 @@
+package invoice
+
+import (
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	v2 "github.com/centrifuge/pod/identity/v2"
+	"github.com/shopspring/decimal"
+)
+
+// fieldValidator validates the required fields and the parties of the invoice model.
+func fieldValidator(identityService v2.Service) documents.Validator {
+	return documents.ValidatorFunc(func(_, new documents.Document) error {
+		inv, err := getInvoice(new)
+		if err != nil {
+			return err
+		}
+
+		d := inv.Data
+
+		if strings.TrimSpace(d.Number) == "" {
+			return errors.NewTypedError(ErrInvoiceInvalidData, errors.New("invoice number not set"))
+		}
+
+		if !documents.IsCurrencyValid(d.Currency) {
+			return errors.NewTypedError(ErrInvoiceInvalidData, errors.New("invalid currency '%s'", d.Currency))
+		}
+
+		if d.DateCreated != nil && d.DateDue != nil && d.DateDue.Before(*d.DateCreated) {
+			return errors.NewTypedError(ErrInvoiceInvalidData, errors.New("due date is before the creation date"))
+		}
+
+		if d.Sender == nil {
+			return errors.NewTypedError(ErrInvoiceInvalidParty, errors.New("sender not set"))
+		}
+
+		if d.Recipient == nil {
+			return errors.NewTypedError(ErrInvoiceInvalidParty, errors.New("recipient not set"))
+		}
+
+		for _, party := range []*types.AccountID{d.Sender, d.Recipient, d.Payee} {
+			if party == nil {
+				continue
+			}
+
+			if err := identityService.ValidateAccount(party); err != nil {
+				return errors.NewTypedError(ErrInvoiceInvalidParty, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// totalsValidator checks that the amounts of the line items, tax items and totals of the invoice are consistent.
+// Amounts that are not set are not checked. Products are rounded to the precision of the amount that they are
+// compared to.
+func totalsValidator() documents.Validator {
+	return documents.ValidatorFunc(func(_, new documents.Document) error {
+		inv, err := getInvoice(new)
+		if err != nil {
+			return err
+		}
+
+		d := inv.Data
+
+		var netAmount decimal.Decimal
+
+		for i, item := range d.LineItems {
+			if item.Quantity != nil && item.UnitPrice != nil && item.NetAmount != nil &&
+				!matchesRounded(toDecimal(item.Quantity).Mul(toDecimal(item.UnitPrice)), toDecimal(item.NetAmount)) {
+				return errors.NewTypedError(
+					ErrInvoiceTotalsMismatch,
+					errors.New("net amount of line item %d is not quantity multiplied by unit price", i),
+				)
+			}
+
+			netAmount = netAmount.Add(toDecimal(item.NetAmount))
+		}
+
+		if d.NetAmount != nil && len(d.LineItems) > 0 && !netAmount.Equal(toDecimal(d.NetAmount)) {
+			return errors.NewTypedError(
+				ErrInvoiceTotalsMismatch,
+				errors.New("net amount is not the sum of the line items"),
+			)
+		}
+
+		var taxAmount decimal.Decimal
+
+		for i, item := range d.TaxItems {
+			if item.TaxRate != nil && item.TaxBaseAmount != nil && item.TaxAmount != nil {
+				tax := toDecimal(item.TaxBaseAmount).Mul(toDecimal(item.TaxRate)).Div(decimal.NewFromInt(100))
+
+				if !matchesRounded(tax, toDecimal(item.TaxAmount)) {
+					return errors.NewTypedError(
+						ErrInvoiceTotalsMismatch,
+						errors.New("tax amount of tax item %d doesn't match its tax rate", i),
+					)
+				}
+			}
+
+			taxAmount = taxAmount.Add(toDecimal(item.TaxAmount))
+		}
+
+		if d.TaxAmount != nil && len(d.TaxItems) > 0 && !taxAmount.Equal(toDecimal(d.TaxAmount)) {
+			return errors.NewTypedError(
+				ErrInvoiceTotalsMismatch,
+				errors.New("tax amount is not the sum of the tax items"),
+			)
+		}
+
+		// The tax amount defaults to the sum of the tax items, which is zero if there are none.
+		if d.TaxAmount != nil {
+			taxAmount = toDecimal(d.TaxAmount)
+		}
+
+		if d.GrossAmount != nil && d.NetAmount != nil &&
+			!toDecimal(d.NetAmount).Add(taxAmount).Equal(toDecimal(d.GrossAmount)) {
+			return errors.NewTypedError(
+				ErrInvoiceTotalsMismatch,
+				errors.New("gross amount is not the sum of the net and tax amounts"),
+			)
+		}
+
+		return nil
+	})
+}
+
+func getInvoice(doc documents.Document) (*Invoice, error) {
+	if doc == nil {
+		return nil, documents.ErrDocumentNil
+	}
+
+	inv, ok := doc.(*Invoice)
+	if !ok {
+		return nil, documents.ErrDocumentInvalidType
+	}
+
+	return inv, nil
+}
+
+// toDecimal converts the decimal for arithmetic, a nil decimal is zero.
+func toDecimal(d *documents.Decimal) decimal.Decimal {
+	if d == nil {
+		return decimal.Zero
+	}
+
+	// the string of a documents.Decimal is always a valid decimal
+	res, _ := decimal.NewFromString(d.String())
+	return res
+}
+
+// matchesRounded checks that the computed value rounded to the precision of the expected value is equal to it.
+func matchesRounded(computed, expected decimal.Decimal) bool {
+	places := -expected.Exponent()
+	if places < 0 {
+		places = 0
+	}
+
+	return computed.Round(places).Equal(expected)
+}
@@ -0,0 +1,199 @@
+//go:build unit
+
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	v2 "github.com/centrifuge/pod/identity/v2"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldValidator_Validate(t *testing.T) {
+	identityServiceMock := v2.NewServiceMock(t)
+
+	fv := fieldValidator(identityServiceMock)
+
+	err := fv.Validate(nil, nil)
+	assert.ErrorIs(t, err, documents.ErrDocumentNil)
+
+	err = fv.Validate(nil, documents.NewDocumentMock(t))
+	assert.ErrorIs(t, err, documents.ErrDocumentInvalidType)
+
+	inv := &Invoice{Data: *getTestData(t)}
+
+	identityServiceMock.On("ValidateAccount", inv.Data.Sender).
+		Return(nil)
+	identityServiceMock.On("ValidateAccount", inv.Data.Recipient).
+		Return(nil)
+
+	err = fv.Validate(nil, inv)
+	assert.NoError(t, err)
+
+	// Invalid payee
+	payee, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	inv.Data.Payee = payee
+
+	identityServiceMock.On("ValidateAccount", payee).
+		Return(errors.New("error")).
+		Once()
+
+	err = fv.Validate(nil, inv)
+	assert.True(t, errors.IsOfType(ErrInvoiceInvalidParty, err))
+}
+
+func TestFieldValidator_Validate_InvalidData(t *testing.T) {
+	tests := []struct {
+		name        string
+		update      func(d *Data)
+		expectedErr error
+	}{
+		{
+			name:        "no number",
+			update:      func(d *Data) { d.Number = " " },
+			expectedErr: ErrInvoiceInvalidData,
+		},
+		{
+			name:        "no currency",
+			update:      func(d *Data) { d.Currency = "" },
+			expectedErr: ErrInvoiceInvalidData,
+		},
+		{
+			name:        "invalid currency",
+			update:      func(d *Data) { d.Currency = "EURO" },
+			expectedErr: ErrInvoiceInvalidData,
+		},
+		{
+			name: "due date before creation date",
+			update: func(d *Data) {
+				dateDue := d.DateCreated.Add(-time.Hour)
+				d.DateDue = &dateDue
+			},
+			expectedErr: ErrInvoiceInvalidData,
+		},
+		{
+			name:        "no sender",
+			update:      func(d *Data) { d.Sender = nil },
+			expectedErr: ErrInvoiceInvalidParty,
+		},
+		{
+			name:        "no recipient",
+			update:      func(d *Data) { d.Recipient = nil },
+			expectedErr: ErrInvoiceInvalidParty,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inv := &Invoice{Data: *getTestData(t)}
+			test.update(&inv.Data)
+
+			err := fieldValidator(v2.NewServiceMock(t)).Validate(nil, inv)
+			assert.True(t, errors.IsOfType(test.expectedErr, err))
+		})
+	}
+}
+
+func TestTotalsValidator_Validate(t *testing.T) {
+	tv := totalsValidator()
+
+	err := tv.Validate(nil, nil)
+	assert.ErrorIs(t, err, documents.ErrDocumentNil)
+
+	inv := &Invoice{Data: *getTestData(t)}
+
+	err = tv.Validate(nil, inv)
+	assert.NoError(t, err)
+
+	// Amounts that are not set are not checked.
+	inv.Data.LineItems[0].NetAmount = nil
+	inv.Data.NetAmount = nil
+	inv.Data.TaxAmount = nil
+	inv.Data.GrossAmount = nil
+
+	err = tv.Validate(nil, inv)
+	assert.NoError(t, err)
+
+	// The tax amount defaults to the sum of the tax items.
+	inv = &Invoice{Data: *getTestData(t)}
+	inv.Data.TaxAmount = nil
+
+	err = tv.Validate(nil, inv)
+	assert.NoError(t, err)
+
+	// Empty invoice
+	err = tv.Validate(nil, &Invoice{})
+	assert.NoError(t, err)
+}
+
+func TestTotalsValidator_Validate_OnlyGrossAmount(t *testing.T) {
+	inv := &Invoice{
+		Data: Data{
+			GrossAmount: getTestDecimal(t, "1314.95"),
+		},
+	}
+
+	err := totalsValidator().Validate(nil, inv)
+	assert.NoError(t, err)
+
+	// The gross amount is not compared without a net amount, even if there are tax items.
+	inv.Data.TaxItems = getTestData(t).TaxItems
+
+	err = totalsValidator().Validate(nil, inv)
+	assert.NoError(t, err)
+}
+
+func TestTotalsValidator_Validate_Mismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(d *Data)
+	}{
+		{
+			name:   "line item net amount",
+			update: func(d *Data) { d.LineItems[0].NetAmount = getTestDecimal(t, "1000") },
+		},
+		{
+			name:   "line item net amount not rounded",
+			update: func(d *Data) { d.LineItems[1].NetAmount = getTestDecimal(t, "99.99") },
+		},
+		{
+			name:   "net amount",
+			update: func(d *Data) { d.NetAmount = getTestDecimal(t, "1104") },
+		},
+		{
+			name:   "tax item tax amount",
+			update: func(d *Data) { d.TaxItems[0].TaxAmount = getTestDecimal(t, "210") },
+		},
+		{
+			name:   "tax amount",
+			update: func(d *Data) { d.TaxAmount = getTestDecimal(t, "209") },
+		},
+		{
+			name:   "gross amount",
+			update: func(d *Data) { d.GrossAmount = getTestDecimal(t, "1314.96") },
+		},
+		{
+			name: "gross amount without tax amount",
+			update: func(d *Data) {
+				d.TaxAmount = nil
+				d.GrossAmount = getTestDecimal(t, "1105")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inv := &Invoice{Data: *getTestData(t)}
+			test.update(&inv.Data)
+
+			err := totalsValidator().Validate(nil, inv)
+			assert.True(t, errors.IsOfType(ErrInvoiceTotalsMismatch, err))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package documents
 
 import (
 	"context"
+	"time"
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
@@ -23,11 +24,27 @@ type Client interface {
 	// GetSignaturesForDocument gets the signatures for document
 	GetSignaturesForDocument(ctx context.Context, model Document) ([]*coredocumentpb.Signature, []error, error)
 
+	// GetSignaturesFromCollaborators gets the signatures for document from the provided collaborators
+	GetSignaturesFromCollaborators(ctx context.Context, model Document, collaborators []*types.AccountID) ([]*coredocumentpb.Signature, []error, error)
+
 	// SendAnchoredDocument after all signatures are collected the sender sends the document including the signatures
 	SendAnchoredDocument(ctx context.Context, receiverID *types.AccountID, in *p2ppb.AnchorDocumentRequest) (*p2ppb.AnchorDocumentResponse, error)
 
+	// QueueAnchoredDocument sends the anchored document to the receiver, the delivery is retried until it succeeds
+	// or expires if the receiver cannot be reached.
+	QueueAnchoredDocument(ctx context.Context, receiverID *types.AccountID, in *p2ppb.AnchorDocumentRequest) error
+
+	// GetDeliveryStatus returns the delivery status of the anchored document version for each collaborator
+	GetDeliveryStatus(ctx context.Context, documentID, versionID []byte) ([]*DeliveryStatus, error)
+
 	// GetDocumentRequest requests a document from a collaborator
 	GetDocumentRequest(ctx context.Context, documentOwner *types.AccountID, in *p2ppb.GetDocumentRequest) (*p2ppb.GetDocumentResponse, error)
+
+	// GetLatestVersionRequest requests the latest anchored version of a document from a collaborator
+	GetLatestVersionRequest(ctx context.Context, collaborator *types.AccountID, in *p2ppb.GetDocumentRequest) (*p2ppb.GetDocumentResponse, error)
+
+	// GetAttachmentRequest requests the content of an attachment of the document from a collaborator
+	GetAttachmentRequest(ctx context.Context, collaborator *types.AccountID, documentID, hash []byte) ([]byte, error)
 }
 
 //go:generate mockery --name AnchorProcessor --structname AnchorProcessorMock --filename anchor_processor_mock.go --inpackage
@@ -97,7 +114,7 @@ func (ap *anchorProcessor) PrepareForSignatureRequests(ctx context.Context, mode
 	model.AddUpdateLog(id)
 
 	// execute compute fields
-	err = model.ExecuteComputeFields(computeFieldsTimeout)
+	err = model.ExecuteComputeFields(computeFieldsGasLimit)
 	if err != nil {
 		log.Errorf("Couldn't execute compute fields: %s", err)
 
@@ -137,16 +154,96 @@ func (ap *anchorProcessor) RequestSignatures(ctx context.Context, model Document
 		return ErrDocumentValidation
 	}
 
-	// we ignore signature collection errors and anchor anyways
-	signs, _, err := ap.p2pClient.GetSignaturesForDocument(ctx, model)
+	policy := model.GetSignaturePolicy()
+
+	if policy == nil {
+		// we ignore signature collection errors and anchor anyways
+		signs, _, err := ap.p2pClient.GetSignaturesForDocument(ctx, model)
+		if err != nil {
+			log.Errorf("Couldn't get signatures for document: %s", err)
+
+			return ErrDocumentSignaturesRetrieval
+		}
+
+		model.AppendSignatures(signs...)
+		return nil
+	}
+
+	return ap.requestPolicySignatures(ctx, model, policy)
+}
+
+// requestPolicySignatures requests the signatures from the signers that did not sign yet until the
+// signature policy is satisfied or the signature collection window ends.
+func (ap *anchorProcessor) requestPolicySignatures(ctx context.Context, model Document, policy *SignaturePolicy) error {
+	sender, err := contextutil.Identity(ctx)
+	if err != nil {
+		return errors.ErrContextIdentityRetrieval
+	}
+
+	signers, err := model.GetSignerCollaborators(sender)
 	if err != nil {
-		log.Errorf("Couldn't get signatures for document: %s", err)
+		log.Errorf("Couldn't get signer collaborators: %s", err)
 
 		return ErrDocumentSignaturesRetrieval
 	}
 
-	model.AppendSignatures(signs...)
-	return nil
+	if err := policy.Validate(signers); err != nil {
+		log.Errorf("Invalid signature policy: %s", err)
+
+		return err
+	}
+
+	deadline := time.Now().Add(ap.config.GetP2PSignatureCollectionWindow())
+	retryInterval := ap.config.GetP2PSignatureRetryInterval()
+
+	for {
+		signed, err := getSignedCollaborators(model)
+		if err != nil {
+			log.Errorf("Couldn't get signed collaborators: %s", err)
+
+			return ErrDocumentSignaturesRetrieval
+		}
+
+		if missing := getMissingSigners(signers, signed); len(missing) > 0 {
+			signs, signErrs, err := ap.p2pClient.GetSignaturesFromCollaborators(ctx, model, missing)
+
+			if err != nil {
+				log.Warnf("Couldn't get signatures for document: %s", err)
+			} else {
+				for _, signErr := range signErrs {
+					log.Warnf("Couldn't get signature for document: %s", signErr)
+				}
+
+				model.AppendSignatures(signs...)
+
+				if signed, err = getSignedCollaborators(model); err != nil {
+					log.Errorf("Couldn't get signed collaborators: %s", err)
+
+					return ErrDocumentSignaturesRetrieval
+				}
+			}
+		}
+
+		if policy.IsSatisfied(signers, signed) {
+			return nil
+		}
+
+		if time.Now().Add(retryInterval).After(deadline) {
+			log.Errorf(
+				"Signature policy not satisfied for document %s, missing signers - %d",
+				hexutil.Encode(model.ID()),
+				len(getMissingSigners(signers, signed)),
+			)
+
+			return ErrSignaturePolicyNotSatisfied
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.NewTypedError(ErrSignaturePolicyNotSatisfied, ctx.Err())
+		case <-time.After(retryInterval):
+		}
+	}
 }
 
 // PrepareForAnchoring validates the signatures and generates the document root
@@ -321,11 +418,11 @@ func (ap *anchorProcessor) SendDocument(ctx context.Context, model Document) err
 	for _, c := range cs {
 		doc := proto.Clone(cd).(*coredocumentpb.CoreDocument)
 
-		// TODO(cdamian): Why not propagate this error?
-		err := ap.Send(ctx, doc, c)
+		// the delivery is retried by the p2p layer if the collaborator cannot be reached
+		err := ap.p2pClient.QueueAnchoredDocument(ctx, c, &p2ppb.AnchorDocumentRequest{Document: doc})
 
 		if err != nil {
-			log.Errorf("Couldn't send document: %s", err)
+			log.Errorf("Couldn't send document to %s, delivery will be retried: %s", c.ToHexString(), err)
 		}
 	}
 
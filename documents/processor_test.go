@@ -111,7 +111,7 @@ func TestAnchorProcessor_PrepareForSignatureRequests(t *testing.T) {
 	documentMock := NewDocumentMock(t)
 	documentMock.On("AddUpdateLog", accountID).
 		Once()
-	documentMock.On("ExecuteComputeFields", computeFieldsTimeout).
+	documentMock.On("ExecuteComputeFields", computeFieldsGasLimit).
 		Return(nil)
 
 	signingRoot := utils.RandomSlice(32)
@@ -169,7 +169,7 @@ func TestAnchorProcessor_PrepareForSignatureRequests_ExecuteComputeFieldsError(t
 
 	docErr := errors.New("error")
 
-	documentMock.On("ExecuteComputeFields", computeFieldsTimeout).
+	documentMock.On("ExecuteComputeFields", computeFieldsGasLimit).
 		Return(docErr)
 
 	err = ap.PrepareForSignatureRequests(ctx, documentMock)
@@ -197,7 +197,7 @@ func TestAnchorProcessor_PrepareForSignatureRequests_CalculateSigningRootError(t
 	documentMock.On("AddUpdateLog", accountID).
 		Once()
 
-	documentMock.On("ExecuteComputeFields", computeFieldsTimeout).
+	documentMock.On("ExecuteComputeFields", computeFieldsGasLimit).
 		Return(nil)
 
 	documentMock.On("CalculateSigningRoot").
@@ -228,7 +228,7 @@ func TestAnchorProcessor_PrepareForSignatureRequests_SignMessageError(t *testing
 	documentMock.On("AddUpdateLog", accountID).
 		Once()
 
-	documentMock.On("ExecuteComputeFields", computeFieldsTimeout).
+	documentMock.On("ExecuteComputeFields", computeFieldsGasLimit).
 		Return(nil)
 
 	signingRoot := utils.RandomSlice(32)
@@ -292,6 +292,8 @@ func TestAnchorProcessor_RequestSignatures(t *testing.T) {
 		mock.Anything,
 	).Return(nil)
 
+	documentMock.On("GetSignaturePolicy").Return(nil)
+
 	p2pClientMock.On("GetSignaturesForDocument", ctx, documentMock).
 		Return(signatures, nil, nil)
 
@@ -399,6 +401,8 @@ func TestAnchorProcessor_RequestSignatures_GetSignaturesForDocumentError(t *test
 
 	p2pClientError := errors.New("error")
 
+	documentMock.On("GetSignaturePolicy").Return(nil)
+
 	p2pClientMock.On("GetSignaturesForDocument", ctx, documentMock).
 		Return(nil, nil, p2pClientError)
 
@@ -406,6 +410,157 @@ func TestAnchorProcessor_RequestSignatures_GetSignaturesForDocumentError(t *test
 	assert.ErrorIs(t, err, ErrDocumentSignaturesRetrieval)
 }
 
+func TestAnchorProcessor_RequestSignatures_SignaturePolicy(t *testing.T) {
+	p2pClientMock := NewClientMock(t)
+	anchorServiceMock := anchors.NewAPIMock(t)
+	configMock := config.NewConfigurationMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+
+	ap := NewAnchorProcessor(p2pClientMock, anchorServiceMock, configMock, identityServiceMock)
+
+	author, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(author)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := NewDocumentMock(t)
+
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
+
+	signatures := getTestSignatures(author, collaborators)
+
+	// Only the author signature is present before the signatures are requested.
+	docSignatures := signatures[:1]
+
+	documentMock.On("ID").Return(utils.RandomSlice(32))
+	documentMock.On("CurrentVersion").Return(utils.RandomSlice(32))
+	documentMock.On("NextVersion").Return(utils.RandomSlice(32))
+	documentMock.On("CalculateSigningRoot").Return(utils.RandomSlice(32), nil)
+	documentMock.On("Signatures").Return(func() []*coredocumentpb.Signature {
+		return docSignatures
+	})
+	documentMock.On("Author").Return(author, nil)
+	documentMock.On("GetSignerCollaborators", author).Return(collaborators, nil)
+	documentMock.On("GetAttributes").Return(nil)
+	documentMock.On("GetComputeFieldsRules").Return(nil)
+	documentMock.On("Timestamp").Return(time.Now(), nil)
+	documentMock.On("GetSignaturePolicy").Return(&SignaturePolicy{Type: SignaturePolicyAll})
+	documentMock.On("AppendSignatures", mock.Anything).
+		Run(func(args mock.Arguments) {
+			for _, arg := range args {
+				docSignatures = append(docSignatures, arg.(*coredocumentpb.Signature))
+			}
+		})
+
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	configMock.On("GetP2PSignatureCollectionWindow").
+		Return(time.Minute)
+	configMock.On("GetP2PSignatureRetryInterval").
+		Return(10 * time.Millisecond)
+
+	// The second collaborator is offline during the first round.
+	p2pClientMock.On("GetSignaturesFromCollaborators", ctx, documentMock, collaborators).
+		Return([]*coredocumentpb.Signature{signatures[1]}, []error{errors.New("error")}, nil).
+		Once()
+
+	p2pClientMock.On("GetSignaturesFromCollaborators", ctx, documentMock, collaborators[1:]).
+		Return([]*coredocumentpb.Signature{signatures[2]}, nil, nil).
+		Once()
+
+	err = ap.RequestSignatures(ctx, documentMock)
+	assert.NoError(t, err)
+	assert.Equal(t, signatures, docSignatures)
+}
+
+func TestAnchorProcessor_RequestSignatures_SignaturePolicyNotSatisfied(t *testing.T) {
+	p2pClientMock := NewClientMock(t)
+	anchorServiceMock := anchors.NewAPIMock(t)
+	configMock := config.NewConfigurationMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+
+	ap := NewAnchorProcessor(p2pClientMock, anchorServiceMock, configMock, identityServiceMock)
+
+	author, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(author)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := NewDocumentMock(t)
+
+	collaborators, err := getTestCollaborators(3)
+	assert.NoError(t, err)
+
+	signatures := getTestSignatures(author, collaborators)
+
+	docSignatures := signatures[:1]
+
+	documentMock.On("ID").Return(utils.RandomSlice(32))
+	documentMock.On("CurrentVersion").Return(utils.RandomSlice(32))
+	documentMock.On("NextVersion").Return(utils.RandomSlice(32))
+	documentMock.On("CalculateSigningRoot").Return(utils.RandomSlice(32), nil)
+	documentMock.On("Signatures").Return(func() []*coredocumentpb.Signature {
+		return docSignatures
+	})
+	documentMock.On("Author").Return(author, nil)
+	documentMock.On("GetSignerCollaborators", author).Return(collaborators, nil)
+	documentMock.On("GetAttributes").Return(nil)
+	documentMock.On("GetComputeFieldsRules").Return(nil)
+	documentMock.On("Timestamp").Return(time.Now(), nil)
+	documentMock.On("GetSignaturePolicy").Return(&SignaturePolicy{
+		Type:             SignaturePolicyThreshold,
+		Threshold:        1,
+		MandatorySigners: []*types.AccountID{collaborators[2]},
+	})
+	documentMock.On("AppendSignatures", mock.Anything).
+		Run(func(args mock.Arguments) {
+			for _, arg := range args {
+				docSignatures = append(docSignatures, arg.(*coredocumentpb.Signature))
+			}
+		})
+
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	configMock.On("GetP2PSignatureCollectionWindow").
+		Return(50 * time.Millisecond)
+	configMock.On("GetP2PSignatureRetryInterval").
+		Return(10 * time.Millisecond)
+
+	// The threshold is reached but the mandatory signer never responds.
+	p2pClientMock.On("GetSignaturesFromCollaborators", ctx, documentMock, collaborators).
+		Return([]*coredocumentpb.Signature{signatures[1]}, nil, nil).
+		Once()
+
+	p2pClientMock.On("GetSignaturesFromCollaborators", ctx, documentMock, collaborators[1:]).
+		Return(nil, nil, errors.New("error"))
+
+	err = ap.RequestSignatures(ctx, documentMock)
+	assert.ErrorIs(t, err, ErrSignaturePolicyNotSatisfied)
+}
+
 func TestAnchorProcessor_PrepareForAnchoring(t *testing.T) {
 	p2pClientMock := NewClientMock(t)
 	anchorServiceMock := anchors.NewAPIMock(t)
@@ -1181,27 +1336,19 @@ func TestAnchorProcessor_SendDocument(t *testing.T) {
 	documentMock.On("PackCoreDocument").
 		Return(coreDocument, nil)
 
-	p2pConnectionTimeout := 1 * time.Second
-
-	configMock.On("GetP2PConnectionTimeout").Return(p2pConnectionTimeout)
-
-	anchorDocumentRes := &p2ppb.AnchorDocumentResponse{
-		Accepted: true,
-	}
-
 	p2pClientMock.On(
-		"SendAnchoredDocument",
-		mock.Anything,
+		"QueueAnchoredDocument",
+		ctx,
 		collaborators[0],
 		&p2ppb.AnchorDocumentRequest{Document: coreDocument},
-	).Return(anchorDocumentRes, nil)
+	).Return(nil)
 
 	p2pClientMock.On(
-		"SendAnchoredDocument",
-		mock.Anything,
+		"QueueAnchoredDocument",
+		ctx,
 		collaborators[1],
 		&p2ppb.AnchorDocumentRequest{Document: coreDocument},
-	).Return(anchorDocumentRes, nil)
+	).Return(nil)
 
 	err = ap.SendDocument(ctx, documentMock)
 	assert.NoError(t, err)
@@ -1577,16 +1724,13 @@ func TestAnchorProcessor_SendDocument_SendError(t *testing.T) {
 	documentMock.On("PackCoreDocument").
 		Return(coreDocument, nil)
 
-	p2pConnectionTimeout := 1 * time.Second
-
-	configMock.On("GetP2PConnectionTimeout").Return(p2pConnectionTimeout)
-
+	// The delivery is retried by the p2p layer, the error is not returned.
 	p2pClientMock.On(
-		"SendAnchoredDocument",
-		mock.Anything,
+		"QueueAnchoredDocument",
+		ctx,
 		mock.Anything,
 		mock.Anything,
-	).Return(nil, errors.New("error"))
+	).Return(errors.New("error"))
 
 	err = ap.SendDocument(ctx, documentMock)
 	assert.NoError(t, err)
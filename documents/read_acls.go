@@ -14,6 +14,7 @@ import (
 	"github.com/centrifuge/pod/errors"
 	v2 "github.com/centrifuge/pod/identity/v2"
 	"github.com/centrifuge/pod/utils"
+	"github.com/centrifuge/pod/utils/byteutils"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -56,6 +57,38 @@ func (cd *CoreDocument) addNewReadRule(roleKey []byte, action coredocumentpb.Act
 	cd.Modified = true
 }
 
+// GetReadRules returns a copy of all the read rules of the document.
+func (cd *CoreDocument) GetReadRules() []*coredocumentpb.ReadRule {
+	var rules []*coredocumentpb.ReadRule
+	for _, rule := range cd.Document.ReadRules {
+		rules = append(rules, &coredocumentpb.ReadRule{
+			Roles:  copyByteSlice(rule.Roles),
+			Action: rule.Action,
+		})
+	}
+
+	return rules
+}
+
+// deleteRoleFromReadRules removes the role from the read rules and deletes the rules that are left without roles.
+func (cd *CoreDocument) deleteRoleFromReadRules(roleKey []byte) {
+	var rules []*coredocumentpb.ReadRule
+	for _, rule := range cd.Document.ReadRules {
+		if !byteutils.ContainsBytesInSlice(rule.Roles, roleKey) {
+			rules = append(rules, rule)
+			continue
+		}
+
+		rule.Roles = byteutils.RemoveBytesFromSlice(rule.Roles, roleKey)
+		if len(rule.Roles) > 0 {
+			rules = append(rules, rule)
+		}
+	}
+
+	cd.Document.ReadRules = rules
+	cd.Modified = true
+}
+
 // findRole calls OnRole for every role that matches the actions passed in
 func findReadRole(cd *coredocumentpb.CoreDocument, onRole func(ruleIndex, roleIndex int, role *coredocumentpb.Role) bool, actions ...coredocumentpb.Action) bool {
 	am := make(map[int32]struct{})
@@ -228,6 +261,23 @@ func ConstructNFT(encodedCollectionID []byte, encodedItemID []byte) ([]byte, err
 	}
 }
 
+// DecodeNFT splits the NFT stored in a role into the collection and item IDs.
+func DecodeNFT(nft []byte) (collectionID types.U64, itemID types.U128, err error) {
+	if len(nft) != nftCollectionIDByteCount+nftItemIDByteCount {
+		return collectionID, itemID, errors.NewTypedError(ErrNftByteLength, errors.New("provided length %d", len(nft)))
+	}
+
+	if err := codec.Decode(nft[:nftCollectionIDByteCount], &collectionID); err != nil {
+		return collectionID, itemID, fmt.Errorf("couldn't decode collection ID: %w", err)
+	}
+
+	if err := codec.Decode(nft[nftCollectionIDByteCount:], &itemID); err != nil {
+		return collectionID, itemID, fmt.Errorf("couldn't decode item ID: %w", err)
+	}
+
+	return collectionID, itemID, nil
+}
+
 // isNFTInRole checks if the given nft is part of the core document role.
 // If found, returns the index of the nft in the role and true
 func isNFTInRole(role *coredocumentpb.Role, encodedCollectionID []byte, encodedItemID []byte) (nftIdx int, found bool) {
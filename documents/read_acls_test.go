@@ -1604,6 +1604,28 @@ func TestConstructNFT(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestDecodeNFT(t *testing.T) {
+	collectionID := types.U64(11)
+	itemID := types.NewU128(*big.NewInt(12))
+
+	encodedCollectionID, err := codec.Encode(collectionID)
+	assert.NoError(t, err)
+
+	encodedItemID, err := codec.Encode(itemID)
+	assert.NoError(t, err)
+
+	nft, err := ConstructNFT(encodedCollectionID, encodedItemID)
+	assert.NoError(t, err)
+
+	resCollectionID, resItemID, err := DecodeNFT(nft)
+	assert.NoError(t, err)
+	assert.Equal(t, collectionID, resCollectionID)
+	assert.Equal(t, itemID, resItemID)
+
+	_, _, err = DecodeNFT(nft[1:])
+	assert.True(t, errors.IsOfType(ErrNftByteLength, err))
+}
+
 func TestIsNFTInRole(t *testing.T) {
 	encodedCollectionID := utils.RandomSlice(nftCollectionIDByteCount)
 	encodedItemID := utils.RandomSlice(nftItemIDByteCount)
@@ -70,6 +70,9 @@ type Repository interface {
 
 	// GetLatest returns the latest version of the document.
 	GetLatest(accountID, docID []byte) (Document, error)
+
+	// GetAllLatest returns the latest version of all the committed documents owned by accountID.
+	GetAllLatest(accountID []byte) ([]Document, error)
 }
 
 // NewDBRepository creates an instance of the documents Repository
@@ -140,6 +143,32 @@ func (r *repo) GetLatest(accountID, docID []byte) (Document, error) {
 	return r.Get(accountID, lv.CurrentVersion)
 }
 
+// GetAllLatest returns the latest version of all the committed documents owned by accountID.
+func (r *repo) GetAllLatest(accountID []byte) ([]Document, error) {
+	models, err := r.db.GetAllByPrefix(LatestPrefix + hexutil.Encode(accountID))
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []Document
+
+	for _, model := range models {
+		lv, ok := model.(*latestVersion)
+		if !ok {
+			continue
+		}
+
+		doc, err := r.Get(accountID, lv.CurrentVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
 func (r *repo) getLatestVersion(key []byte) (*latestVersion, error) {
 	val, err := r.db.Get(key)
 	if err != nil {
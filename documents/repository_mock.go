@@ -60,6 +60,29 @@ func (_m *RepositoryMock) Get(accountID []byte, id []byte) (Document, error) {
 	return r0, r1
 }
 
+// GetAllLatest provides a mock function with given fields: accountID
+func (_m *RepositoryMock) GetAllLatest(accountID []byte) ([]Document, error) {
+	ret := _m.Called(accountID)
+
+	var r0 []Document
+	if rf, ok := ret.Get(0).(func([]byte) []Document); ok {
+		r0 = rf(accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Document)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLatest provides a mock function with given fields: accountID, docID
 func (_m *RepositoryMock) GetLatest(accountID []byte, docID []byte) (Document, error) {
 	ret := _m.Called(accountID, docID)
@@ -377,6 +377,56 @@ func TestRepo_GetLatest_GetDocumentError(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestRepo_GetAllLatest(t *testing.T) {
+	storageRepoMock := storage.NewRepositoryMock(t)
+
+	repo := &repo{db: storageRepoMock}
+
+	accountID := utils.RandomSlice(32)
+	currentVersion1 := utils.RandomSlice(32)
+	currentVersion2 := utils.RandomSlice(32)
+
+	storageRepoMock.On("GetAllByPrefix", LatestPrefix+hexutil.Encode(accountID)).
+		Once().
+		Return([]storage.Model{
+			&latestVersion{CurrentVersion: currentVersion1},
+			&latestVersion{CurrentVersion: currentVersion2},
+		}, nil)
+
+	documentMock1 := NewDocumentMock(t)
+	documentMock2 := NewDocumentMock(t)
+
+	storageRepoMock.On("Get", GetKey(accountID, currentVersion1)).
+		Once().
+		Return(documentMock1, nil)
+
+	storageRepoMock.On("Get", GetKey(accountID, currentVersion2)).
+		Once().
+		Return(documentMock2, nil)
+
+	res, err := repo.GetAllLatest(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, []Document{documentMock1, documentMock2}, res)
+}
+
+func TestRepo_GetAllLatest_RepoError(t *testing.T) {
+	storageRepoMock := storage.NewRepositoryMock(t)
+
+	repo := &repo{db: storageRepoMock}
+
+	accountID := utils.RandomSlice(32)
+
+	repoErr := errors.New("error")
+
+	storageRepoMock.On("GetAllByPrefix", LatestPrefix+hexutil.Encode(accountID)).
+		Once().
+		Return(nil, repoErr)
+
+	res, err := repo.GetAllLatest(accountID)
+	assert.ErrorIs(t, err, repoErr)
+	assert.Nil(t, res)
+}
+
 func TestRepo_StoreLatestIndex(t *testing.T) {
 	storageRepoMock := storage.NewRepositoryMock(t)
 
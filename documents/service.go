@@ -30,6 +30,15 @@ type DocumentProof struct {
 	SignaturesRoot []byte
 }
 
+// ReferenceProof holds the proofs of a document together with the proofs of the document it references.
+// The referenced version and its document root identify the anchored version that was proved.
+type ReferenceProof struct {
+	Document               *DocumentProof
+	ReferencedDocument     *DocumentProof
+	ReferencedVersionID    []byte
+	ReferencedDocumentRoot []byte
+}
+
 //go:generate mockery --name Service --structname ServiceMock --filename service_mock.go --inpackage
 
 // Service provides an interface for functions common to all document types
@@ -41,6 +50,9 @@ type Service interface {
 	// GetVersion reads a document from the database
 	GetVersion(ctx context.Context, documentID []byte, version []byte) (Document, error)
 
+	// GetLatestDocuments reads the latest version of all the committed documents from the database
+	GetLatestDocuments(ctx context.Context) ([]Document, error)
+
 	// DeriveFromCoreDocument derives a doc given the core document.
 	DeriveFromCoreDocument(cd *coredocumentpb.CoreDocument) (Document, error)
 
@@ -50,12 +62,23 @@ type Service interface {
 	// CreateProofsForVersion creates proofs for a particular version of the document given the fields
 	CreateProofsForVersion(ctx context.Context, documentID, version []byte, fields []string) (*DocumentProof, error)
 
+	// CreateReferenceProofs creates proofs for the latest version of the document given the fields, and proofs
+	// for the document referenced by the attribute with the given label given the referenced fields.
+	// The proofs of the document always include the reference attribute that links both documents.
+	// References that are not pinned to a version are proved against the latest anchored version of the
+	// referenced document, pinned versions must be anchored.
+	CreateReferenceProofs(ctx context.Context, documentID []byte, attrLabel string, fields, referencedFields []string) (*ReferenceProof, error)
+
 	// RequestDocumentSignature Validates and Signs document received over the p2p layer
 	RequestDocumentSignature(ctx context.Context, doc Document, collaborator *types.AccountID) ([]*coredocumentpb.Signature, error)
 
 	// ReceiveAnchoredDocument receives a new anchored document over the p2p layer, validates and updates the document in DB
 	ReceiveAnchoredDocument(ctx context.Context, doc Document, collaborator *types.AccountID) error
 
+	// FastForwardDocument validates a newer anchored version of a document that was retrieved from a collaborator
+	// and stores it as the latest version in DB
+	FastForwardDocument(ctx context.Context, doc Document, collaborator *types.AccountID) error
+
 	// Derive derives the Document from the Payload.
 	// If document_id is provided, it will prepare a new version of the document
 	// Document Data will be patched from the old and attributes and collaborators are imported
@@ -123,6 +146,20 @@ func (s service) GetVersion(ctx context.Context, documentID []byte, version []by
 	return s.getVersion(ctx, documentID, version)
 }
 
+func (s service) GetLatestDocuments(ctx context.Context) ([]Document, error) {
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return nil, ErrAccountNotFoundInContext
+	}
+
+	docs, err := s.repo.GetAllLatest(acc.GetIdentity().ToBytes())
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentNotFound, err)
+	}
+
+	return docs, nil
+}
+
 func (s service) CreateProofs(ctx context.Context, documentID []byte, fields []string) (*DocumentProof, error) {
 	doc, err := s.GetCurrentVersion(ctx, documentID)
 	if err != nil {
@@ -154,6 +191,94 @@ func (s service) CreateProofsForVersion(ctx context.Context, documentID, version
 	return s.createProofs(doc, fields)
 }
 
+func (s service) CreateReferenceProofs(
+	ctx context.Context,
+	documentID []byte,
+	attrLabel string,
+	fields, referencedFields []string,
+) (*ReferenceProof, error) {
+	doc, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	attr, err := FindAttribute(doc, attrLabel)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentProof, err)
+	}
+
+	if attr.Value.Type != AttrReference {
+		return nil, errors.NewTypedError(ErrDocumentProof, errors.New("attribute %s is not a reference", attrLabel))
+	}
+
+	fields = append([]string{referenceProofField(attr.Key)}, fields...)
+	docProof, err := s.createProofs(doc, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := attr.Value.Reference
+	var refDoc Document
+	if len(ref.VersionID) == 0 {
+		// Unpinned references resolve to the latest anchored version, the current version might not be anchored yet.
+		refDoc, err = s.getLatestCommittedVersion(ctx, ref.DocumentID)
+	} else {
+		refDoc, err = s.getVersion(ctx, ref.DocumentID, ref.VersionID)
+	}
+
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentNotFound, err)
+	}
+
+	if refDoc.GetStatus() != Committed {
+		return nil, ErrReferencedDocumentNotAnchored
+	}
+
+	root, err := refDoc.CalculateDocumentRoot()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrDocumentCalculateDocumentRoot, err)
+	}
+
+	if len(ref.DocumentRoot) != 0 && !bytes.Equal(root, ref.DocumentRoot) {
+		return nil, ErrReferencedDocumentRootMismatch
+	}
+
+	refProof, err := s.createProofs(refDoc, referencedFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReferenceProof{
+		Document:               docProof,
+		ReferencedDocument:     refProof,
+		ReferencedVersionID:    refDoc.CurrentVersion(),
+		ReferencedDocumentRoot: root,
+	}, nil
+}
+
+// getLatestCommittedVersion returns the latest version of the document that was anchored.
+func (s service) getLatestCommittedVersion(ctx context.Context, documentID []byte) (Document, error) {
+	doc, err := s.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	for doc.GetStatus() != Committed {
+		prevVersion := doc.PreviousVersion()
+
+		if utils.IsEmptyByteSlice(prevVersion) {
+			return nil, ErrDocumentVersionNotFound
+		}
+
+		doc, err = s.getVersion(ctx, documentID, prevVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
 func (s service) RequestDocumentSignature(ctx context.Context, doc Document, collaborator *types.AccountID) ([]*coredocumentpb.Signature, error) {
 	acc, err := contextutil.Account(ctx)
 	if err != nil {
@@ -250,6 +375,10 @@ func (s service) ReceiveAnchoredDocument(ctx context.Context, doc Document, coll
 		return errors.NewTypedError(ErrDocumentInvalid, err)
 	}
 
+	if err := s.receivedDocumentTypeValidator(identity, doc).Validate(old, doc); err != nil {
+		return errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
 	// set the status to committed since the document is anchored already.
 	if err := doc.SetStatus(Committed); err != nil {
 		return err
@@ -282,6 +411,78 @@ func (s service) ReceiveAnchoredDocument(ctx context.Context, doc Document, coll
 	return nil
 }
 
+func (s service) FastForwardDocument(ctx context.Context, doc Document, collaborator *types.AccountID) error {
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return ErrAccountNotFoundInContext
+	}
+
+	identity := acc.GetIdentity()
+
+	if doc == nil {
+		return ErrDocumentNil
+	}
+
+	// the intermediate versions might be missing so the transition from the previous version cannot be validated,
+	// the anchor on chain only proves that the version was signed by the collaborators, not that it's the latest one.
+	if err := PostAnchoredValidator(s.identityService, s.anchorSrv).Validate(nil, doc); err != nil {
+		return errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	if err := s.receivedDocumentTypeValidator(identity, doc).Validate(nil, doc); err != nil {
+		return errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
+	if s.repo.Exists(identity.ToBytes(), doc.CurrentVersion()) {
+		return nil
+	}
+
+	if err := doc.SetStatus(Committed); err != nil {
+		return err
+	}
+
+	err = s.repo.Create(identity.ToBytes(), doc.CurrentVersion(), doc)
+	if err != nil {
+		return errors.NewTypedError(ErrDocumentPersistence, err)
+	}
+
+	notificationMsg := notification.Message{
+		EventType:  notification.EventTypeDocument,
+		RecordedAt: time.Now().UTC(),
+		Document: &notification.DocumentMessage{
+			ID:        doc.ID(),
+			VersionID: doc.CurrentVersion(),
+			From:      collaborator.ToBytes(),
+			To:        identity.ToBytes(),
+		},
+	}
+
+	go func() {
+		err = s.notifier.Send(ctx, notificationMsg)
+		if err != nil {
+			log.Error(err)
+		}
+	}()
+
+	return nil
+}
+
+// receivedDocumentTypeValidator returns the validator that is specific to the type of a document received
+// over the p2p layer, if any.
+func (s service) receivedDocumentTypeValidator(accountID *types.AccountID, doc Document) Validator {
+	srv, err := s.registry.LocateService(doc.Scheme())
+	if err != nil {
+		return ValidatorGroup{}
+	}
+
+	provider, ok := srv.(ReceivedDocumentValidatorProvider)
+	if !ok {
+		return ValidatorGroup{}
+	}
+
+	return provider.ReceivedDocumentValidator(accountID)
+}
+
 func (s service) getVersion(ctx context.Context, documentID, version []byte) (Document, error) {
 	acc, err := contextutil.Account(ctx)
 	if err != nil {
@@ -324,6 +525,11 @@ func (s service) Derive(ctx context.Context, payload UpdatePayload) (Document, e
 		if err := doc.DeriveFromCreatePayload(ctx, payload.CreatePayload); err != nil {
 			return nil, errors.NewTypedError(ErrDocumentInvalid, err)
 		}
+
+		if err := setSignaturePolicy(ctx, doc, payload.SignaturePolicy); err != nil {
+			return nil, errors.NewTypedError(ErrDocumentInvalid, err)
+		}
+
 		return doc, nil
 	}
 
@@ -342,9 +548,39 @@ func (s service) Derive(ctx context.Context, payload UpdatePayload) (Document, e
 		return nil, errors.NewTypedError(ErrDocumentInvalid, err)
 	}
 
+	if err := setSignaturePolicy(ctx, doc, payload.SignaturePolicy); err != nil {
+		return nil, errors.NewTypedError(ErrDocumentInvalid, err)
+	}
+
 	return doc, nil
 }
 
+// setSignaturePolicy validates the policy against the signer collaborators of the document and sets it on the document.
+// The existing policy of the document is kept if the provided one is nil.
+func setSignaturePolicy(ctx context.Context, doc Document, policy *SignaturePolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	identity, err := contextutil.Identity(ctx)
+	if err != nil {
+		return ErrAccountNotFoundInContext
+	}
+
+	signers, err := doc.GetSignerCollaborators(identity)
+	if err != nil {
+		return err
+	}
+
+	if err := policy.Validate(signers); err != nil {
+		return err
+	}
+
+	doc.SetSignaturePolicy(policy)
+
+	return nil
+}
+
 // DeriveClone looks for specific document type service based in the schema and delegates the Derivation of a cloned document to that service.˜
 func (s service) DeriveClone(ctx context.Context, payload ClonePayload) (Document, error) {
 	_, err := contextutil.Identity(ctx)
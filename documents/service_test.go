@@ -64,6 +64,55 @@ func TestService_GetCurrentVersion(t *testing.T) {
 	assert.Equal(t, documentMock, res)
 }
 
+func TestService_GetLatestDocuments(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	documentMock := NewDocumentMock(t)
+
+	repoMock.On("GetAllLatest", identity.ToBytes()).
+		Once().
+		Return([]Document{documentMock}, nil)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	res, err := service.GetLatestDocuments(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []Document{documentMock}, res)
+
+	repoMock.On("GetAllLatest", identity.ToBytes()).
+		Once().
+		Return(nil, errors.New("error"))
+
+	res, err = service.GetLatestDocuments(ctx)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+	assert.Nil(t, res)
+
+	res, err = service.GetLatestDocuments(context.Background())
+	assert.ErrorIs(t, err, ErrAccountNotFoundInContext)
+	assert.Nil(t, res)
+}
+
 func TestService_GetCurrentVersion_ContextAccountError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
@@ -679,7 +728,7 @@ func TestService_CreateProofs_DocumentProofError(t *testing.T) {
 	assert.Nil(t, proof)
 }
 
-func TestService_CreateProofsForVersion(t *testing.T) {
+func TestService_CreateReferenceProofs(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -701,39 +750,16 @@ func TestService_CreateProofsForVersion(t *testing.T) {
 
 	accountMock := config.NewAccountMock(t)
 	accountMock.On("GetIdentity").
-		Once().
 		Return(identity)
 
-	documentID := utils.RandomSlice(32)
-	currentVersion := utils.RandomSlice(32)
-	nextVersion := utils.RandomSlice(32)
-	signingRoot := utils.RandomSlice(32)
-	documentRoot := utils.RandomSlice(32)
-
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	documentMock := NewDocumentMock(t)
 	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	mockDocumentPostAnchoredValidatorCalls(
-		documentMock,
-		documentAuthor,
-		collaborators,
-		documentID,
-		currentVersion,
-		nextVersion,
-		signingRoot,
-		documentRoot,
-	)
-
-	repoMock.On("Get", identity.ToBytes(), currentVersion).
-		Once().
-		Return(documentMock, nil)
-
 	identityServiceMock.On(
 		"ValidateDocumentSignature",
 		mock.Anything,
@@ -743,85 +769,108 @@ func TestService_CreateProofsForVersion(t *testing.T) {
 		mock.Anything,
 	).Return(nil)
 
-	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
-	assert.NoError(t, err)
+	mockAnchoredDocument := func(documentMock *DocumentMock, documentID, currentVersion, documentRoot []byte) {
+		nextVersion := utils.RandomSlice(32)
 
-	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
-	assert.NoError(t, err)
+		mockDocumentPostAnchoredValidatorCalls(
+			documentMock,
+			documentAuthor,
+			collaborators,
+			documentID,
+			currentVersion,
+			nextVersion,
+			utils.RandomSlice(32),
+			documentRoot,
+		)
 
-	anchorTime := time.Now()
+		anchorTime := time.Now()
+		documentMock.On("Timestamp").
+			Return(anchorTime.Add(3*time.Hour), nil)
 
-	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+		currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
+		assert.NoError(t, err)
 
-	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
-		Once().
-		Return(anchorRoot, anchorTime, nil)
+		anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+		assert.NoError(t, err)
 
-	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
-		Once().
-		Return(nil, time.Time{}, errors.New("error"))
+		anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+			Once().
+			Return(anchorRoot, anchorTime, nil)
 
-	docTimestamp := anchorTime.Add(3 * time.Hour)
-	documentMock.On("Timestamp").
-		Return(docTimestamp, nil)
+		nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+		assert.NoError(t, err)
 
-	fields := []string{"cd_tree.document_type"}
+		anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+			Once().
+			Return(nil, time.Time{}, errors.New("error"))
+	}
 
-	docProof := &DocumentProof{}
+	documentID := utils.RandomSlice(32)
+	documentMock := NewDocumentMock(t)
+	mockAnchoredDocument(documentMock, documentID, utils.RandomSlice(32), utils.RandomSlice(32))
 
-	documentMock.On("CreateProofs", fields).
-		Return(docProof, nil)
+	refDocumentID := utils.RandomSlice(32)
+	refVersionID := utils.RandomSlice(32)
+	refDocumentRoot := utils.RandomSlice(32)
+	refDocumentMock := NewDocumentMock(t)
+	mockAnchoredDocument(refDocumentMock, refDocumentID, refVersionID, refDocumentRoot)
 
-	proof, err := service.CreateProofsForVersion(ctx, documentID, currentVersion, fields)
-	assert.Nil(t, err)
-	assert.NotNil(t, proof)
+	ref, err := NewReference(refDocumentID, refVersionID, refDocumentRoot)
+	assert.NoError(t, err)
 
-	assert.Equal(t, documentID, proof.DocumentID)
-	assert.Equal(t, currentVersion, proof.VersionID)
-}
+	refAttr, err := NewReferenceAttribute("invoice", ref)
+	assert.NoError(t, err)
 
-func TestService_CreateProofsForVersion_ContextAccountError(t *testing.T) {
-	repoMock := NewRepositoryMock(t)
-	anchorsMock := anchors.NewAPIMock(t)
-	serviceRegistry := NewServiceRegistry()
-	dispatcherMock := jobs.NewDispatcherMock(t)
-	identityServiceMock := v2.NewServiceMock(t)
-	notifierMock := notification.NewSenderMock(t)
+	repoMock.On("GetLatest", identity.ToBytes(), documentID).
+		Once().
+		Return(documentMock, nil)
 
-	service := NewService(
-		repoMock,
-		anchorsMock,
-		serviceRegistry,
-		dispatcherMock,
-		identityServiceMock,
-		notifierMock,
-	)
+	documentMock.On("GetAttribute", refAttr.Key).
+		Once().
+		Return(refAttr, nil)
 
-	documentID := utils.RandomSlice(32)
-	currentVersion := utils.RandomSlice(32)
+	repoMock.On("Get", identity.ToBytes(), refVersionID).
+		Once().
+		Return(refDocumentMock, nil)
+
+	refDocumentMock.On("GetStatus").
+		Once().
+		Return(Committed)
 
 	fields := []string{"cd_tree.document_type"}
+	refFields := []string{"cd_tree.author"}
 
-	proof, err := service.CreateProofsForVersion(context.Background(), documentID, currentVersion, fields)
-	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
-	assert.Nil(t, proof)
+	docProof := &DocumentProof{}
+	documentMock.On("CreateProofs", append([]string{referenceProofField(refAttr.Key)}, fields...)).
+		Once().
+		Return(docProof, nil)
+
+	refDocProof := &DocumentProof{}
+	refDocumentMock.On("CreateProofs", refFields).
+		Once().
+		Return(refDocProof, nil)
+
+	proof, err := service.CreateReferenceProofs(ctx, documentID, "invoice", fields, refFields)
+	assert.NoError(t, err)
+	assert.Equal(t, documentID, proof.Document.DocumentID)
+	assert.Equal(t, refDocumentID, proof.ReferencedDocument.DocumentID)
+	assert.Equal(t, refVersionID, proof.ReferencedDocument.VersionID)
+	assert.Equal(t, refVersionID, proof.ReferencedVersionID)
+	assert.Equal(t, refDocumentRoot, proof.ReferencedDocumentRoot)
 }
 
-func TestService_CreateProofsForVersion_DocumentVersionNotFoundError(t *testing.T) {
+func TestService_CreateReferenceProofs_UnpinnedReference(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
-	serviceRegistry := NewServiceRegistry()
-	dispatcherMock := jobs.NewDispatcherMock(t)
 	identityServiceMock := v2.NewServiceMock(t)
-	notifierMock := notification.NewSenderMock(t)
 
 	service := NewService(
 		repoMock,
 		anchorsMock,
-		serviceRegistry,
-		dispatcherMock,
+		NewServiceRegistry(),
+		jobs.NewDispatcherMock(t),
 		identityServiceMock,
-		notifierMock,
+		notification.NewSenderMock(t),
 	)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -829,135 +878,919 @@ func TestService_CreateProofsForVersion_DocumentVersionNotFoundError(t *testing.
 
 	accountMock := config.NewAccountMock(t)
 	accountMock.On("GetIdentity").
-		Once().
 		Return(identity)
 
-	documentID := utils.RandomSlice(32)
-	currentVersion := utils.RandomSlice(32)
-
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	repoMock.On("Get", identity.ToBytes(), currentVersion).
-		Once().
-		Return(nil, errors.New("error"))
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-	fields := []string{"cd_tree.document_type"}
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
 
-	proof, err := service.CreateProofsForVersion(ctx, documentID, currentVersion, fields)
-	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
-	assert.Nil(t, proof)
-}
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
 
-func TestService_CreateProofsForVersion_DocumentIDMismatch(t *testing.T) {
-	repoMock := NewRepositoryMock(t)
-	anchorsMock := anchors.NewAPIMock(t)
-	serviceRegistry := NewServiceRegistry()
-	dispatcherMock := jobs.NewDispatcherMock(t)
-	identityServiceMock := v2.NewServiceMock(t)
-	notifierMock := notification.NewSenderMock(t)
+	mockAnchoredDocument := func(documentMock *DocumentMock, documentID, currentVersion, documentRoot []byte) {
+		nextVersion := utils.RandomSlice(32)
 
-	service := NewService(
-		repoMock,
-		anchorsMock,
-		serviceRegistry,
-		dispatcherMock,
-		identityServiceMock,
-		notifierMock,
-	)
+		mockDocumentPostAnchoredValidatorCalls(
+			documentMock,
+			documentAuthor,
+			collaborators,
+			documentID,
+			currentVersion,
+			nextVersion,
+			utils.RandomSlice(32),
+			documentRoot,
+		)
 
-	identity, err := testingcommons.GetRandomAccountID()
+		anchorTime := time.Now()
+		documentMock.On("Timestamp").
+			Return(anchorTime.Add(3*time.Hour), nil)
+
+		currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
+		assert.NoError(t, err)
+
+		anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+		assert.NoError(t, err)
+
+		anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+			Once().
+			Return(anchorRoot, anchorTime, nil)
+
+		nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+		assert.NoError(t, err)
+
+		anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+			Once().
+			Return(nil, time.Time{}, errors.New("error"))
+	}
+
+	documentID := utils.RandomSlice(32)
+	documentMock := NewDocumentMock(t)
+	mockAnchoredDocument(documentMock, documentID, utils.RandomSlice(32), utils.RandomSlice(32))
+
+	refDocumentID := utils.RandomSlice(32)
+
+	ref, err := NewReference(refDocumentID, nil, nil)
 	assert.NoError(t, err)
 
-	accountMock := config.NewAccountMock(t)
-	accountMock.On("GetIdentity").
+	refAttr, err := NewReferenceAttribute("invoice", ref)
+	assert.NoError(t, err)
+
+	repoMock.On("GetLatest", identity.ToBytes(), documentID).
 		Once().
-		Return(identity)
+		Return(documentMock, nil)
 
-	documentID := utils.RandomSlice(32)
-	currentVersion := utils.RandomSlice(32)
+	documentMock.On("GetAttribute", refAttr.Key).
+		Once().
+		Return(refAttr, nil)
 
-	ctx := contextutil.WithAccount(context.Background(), accountMock)
+	docProof := &DocumentProof{}
+	documentMock.On("CreateProofs", []string{referenceProofField(refAttr.Key)}).
+		Once().
+		Return(docProof, nil)
 
-	documentMock := NewDocumentMock(t)
+	// The current version of the referenced document is still pending, the previous version is anchored.
+	anchoredVersionID := utils.RandomSlice(32)
+	anchoredDocumentRoot := utils.RandomSlice(32)
 
-	documentMock.On("ID").
+	pendingDocumentMock := NewDocumentMock(t)
+	pendingDocumentMock.On("GetStatus").
+		Return(Pending)
+	pendingDocumentMock.On("PreviousVersion").
 		Once().
-		Return(utils.RandomSlice(32))
+		Return(anchoredVersionID)
 
-	repoMock.On("Get", identity.ToBytes(), currentVersion).
+	repoMock.On("GetLatest", identity.ToBytes(), refDocumentID).
 		Once().
-		Return(documentMock, nil)
+		Return(pendingDocumentMock, nil)
 
-	fields := []string{"cd_tree.document_type"}
+	anchoredDocumentMock := NewDocumentMock(t)
+	mockAnchoredDocument(anchoredDocumentMock, refDocumentID, anchoredVersionID, anchoredDocumentRoot)
+	anchoredDocumentMock.On("GetStatus").
+		Return(Committed)
 
-	proof, err := service.CreateProofsForVersion(ctx, documentID, currentVersion, fields)
-	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
-	assert.Nil(t, proof)
+	repoMock.On("Get", identity.ToBytes(), anchoredVersionID).
+		Once().
+		Return(anchoredDocumentMock, nil)
+
+	refDocProof := &DocumentProof{}
+	anchoredDocumentMock.On("CreateProofs", []string(nil)).
+		Once().
+		Return(refDocProof, nil)
+
+	proof, err := service.CreateReferenceProofs(ctx, documentID, "invoice", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, anchoredVersionID, proof.ReferencedDocument.VersionID)
+	assert.Equal(t, anchoredVersionID, proof.ReferencedVersionID)
+	assert.Equal(t, anchoredDocumentRoot, proof.ReferencedDocumentRoot)
 }
 
-func TestService_RequestDocumentSignature(t *testing.T) {
+func TestService_CreateReferenceProofs_ReferencedVersionNotAnchored(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
-	serviceRegistry := NewServiceRegistry()
-	dispatcherMock := jobs.NewDispatcherMock(t)
 	identityServiceMock := v2.NewServiceMock(t)
-	notifierMock := notification.NewSenderMock(t)
 
 	service := NewService(
 		repoMock,
 		anchorsMock,
-		serviceRegistry,
-		dispatcherMock,
+		NewServiceRegistry(),
+		jobs.NewDispatcherMock(t),
 		identityServiceMock,
-		notifierMock,
+		notification.NewSenderMock(t),
 	)
 
-	accountID, err := testingcommons.GetRandomAccountID()
+	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	accountMock := config.NewAccountMock(t)
-	accountMock.On("GetIdentity").Return(accountID)
+	accountMock.On("GetIdentity").
+		Return(identity)
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	documentMock := NewDocumentMock(t)
-	documentMock.On("PreviousVersion").Return(nil)
-
 	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
 	documentID := utils.RandomSlice(32)
 	currentVersion := utils.RandomSlice(32)
 	nextVersion := utils.RandomSlice(32)
-	signingRoot := utils.RandomSlice(32)
 	documentRoot := utils.RandomSlice(32)
 
-	mockDocumentRequestDocumentSignatureValidatorCalls(
+	documentMock := NewDocumentMock(t)
+	mockDocumentPostAnchoredValidatorCalls(
 		documentMock,
 		documentAuthor,
 		collaborators,
 		documentID,
 		currentVersion,
 		nextVersion,
-		signingRoot,
+		utils.RandomSlice(32),
+		documentRoot,
 	)
 
-	identityServiceMock.On(
-		"ValidateDocumentSignature",
-		mock.Anything,
-		mock.Anything,
-		mock.Anything,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil)
+	anchorTime := time.Now()
+	documentMock.On("Timestamp").
+		Return(anchorTime.Add(3*time.Hour), nil)
 
 	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
 	assert.NoError(t, err)
 
-	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+	assert.NoError(t, err)
+
+	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+		Once().
+		Return(anchorRoot, anchorTime, nil)
+
+	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+	assert.NoError(t, err)
+
+	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+		Once().
+		Return(nil, time.Time{}, errors.New("error"))
+
+	refDocumentID := utils.RandomSlice(32)
+	refVersionID := utils.RandomSlice(32)
+
+	ref, err := NewReference(refDocumentID, refVersionID, nil)
+	assert.NoError(t, err)
+
+	refAttr, err := NewReferenceAttribute("invoice", ref)
+	assert.NoError(t, err)
+
+	repoMock.On("GetLatest", identity.ToBytes(), documentID).
+		Once().
+		Return(documentMock, nil)
+
+	documentMock.On("GetAttribute", refAttr.Key).
+		Once().
+		Return(refAttr, nil)
+
+	documentMock.On("CreateProofs", []string{referenceProofField(refAttr.Key)}).
+		Once().
+		Return(&DocumentProof{}, nil)
+
+	refDocumentMock := NewDocumentMock(t)
+	refDocumentMock.On("ID").
+		Return(refDocumentID)
+	refDocumentMock.On("GetStatus").
+		Once().
+		Return(Committing)
+
+	repoMock.On("Get", identity.ToBytes(), refVersionID).
+		Once().
+		Return(refDocumentMock, nil)
+
+	proof, err := service.CreateReferenceProofs(ctx, documentID, "invoice", nil, nil)
+	assert.ErrorIs(t, err, ErrReferencedDocumentNotAnchored)
+	assert.Nil(t, proof)
+}
+
+func TestService_CreateReferenceProofs_AttributeErrors(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		NewServiceRegistry(),
+		jobs.NewDispatcherMock(t),
+		v2.NewServiceMock(t),
+		notification.NewSenderMock(t),
+	)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+	documentID := utils.RandomSlice(32)
+	documentMock := NewDocumentMock(t)
+
+	repoMock.On("GetLatest", identity.ToBytes(), documentID).
+		Return(documentMock, nil)
+
+	// missing attribute
+	missingKey, err := AttrKeyFromLabel("missing")
+	assert.NoError(t, err)
+
+	documentMock.On("GetAttribute", missingKey).
+		Once().
+		Return(Attribute{}, ErrCDAttribute)
+	documentMock.On("GetAttributes").
+		Once().
+		Return(nil)
+
+	proof, err := service.CreateReferenceProofs(ctx, documentID, "missing", nil, nil)
+	assert.True(t, errors.IsOfType(ErrDocumentProof, err))
+	assert.Nil(t, proof)
+
+	// attribute is not a reference
+	attr, err := NewStringAttribute("comment", AttrString, "some comment")
+	assert.NoError(t, err)
+
+	documentMock.On("GetAttribute", attr.Key).
+		Once().
+		Return(attr, nil)
+
+	proof, err = service.CreateReferenceProofs(ctx, documentID, "comment", nil, nil)
+	assert.True(t, errors.IsOfType(ErrDocumentProof, err))
+	assert.Nil(t, proof)
+
+	// unknown document
+	repoMock.On("GetLatest", identity.ToBytes(), missingKey[:]).
+		Once().
+		Return(nil, errors.New("error"))
+
+	proof, err = service.CreateReferenceProofs(ctx, missingKey[:], "comment", nil, nil)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+	assert.Nil(t, proof)
+}
+
+func TestService_CreateProofsForVersion(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Once().
+		Return(identity)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := NewDocumentMock(t)
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
+
+	mockDocumentPostAnchoredValidatorCalls(
+		documentMock,
+		documentAuthor,
+		collaborators,
+		documentID,
+		currentVersion,
+		nextVersion,
+		signingRoot,
+		documentRoot,
+	)
+
+	repoMock.On("Get", identity.ToBytes(), currentVersion).
+		Once().
+		Return(documentMock, nil)
+
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
+	assert.NoError(t, err)
+
+	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+	assert.NoError(t, err)
+
+	anchorTime := time.Now()
+
+	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+
+	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+		Once().
+		Return(anchorRoot, anchorTime, nil)
+
+	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+		Once().
+		Return(nil, time.Time{}, errors.New("error"))
+
+	docTimestamp := anchorTime.Add(3 * time.Hour)
+	documentMock.On("Timestamp").
+		Return(docTimestamp, nil)
+
+	fields := []string{"cd_tree.document_type"}
+
+	docProof := &DocumentProof{}
+
+	documentMock.On("CreateProofs", fields).
+		Return(docProof, nil)
+
+	proof, err := service.CreateProofsForVersion(ctx, documentID, currentVersion, fields)
+	assert.Nil(t, err)
+	assert.NotNil(t, proof)
+
+	assert.Equal(t, documentID, proof.DocumentID)
+	assert.Equal(t, currentVersion, proof.VersionID)
+}
+
+func TestService_CreateProofsForVersion_ContextAccountError(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+
+	fields := []string{"cd_tree.document_type"}
+
+	proof, err := service.CreateProofsForVersion(context.Background(), documentID, currentVersion, fields)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+	assert.Nil(t, proof)
+}
+
+func TestService_CreateProofsForVersion_DocumentVersionNotFoundError(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Once().
+		Return(identity)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repoMock.On("Get", identity.ToBytes(), currentVersion).
+		Once().
+		Return(nil, errors.New("error"))
+
+	fields := []string{"cd_tree.document_type"}
+
+	proof, err := service.CreateProofsForVersion(ctx, documentID, currentVersion, fields)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+	assert.Nil(t, proof)
+}
+
+func TestService_CreateProofsForVersion_DocumentIDMismatch(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Once().
+		Return(identity)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := NewDocumentMock(t)
+
+	documentMock.On("ID").
+		Once().
+		Return(utils.RandomSlice(32))
+
+	repoMock.On("Get", identity.ToBytes(), currentVersion).
+		Once().
+		Return(documentMock, nil)
+
+	fields := []string{"cd_tree.document_type"}
+
+	proof, err := service.CreateProofsForVersion(ctx, documentID, currentVersion, fields)
+	assert.True(t, errors.IsOfType(ErrDocumentNotFound, err))
+	assert.Nil(t, proof)
+}
+
+func TestService_RequestDocumentSignature(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := NewDocumentMock(t)
+	documentMock.On("PreviousVersion").Return(nil)
+
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
+
+	mockDocumentRequestDocumentSignatureValidatorCalls(
+		documentMock,
+		documentAuthor,
+		collaborators,
+		documentID,
+		currentVersion,
+		nextVersion,
+		signingRoot,
+	)
+
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
+	assert.NoError(t, err)
+
+	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+	assert.NoError(t, err)
+
+	currentVersionAnchorTime := time.Now()
+
+	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+
+	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+		Once().
+		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
+
+	nextVersionAnchorTime := time.Now()
+
+	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+		Once().
+		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
+
+	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
+
+	signature := &coredocumentpb.Signature{}
+
+	accountMock.On("SignMsg", signaturePayload).
+		Once().
+		Return(signature, nil)
+
+	documentMock.On("AppendSignatures", signature)
+
+	documentMock.On("SetStatus", Committing).
+		Once().
+		Return(nil)
+
+	repoMock.On("Exists", accountID.ToBytes(), documentID).
+		Once().
+		Return(true)
+
+	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Once().
+		Return(nil)
+
+	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, []*coredocumentpb.Signature{signature}, res)
+}
+
+func TestService_RequestDocumentSignature_OldDocumentPresent(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	previousVersion := utils.RandomSlice(32)
+
+	documentMock := NewDocumentMock(t)
+	documentMock.On("PreviousVersion").Return(previousVersion)
+
+	oldDocumentMock := NewDocumentMock(t)
+
+	repoMock.On("Get", accountID.ToBytes(), previousVersion).
+		Once().
+		Return(oldDocumentMock, nil)
+
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
+
+	mockDocumentRequestDocumentSignatureValidatorCalls(
+		documentMock,
+		documentAuthor,
+		collaborators,
+		documentID,
+		currentVersion,
+		nextVersion,
+		signingRoot,
+	)
+
+	oldDocumentMock.On("CollaboratorCanUpdate", documentMock, documentAuthor).
+		Once().
+		Return(nil)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
+
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
+	assert.NoError(t, err)
+
+	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+	assert.NoError(t, err)
+
+	currentVersionAnchorTime := time.Now()
+
+	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+
+	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+		Once().
+		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
+
+	nextVersionAnchorTime := time.Now()
+
+	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+		Once().
+		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
+
+	signaturePayload := ConsensusSignaturePayload(signingRoot, true)
+
+	signature := &coredocumentpb.Signature{}
+
+	accountMock.On("SignMsg", signaturePayload).
+		Once().
+		Return(signature, nil)
+
+	documentMock.On("AppendSignatures", signature)
+
+	documentMock.On("SetStatus", Committing).
+		Once().
+		Return(nil)
+
+	repoMock.On("Exists", accountID.ToBytes(), documentID).
+		Once().
+		Return(true)
+
+	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Once().
+		Return(nil)
+
+	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, []*coredocumentpb.Signature{signature}, res)
+}
+
+func TestService_RequestDocumentSignature_OldDocumentPresent_RepoError(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	previousVersion := utils.RandomSlice(32)
+
+	documentMock := NewDocumentMock(t)
+	documentMock.On("PreviousVersion").Return(previousVersion)
+
+	repoErr := errors.New("error")
+
+	// Everything should work despite this error since we are only logging it
+	// and continuing with the validation.
+	repoMock.On("Get", accountID.ToBytes(), previousVersion).
+		Once().
+		Return(nil, repoErr)
+
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
+
+	mockDocumentRequestDocumentSignatureValidatorCalls(
+		documentMock,
+		documentAuthor,
+		collaborators,
+		documentID,
+		currentVersion,
+		nextVersion,
+		signingRoot,
+	)
+
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
+	assert.NoError(t, err)
+
+	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+	assert.NoError(t, err)
+
+	currentVersionAnchorTime := time.Now()
+
+	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+
+	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+		Once().
+		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
+
+	nextVersionAnchorTime := time.Now()
+
+	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+		Once().
+		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
+
+	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
+
+	signature := &coredocumentpb.Signature{}
+
+	accountMock.On("SignMsg", signaturePayload).
+		Once().
+		Return(signature, nil)
+
+	documentMock.On("AppendSignatures", signature)
+
+	documentMock.On("SetStatus", Committing).
+		Once().
+		Return(nil)
+
+	repoMock.On("Exists", accountID.ToBytes(), documentID).
+		Once().
+		Return(true)
+
+	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Once().
+		Return(nil)
+
+	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, []*coredocumentpb.Signature{signature}, res)
+}
+
+func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := NewDocumentMock(t)
+	documentMock.On("PreviousVersion").Return(nil)
+
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
+
+	mockDocumentRequestDocumentSignatureValidatorCalls(
+		documentMock,
+		documentAuthor,
+		collaborators,
+		documentID,
+		currentVersion,
+		nextVersion,
+		signingRoot,
+	)
+
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
+	assert.NoError(t, err)
+
+	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
 	assert.NoError(t, err)
 
 	currentVersionAnchorTime := time.Now()
@@ -982,26 +1815,89 @@ func TestService_RequestDocumentSignature(t *testing.T) {
 		Once().
 		Return(signature, nil)
 
-	documentMock.On("AppendSignatures", signature)
+	documentMock.On("AppendSignatures", signature)
+
+	documentMock.On("SetStatus", Committing).
+		Once().
+		Return(nil)
+
+	repoMock.On("Exists", accountID.ToBytes(), documentID).
+		Once().
+		Return(false)
+
+	repoMock.On("Create", accountID.ToBytes(), documentID, documentMock).
+		Once().
+		Return(nil)
+
+	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Once().
+		Return(nil)
+
+	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, []*coredocumentpb.Signature{signature}, res)
+}
+
+func TestService_RequestDocumentSignature_ContextAccountError(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	documentMock := NewDocumentMock(t)
+
+	res, err := service.RequestDocumentSignature(context.Background(), documentMock, documentAuthor)
+	assert.ErrorIs(t, err, ErrAccountNotFoundInContext)
+	assert.Nil(t, res)
+}
+
+func TestService_RequestDocumentSignature_NilDocument(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
 
-	documentMock.On("SetStatus", Committing).
-		Once().
-		Return(nil)
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-	repoMock.On("Exists", accountID.ToBytes(), documentID).
-		Once().
-		Return(true)
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
 
-	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Once().
-		Return(nil)
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
+	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
-	assert.Equal(t, []*coredocumentpb.Signature{signature}, res)
+
+	res, err := service.RequestDocumentSignature(ctx, nil, documentAuthor)
+	assert.ErrorIs(t, err, ErrDocumentNil)
+	assert.Nil(t, res)
 }
 
-func TestService_RequestDocumentSignature_OldDocumentPresent(t *testing.T) {
+func TestService_RequestDocumentSignature_ValidationError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1026,16 +1922,8 @@ func TestService_RequestDocumentSignature_OldDocumentPresent(t *testing.T) {
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	previousVersion := utils.RandomSlice(32)
-
 	documentMock := NewDocumentMock(t)
-	documentMock.On("PreviousVersion").Return(previousVersion)
-
-	oldDocumentMock := NewDocumentMock(t)
-
-	repoMock.On("Get", accountID.ToBytes(), previousVersion).
-		Once().
-		Return(oldDocumentMock, nil)
+	documentMock.On("PreviousVersion").Return(nil)
 
 	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
@@ -1059,10 +1947,6 @@ func TestService_RequestDocumentSignature_OldDocumentPresent(t *testing.T) {
 		signingRoot,
 	)
 
-	oldDocumentMock.On("CollaboratorCanUpdate", documentMock, documentAuthor).
-		Once().
-		Return(nil)
-
 	identityServiceMock.On(
 		"ValidateDocumentSignature",
 		mock.Anything,
@@ -1082,9 +1966,10 @@ func TestService_RequestDocumentSignature_OldDocumentPresent(t *testing.T) {
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
 
+	// This will throw ErrDocumentIDReused causing validation to fail.
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
-		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
+		Return(anchorRoot, currentVersionAnchorTime, nil)
 
 	nextVersionAnchorTime := time.Now()
 
@@ -1092,34 +1977,12 @@ func TestService_RequestDocumentSignature_OldDocumentPresent(t *testing.T) {
 		Once().
 		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
 
-	signaturePayload := ConsensusSignaturePayload(signingRoot, true)
-
-	signature := &coredocumentpb.Signature{}
-
-	accountMock.On("SignMsg", signaturePayload).
-		Once().
-		Return(signature, nil)
-
-	documentMock.On("AppendSignatures", signature)
-
-	documentMock.On("SetStatus", Committing).
-		Once().
-		Return(nil)
-
-	repoMock.On("Exists", accountID.ToBytes(), documentID).
-		Once().
-		Return(true)
-
-	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Once().
-		Return(nil)
-
 	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.NoError(t, err)
-	assert.Equal(t, []*coredocumentpb.Signature{signature}, res)
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+	assert.Nil(t, res)
 }
 
-func TestService_RequestDocumentSignature_OldDocumentPresent_RepoError(t *testing.T) {
+func TestService_RequestDocumentSignature_SigningRootError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1144,18 +2007,8 @@ func TestService_RequestDocumentSignature_OldDocumentPresent_RepoError(t *testin
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	previousVersion := utils.RandomSlice(32)
-
 	documentMock := NewDocumentMock(t)
-	documentMock.On("PreviousVersion").Return(previousVersion)
-
-	repoErr := errors.New("error")
-
-	// Everything should work despite this error since we are only logging it
-	// and continuing with the validation.
-	repoMock.On("Get", accountID.ToBytes(), previousVersion).
-		Once().
-		Return(nil, repoErr)
+	documentMock.On("PreviousVersion").Return(nil)
 
 	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
@@ -1169,15 +2022,26 @@ func TestService_RequestDocumentSignature_OldDocumentPresent_RepoError(t *testin
 	signingRoot := utils.RandomSlice(32)
 	documentRoot := utils.RandomSlice(32)
 
-	mockDocumentRequestDocumentSignatureValidatorCalls(
-		documentMock,
-		documentAuthor,
-		collaborators,
-		documentID,
-		currentVersion,
-		nextVersion,
-		signingRoot,
-	)
+	documentMock.On("ID").Return(documentID)
+	documentMock.On("CurrentVersion").Return(currentVersion)
+	documentMock.On("NextVersion").Return(nextVersion)
+	documentMock.On("Signatures").Return(getTestSignatures(documentAuthor, collaborators))
+	documentMock.On("Author").Return(documentAuthor, nil)
+	documentMock.On("GetSignerCollaborators", documentAuthor).Return(collaborators, nil)
+	documentMock.On("GetAttributes").Return(nil)
+	documentMock.On("GetValidationRules").Return(nil)
+	documentMock.On("GetComputeFieldsRules").Return(nil)
+	documentMock.On("Timestamp").Return(time.Now(), nil)
+
+	// Called 2 times during validation.
+	documentMock.On("CalculateSigningRoot").
+		Times(2).
+		Return(signingRoot, nil)
+
+	// Called 1 time after validation, this is where we error out for this test.
+	documentMock.On("CalculateSigningRoot").
+		Times(1).
+		Return(nil, errors.New("error"))
 
 	identityServiceMock.On(
 		"ValidateDocumentSignature",
@@ -1208,34 +2072,12 @@ func TestService_RequestDocumentSignature_OldDocumentPresent_RepoError(t *testin
 		Once().
 		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
 
-	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
-
-	signature := &coredocumentpb.Signature{}
-
-	accountMock.On("SignMsg", signaturePayload).
-		Once().
-		Return(signature, nil)
-
-	documentMock.On("AppendSignatures", signature)
-
-	documentMock.On("SetStatus", Committing).
-		Once().
-		Return(nil)
-
-	repoMock.On("Exists", accountID.ToBytes(), documentID).
-		Once().
-		Return(true)
-
-	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Once().
-		Return(nil)
-
 	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.NoError(t, err)
-	assert.Equal(t, []*coredocumentpb.Signature{signature}, res)
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
 }
 
-func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch(t *testing.T) {
+func TestService_RequestDocumentSignature_SignMessageError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1316,36 +2158,18 @@ func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch(t *test
 
 	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
 
-	signature := &coredocumentpb.Signature{}
+	signErr := errors.New("error")
 
 	accountMock.On("SignMsg", signaturePayload).
 		Once().
-		Return(signature, nil)
-
-	documentMock.On("AppendSignatures", signature)
-
-	documentMock.On("SetStatus", Committing).
-		Once().
-		Return(nil)
-
-	repoMock.On("Exists", accountID.ToBytes(), documentID).
-		Once().
-		Return(false)
-
-	repoMock.On("Create", accountID.ToBytes(), documentID, documentMock).
-		Once().
-		Return(nil)
-
-	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Once().
-		Return(nil)
+		Return(nil, signErr)
 
 	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.NoError(t, err)
-	assert.Equal(t, []*coredocumentpb.Signature{signature}, res)
+	assert.ErrorIs(t, err, signErr)
+	assert.Nil(t, res)
 }
 
-func TestService_RequestDocumentSignature_ContextAccountError(t *testing.T) {
+func TestService_RequestDocumentSignature_SetStatusError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1361,50 +2185,90 @@ func TestService_RequestDocumentSignature_ContextAccountError(t *testing.T) {
 		identityServiceMock,
 		notifierMock,
 	)
-	documentAuthor, err := testingcommons.GetRandomAccountID()
+
+	accountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
 	documentMock := NewDocumentMock(t)
+	documentMock.On("PreviousVersion").Return(nil)
 
-	res, err := service.RequestDocumentSignature(context.Background(), documentMock, documentAuthor)
-	assert.ErrorIs(t, err, ErrAccountNotFoundInContext)
-	assert.Nil(t, res)
-}
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-func TestService_RequestDocumentSignature_NilDocument(t *testing.T) {
-	repoMock := NewRepositoryMock(t)
-	anchorsMock := anchors.NewAPIMock(t)
-	serviceRegistry := NewServiceRegistry()
-	dispatcherMock := jobs.NewDispatcherMock(t)
-	identityServiceMock := v2.NewServiceMock(t)
-	notifierMock := notification.NewSenderMock(t)
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
 
-	service := NewService(
-		repoMock,
-		anchorsMock,
-		serviceRegistry,
-		dispatcherMock,
-		identityServiceMock,
-		notifierMock,
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
+
+	mockDocumentRequestDocumentSignatureValidatorCalls(
+		documentMock,
+		documentAuthor,
+		collaborators,
+		documentID,
+		currentVersion,
+		nextVersion,
+		signingRoot,
 	)
 
-	accountID, err := testingcommons.GetRandomAccountID()
+	identityServiceMock.On(
+		"ValidateDocumentSignature",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
 	assert.NoError(t, err)
 
-	accountMock := config.NewAccountMock(t)
-	accountMock.On("GetIdentity").Return(accountID)
+	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
+	assert.NoError(t, err)
+
+	currentVersionAnchorTime := time.Now()
+
+	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+
+	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+		Once().
+		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
+
+	nextVersionAnchorTime := time.Now()
+
+	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+		Once().
+		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
+
+	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
+
+	signature := &coredocumentpb.Signature{}
+
+	accountMock.On("SignMsg", signaturePayload).
+		Once().
+		Return(signature, nil)
 
-	ctx := contextutil.WithAccount(context.Background(), accountMock)
+	documentMock.On("AppendSignatures", signature)
 
-	documentAuthor, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
+	setStatusErr := errors.New("error")
+	documentMock.On("SetStatus", Committing).
+		Once().
+		Return(setStatusErr)
 
-	res, err := service.RequestDocumentSignature(ctx, nil, documentAuthor)
-	assert.ErrorIs(t, err, ErrDocumentNil)
+	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
+	assert.ErrorIs(t, err, setStatusErr)
 	assert.Nil(t, res)
 }
 
-func TestService_RequestDocumentSignature_ValidationError(t *testing.T) {
+func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocIDCreateRepoError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1473,10 +2337,9 @@ func TestService_RequestDocumentSignature_ValidationError(t *testing.T) {
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
 
-	// This will throw ErrDocumentIDReused causing validation to fail.
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
-		Return(anchorRoot, currentVersionAnchorTime, nil)
+		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
 
 	nextVersionAnchorTime := time.Now()
 
@@ -1484,12 +2347,36 @@ func TestService_RequestDocumentSignature_ValidationError(t *testing.T) {
 		Once().
 		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
 
+	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
+
+	signature := &coredocumentpb.Signature{}
+
+	accountMock.On("SignMsg", signaturePayload).
+		Once().
+		Return(signature, nil)
+
+	documentMock.On("AppendSignatures", signature)
+
+	documentMock.On("SetStatus", Committing).
+		Once().
+		Return(nil)
+
+	repoMock.On("Exists", accountID.ToBytes(), documentID).
+		Once().
+		Return(false)
+
+	repoErr := errors.New("error")
+
+	repoMock.On("Create", accountID.ToBytes(), documentID, documentMock).
+		Once().
+		Return(repoErr)
+
 	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+	assert.True(t, errors.IsOfType(ErrDocumentPersistence, err))
 	assert.Nil(t, res)
 }
 
-func TestService_RequestDocumentSignature_SigningRootError(t *testing.T) {
+func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocCurrentVersionRepoError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1529,25 +2416,15 @@ func TestService_RequestDocumentSignature_SigningRootError(t *testing.T) {
 	signingRoot := utils.RandomSlice(32)
 	documentRoot := utils.RandomSlice(32)
 
-	documentMock.On("ID").Return(documentID)
-	documentMock.On("CurrentVersion").Return(currentVersion)
-	documentMock.On("NextVersion").Return(nextVersion)
-	documentMock.On("Signatures").Return(getTestSignatures(documentAuthor, collaborators))
-	documentMock.On("Author").Return(documentAuthor, nil)
-	documentMock.On("GetSignerCollaborators", documentAuthor).Return(collaborators, nil)
-	documentMock.On("GetAttributes").Return(nil)
-	documentMock.On("GetComputeFieldsRules").Return(nil)
-	documentMock.On("Timestamp").Return(time.Now(), nil)
-
-	// Called 2 times during validation.
-	documentMock.On("CalculateSigningRoot").
-		Times(2).
-		Return(signingRoot, nil)
-
-	// Called 1 time after validation, this is where we error out for this test.
-	documentMock.On("CalculateSigningRoot").
-		Times(1).
-		Return(nil, errors.New("error"))
+	mockDocumentRequestDocumentSignatureValidatorCalls(
+		documentMock,
+		documentAuthor,
+		collaborators,
+		documentID,
+		currentVersion,
+		nextVersion,
+		signingRoot,
+	)
 
 	identityServiceMock.On(
 		"ValidateDocumentSignature",
@@ -1578,12 +2455,40 @@ func TestService_RequestDocumentSignature_SigningRootError(t *testing.T) {
 		Once().
 		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
 
+	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
+
+	signature := &coredocumentpb.Signature{}
+
+	accountMock.On("SignMsg", signaturePayload).
+		Once().
+		Return(signature, nil)
+
+	documentMock.On("AppendSignatures", signature)
+
+	documentMock.On("SetStatus", Committing).
+		Once().
+		Return(nil)
+
+	repoMock.On("Exists", accountID.ToBytes(), documentID).
+		Once().
+		Return(false)
+
+	repoMock.On("Create", accountID.ToBytes(), documentID, documentMock).
+		Once().
+		Return(nil)
+
+	repoErr := errors.New("error")
+
+	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Once().
+		Return(repoErr)
+
 	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.NotNil(t, err)
+	assert.True(t, errors.IsOfType(ErrDocumentPersistence, err))
 	assert.Nil(t, res)
 }
 
-func TestService_RequestDocumentSignature_SignMessageError(t *testing.T) {
+func TestService_RequestDocumentSignature_RepoCreateError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1664,18 +2569,34 @@ func TestService_RequestDocumentSignature_SignMessageError(t *testing.T) {
 
 	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
 
-	signErr := errors.New("error")
+	signature := &coredocumentpb.Signature{}
 
 	accountMock.On("SignMsg", signaturePayload).
 		Once().
-		Return(nil, signErr)
+		Return(signature, nil)
+
+	documentMock.On("AppendSignatures", signature)
+
+	documentMock.On("SetStatus", Committing).
+		Once().
+		Return(nil)
+
+	repoMock.On("Exists", accountID.ToBytes(), documentID).
+		Once().
+		Return(true)
+
+	repoErr := errors.New("error")
+
+	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Once().
+		Return(repoErr)
 
 	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.ErrorIs(t, err, signErr)
+	assert.True(t, errors.IsOfType(ErrDocumentPersistence, err))
 	assert.Nil(t, res)
 }
 
-func TestService_RequestDocumentSignature_SetStatusError(t *testing.T) {
+func TestService_ReceiveAnchoredDocument(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1700,22 +2621,22 @@ func TestService_RequestDocumentSignature_SetStatusError(t *testing.T) {
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	documentMock := NewDocumentMock(t)
-	documentMock.On("PreviousVersion").Return(nil)
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
 
+	documentMock := NewDocumentMock(t)
 	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	documentID := utils.RandomSlice(32)
-	currentVersion := utils.RandomSlice(32)
-	nextVersion := utils.RandomSlice(32)
-	signingRoot := utils.RandomSlice(32)
-	documentRoot := utils.RandomSlice(32)
+	documentMock.On("PreviousVersion").Return(nil)
 
-	mockDocumentRequestDocumentSignatureValidatorCalls(
+	mockDocumentReceivedAnchoredDocumentValidatorCalls(
 		documentMock,
 		documentAuthor,
 		collaborators,
@@ -1723,6 +2644,7 @@ func TestService_RequestDocumentSignature_SetStatusError(t *testing.T) {
 		currentVersion,
 		nextVersion,
 		signingRoot,
+		documentRoot,
 	)
 
 	identityServiceMock.On(
@@ -1740,41 +2662,42 @@ func TestService_RequestDocumentSignature_SetStatusError(t *testing.T) {
 	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
 	assert.NoError(t, err)
 
-	currentVersionAnchorTime := time.Now()
+	anchorTime := time.Now()
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
 
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
-		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
-
-	nextVersionAnchorTime := time.Now()
+		Return(anchorRoot, anchorTime, nil)
 
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Once().
-		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
+		Return(nil, time.Time{}, errors.New("error"))
 
-	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
+	docTimestamp := anchorTime.Add(3 * time.Hour)
+	documentMock.On("Timestamp").
+		Return(docTimestamp, nil)
 
-	signature := &coredocumentpb.Signature{}
+	documentMock.On("SetStatus", Committed).
+		Return(nil)
 
-	accountMock.On("SignMsg", signaturePayload).
-		Once().
-		Return(signature, nil)
+	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Return(nil)
 
-	documentMock.On("AppendSignatures", signature)
+	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
+		Return(nil)
 
-	setStatusErr := errors.New("error")
-	documentMock.On("SetStatus", Committing).
-		Once().
-		Return(setStatusErr)
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
 
-	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.ErrorIs(t, err, setStatusErr)
-	assert.Nil(t, res)
+	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
+	assert.NoError(t, err)
+
+	// Sleep to ensure that the notifier is called.
+	time.Sleep(1 * time.Second)
 }
 
-func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocIDCreateRepoError(t *testing.T) {
+func TestService_ReceiveAnchoredDocument_OldDocumentPresent(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1799,22 +2722,33 @@ func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocIDCr
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	documentMock := NewDocumentMock(t)
-	documentMock.On("PreviousVersion").Return(nil)
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
 
+	documentMock := NewDocumentMock(t)
 	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	documentID := utils.RandomSlice(32)
-	currentVersion := utils.RandomSlice(32)
-	nextVersion := utils.RandomSlice(32)
-	signingRoot := utils.RandomSlice(32)
-	documentRoot := utils.RandomSlice(32)
+	previousVersion := utils.RandomSlice(32)
 
-	mockDocumentRequestDocumentSignatureValidatorCalls(
+	documentMock.On("PreviousVersion").Return(previousVersion)
+
+	oldDocumentMock := NewDocumentMock(t)
+
+	repoMock.On("Get", accountID.ToBytes(), previousVersion).
+		Return(oldDocumentMock, nil)
+
+	oldDocumentMock.On("CollaboratorCanUpdate", documentMock, documentAuthor).
+		Return(nil)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
+
+	mockDocumentReceivedAnchoredDocumentValidatorCalls(
 		documentMock,
 		documentAuthor,
 		collaborators,
@@ -1822,6 +2756,7 @@ func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocIDCr
 		currentVersion,
 		nextVersion,
 		signingRoot,
+		documentRoot,
 	)
 
 	identityServiceMock.On(
@@ -1839,50 +2774,99 @@ func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocIDCr
 	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
 	assert.NoError(t, err)
 
-	currentVersionAnchorTime := time.Now()
+	anchorTime := time.Now()
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
 
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
-		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
-
-	nextVersionAnchorTime := time.Now()
+		Return(anchorRoot, anchorTime, nil)
 
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Once().
-		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
+		Return(nil, time.Time{}, errors.New("error"))
+
+	docTimestamp := anchorTime.Add(3 * time.Hour)
+	documentMock.On("Timestamp").
+		Return(docTimestamp, nil)
+
+	documentMock.On("SetStatus", Committed).
+		Return(nil)
+
+	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Return(nil)
+
+	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
+		Return(nil)
+
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
+
+	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
+	assert.NoError(t, err)
+
+	// Sleep to ensure that the notifier is called.
+	time.Sleep(1 * time.Second)
+}
+
+func TestService_ReceiveAnchoredDocument_ContextAccountError(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
 
-	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
+	documentMock := NewDocumentMock(t)
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-	signature := &coredocumentpb.Signature{}
+	err = service.ReceiveAnchoredDocument(context.Background(), documentMock, documentAuthor)
+	assert.ErrorIs(t, err, ErrAccountNotFoundInContext)
+}
 
-	accountMock.On("SignMsg", signaturePayload).
-		Once().
-		Return(signature, nil)
+func TestService_ReceiveAnchoredDocument_DocumentNil(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
 
-	documentMock.On("AppendSignatures", signature)
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
 
-	documentMock.On("SetStatus", Committing).
-		Once().
-		Return(nil)
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-	repoMock.On("Exists", accountID.ToBytes(), documentID).
-		Once().
-		Return(false)
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
 
-	repoErr := errors.New("error")
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	repoMock.On("Create", accountID.ToBytes(), documentID, documentMock).
-		Once().
-		Return(repoErr)
+	documentAuthor, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.True(t, errors.IsOfType(ErrDocumentPersistence, err))
-	assert.Nil(t, res)
+	err = service.ReceiveAnchoredDocument(ctx, nil, documentAuthor)
+	assert.ErrorIs(t, err, ErrDocumentNil)
 }
 
-func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocCurrentVersionRepoError(t *testing.T) {
+func TestService_ReceiveAnchoredDocument_OldDocumentPresent_RepoError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -1907,22 +2891,28 @@ func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocCurr
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	documentMock := NewDocumentMock(t)
-	documentMock.On("PreviousVersion").Return(nil)
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
 
+	documentMock := NewDocumentMock(t)
 	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	documentID := utils.RandomSlice(32)
-	currentVersion := utils.RandomSlice(32)
-	nextVersion := utils.RandomSlice(32)
-	signingRoot := utils.RandomSlice(32)
-	documentRoot := utils.RandomSlice(32)
+	previousVersion := utils.RandomSlice(32)
 
-	mockDocumentRequestDocumentSignatureValidatorCalls(
+	documentMock.On("PreviousVersion").Return(previousVersion)
+
+	// This error will not interrupt the operation since we are logging it.
+	repoMock.On("Get", accountID.ToBytes(), previousVersion).
+		Return(nil, errors.New("error"))
+
+	mockDocumentReceivedAnchoredDocumentValidatorCalls(
 		documentMock,
 		documentAuthor,
 		collaborators,
@@ -1930,6 +2920,7 @@ func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocCurr
 		currentVersion,
 		nextVersion,
 		signingRoot,
+		documentRoot,
 	)
 
 	identityServiceMock.On(
@@ -1947,54 +2938,42 @@ func TestService_RequestDocumentSignature_DocIDAndCurrentVersionMismatch_DocCurr
 	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
 	assert.NoError(t, err)
 
-	currentVersionAnchorTime := time.Now()
+	anchorTime := time.Now()
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
 
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
-		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
-
-	nextVersionAnchorTime := time.Now()
+		Return(anchorRoot, anchorTime, nil)
 
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Once().
-		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
-
-	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
-
-	signature := &coredocumentpb.Signature{}
-
-	accountMock.On("SignMsg", signaturePayload).
-		Once().
-		Return(signature, nil)
+		Return(nil, time.Time{}, errors.New("error"))
 
-	documentMock.On("AppendSignatures", signature)
+	docTimestamp := anchorTime.Add(3 * time.Hour)
+	documentMock.On("Timestamp").
+		Return(docTimestamp, nil)
 
-	documentMock.On("SetStatus", Committing).
-		Once().
+	documentMock.On("SetStatus", Committed).
 		Return(nil)
 
-	repoMock.On("Exists", accountID.ToBytes(), documentID).
-		Once().
-		Return(false)
+	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Return(nil)
 
-	repoMock.On("Create", accountID.ToBytes(), documentID, documentMock).
-		Once().
+	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
 		Return(nil)
 
-	repoErr := errors.New("error")
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
 
-	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Once().
-		Return(repoErr)
+	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
+	assert.NoError(t, err)
 
-	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.True(t, errors.IsOfType(ErrDocumentPersistence, err))
-	assert.Nil(t, res)
+	// Sleep to ensure that the notifier is called.
+	time.Sleep(1 * time.Second)
 }
 
-func TestService_RequestDocumentSignature_RepoCreateError(t *testing.T) {
+func TestService_ReceiveAnchoredDocument_ValidationError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -2019,22 +2998,22 @@ func TestService_RequestDocumentSignature_RepoCreateError(t *testing.T) {
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	documentMock := NewDocumentMock(t)
-	documentMock.On("PreviousVersion").Return(nil)
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	nextVersion := utils.RandomSlice(32)
+	signingRoot := utils.RandomSlice(32)
+	documentRoot := utils.RandomSlice(32)
 
+	documentMock := NewDocumentMock(t)
 	documentAuthor, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	documentID := utils.RandomSlice(32)
-	currentVersion := utils.RandomSlice(32)
-	nextVersion := utils.RandomSlice(32)
-	signingRoot := utils.RandomSlice(32)
-	documentRoot := utils.RandomSlice(32)
+	documentMock.On("PreviousVersion").Return(nil)
 
-	mockDocumentRequestDocumentSignatureValidatorCalls(
+	mockDocumentReceivedAnchoredDocumentValidatorCalls(
 		documentMock,
 		documentAuthor,
 		collaborators,
@@ -2042,8 +3021,12 @@ func TestService_RequestDocumentSignature_RepoCreateError(t *testing.T) {
 		currentVersion,
 		nextVersion,
 		signingRoot,
+		documentRoot,
 	)
 
+	documentMock.On("Timestamp").
+		Return(time.Now(), nil)
+
 	identityServiceMock.On(
 		"ValidateDocumentSignature",
 		mock.Anything,
@@ -2059,50 +3042,24 @@ func TestService_RequestDocumentSignature_RepoCreateError(t *testing.T) {
 	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
 	assert.NoError(t, err)
 
-	currentVersionAnchorTime := time.Now()
+	anchorTime := time.Now()
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
 
+	// This will cause a validation error.
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
-		Return(anchorRoot, currentVersionAnchorTime, errors.New("error"))
-
-	nextVersionAnchorTime := time.Now()
+		Return(anchorRoot, anchorTime, errors.New("error"))
 
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Once().
-		Return(anchorRoot, nextVersionAnchorTime, errors.New("error"))
-
-	signaturePayload := ConsensusSignaturePayload(signingRoot, false)
-
-	signature := &coredocumentpb.Signature{}
-
-	accountMock.On("SignMsg", signaturePayload).
-		Once().
-		Return(signature, nil)
-
-	documentMock.On("AppendSignatures", signature)
-
-	documentMock.On("SetStatus", Committing).
-		Once().
-		Return(nil)
-
-	repoMock.On("Exists", accountID.ToBytes(), documentID).
-		Once().
-		Return(true)
-
-	repoErr := errors.New("error")
-
-	repoMock.On("Create", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Once().
-		Return(repoErr)
+		Return(nil, time.Time{}, errors.New("error"))
 
-	res, err := service.RequestDocumentSignature(ctx, documentMock, documentAuthor)
-	assert.True(t, errors.IsOfType(ErrDocumentPersistence, err))
-	assert.Nil(t, res)
+	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
 }
 
-func TestService_ReceiveAnchoredDocument(t *testing.T) {
+func TestService_ReceiveAnchoredDocument_SetStatusError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -2184,23 +3141,18 @@ func TestService_ReceiveAnchoredDocument(t *testing.T) {
 	documentMock.On("Timestamp").
 		Return(docTimestamp, nil)
 
+	setStatusError := errors.New("error")
 	documentMock.On("SetStatus", Committed).
-		Return(nil)
-
-	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Return(nil)
+		Return(setStatusError)
 
-	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
-		Return(nil)
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
 
 	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
-	assert.NoError(t, err)
-
-	// Sleep to ensure that the notifier is called.
-	time.Sleep(1 * time.Second)
+	assert.ErrorIs(t, err, setStatusError)
 }
 
-func TestService_ReceiveAnchoredDocument_OldDocumentPresent(t *testing.T) {
+func TestService_ReceiveAnchoredDocument_DocumentTypeValidationError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -2238,17 +3190,7 @@ func TestService_ReceiveAnchoredDocument_OldDocumentPresent(t *testing.T) {
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	previousVersion := utils.RandomSlice(32)
-
-	documentMock.On("PreviousVersion").Return(previousVersion)
-
-	oldDocumentMock := NewDocumentMock(t)
-
-	repoMock.On("Get", accountID.ToBytes(), previousVersion).
-		Return(oldDocumentMock, nil)
-
-	oldDocumentMock.On("CollaboratorCanUpdate", documentMock, documentAuthor).
-		Return(nil)
+	documentMock.On("PreviousVersion").Return(nil)
 
 	mockDocumentReceivedAnchoredDocumentValidatorCalls(
 		documentMock,
@@ -2270,102 +3212,56 @@ func TestService_ReceiveAnchoredDocument_OldDocumentPresent(t *testing.T) {
 		mock.Anything,
 	).Return(nil)
 
-	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
-	assert.NoError(t, err)
-
-	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
-	assert.NoError(t, err)
-
-	anchorTime := time.Now()
-
-	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
-
-	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
-		Once().
-		Return(anchorRoot, anchorTime, nil)
-
-	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
-		Once().
-		Return(nil, time.Time{}, errors.New("error"))
-
-	docTimestamp := anchorTime.Add(3 * time.Hour)
-	documentMock.On("Timestamp").
-		Return(docTimestamp, nil)
-
-	documentMock.On("SetStatus", Committed).
-		Return(nil)
-
-	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Return(nil)
-
-	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
-		Return(nil)
-
-	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
-	assert.NoError(t, err)
-
-	// Sleep to ensure that the notifier is called.
-	time.Sleep(1 * time.Second)
-}
-
-func TestService_ReceiveAnchoredDocument_ContextAccountError(t *testing.T) {
-	repoMock := NewRepositoryMock(t)
-	anchorsMock := anchors.NewAPIMock(t)
-	serviceRegistry := NewServiceRegistry()
-	dispatcherMock := jobs.NewDispatcherMock(t)
-	identityServiceMock := v2.NewServiceMock(t)
-	notifierMock := notification.NewSenderMock(t)
-
-	service := NewService(
-		repoMock,
-		anchorsMock,
-		serviceRegistry,
-		dispatcherMock,
-		identityServiceMock,
-		notifierMock,
-	)
+	currentVersionAnchorID, err := anchors.ToAnchorID(currentVersion)
+	assert.NoError(t, err)
 
-	documentMock := NewDocumentMock(t)
-	documentAuthor, err := testingcommons.GetRandomAccountID()
+	nextVersionAnchorID, err := anchors.ToAnchorID(nextVersion)
 	assert.NoError(t, err)
 
-	err = service.ReceiveAnchoredDocument(context.Background(), documentMock, documentAuthor)
-	assert.ErrorIs(t, err, ErrAccountNotFoundInContext)
-}
+	anchorTime := time.Now()
 
-func TestService_ReceiveAnchoredDocument_DocumentNil(t *testing.T) {
-	repoMock := NewRepositoryMock(t)
-	anchorsMock := anchors.NewAPIMock(t)
-	serviceRegistry := NewServiceRegistry()
-	dispatcherMock := jobs.NewDispatcherMock(t)
-	identityServiceMock := v2.NewServiceMock(t)
-	notifierMock := notification.NewSenderMock(t)
+	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
 
-	service := NewService(
-		repoMock,
-		anchorsMock,
-		serviceRegistry,
-		dispatcherMock,
-		identityServiceMock,
-		notifierMock,
-	)
+	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
+		Once().
+		Return(anchorRoot, anchorTime, nil)
 
-	accountID, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
+	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
+		Once().
+		Return(nil, time.Time{}, errors.New("error"))
 
-	accountMock := config.NewAccountMock(t)
-	accountMock.On("GetIdentity").Return(accountID)
+	docTimestamp := anchorTime.Add(3 * time.Hour)
+	documentMock.On("Timestamp").
+		Return(docTimestamp, nil)
 
-	ctx := contextutil.WithAccount(context.Background(), accountMock)
+	validationError := errors.New("error")
 
-	documentAuthor, err := testingcommons.GetRandomAccountID()
+	err = serviceRegistry.Register("test_scheme", receivedDocumentValidatorServiceMock{
+		ServiceMock: NewServiceMock(t),
+		validator: ValidatorFunc(func(_, _ Document) error {
+			return validationError
+		}),
+	})
 	assert.NoError(t, err)
 
-	err = service.ReceiveAnchoredDocument(ctx, nil, documentAuthor)
-	assert.ErrorIs(t, err, ErrDocumentNil)
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
+
+	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
 }
 
-func TestService_ReceiveAnchoredDocument_OldDocumentPresent_RepoError(t *testing.T) {
+// receivedDocumentValidatorServiceMock is a document service that validates the documents received over p2p.
+type receivedDocumentValidatorServiceMock struct {
+	*ServiceMock
+	validator Validator
+}
+
+func (s receivedDocumentValidatorServiceMock) ReceivedDocumentValidator(_ *types.AccountID) Validator {
+	return s.validator
+}
+
+func TestService_ReceiveAnchoredDocument_RepoUpdateError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -2403,13 +3299,7 @@ func TestService_ReceiveAnchoredDocument_OldDocumentPresent_RepoError(t *testing
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	previousVersion := utils.RandomSlice(32)
-
-	documentMock.On("PreviousVersion").Return(previousVersion)
-
-	// This error will not interrupt the operation since we are logging it.
-	repoMock.On("Get", accountID.ToBytes(), previousVersion).
-		Return(nil, errors.New("error"))
+	documentMock.On("PreviousVersion").Return(nil)
 
 	mockDocumentReceivedAnchoredDocumentValidatorCalls(
 		documentMock,
@@ -2456,20 +3346,19 @@ func TestService_ReceiveAnchoredDocument_OldDocumentPresent_RepoError(t *testing
 	documentMock.On("SetStatus", Committed).
 		Return(nil)
 
+	repoError := errors.New("error")
+
 	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Return(nil)
+		Return(repoError)
 
-	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
-		Return(nil)
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
 
 	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
-	assert.NoError(t, err)
-
-	// Sleep to ensure that the notifier is called.
-	time.Sleep(1 * time.Second)
+	assert.True(t, errors.IsOfType(ErrDocumentPersistence, err))
 }
 
-func TestService_ReceiveAnchoredDocument_ValidationError(t *testing.T) {
+func TestService_ReceiveAnchoredDocument_NotifierError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -2520,9 +3409,6 @@ func TestService_ReceiveAnchoredDocument_ValidationError(t *testing.T) {
 		documentRoot,
 	)
 
-	documentMock.On("Timestamp").
-		Return(time.Now(), nil)
-
 	identityServiceMock.On(
 		"ValidateDocumentSignature",
 		mock.Anything,
@@ -2542,20 +3428,40 @@ func TestService_ReceiveAnchoredDocument_ValidationError(t *testing.T) {
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
 
-	// This will cause a validation error.
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
-		Return(anchorRoot, anchorTime, errors.New("error"))
+		Return(anchorRoot, anchorTime, nil)
 
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Once().
 		Return(nil, time.Time{}, errors.New("error"))
 
+	docTimestamp := anchorTime.Add(3 * time.Hour)
+	documentMock.On("Timestamp").
+		Return(docTimestamp, nil)
+
+	documentMock.On("SetStatus", Committed).
+		Return(nil)
+
+	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
+		Return(nil)
+
+	// This will not interrupt the operation.
+	notifierError := errors.New("error")
+	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
+		Return(notifierError)
+
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
+
 	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
-	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+	assert.NoError(t, err)
+
+	// Sleep to ensure that the notifier is called.
+	time.Sleep(1 * time.Second)
 }
 
-func TestService_ReceiveAnchoredDocument_SetStatusError(t *testing.T) {
+func TestService_FastForwardDocument(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -2593,9 +3499,7 @@ func TestService_ReceiveAnchoredDocument_SetStatusError(t *testing.T) {
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	documentMock.On("PreviousVersion").Return(nil)
-
-	mockDocumentReceivedAnchoredDocumentValidatorCalls(
+	mockDocumentPostAnchoredValidatorCalls(
 		documentMock,
 		documentAuthor,
 		collaborators,
@@ -2624,6 +3528,7 @@ func TestService_ReceiveAnchoredDocument_SetStatusError(t *testing.T) {
 	anchorTime := time.Now()
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+	assert.NoError(t, err)
 
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
@@ -2637,15 +3542,35 @@ func TestService_ReceiveAnchoredDocument_SetStatusError(t *testing.T) {
 	documentMock.On("Timestamp").
 		Return(docTimestamp, nil)
 
-	setStatusError := errors.New("error")
+	collaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	repoMock.On("Exists", accountID.ToBytes(), currentVersion).
+		Return(false).
+		Once()
+
 	documentMock.On("SetStatus", Committed).
-		Return(setStatusError)
+		Return(nil).
+		Once()
 
-	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
-	assert.ErrorIs(t, err, setStatusError)
+	repoMock.On("Create", accountID.ToBytes(), currentVersion, documentMock).
+		Return(nil).
+		Once()
+
+	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
+		Return(nil)
+
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
+
+	err = service.FastForwardDocument(ctx, documentMock, collaborator)
+	assert.NoError(t, err)
+
+	// Sleep to ensure that the notifier is called.
+	time.Sleep(1 * time.Second)
 }
 
-func TestService_ReceiveAnchoredDocument_RepoUpdateError(t *testing.T) {
+func TestService_FastForwardDocument_VersionExists(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -2683,9 +3608,7 @@ func TestService_ReceiveAnchoredDocument_RepoUpdateError(t *testing.T) {
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	documentMock.On("PreviousVersion").Return(nil)
-
-	mockDocumentReceivedAnchoredDocumentValidatorCalls(
+	mockDocumentPostAnchoredValidatorCalls(
 		documentMock,
 		documentAuthor,
 		collaborators,
@@ -2714,6 +3637,7 @@ func TestService_ReceiveAnchoredDocument_RepoUpdateError(t *testing.T) {
 	anchorTime := time.Now()
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+	assert.NoError(t, err)
 
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
@@ -2727,19 +3651,21 @@ func TestService_ReceiveAnchoredDocument_RepoUpdateError(t *testing.T) {
 	documentMock.On("Timestamp").
 		Return(docTimestamp, nil)
 
-	documentMock.On("SetStatus", Committed).
-		Return(nil)
+	collaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-	repoError := errors.New("error")
+	repoMock.On("Exists", accountID.ToBytes(), currentVersion).
+		Return(true).
+		Once()
 
-	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Return(repoError)
+	documentMock.On("Scheme").
+		Return("test_scheme").Once()
 
-	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
-	assert.True(t, errors.IsOfType(ErrDocumentPersistence, err))
+	err = service.FastForwardDocument(ctx, documentMock, collaborator)
+	assert.NoError(t, err)
 }
 
-func TestService_ReceiveAnchoredDocument_NotifierError(t *testing.T) {
+func TestService_FastForwardDocument_ValidationError(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
 	serviceRegistry := NewServiceRegistry()
@@ -2777,9 +3703,7 @@ func TestService_ReceiveAnchoredDocument_NotifierError(t *testing.T) {
 	collaborators, err := getTestCollaborators(2)
 	assert.NoError(t, err)
 
-	documentMock.On("PreviousVersion").Return(nil)
-
-	mockDocumentReceivedAnchoredDocumentValidatorCalls(
+	mockDocumentPostAnchoredValidatorCalls(
 		documentMock,
 		documentAuthor,
 		collaborators,
@@ -2808,10 +3732,12 @@ func TestService_ReceiveAnchoredDocument_NotifierError(t *testing.T) {
 	anchorTime := time.Now()
 
 	anchorRoot, err := anchors.ToDocumentRoot(documentRoot)
+	assert.NoError(t, err)
 
+	// This will cause a validation error.
 	anchorsMock.On("GetAnchorData", currentVersionAnchorID).
 		Once().
-		Return(anchorRoot, anchorTime, nil)
+		Return(anchorRoot, anchorTime, errors.New("error"))
 
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Once().
@@ -2821,22 +3747,39 @@ func TestService_ReceiveAnchoredDocument_NotifierError(t *testing.T) {
 	documentMock.On("Timestamp").
 		Return(docTimestamp, nil)
 
-	documentMock.On("SetStatus", Committed).
-		Return(nil)
+	collaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-	repoMock.On("Update", accountID.ToBytes(), documentMock.CurrentVersion(), documentMock).
-		Return(nil)
+	err = service.FastForwardDocument(ctx, documentMock, collaborator)
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+}
 
-	// This will not interrupt the operation.
-	notifierError := errors.New("error")
-	notifierMock.On("Send", ctx, mock.IsType(notification.Message{})).
-		Return(notifierError)
+func TestService_FastForwardDocument_Errors(t *testing.T) {
+	service := NewService(
+		NewRepositoryMock(t),
+		anchors.NewAPIMock(t),
+		NewServiceRegistry(),
+		jobs.NewDispatcherMock(t),
+		v2.NewServiceMock(t),
+		notification.NewSenderMock(t),
+	)
 
-	err = service.ReceiveAnchoredDocument(ctx, documentMock, documentAuthor)
+	collaborator, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	// Sleep to ensure that the notifier is called.
-	time.Sleep(1 * time.Second)
+	err = service.FastForwardDocument(context.Background(), NewDocumentMock(t), collaborator)
+	assert.ErrorIs(t, err, ErrAccountNotFoundInContext)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	err = service.FastForwardDocument(ctx, nil, collaborator)
+	assert.ErrorIs(t, err, ErrDocumentNil)
 }
 
 func TestService_Derive(t *testing.T) {
@@ -2892,6 +3835,82 @@ func TestService_Derive(t *testing.T) {
 	assert.Equal(t, documentMock, res)
 }
 
+func TestService_Derive_SignaturePolicy(t *testing.T) {
+	repoMock := NewRepositoryMock(t)
+	anchorsMock := anchors.NewAPIMock(t)
+	serviceRegistry := NewServiceRegistry()
+	dispatcherMock := jobs.NewDispatcherMock(t)
+	identityServiceMock := v2.NewServiceMock(t)
+	notifierMock := notification.NewSenderMock(t)
+
+	service := NewService(
+		repoMock,
+		anchorsMock,
+		serviceRegistry,
+		dispatcherMock,
+		identityServiceMock,
+		notifierMock,
+	)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	scheme := "scheme"
+	documentID := utils.RandomSlice(32)
+
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
+
+	policy := &SignaturePolicy{
+		Type:      SignaturePolicyThreshold,
+		Threshold: 2,
+	}
+
+	payload := UpdatePayload{
+		CreatePayload: CreatePayload{
+			Scheme:          scheme,
+			SignaturePolicy: policy,
+		},
+		DocumentID: documentID,
+	}
+
+	oldDocumentMock := NewDocumentMock(t)
+
+	repoMock.On("GetLatest", accountID.ToBytes(), documentID).
+		Return(oldDocumentMock, nil)
+
+	oldDocumentMock.On("Scheme").
+		Return(scheme)
+
+	documentMock := NewDocumentMock(t)
+
+	oldDocumentMock.On("DeriveFromUpdatePayload", ctx, payload).
+		Return(documentMock, nil)
+
+	documentMock.On("GetSignerCollaborators", accountID).
+		Return(collaborators, nil)
+
+	documentMock.On("SetSignaturePolicy", policy).
+		Once()
+
+	res, err := service.Derive(ctx, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, documentMock, res)
+
+	// Invalid policy
+	policy.Threshold = 3
+
+	res, err = service.Derive(ctx, payload)
+	assert.True(t, errors.IsOfType(ErrDocumentInvalid, err))
+	assert.True(t, errors.IsOfType(ErrInvalidSignaturePolicy, err))
+	assert.Nil(t, res)
+}
+
 func TestService_Derive_DocumentIDNotPresent(t *testing.T) {
 	repoMock := NewRepositoryMock(t)
 	anchorsMock := anchors.NewAPIMock(t)
@@ -3541,6 +4560,7 @@ func TestService_Commit(t *testing.T) {
 	newDocumentMock.On("PreviousVersion").Return(newDocumentPreviousVersion)
 
 	oldDocumentMock.On("NextVersion").Return(newDocumentCurrentVersion)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
 	newDocumentMock.On("CurrentVersion").Return(newDocumentCurrentVersion)
 
 	newDocumentMock.On("NextVersion").Return(newDocumentNextVersion)
@@ -3563,6 +4583,9 @@ func TestService_Commit(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	serviceMock.On("Validate", ctx, newDocumentMock, oldDocumentMock).
 		Return(nil)
 
@@ -3655,6 +4678,9 @@ func TestService_Commit_CurrentVersionNotFound(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	serviceMock.On("Validate", ctx, newDocumentMock, nil).
 		Return(nil)
 
@@ -3730,6 +4756,7 @@ func TestService_Commit_ValidationError(t *testing.T) {
 	newDocumentMock.On("PreviousVersion").Return(newDocumentPreviousVersion)
 
 	oldDocumentMock.On("NextVersion").Return(newDocumentCurrentVersion)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
 	newDocumentMock.On("CurrentVersion").Return(newDocumentCurrentVersion)
 
 	newDocumentMock.On("NextVersion").Return(newDocumentNextVersion)
@@ -3752,6 +4779,9 @@ func TestService_Commit_ValidationError(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	validationErr := errors.New("error")
 
 	serviceMock.On("Validate", ctx, newDocumentMock, oldDocumentMock).
@@ -3814,6 +4844,7 @@ func TestService_Commit_DocumentSetStatusError(t *testing.T) {
 	newDocumentMock.On("PreviousVersion").Return(newDocumentPreviousVersion)
 
 	oldDocumentMock.On("NextVersion").Return(newDocumentCurrentVersion)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
 	newDocumentMock.On("CurrentVersion").Return(newDocumentCurrentVersion)
 
 	newDocumentMock.On("NextVersion").Return(newDocumentNextVersion)
@@ -3836,6 +4867,9 @@ func TestService_Commit_DocumentSetStatusError(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	serviceMock.On("Validate", ctx, newDocumentMock, oldDocumentMock).
 		Return(nil)
 
@@ -3901,6 +4935,7 @@ func TestService_Commit_UpdateError(t *testing.T) {
 	newDocumentMock.On("PreviousVersion").Return(newDocumentPreviousVersion)
 
 	oldDocumentMock.On("NextVersion").Return(newDocumentCurrentVersion)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
 	newDocumentMock.On("CurrentVersion").Return(newDocumentCurrentVersion)
 
 	newDocumentMock.On("NextVersion").Return(newDocumentNextVersion)
@@ -3923,6 +4958,9 @@ func TestService_Commit_UpdateError(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	serviceMock.On("Validate", ctx, newDocumentMock, oldDocumentMock).
 		Return(nil)
 
@@ -3993,6 +5031,7 @@ func TestService_Commit_CreateError(t *testing.T) {
 	newDocumentMock.On("PreviousVersion").Return(newDocumentPreviousVersion)
 
 	oldDocumentMock.On("NextVersion").Return(newDocumentCurrentVersion)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
 	newDocumentMock.On("CurrentVersion").Return(newDocumentCurrentVersion)
 
 	newDocumentMock.On("NextVersion").Return(newDocumentNextVersion)
@@ -4015,6 +5054,9 @@ func TestService_Commit_CreateError(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	serviceMock.On("Validate", ctx, newDocumentMock, oldDocumentMock).
 		Return(nil)
 
@@ -4087,6 +5129,7 @@ func TestService_Commit_DispatcherError(t *testing.T) {
 	newDocumentMock.On("PreviousVersion").Return(newDocumentPreviousVersion)
 
 	oldDocumentMock.On("NextVersion").Return(newDocumentCurrentVersion)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
 	newDocumentMock.On("CurrentVersion").Return(newDocumentCurrentVersion)
 
 	newDocumentMock.On("NextVersion").Return(newDocumentNextVersion)
@@ -4109,6 +5152,9 @@ func TestService_Commit_DispatcherError(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	serviceMock.On("Validate", ctx, newDocumentMock, oldDocumentMock).
 		Return(nil)
 
@@ -4190,6 +5236,9 @@ func TestService_Validate_New(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	serviceMock.On("Validate", ctx, newDocumentMock, nil).
 		Return(nil)
 
@@ -4242,6 +5291,7 @@ func TestService_Validate_OldAndNew(t *testing.T) {
 	newDocumentMock.On("PreviousVersion").Return(newDocumentPreviousVersion)
 
 	oldDocumentMock.On("NextVersion").Return(newDocumentCurrentVersion)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
 	newDocumentMock.On("CurrentVersion").Return(newDocumentCurrentVersion)
 
 	newDocumentMock.On("NextVersion").Return(newDocumentNextVersion)
@@ -4264,6 +5314,9 @@ func TestService_Validate_OldAndNew(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	serviceMock.On("Validate", ctx, newDocumentMock, oldDocumentMock).
 		Return(nil)
 
@@ -4356,6 +5409,9 @@ func TestService_Validate_New_CreateVersionValidatorError(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	err = service.Validate(ctx, newDocumentMock, nil)
 	assert.True(t, errors.IsOfType(ErrDocumentValidation, err))
 }
@@ -4405,6 +5461,7 @@ func TestService_Validate_OldAndNew_UpdateVersionValidatorError(t *testing.T) {
 	newDocumentMock.On("PreviousVersion").Return(newDocumentPreviousVersion)
 
 	oldDocumentMock.On("NextVersion").Return(newDocumentCurrentVersion)
+	oldDocumentMock.On("GetValidationRules").Return(nil)
 	newDocumentMock.On("CurrentVersion").Return(newDocumentCurrentVersion)
 
 	newDocumentMock.On("NextVersion").Return(newDocumentNextVersion)
@@ -4427,6 +5484,9 @@ func TestService_Validate_OldAndNew_UpdateVersionValidatorError(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	err = service.Validate(ctx, newDocumentMock, oldDocumentMock)
 	assert.True(t, errors.IsOfType(ErrDocumentValidation, err))
 }
@@ -4490,6 +5550,9 @@ func TestService_Validate_New_ServiceValidationError(t *testing.T) {
 	anchorsMock.On("GetAnchorData", nextVersionAnchorID).
 		Return(anchorRoot, time.Now(), errors.New("error"))
 
+	newDocumentMock.On("GetAttributes").Return(nil)
+	newDocumentMock.On("GetValidationRules").Return(nil)
+
 	validationError := errors.New("error")
 
 	serviceMock.On("Validate", ctx, newDocumentMock, nil).
@@ -4603,6 +5666,8 @@ func mockDocumentReceivedAnchoredDocumentValidatorCalls(
 ) {
 	// Transition validator is only called when the old document is also present.
 
+	documentMock.On("GetValidationRules").Return(nil)
+
 	mockDocumentPostAnchoredValidatorCalls(
 		documentMock,
 		author,
@@ -4655,6 +5720,7 @@ func mockDocumentRequestDocumentSignatureValidatorCalls(
 	documentMock.On("GetSignerCollaborators", author).Return(collaborators, nil)
 	documentMock.On("GetAttributes").Return(nil)
 	documentMock.On("GetComputeFieldsRules").Return(nil)
+	documentMock.On("GetValidationRules").Return(nil)
 
 	documentMock.On("Timestamp").Return(time.Now(), nil)
 }
@@ -0,0 +1,122 @@
+package documents
+
+import (
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+)
+
+// SignaturePolicyType defines how many of the signer collaborators have to sign a document before it is anchored.
+type SignaturePolicyType string
+
+const (
+	// SignaturePolicyAll requires the signatures of all the signer collaborators.
+	SignaturePolicyAll SignaturePolicyType = "all"
+
+	// SignaturePolicyThreshold requires the signatures of at least Threshold signer collaborators.
+	SignaturePolicyThreshold SignaturePolicyType = "threshold"
+)
+
+// SignaturePolicy holds the signatures that are required before a document is anchored.
+//
+// The policy is local to the node that anchors the document, it is not part of the core document
+// that is shared with the collaborators.
+type SignaturePolicy struct {
+	Type SignaturePolicyType `json:"type" enums:"all,threshold"`
+
+	// Threshold is the minimum number of signer collaborators that have to sign, used with SignaturePolicyThreshold.
+	Threshold int `json:"threshold,omitempty"`
+
+	// MandatorySigners are the signer collaborators that have to sign, regardless of the threshold.
+	MandatorySigners []*types.AccountID `json:"mandatory_signers,omitempty" swaggertype:"array,string"`
+}
+
+// Validate checks that the policy can be satisfied by the provided signers.
+func (p *SignaturePolicy) Validate(signers []*types.AccountID) error {
+	switch p.Type {
+	case SignaturePolicyAll:
+	case SignaturePolicyThreshold:
+		if p.Threshold < 1 || p.Threshold > len(signers) {
+			return errors.NewTypedError(
+				ErrInvalidSignaturePolicy,
+				errors.New("threshold must be between 1 and %d", len(signers)),
+			)
+		}
+	default:
+		return errors.NewTypedError(ErrInvalidSignaturePolicy, errors.New("unknown policy type '%s'", p.Type))
+	}
+
+	for _, mandatorySigner := range p.MandatorySigners {
+		if !containsAccountID(signers, mandatorySigner) {
+			return errors.NewTypedError(
+				ErrInvalidSignaturePolicy,
+				errors.New("mandatory signer %s is not a signer collaborator", mandatorySigner.ToHexString()),
+			)
+		}
+	}
+
+	return nil
+}
+
+// IsSatisfied checks if the signed collaborators satisfy the policy.
+func (p *SignaturePolicy) IsSatisfied(signers []*types.AccountID, signed []*types.AccountID) bool {
+	for _, mandatorySigner := range p.MandatorySigners {
+		if !containsAccountID(signed, mandatorySigner) {
+			return false
+		}
+	}
+
+	var count int
+
+	for _, signer := range signers {
+		if containsAccountID(signed, signer) {
+			count++
+		}
+	}
+
+	switch p.Type {
+	case SignaturePolicyThreshold:
+		return count >= p.Threshold
+	default:
+		return count == len(signers)
+	}
+}
+
+// getSignedCollaborators returns the account IDs of the signers of the document signatures.
+func getSignedCollaborators(doc Document) ([]*types.AccountID, error) {
+	var signed []*types.AccountID
+
+	for _, signature := range doc.Signatures() {
+		accountID, err := types.NewAccountID(signature.GetSignerId())
+
+		if err != nil {
+			return nil, errors.NewTypedError(ErrAccountIDBytesParsing, err)
+		}
+
+		signed = append(signed, accountID)
+	}
+
+	return signed, nil
+}
+
+// getMissingSigners returns the signers that did not sign yet.
+func getMissingSigners(signers []*types.AccountID, signed []*types.AccountID) []*types.AccountID {
+	var missing []*types.AccountID
+
+	for _, signer := range signers {
+		if !containsAccountID(signed, signer) {
+			missing = append(missing, signer)
+		}
+	}
+
+	return missing
+}
+
+func containsAccountID(accountIDs []*types.AccountID, accountID *types.AccountID) bool {
+	for _, id := range accountIDs {
+		if id.Equal(accountID) {
+			return true
+		}
+	}
+
+	return false
+}
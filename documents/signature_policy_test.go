@@ -0,0 +1,114 @@
+//go:build unit
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignaturePolicy_Validate(t *testing.T) {
+	signers, err := getTestCollaborators(3)
+	assert.NoError(t, err)
+
+	nonSigner, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		policy *SignaturePolicy
+		valid  bool
+	}{
+		{
+			name:   "all",
+			policy: &SignaturePolicy{Type: SignaturePolicyAll},
+			valid:  true,
+		},
+		{
+			name: "threshold",
+			policy: &SignaturePolicy{
+				Type:             SignaturePolicyThreshold,
+				Threshold:        2,
+				MandatorySigners: []*types.AccountID{signers[0]},
+			},
+			valid: true,
+		},
+		{
+			name:   "threshold too low",
+			policy: &SignaturePolicy{Type: SignaturePolicyThreshold},
+		},
+		{
+			name:   "threshold too high",
+			policy: &SignaturePolicy{Type: SignaturePolicyThreshold, Threshold: 4},
+		},
+		{
+			name: "mandatory signer not a signer collaborator",
+			policy: &SignaturePolicy{
+				Type:             SignaturePolicyAll,
+				MandatorySigners: []*types.AccountID{nonSigner},
+			},
+		},
+		{
+			name:   "unknown type",
+			policy: &SignaturePolicy{Type: "unknown"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.policy.Validate(signers)
+
+			if test.valid {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.True(t, errors.IsOfType(ErrInvalidSignaturePolicy, err))
+		})
+	}
+}
+
+func TestSignaturePolicy_IsSatisfied(t *testing.T) {
+	signers, err := getTestCollaborators(3)
+	assert.NoError(t, err)
+
+	allPolicy := &SignaturePolicy{Type: SignaturePolicyAll}
+
+	assert.True(t, allPolicy.IsSatisfied(signers, signers))
+	assert.False(t, allPolicy.IsSatisfied(signers, signers[:2]))
+
+	thresholdPolicy := &SignaturePolicy{
+		Type:             SignaturePolicyThreshold,
+		Threshold:        2,
+		MandatorySigners: []*types.AccountID{signers[2]},
+	}
+
+	assert.True(t, thresholdPolicy.IsSatisfied(signers, signers[1:]))
+	assert.False(t, thresholdPolicy.IsSatisfied(signers, signers[:2]))
+	assert.False(t, thresholdPolicy.IsSatisfied(signers, signers[2:]))
+}
+
+func TestGetMissingSigners(t *testing.T) {
+	signers, err := getTestCollaborators(3)
+	assert.NoError(t, err)
+
+	assert.Equal(t, signers[1:], getMissingSigners(signers, signers[:1]))
+	assert.Nil(t, getMissingSigners(signers, signers))
+}
+
+func TestCoreDocument_PrepareNewVersion_SignaturePolicy(t *testing.T) {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+
+	policy := &SignaturePolicy{Type: SignaturePolicyAll}
+
+	cd.SetSignaturePolicy(policy)
+
+	ncd, err := cd.PrepareNewVersion(nil, CollaboratorsAccess{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, policy, ncd.GetSignaturePolicy())
+}
@@ -0,0 +1,770 @@
+package documents
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/pod/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// ErrValidationRuleExpression is a sentinel error when the expression of a validation rule is invalid
+	ErrValidationRuleExpression = errors.Error("invalid validation rule expression")
+
+	// ErrValidationRuleViolated is a sentinel error when the document doesn't satisfy a validation rule
+	ErrValidationRuleViolated = errors.Error("validation rule violated")
+
+	// maxValidationRuleLength is the max length of the expression of a validation rule.
+	maxValidationRuleLength = 1024
+)
+
+// Validation rules are declarative rules that every version of the document must satisfy. A rule is a boolean
+// expression on the attributes of the new version of the document and of the previous one:
+//
+//	new("label"), old("label")
+//	    The value of the attribute in the new or previous version of the document.
+//	exists(new("label")), exists(old("label"))
+//	    Checks if the attribute exists in the new or previous version of the document.
+//	transition("label", "state1", "state2", ...)
+//	    Checks that the attribute either keeps its value or moves to the next state. The first version of the
+//	    attribute must be the first state.
+//	==, !=, <, <=, >, >=
+//	    Compare numbers, strings, timestamps or bytes. Timestamps can be compared with RFC3339 strings and
+//	    bytes with hex strings. Comparisons with a missing attribute are false.
+//	&&, ||, !, (...)
+//	    Logical operators.
+//	"string", 1.5, true, false
+//	    Literals.
+//
+// Examples:
+//
+//	transition("status", "draft", "approved", "paid")
+//	!exists(old("amount")) || new("amount") >= old("amount")
+//	new("due_date") > new("issue_date")
+
+type ruleTokenKind int
+
+const (
+	ruleTokenEOF ruleTokenKind = iota
+	ruleTokenIdent
+	ruleTokenString
+	ruleTokenNumber
+	ruleTokenOperator
+)
+
+type ruleToken struct {
+	kind ruleTokenKind
+	text string
+	pos  int
+}
+
+var ruleOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", ","}
+
+func isRuleDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isRuleLetter(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// tokenizeValidationRule splits the expression into tokens.
+func tokenizeValidationRule(expr string) ([]ruleToken, error) {
+	var tokens []ruleToken
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(expr) && expr[j] != '"'; j++ {
+				if expr[j] == '\\' {
+					j++
+				}
+			}
+
+			if j >= len(expr) {
+				return nil, errors.New("unterminated string at %d", i)
+			}
+
+			s, err := strconv.Unquote(expr[i : j+1])
+			if err != nil {
+				return nil, errors.New("invalid string at %d", i)
+			}
+
+			tokens = append(tokens, ruleToken{kind: ruleTokenString, text: s, pos: i})
+			i = j + 1
+		case isRuleDigit(c) || (c == '-' && i+1 < len(expr) && isRuleDigit(expr[i+1])):
+			j := i + 1
+			for ; j < len(expr) && (isRuleDigit(expr[j]) || expr[j] == '.'); j++ {
+			}
+
+			tokens = append(tokens, ruleToken{kind: ruleTokenNumber, text: expr[i:j], pos: i})
+			i = j
+		case isRuleLetter(c):
+			j := i + 1
+			for ; j < len(expr) && (isRuleLetter(expr[j]) || isRuleDigit(expr[j])); j++ {
+			}
+
+			tokens = append(tokens, ruleToken{kind: ruleTokenIdent, text: expr[i:j], pos: i})
+			i = j
+		default:
+			var op string
+			for _, o := range ruleOperators {
+				if strings.HasPrefix(expr[i:], o) {
+					op = o
+					break
+				}
+			}
+
+			if op == "" {
+				return nil, errors.New("unexpected character '%c' at %d", c, i)
+			}
+
+			tokens = append(tokens, ruleToken{kind: ruleTokenOperator, text: op, pos: i})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, ruleToken{kind: ruleTokenEOF, pos: len(expr)}), nil
+}
+
+type ruleValueKind int
+
+const (
+	ruleValueMissing ruleValueKind = iota
+	ruleValueBool
+	ruleValueNumber
+	ruleValueString
+	ruleValueTime
+	ruleValueBytes
+)
+
+var ruleValueKindNames = map[ruleValueKind]string{
+	ruleValueMissing: "missing",
+	ruleValueBool:    "bool",
+	ruleValueNumber:  "number",
+	ruleValueString:  "string",
+	ruleValueTime:    "timestamp",
+	ruleValueBytes:   "bytes",
+}
+
+type ruleValue struct {
+	kind  ruleValueKind
+	b     bool
+	num   decimal.Decimal
+	str   string
+	t     time.Time
+	bytes []byte
+}
+
+// toRuleValue converts the attribute value to a value that can be used in the validation rules.
+func toRuleValue(attrVal AttrVal) (ruleValue, error) {
+	switch attrVal.Type {
+	case AttrInt256:
+		num, err := decimal.NewFromString(attrVal.Int256.String())
+		return ruleValue{kind: ruleValueNumber, num: num}, err
+	case AttrDecimal:
+		num, err := decimal.NewFromString(attrVal.Decimal.String())
+		return ruleValue{kind: ruleValueNumber, num: num}, err
+	case AttrMonetary:
+		num, err := decimal.NewFromString(attrVal.Monetary.Value.String())
+		return ruleValue{kind: ruleValueNumber, num: num}, err
+	case AttrString:
+		return ruleValue{kind: ruleValueString, str: attrVal.Str}, nil
+	case AttrTimestamp:
+		if !attrVal.Timestamp.IsValid() {
+			return ruleValue{}, ErrInvalidAttrTimestamp
+		}
+
+		return ruleValue{kind: ruleValueTime, t: attrVal.Timestamp.AsTime()}, nil
+	case AttrBytes:
+		return ruleValue{kind: ruleValueBytes, bytes: attrVal.Bytes}, nil
+	case AttrSigned:
+		return ruleValue{kind: ruleValueBytes, bytes: attrVal.Signed.Value}, nil
+	default:
+		return ruleValue{}, errors.New("'%s' attribute type not supported by validation rules", attrVal.Type)
+	}
+}
+
+// ruleEnv holds the versions of the document that the validation rules are evaluated against.
+// The old version is nil for the first version of the document.
+type ruleEnv struct {
+	old, new Document
+}
+
+// attribute returns the value of the attribute, or false if the attribute doesn't exist.
+func (env ruleEnv) attribute(label string, old bool) (AttrVal, bool, error) {
+	doc := env.new
+	if old {
+		doc = env.old
+	}
+
+	if doc == nil {
+		return AttrVal{}, false, nil
+	}
+
+	key, err := AttrKeyFromLabel(label)
+	if err != nil {
+		return AttrVal{}, false, err
+	}
+
+	if !doc.AttributeExists(key) {
+		return AttrVal{}, false, nil
+	}
+
+	attr, err := doc.GetAttribute(key)
+	if err != nil {
+		return AttrVal{}, false, err
+	}
+
+	return attr.Value, true, nil
+}
+
+type ruleExpr interface {
+	eval(env ruleEnv) (ruleValue, error)
+
+	// isBool checks if the expression always evaluates to a bool.
+	isBool() bool
+}
+
+type ruleLiteral struct {
+	value ruleValue
+}
+
+func (e *ruleLiteral) eval(ruleEnv) (ruleValue, error) {
+	return e.value, nil
+}
+
+func (e *ruleLiteral) isBool() bool {
+	return e.value.kind == ruleValueBool
+}
+
+type ruleAttribute struct {
+	label string
+	old   bool
+}
+
+func (e *ruleAttribute) eval(env ruleEnv) (ruleValue, error) {
+	attrVal, ok, err := env.attribute(e.label, e.old)
+	if err != nil || !ok {
+		return ruleValue{}, err
+	}
+
+	return toRuleValue(attrVal)
+}
+
+func (e *ruleAttribute) isBool() bool {
+	return false
+}
+
+type ruleExists struct {
+	attr *ruleAttribute
+}
+
+func (e *ruleExists) eval(env ruleEnv) (ruleValue, error) {
+	_, ok, err := env.attribute(e.attr.label, e.attr.old)
+	return ruleValue{kind: ruleValueBool, b: ok}, err
+}
+
+func (e *ruleExists) isBool() bool {
+	return true
+}
+
+type ruleTransition struct {
+	label  string
+	states []string
+}
+
+func (e *ruleTransition) eval(env ruleEnv) (ruleValue, error) {
+	state := func(old bool) (int, bool, error) {
+		attrVal, ok, err := env.attribute(e.label, old)
+		if err != nil || !ok {
+			return 0, ok, err
+		}
+
+		s, err := attrVal.String()
+		if err != nil {
+			return 0, false, err
+		}
+
+		for i, st := range e.states {
+			if st == s {
+				return i, true, nil
+			}
+		}
+
+		return -1, true, nil
+	}
+
+	newState, newOk, err := state(false)
+	if err != nil {
+		return ruleValue{}, err
+	}
+
+	oldState, oldOk, err := state(true)
+	if err != nil {
+		return ruleValue{}, err
+	}
+
+	var valid bool
+	switch {
+	case !newOk:
+		valid = !oldOk
+	case newState < 0:
+		valid = false
+	case !oldOk:
+		valid = newState == 0
+	default:
+		valid = oldState >= 0 && (newState == oldState || newState == oldState+1)
+	}
+
+	return ruleValue{kind: ruleValueBool, b: valid}, nil
+}
+
+func (e *ruleTransition) isBool() bool {
+	return true
+}
+
+type ruleNot struct {
+	expr ruleExpr
+}
+
+func (e *ruleNot) eval(env ruleEnv) (ruleValue, error) {
+	v, err := e.expr.eval(env)
+	if err != nil {
+		return v, err
+	}
+
+	return ruleValue{kind: ruleValueBool, b: !v.b}, nil
+}
+
+func (e *ruleNot) isBool() bool {
+	return true
+}
+
+type ruleLogical struct {
+	op          string
+	left, right ruleExpr
+}
+
+func (e *ruleLogical) eval(env ruleEnv) (ruleValue, error) {
+	l, err := e.left.eval(env)
+	if err != nil {
+		return l, err
+	}
+
+	// short circuit
+	if (e.op == "&&" && !l.b) || (e.op == "||" && l.b) {
+		return l, nil
+	}
+
+	return e.right.eval(env)
+}
+
+func (e *ruleLogical) isBool() bool {
+	return true
+}
+
+type ruleComparison struct {
+	op          string
+	left, right ruleExpr
+}
+
+// coerceRuleValues converts string literals to timestamps or bytes when they are compared with them.
+func coerceRuleValues(l, r ruleValue) (ruleValue, ruleValue, error) {
+	coerce := func(v ruleValue, kind ruleValueKind) (ruleValue, error) {
+		if v.kind != ruleValueString {
+			return v, nil
+		}
+
+		switch kind {
+		case ruleValueTime:
+			t, err := time.Parse(time.RFC3339Nano, v.str)
+			return ruleValue{kind: ruleValueTime, t: t}, err
+		case ruleValueBytes:
+			b, err := hexutil.Decode(v.str)
+			return ruleValue{kind: ruleValueBytes, bytes: b}, err
+		default:
+			return v, nil
+		}
+	}
+
+	var err error
+	if l, err = coerce(l, r.kind); err != nil {
+		return l, r, err
+	}
+
+	r, err = coerce(r, l.kind)
+	return l, r, err
+}
+
+func (e *ruleComparison) eval(env ruleEnv) (ruleValue, error) {
+	l, err := e.left.eval(env)
+	if err != nil {
+		return l, err
+	}
+
+	r, err := e.right.eval(env)
+	if err != nil {
+		return r, err
+	}
+
+	if l.kind == ruleValueMissing || r.kind == ruleValueMissing {
+		return ruleValue{kind: ruleValueBool}, nil
+	}
+
+	l, r, err = coerceRuleValues(l, r)
+	if err != nil {
+		return ruleValue{}, err
+	}
+
+	if l.kind != r.kind {
+		return ruleValue{}, errors.New("cannot compare %s with %s", ruleValueKindNames[l.kind], ruleValueKindNames[r.kind])
+	}
+
+	var cmp int
+	switch l.kind {
+	case ruleValueNumber:
+		cmp = l.num.Cmp(r.num)
+	case ruleValueString:
+		cmp = strings.Compare(l.str, r.str)
+	case ruleValueTime:
+		switch {
+		case l.t.Before(r.t):
+			cmp = -1
+		case l.t.After(r.t):
+			cmp = 1
+		}
+	case ruleValueBool, ruleValueBytes:
+		if e.op != "==" && e.op != "!=" {
+			return ruleValue{}, errors.New("cannot order %s values", ruleValueKindNames[l.kind])
+		}
+
+		if l.b != r.b || !bytes.Equal(l.bytes, r.bytes) {
+			cmp = 1
+		}
+	}
+
+	var res bool
+	switch e.op {
+	case "==":
+		res = cmp == 0
+	case "!=":
+		res = cmp != 0
+	case "<":
+		res = cmp < 0
+	case "<=":
+		res = cmp <= 0
+	case ">":
+		res = cmp > 0
+	case ">=":
+		res = cmp >= 0
+	}
+
+	return ruleValue{kind: ruleValueBool, b: res}, nil
+}
+
+func (e *ruleComparison) isBool() bool {
+	return true
+}
+
+type ruleParser struct {
+	tokens []ruleToken
+	pos    int
+}
+
+func (p *ruleParser) peek() ruleToken {
+	return p.tokens[p.pos]
+}
+
+func (p *ruleParser) next() ruleToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != ruleTokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+// accept consumes the next token if it's the operator.
+func (p *ruleParser) accept(op string) bool {
+	tok := p.peek()
+	if tok.kind != ruleTokenOperator || tok.text != op {
+		return false
+	}
+
+	p.pos++
+	return true
+}
+
+func (p *ruleParser) expect(op string) error {
+	if !p.accept(op) {
+		return errors.New("expected '%s' at %d", op, p.peek().pos)
+	}
+
+	return nil
+}
+
+func (p *ruleParser) expectString() (string, error) {
+	tok := p.next()
+	if tok.kind != ruleTokenString {
+		return "", errors.New("expected string at %d", tok.pos)
+	}
+
+	return tok.text, nil
+}
+
+func (p *ruleParser) parseLogical(op string, operand func() (ruleExpr, error)) (ruleExpr, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		pos := p.peek().pos
+		if !p.accept(op) {
+			return left, nil
+		}
+
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		if !left.isBool() || !right.isBool() {
+			return nil, errors.New("operands of '%s' at %d must be bool", op, pos)
+		}
+
+		left = &ruleLogical{op: op, left: left, right: right}
+	}
+}
+
+func (p *ruleParser) parseOr() (ruleExpr, error) {
+	return p.parseLogical("||", p.parseAnd)
+}
+
+func (p *ruleParser) parseAnd() (ruleExpr, error) {
+	return p.parseLogical("&&", p.parseNot)
+}
+
+func (p *ruleParser) parseNot() (ruleExpr, error) {
+	pos := p.peek().pos
+	if !p.accept("!") {
+		return p.parseComparison()
+	}
+
+	expr, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	if !expr.isBool() {
+		return nil, errors.New("operand of '!' at %d must be bool", pos)
+	}
+
+	return &ruleNot{expr: expr}, nil
+}
+
+func (p *ruleParser) parseComparison() (ruleExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if !p.accept(op) {
+			continue
+		}
+
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ruleComparison{op: op, left: left, right: right}, nil
+	}
+
+	return left, nil
+}
+
+func (p *ruleParser) parseOperand() (ruleExpr, error) {
+	tok := p.next()
+	switch tok.kind {
+	case ruleTokenString:
+		return &ruleLiteral{value: ruleValue{kind: ruleValueString, str: tok.text}}, nil
+	case ruleTokenNumber:
+		num, err := decimal.NewFromString(tok.text)
+		if err != nil {
+			return nil, errors.New("invalid number at %d", tok.pos)
+		}
+
+		return &ruleLiteral{value: ruleValue{kind: ruleValueNumber, num: num}}, nil
+	case ruleTokenOperator:
+		if tok.text != "(" {
+			break
+		}
+
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		return expr, p.expect(")")
+	case ruleTokenIdent:
+		return p.parseIdent(tok)
+	}
+
+	return nil, errors.New("unexpected token at %d", tok.pos)
+}
+
+func (p *ruleParser) parseIdent(tok ruleToken) (ruleExpr, error) {
+	switch tok.text {
+	case "true", "false":
+		return &ruleLiteral{value: ruleValue{kind: ruleValueBool, b: tok.text == "true"}}, nil
+	case "new", "old":
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+
+		label, err := p.expectString()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := AttrKeyFromLabel(label); err != nil {
+			return nil, err
+		}
+
+		return &ruleAttribute{label: label, old: tok.text == "old"}, p.expect(")")
+	case "exists":
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+
+		pos := p.peek().pos
+		expr, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		attr, ok := expr.(*ruleAttribute)
+		if !ok {
+			return nil, errors.New("expected attribute at %d", pos)
+		}
+
+		return &ruleExists{attr: attr}, p.expect(")")
+	case "transition":
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+
+		label, err := p.expectString()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := AttrKeyFromLabel(label); err != nil {
+			return nil, err
+		}
+
+		e := &ruleTransition{label: label}
+		for p.accept(",") {
+			state, err := p.expectString()
+			if err != nil {
+				return nil, err
+			}
+
+			e.states = append(e.states, state)
+		}
+
+		if len(e.states) < 2 {
+			return nil, errors.New("transition at %d requires at least two states", tok.pos)
+		}
+
+		return e, p.expect(")")
+	}
+
+	return nil, errors.New("unknown identifier '%s' at %d", tok.text, tok.pos)
+}
+
+// parseValidationRule parses the expression of a validation rule.
+func parseValidationRule(expression string) (ruleExpr, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, errors.NewTypedError(ErrValidationRuleExpression, errors.New("empty expression"))
+	}
+
+	if len(expression) > maxValidationRuleLength {
+		return nil, errors.NewTypedError(
+			ErrValidationRuleExpression,
+			errors.New("expression exceeds %d characters", maxValidationRuleLength),
+		)
+	}
+
+	tokens, err := tokenizeValidationRule(expression)
+	if err != nil {
+		return nil, errors.NewTypedError(ErrValidationRuleExpression, err)
+	}
+
+	p := &ruleParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, errors.NewTypedError(ErrValidationRuleExpression, err)
+	}
+
+	if tok := p.peek(); tok.kind != ruleTokenEOF {
+		return nil, errors.NewTypedError(ErrValidationRuleExpression, errors.New("unexpected token at %d", tok.pos))
+	}
+
+	if !expr.isBool() {
+		return nil, errors.NewTypedError(ErrValidationRuleExpression, errors.New("expression must be bool"))
+	}
+
+	return expr, nil
+}
+
+// evaluateValidationRules checks that the transition from the old to the new version of the document satisfies
+// the validation rules of both versions. The old version is nil for the first version of the document.
+func evaluateValidationRules(old, new Document) error {
+	var rules []*coredocumentpb.TransitionRule
+	if old != nil {
+		rules = old.GetValidationRules()
+	}
+
+	rules = append(rules, new.GetValidationRules()...)
+
+	env := ruleEnv{old: old, new: new}
+	evaluated := make(map[string]struct{})
+	for _, rule := range rules {
+		ruleKey := hexutil.Encode(rule.RuleKey)
+		if _, ok := evaluated[ruleKey]; ok {
+			continue
+		}
+
+		evaluated[ruleKey] = struct{}{}
+
+		expr, err := parseValidationRule(rule.Expression)
+		if err != nil {
+			return err
+		}
+
+		res, err := expr.eval(env)
+		if err != nil {
+			return errors.NewTypedError(ErrValidationRuleViolated, errors.New("rule[%s] %s: %s", ruleKey, rule.Expression, err))
+		}
+
+		if !res.b {
+			return errors.NewTypedError(ErrValidationRuleViolated, errors.New("rule[%s] %s", ruleKey, rule.Expression))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+//go:build unit
+
+package documents
+
+import (
+	"strings"
+	"testing"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/pod/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getValidationRules(t *testing.T, expressions ...string) []*coredocumentpb.TransitionRule {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+
+	for _, expression := range expressions {
+		_, err := cd.AddValidationRule(expression)
+		assert.NoError(t, err)
+	}
+
+	return cd.GetValidationRules()
+}
+
+// getValidationRulesDocumentMock returns a document mock with the validation rules whose attributes are backed
+// by a core document.
+func getValidationRulesDocumentMock(
+	t *testing.T,
+	rules []*coredocumentpb.TransitionRule,
+	attrs map[string]string,
+) *DocumentMock {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+
+	for label, value := range attrs {
+		// the type of the attribute is the prefix of the value
+		s := strings.SplitN(value, ":", 2)
+		attr, err := NewStringAttribute(label, AttributeType(s[0]), s[1])
+		assert.NoError(t, err)
+
+		cd, err = cd.AddAttributes(CollaboratorsAccess{}, false, nil, attr)
+		assert.NoError(t, err)
+	}
+
+	doc := NewDocumentMock(t)
+	doc.On("GetValidationRules").Return(rules)
+	doc.On("AttributeExists", mock.Anything).Return(cd.AttributeExists).Maybe()
+	doc.On("GetAttribute", mock.Anything).Return(
+		func(key AttrKey) Attribute {
+			attr, _ := cd.GetAttribute(key)
+			return attr
+		},
+		func(key AttrKey) error {
+			_, err := cd.GetAttribute(key)
+			return err
+		},
+	).Maybe()
+
+	return doc
+}
+
+func Test_parseValidationRule(t *testing.T) {
+	valid := []string{
+		`transition("status", "draft", "approved", "paid")`,
+		`!exists(old("amount")) || new("amount") >= old("amount")`,
+		`new("due_date") > new("issue_date")`,
+		`(new("amount") > 0 && new("amount") <= 1000.5) || new("currency") != "USD"`,
+		`new("flag") == true`,
+		`!(new("hash") == "0x01")`,
+	}
+
+	for _, expression := range valid {
+		_, err := parseValidationRule(expression)
+		assert.NoError(t, err, expression)
+	}
+
+	invalid := []string{
+		"",
+		"   ",
+		strings.Repeat("a", maxValidationRuleLength+1),
+		`new("amount")`,
+		`new("amount") > `,
+		`new("amount") > 1 &&`,
+		`new(amount) > 1`,
+		`new("amount" > 1`,
+		`unknown("amount") > 1`,
+		`exists("amount")`,
+		`transition("status")`,
+		`new("label") == "unterminated`,
+		`new("amount") > 1 1`,
+		`new("amount") # 1`,
+		`!new("amount")`,
+		`new("a") > 1 && "b"`,
+	}
+
+	for _, expression := range invalid {
+		_, err := parseValidationRule(expression)
+		assert.True(t, errors.IsOfType(ErrValidationRuleExpression, err), expression)
+	}
+}
+
+func Test_evaluateValidationRules(t *testing.T) {
+	tests := []struct {
+		expression string
+		old        map[string]string
+		new        map[string]string
+		valid      bool
+	}{
+		// transitions
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			new:        map[string]string{"status": "string:draft"},
+			valid:      true,
+		},
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			new:        map[string]string{"status": "string:approved"},
+		},
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			old:        map[string]string{"status": "string:draft"},
+			new:        map[string]string{"status": "string:approved"},
+			valid:      true,
+		},
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			old:        map[string]string{"status": "string:approved"},
+			new:        map[string]string{"status": "string:approved"},
+			valid:      true,
+		},
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			old:        map[string]string{"status": "string:draft"},
+			new:        map[string]string{"status": "string:paid"},
+		},
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			old:        map[string]string{"status": "string:approved"},
+			new:        map[string]string{"status": "string:draft"},
+		},
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			old:        map[string]string{"status": "string:draft"},
+			new:        map[string]string{"status": "string:rejected"},
+		},
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			old:        map[string]string{"status": "string:draft"},
+		},
+		{
+			expression: `transition("status", "draft", "approved", "paid")`,
+			valid:      true,
+		},
+		// amount cannot decrease
+		{
+			expression: `!exists(old("amount")) || new("amount") >= old("amount")`,
+			new:        map[string]string{"amount": "decimal:100"},
+			valid:      true,
+		},
+		{
+			expression: `!exists(old("amount")) || new("amount") >= old("amount")`,
+			old:        map[string]string{"amount": "decimal:100"},
+			new:        map[string]string{"amount": "decimal:100.5"},
+			valid:      true,
+		},
+		{
+			expression: `!exists(old("amount")) || new("amount") >= old("amount")`,
+			old:        map[string]string{"amount": "decimal:100"},
+			new:        map[string]string{"amount": "decimal:99.99"},
+		},
+		// numbers of different attribute types
+		{
+			expression: `new("amount") > new("limit") && new("amount") < 1000`,
+			new:        map[string]string{"amount": "decimal:100.5", "limit": "integer:100"},
+			valid:      true,
+		},
+		// timestamps
+		{
+			expression: `new("due_date") > new("issue_date")`,
+			new: map[string]string{
+				"issue_date": "timestamp:2022-10-01T00:00:00Z",
+				"due_date":   "timestamp:2022-11-01T00:00:00Z",
+			},
+			valid: true,
+		},
+		{
+			expression: `new("due_date") > new("issue_date")`,
+			new: map[string]string{
+				"issue_date": "timestamp:2022-10-01T00:00:00Z",
+				"due_date":   "timestamp:2022-10-01T00:00:00Z",
+			},
+		},
+		{
+			expression: `new("due_date") <= "2022-12-31T00:00:00Z"`,
+			new:        map[string]string{"due_date": "timestamp:2022-11-01T00:00:00Z"},
+			valid:      true,
+		},
+		// bytes
+		{
+			expression: `new("hash") == "0x0102"`,
+			new:        map[string]string{"hash": "bytes:0x0102"},
+			valid:      true,
+		},
+		{
+			expression: `new("hash") != old("hash")`,
+			old:        map[string]string{"hash": "bytes:0x0102"},
+			new:        map[string]string{"hash": "bytes:0x0102"},
+		},
+		// missing attributes
+		{
+			expression: `new("due_date") > new("issue_date")`,
+			new:        map[string]string{"due_date": "timestamp:2022-11-01T00:00:00Z"},
+		},
+		{
+			expression: `new("currency") != "USD"`,
+		},
+		// strings
+		{
+			expression: `new("currency") == "USD" || new("currency") == "EUR"`,
+			new:        map[string]string{"currency": "string:EUR"},
+			valid:      true,
+		},
+	}
+
+	for _, test := range tests {
+		rules := getValidationRules(t, test.expression)
+
+		var old Document
+		if test.old != nil {
+			old = getValidationRulesDocumentMock(t, rules, test.old)
+		}
+
+		err := evaluateValidationRules(old, getValidationRulesDocumentMock(t, rules, test.new))
+		if test.valid {
+			assert.NoError(t, err, test.expression)
+			continue
+		}
+
+		assert.True(t, errors.IsOfType(ErrValidationRuleViolated, err), test.expression)
+	}
+}
+
+func Test_evaluateValidationRules_Errors(t *testing.T) {
+	// values that can't be compared
+	rules := getValidationRules(t, `new("amount") > "100"`)
+	err := evaluateValidationRules(nil, getValidationRulesDocumentMock(t, rules, map[string]string{
+		"amount": "decimal:100",
+	}))
+	assert.True(t, errors.IsOfType(ErrValidationRuleViolated, err))
+
+	rules = getValidationRules(t, `new("hash") > "0x01"`)
+	err = evaluateValidationRules(nil, getValidationRulesDocumentMock(t, rules, map[string]string{
+		"hash": "bytes:0x0102",
+	}))
+	assert.True(t, errors.IsOfType(ErrValidationRuleViolated, err))
+
+	rules = getValidationRules(t, `new("due_date") > "tomorrow"`)
+	err = evaluateValidationRules(nil, getValidationRulesDocumentMock(t, rules, map[string]string{
+		"due_date": "timestamp:2022-11-01T00:00:00Z",
+	}))
+	assert.True(t, errors.IsOfType(ErrValidationRuleViolated, err))
+
+	// invalid expression stored in the document
+	rules = []*coredocumentpb.TransitionRule{
+		{
+			RuleKey:    []byte{0x01},
+			Action:     coredocumentpb.TransitionAction_TRANSITION_ACTION_VALIDATE,
+			Expression: `new("amount")`,
+		},
+	}
+	err = evaluateValidationRules(nil, getValidationRulesDocumentMock(t, rules, nil))
+	assert.True(t, errors.IsOfType(ErrValidationRuleExpression, err))
+}
+
+func Test_evaluateValidationRules_OldRules(t *testing.T) {
+	oldRules := getValidationRules(t, `new("amount") > 0`)
+
+	old := getValidationRulesDocumentMock(t, oldRules, map[string]string{"amount": "decimal:100"})
+
+	// the rules of the previous version can't be dropped by the new version
+	newDoc := getValidationRulesDocumentMock(t, nil, map[string]string{"amount": "decimal:-1"})
+	err := evaluateValidationRules(old, newDoc)
+	assert.True(t, errors.IsOfType(ErrValidationRuleViolated, err))
+
+	newDoc = getValidationRulesDocumentMock(t, nil, map[string]string{"amount": "decimal:1"})
+	err = evaluateValidationRules(old, newDoc)
+	assert.NoError(t, err)
+
+	// the rules of the new version are evaluated as well
+	newRules := append(oldRules, getValidationRules(t, `new("amount") < 10`)...)
+
+	newDoc = getValidationRulesDocumentMock(t, newRules, map[string]string{"amount": "decimal:100"})
+	err = evaluateValidationRules(old, newDoc)
+	assert.True(t, errors.IsOfType(ErrValidationRuleViolated, err))
+
+	newDoc = getValidationRulesDocumentMock(t, newRules, map[string]string{"amount": "decimal:5"})
+	err = evaluateValidationRules(old, newDoc)
+	assert.NoError(t, err)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/centrifuge/pod/pallets/anchors"
 	"github.com/centrifuge/pod/utils"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
 )
 
 // MaxAuthoredToCommitDuration is the maximum allowed time period for a document to be anchored after a authoring it based on document timestamp.
@@ -296,10 +297,15 @@ func signaturesValidator(identityService v2.Service) Validator {
 			)
 
 			if validationError != nil {
-				err = errors.AppendError(
-					err,
-					errors.New("signature_%s verification failed: %v", hexutil.Encode(sig.SignerId), validationError),
-				)
+				sigErr := errors.New("signature_%s verification failed: %v", hexutil.Encode(sig.SignerId), validationError)
+
+				// the key might not be valid because of an error on chain, only a failed verification
+				// proves that the signature is invalid.
+				if errors.IsOfType(v2.ErrInvalidSignature, validationError) {
+					sigErr = errors.NewTypedError(ErrDocumentSignatureInvalid, sigErr)
+				}
+
+				err = errors.AppendError(err, sigErr)
 			}
 		}
 
@@ -413,6 +419,44 @@ func currentVersionValidator(anchorSrv anchors.API) Validator {
 	})
 }
 
+// referenceAttributesValidator checks that the document versions referenced by the attributes are anchored
+// and that the pinned document roots match the anchored ones.
+func referenceAttributesValidator(anchorSrv anchors.API) Validator {
+	return ValidatorFunc(func(_, model Document) error {
+		if model == nil {
+			return ErrModelNil
+		}
+
+		for _, attr := range model.GetAttributes() {
+			for _, ref := range attr.Value.references() {
+				if err := validateReference(anchorSrv, ref); err != nil {
+					return errors.NewTypedError(err, errors.New("attribute %s", attr.KeyLabel))
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func validateReference(anchorSrv anchors.API, ref Reference) error {
+	anchorID, err := anchors.ToAnchorID(ref.AnchoredVersion())
+	if err != nil {
+		return ErrAnchorIDCreation
+	}
+
+	gotRoot, _, err := anchorSrv.GetAnchorData(anchorID)
+	if err != nil {
+		return ErrReferencedDocumentNotAnchored
+	}
+
+	if len(ref.DocumentRoot) != 0 && !utils.IsSameByteSlice(ref.DocumentRoot, gotRoot[:]) {
+		return ErrReferencedDocumentRootMismatch
+	}
+
+	return nil
+}
+
 // attributeValidator validates the signed attributes.
 func attributeValidator(identityService v2.Service) Validator {
 	return ValidatorFunc(func(_, model Document) (err error) {
@@ -471,9 +515,21 @@ func transitionValidator(collaborator *types.AccountID) Validator {
 	})
 }
 
+// validationRulesValidator checks that the new document satisfies the validation rules of the old and the new document.
+func validationRulesValidator() Validator {
+	return ValidatorFunc(func(old, new Document) error {
+		err := evaluateValidationRules(old, new)
+		if err != nil {
+			return errors.NewTypedError(ErrInvalidDocumentStateTransition, err)
+		}
+
+		return nil
+	})
+}
+
 // computeFieldsValidator verifies the execution of each compute field by re executing the WASM and checking the result
-// is same as the one that is stored in the document.
-func computeFieldsValidator(timeout time.Duration) Validator {
+// and the execution error are same as the ones that are stored in the document.
+func computeFieldsValidator(gasLimit uint64) Validator {
 	return ValidatorFunc(func(_, new Document) error {
 		computeFields := new.GetComputeFieldsRules()
 		attributes := func() map[AttrKey]Attribute {
@@ -483,31 +539,79 @@ func computeFieldsValidator(timeout time.Duration) Validator {
 			}
 			return attrMap
 		}()
+		anchoredTime := computeFieldsAnchoredTime(new.Timestamp())
 
 		for _, computeField := range computeFields {
 			// execute compute fields
-			targetAttr, err := executeComputeField(computeField, attributes, timeout)
+			targetAttr, execution, err := executeComputeField(computeField, attributes, anchoredTime, gasLimit)
 			if err != nil {
 				return err
 			}
 
 			// verify the targetAttr is same as the one already stored
-			attr := attributes[targetAttr.Key]
-			if !reflect.DeepEqual(attr, targetAttr) {
+			attr, ok := attributes[targetAttr.Key]
+			if !ok || !isSameComputeFieldsResult(attr, targetAttr) {
 				return errors.New("compute fields[%s] validation failed", hexutil.Encode(computeField.RuleKey))
 			}
+
+			// verify the execution error is same as the one already stored
+			errorKey, err := AttrKeyFromLabel(ComputeFieldsErrorLabel(targetAttr.KeyLabel))
+			if err != nil {
+				return err
+			}
+
+			errorAttr, ok := attributes[errorKey]
+			if execution.Err == nil {
+				if ok {
+					return errors.New("compute fields[%s] error validation failed", hexutil.Encode(computeField.RuleKey))
+				}
+
+				continue
+			}
+
+			expectedErrorAttr, err := computeFieldsErrorAttribute(targetAttr.KeyLabel, execution.Err)
+			if err != nil {
+				return err
+			}
+
+			if !ok || !reflect.DeepEqual(errorAttr, expectedErrorAttr) {
+				return errors.New("compute fields[%s] error validation failed", hexutil.Encode(computeField.RuleKey))
+			}
 		}
 
 		return nil
 	})
 }
 
+// isSameComputeFieldsResult checks if the stored target attribute holds the computed value.
+// The attributes are compared by their protocol representation, since typed values like decimals
+// can have different in-memory representations of the same value.
+func isSameComputeFieldsResult(stored, computed Attribute) bool {
+	if stored.KeyLabel != computed.KeyLabel || stored.Key != computed.Key {
+		return false
+	}
+
+	storedPattr, err := toProtocolAttribute(stored)
+	if err != nil {
+		return false
+	}
+
+	computedPattr, err := toProtocolAttribute(computed)
+	if err != nil {
+		return false
+	}
+
+	return proto.Equal(storedPattr, computedPattr)
+}
+
 // CreateVersionValidator validates if the new core document is properly derived from old one
 func CreateVersionValidator(anchorSrv anchors.API) Validator {
 	return ValidatorGroup{
 		baseValidator(),
 		currentVersionValidator(anchorSrv),
 		LatestVersionValidator(anchorSrv),
+		referenceAttributesValidator(anchorSrv),
+		validationRulesValidator(),
 	}
 }
 
@@ -517,6 +621,8 @@ func UpdateVersionValidator(anchorSrv anchors.API) Validator {
 		versionIDsValidator(),
 		currentVersionValidator(anchorSrv),
 		LatestVersionValidator(anchorSrv),
+		referenceAttributesValidator(anchorSrv),
+		validationRulesValidator(),
 	}
 }
 
@@ -536,17 +642,21 @@ func PreAnchorValidator(identityService v2.Service) Validator {
 // PostAnchoredValidator is a validator group with following validators
 // PreAnchorValidator
 // anchoredValidator
+// LatestVersionValidator
+// referenceAttributesValidator
 // should be called after anchoring the document/when received anchored document
 func PostAnchoredValidator(identityService v2.Service, anchorSrv anchors.API) Validator {
 	return ValidatorGroup{
 		PreAnchorValidator(identityService),
 		anchoredValidator(anchorSrv),
 		LatestVersionValidator(anchorSrv),
+		referenceAttributesValidator(anchorSrv),
 	}
 }
 
 // ReceivedAnchoredDocumentValidator is a validator group with following validators
 // transitionValidator
+// validationRulesValidator
 // PostAnchoredValidator
 func ReceivedAnchoredDocumentValidator(
 	identityService v2.Service,
@@ -555,13 +665,23 @@ func ReceivedAnchoredDocumentValidator(
 ) Validator {
 	return ValidatorGroup{
 		transitionValidator(collaborator),
+		validationRulesValidator(),
 		PostAnchoredValidator(identityService, anchorSrv),
 	}
 }
 
+// ReceivedDocumentValidatorProvider is implemented by the document services that have validations specific
+// to their document type, which are run on the anchored documents received over the p2p layer.
+type ReceivedDocumentValidatorProvider interface {
+	// ReceivedDocumentValidator returns the validator of the documents received by the account.
+	ReceivedDocumentValidator(accountID *types.AccountID) Validator
+}
+
 // RequestDocumentSignatureValidator is a validator group with the following validators
 // SignatureValidator
 // transitionsValidator
+// validationRulesValidator
+// referenceAttributesValidator
 // it should be called when a document is received over the p2p layer before signing
 func RequestDocumentSignatureValidator(
 	anchorSrv anchors.API,
@@ -574,7 +694,9 @@ func RequestDocumentSignatureValidator(
 		currentVersionValidator(anchorSrv),
 		LatestVersionValidator(anchorSrv),
 		transitionValidator(collaborator),
+		validationRulesValidator(),
 		SignatureValidator(identityService),
+		referenceAttributesValidator(anchorSrv),
 	}
 }
 
@@ -589,6 +711,6 @@ func SignatureValidator(identityService v2.Service) Validator {
 		signingRootValidator(),
 		signaturesValidator(identityService),
 		attributeValidator(identityService),
-		computeFieldsValidator(computeFieldsTimeout),
+		computeFieldsValidator(computeFieldsGasLimit),
 	}
 }
@@ -796,11 +796,17 @@ func TestValidator_signatureValidator_ValidationError(t *testing.T) {
 		ConsensusSignaturePayload(signingRoot, collaboratorTransitionValidated),
 		collaboratorSignature,
 		timestamp,
-	).Return(nil).Once()
+	).Return(v2.ErrInvalidSignature).Once()
 
 	err = ssv.Validate(nil, documentMock)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), errMsg)
+
+	// Only the failed signature verification is reported as an invalid signature.
+	errs := errors.GetErrs(err)
+	assert.Len(t, errs, 2)
+	assert.False(t, errors.IsOfType(ErrDocumentSignatureInvalid, errs[0]))
+	assert.True(t, errors.IsOfType(ErrDocumentSignatureInvalid, errs[1]))
 }
 
 func TestValidator_signatureValidator_AuthorNotFound(t *testing.T) {
@@ -1372,7 +1378,7 @@ func TestValidator_computeFieldsValidator(t *testing.T) {
 	wasmPath := path.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm")
 	wasm := wasmLoader(t, wasmPath)
 
-	rule, err := cd.AddComputeFieldsRule(wasm, []string{"test", "test2", "test3"}, "result")
+	rule, err := cd.AddComputeFieldsRule(wasm, []string{"test", "test2", "test3"}, "result", "")
 	assert.NoError(t, err)
 
 	// add required attributes
@@ -1392,7 +1398,10 @@ func TestValidator_computeFieldsValidator(t *testing.T) {
 		Return(cd.GetAttributes()).
 		Twice()
 
-	validator := computeFieldsValidator(10 * time.Second)
+	doc.On("Timestamp").
+		Return(time.Now(), nil)
+
+	validator := computeFieldsValidator(computeFieldsGasLimit)
 
 	err = validator.Validate(nil, doc)
 	assert.Error(t, err)
@@ -1430,16 +1439,88 @@ func TestValidator_computeFieldsValidator(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidator_computeFieldsValidator_ExecutionError(t *testing.T) {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+
+	wasm := wasmLoader(t, path.AppendPathToProjectRoot("testingutils/compute_fields/long_running.wasm"))
+
+	rule, err := cd.AddComputeFieldsRule(wasm, []string{"test", "test2", "test3"}, "result", "")
+	assert.NoError(t, err)
+
+	cd, err = cd.AddAttributes(CollaboratorsAccess{}, false, nil, getValidComputeFieldAttrs(t)...)
+	assert.NoError(t, err)
+
+	gasLimit := uint64(100_000)
+	validator := computeFieldsValidator(gasLimit)
+
+	doc := NewDocumentMock(t)
+	doc.On("GetComputeFieldsRules").
+		Return(cd.GetComputeFieldsRules())
+
+	doc.On("Timestamp").
+		Return(time.Now(), nil)
+
+	// execution error is missing
+	resultAttr, err := NewStringAttribute("result", AttrBytes, hexutil.Encode(make([]byte, 32)))
+	assert.NoError(t, err)
+
+	cd, err = cd.AddAttributes(CollaboratorsAccess{}, false, nil, resultAttr)
+	assert.NoError(t, err)
+
+	doc.On("GetAttributes").
+		Return(cd.GetAttributes()).
+		Once()
+
+	err = validator.Validate(nil, doc)
+	assert.EqualError(t, err, fmt.Sprintf("compute fields[%s] error validation failed", hexutil.Encode(rule.RuleKey)))
+
+	// successful validation
+	err = cd.ExecuteComputeFields(gasLimit)
+	assert.NoError(t, err)
+
+	doc.On("GetAttributes").
+		Return(cd.GetAttributes()).
+		Once()
+
+	err = validator.Validate(nil, doc)
+	assert.NoError(t, err)
+}
+
+func Test_isSameComputeFieldsResult(t *testing.T) {
+	computed, err := NewStringAttribute("result", AttrDecimal, "1.5")
+	assert.NoError(t, err)
+
+	// the same value with a different representation
+	stored, err := NewStringAttribute("result", AttrDecimal, "1.500000000000000000")
+	assert.NoError(t, err)
+	assert.True(t, isSameComputeFieldsResult(stored, computed))
+
+	stored, err = NewStringAttribute("result", AttrDecimal, "1.6")
+	assert.NoError(t, err)
+	assert.False(t, isSameComputeFieldsResult(stored, computed))
+
+	stored, err = NewStringAttribute("result", AttrString, "1.5")
+	assert.NoError(t, err)
+	assert.False(t, isSameComputeFieldsResult(stored, computed))
+
+	stored, err = NewStringAttribute("other_result", AttrDecimal, "1.5")
+	assert.NoError(t, err)
+	assert.False(t, isSameComputeFieldsResult(stored, computed))
+}
+
 func Test_CreateVersionValidator(t *testing.T) {
 	res := CreateVersionValidator(nil)
 
 	vg, ok := res.(ValidatorGroup)
 	assert.True(t, ok)
-	assert.Len(t, vg, 3)
+	assert.Len(t, vg, 5)
 
 	assert.Equal(t, reflect.ValueOf(baseValidator()).Pointer(), reflect.ValueOf(vg[0]).Pointer())
 	assert.Equal(t, reflect.ValueOf(currentVersionValidator(nil)).Pointer(), reflect.ValueOf(vg[1]).Pointer())
 	assert.Equal(t, reflect.ValueOf(LatestVersionValidator(nil)).Pointer(), reflect.ValueOf(vg[2]).Pointer())
+	assert.Equal(t, reflect.ValueOf(referenceAttributesValidator(nil)).Pointer(), reflect.ValueOf(vg[3]).Pointer())
+	assert.Equal(t, reflect.ValueOf(validationRulesValidator()).Pointer(), reflect.ValueOf(vg[4]).Pointer())
 }
 
 func Test_UpdateVersionValidator(t *testing.T) {
@@ -1447,11 +1528,13 @@ func Test_UpdateVersionValidator(t *testing.T) {
 
 	vg, ok := res.(ValidatorGroup)
 	assert.True(t, ok)
-	assert.Len(t, vg, 3)
+	assert.Len(t, vg, 5)
 
 	assert.Equal(t, reflect.ValueOf(versionIDsValidator()).Pointer(), reflect.ValueOf(vg[0]).Pointer())
 	assert.Equal(t, reflect.ValueOf(currentVersionValidator(nil)).Pointer(), reflect.ValueOf(vg[1]).Pointer())
 	assert.Equal(t, reflect.ValueOf(LatestVersionValidator(nil)).Pointer(), reflect.ValueOf(vg[2]).Pointer())
+	assert.Equal(t, reflect.ValueOf(referenceAttributesValidator(nil)).Pointer(), reflect.ValueOf(vg[3]).Pointer())
+	assert.Equal(t, reflect.ValueOf(validationRulesValidator()).Pointer(), reflect.ValueOf(vg[4]).Pointer())
 }
 
 func Test_PreAnchorValidator(t *testing.T) {
@@ -1479,13 +1562,14 @@ func Test_ReceivedAnchoredDocumentValidator(t *testing.T) {
 
 	vg, ok := res.(ValidatorGroup)
 	assert.True(t, ok)
-	assert.Len(t, vg, 2)
+	assert.Len(t, vg, 3)
 
-	postAnchoredValidator, ok := vg[1].(ValidatorGroup)
+	postAnchoredValidator, ok := vg[2].(ValidatorGroup)
 	assert.True(t, ok)
 	assertPostAnchorValidator(t, postAnchoredValidator)
 
 	assert.Equal(t, reflect.ValueOf(transitionValidator(collaborator)).Pointer(), reflect.ValueOf(vg[0]).Pointer())
+	assert.Equal(t, reflect.ValueOf(validationRulesValidator()).Pointer(), reflect.ValueOf(vg[1]).Pointer())
 }
 
 func Test_RequestDocumentSignatureValidator(t *testing.T) {
@@ -1496,9 +1580,9 @@ func Test_RequestDocumentSignatureValidator(t *testing.T) {
 
 	vg, ok := res.(ValidatorGroup)
 	assert.True(t, ok)
-	assert.Len(t, vg, 6)
+	assert.Len(t, vg, 8)
 
-	signatureValidator, ok := vg[5].(ValidatorGroup)
+	signatureValidator, ok := vg[6].(ValidatorGroup)
 	assert.True(t, ok)
 	assertSignatureValidators(t, signatureValidator)
 
@@ -1507,6 +1591,8 @@ func Test_RequestDocumentSignatureValidator(t *testing.T) {
 	assert.Equal(t, reflect.ValueOf(currentVersionValidator(nil)).Pointer(), reflect.ValueOf(vg[2]).Pointer())
 	assert.Equal(t, reflect.ValueOf(LatestVersionValidator(nil)).Pointer(), reflect.ValueOf(vg[3]).Pointer())
 	assert.Equal(t, reflect.ValueOf(transitionValidator(collaborator)).Pointer(), reflect.ValueOf(vg[4]).Pointer())
+	assert.Equal(t, reflect.ValueOf(validationRulesValidator()).Pointer(), reflect.ValueOf(vg[5]).Pointer())
+	assert.Equal(t, reflect.ValueOf(referenceAttributesValidator(nil)).Pointer(), reflect.ValueOf(vg[7]).Pointer())
 }
 
 func Test_SignatureValidator(t *testing.T) {
@@ -1529,7 +1615,7 @@ func assertPreAnchorValidator(t *testing.T, vg ValidatorGroup) {
 }
 
 func assertPostAnchorValidator(t *testing.T, vg ValidatorGroup) {
-	assert.Len(t, vg, 3)
+	assert.Len(t, vg, 4)
 
 	preAnchorValidator, ok := vg[0].(ValidatorGroup)
 	assert.True(t, ok)
@@ -1537,6 +1623,7 @@ func assertPostAnchorValidator(t *testing.T, vg ValidatorGroup) {
 
 	assert.Equal(t, reflect.ValueOf(anchoredValidator(nil)).Pointer(), reflect.ValueOf(vg[1]).Pointer())
 	assert.Equal(t, reflect.ValueOf(LatestVersionValidator(nil)).Pointer(), reflect.ValueOf(vg[2]).Pointer())
+	assert.Equal(t, reflect.ValueOf(referenceAttributesValidator(nil)).Pointer(), reflect.ValueOf(vg[3]).Pointer())
 }
 
 func assertSignatureValidators(t *testing.T, vg ValidatorGroup) {
@@ -1546,7 +1633,7 @@ func assertSignatureValidators(t *testing.T, vg ValidatorGroup) {
 	assert.Equal(t, reflect.ValueOf(signingRootValidator()).Pointer(), reflect.ValueOf(vg[1]).Pointer())
 	assert.Equal(t, reflect.ValueOf(signaturesValidator(nil)).Pointer(), reflect.ValueOf(vg[2]).Pointer())
 	assert.Equal(t, reflect.ValueOf(attributeValidator(nil)).Pointer(), reflect.ValueOf(vg[3]).Pointer())
-	assert.Equal(t, reflect.ValueOf(computeFieldsValidator(computeFieldsTimeout)).Pointer(), reflect.ValueOf(vg[4]).Pointer())
+	assert.Equal(t, reflect.ValueOf(computeFieldsValidator(computeFieldsGasLimit)).Pointer(), reflect.ValueOf(vg[4]).Pointer())
 }
 
 func TestValidatorGroup_Validate(t *testing.T) {
@@ -1638,3 +1725,72 @@ func TestValidatorGroup_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_referenceAttributesValidator(t *testing.T) {
+	anchorSrv := anchors.NewAPIMock(t)
+	rv := referenceAttributesValidator(anchorSrv)
+
+	// nil model
+	err := rv.Validate(nil, nil)
+	assert.ErrorIs(t, err, ErrModelNil)
+
+	anchoredRoot := utils.RandomSlice(32)
+	docRoot, err := anchors.ToDocumentRoot(anchoredRoot)
+	assert.NoError(t, err)
+
+	unpinned, err := NewReference(utils.RandomSlice(32), nil, nil)
+	assert.NoError(t, err)
+	pinned, err := NewReference(utils.RandomSlice(32), utils.RandomSlice(32), anchoredRoot)
+	assert.NoError(t, err)
+	mismatch, err := NewReference(utils.RandomSlice(32), utils.RandomSlice(32), utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	anchorID := func(id []byte) anchors.AnchorID {
+		anchorID, err := anchors.ToAnchorID(id)
+		assert.NoError(t, err)
+		return anchorID
+	}
+
+	anchorSrv.On("GetAnchorData", anchorID(unpinned.DocumentID)).Return(docRoot, time.Now(), nil)
+	anchorSrv.On("GetAnchorData", anchorID(pinned.VersionID)).Return(docRoot, time.Now(), nil)
+	anchorSrv.On("GetAnchorData", anchorID(mismatch.VersionID)).Return(docRoot, time.Now(), nil)
+
+	unanchored, err := NewReference(utils.RandomSlice(32), nil, nil)
+	assert.NoError(t, err)
+	anchorSrv.On("GetAnchorData", anchorID(unanchored.DocumentID)).Return(nil, time.Time{}, errors.New("error"))
+
+	refAttr := func(label string, ref Reference) Attribute {
+		attr, err := NewReferenceAttribute(label, ref)
+		assert.NoError(t, err)
+		return attr
+	}
+
+	refsList, err := NewListAttribute("refs", []AttrVal{
+		{Type: AttrReference, Reference: unpinned},
+		{Type: AttrReference, Reference: pinned},
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		attrs []Attribute
+		err   error
+	}{
+		{},
+		{attrs: []Attribute{refAttr("ref", unpinned), refAttr("pinned", pinned), refsList}},
+		{attrs: []Attribute{refAttr("ref", unanchored)}, err: ErrReferencedDocumentNotAnchored},
+		{attrs: []Attribute{refAttr("ref", mismatch)}, err: ErrReferencedDocumentRootMismatch},
+	}
+
+	for _, test := range tests {
+		documentMock := NewDocumentMock(t)
+		documentMock.On("GetAttributes").Return(test.attrs).Once()
+
+		err := rv.Validate(nil, documentMock)
+		if test.err == nil {
+			assert.NoError(t, err)
+			continue
+		}
+
+		assert.True(t, errors.IsOfType(test.err, err))
+	}
+}
@@ -131,6 +131,10 @@ func copyBytes(data []byte) []byte {
 }
 
 func copyByteSlice(data [][]byte) [][]byte {
+	if data == nil {
+		return nil
+	}
+
 	nbs := make([][]byte, len(data))
 	for i, b := range data {
 		nbs[i] = copyBytes(b)
@@ -391,12 +395,22 @@ func (cd *CoreDocument) AddTransitionRuleForAttribute(roleID []byte, key AttrKey
 // wasm is the WASM blob
 // fields are the attribute labels that are passed to wasm
 // targetField is the attribute label under which WASM result is stored
-func (cd *CoreDocument) AddComputeFieldsRule(wasm []byte, fields []string, targetField string) (*coredocumentpb.TransitionRule, error) {
-	_, _, _, err := fetchComputeFunctions(wasm)
+// outputType is the attribute type of the WASM result, if empty the raw 32 byte result is stored as bytes
+func (cd *CoreDocument) AddComputeFieldsRule(
+	wasm []byte,
+	fields []string,
+	targetField string,
+	outputType AttributeType,
+) (*coredocumentpb.TransitionRule, error) {
+	_, err := fetchComputeFunctions(wasm)
 	if err != nil {
 		return nil, err
 	}
 
+	if !isComputeFieldsOutputTypeAllowed(outputType) {
+		return nil, errors.NewTypedError(ErrComputeFieldsOutputType, errors.New("type: %s", outputType))
+	}
+
 	if len(fields) < 1 || targetField == "" {
 		return nil, errors.New("at least one non-empty input attribute field and non empty target attribute field is required")
 	}
@@ -417,6 +431,7 @@ func (cd *CoreDocument) AddComputeFieldsRule(wasm []byte, fields []string, targe
 		ComputeFields:      cf,
 		ComputeTargetField: []byte(targetField),
 		ComputeCode:        wasm,
+		OutputType:         computeFieldsProtocolOutputType(outputType),
 	}
 	cd.Document.TransitionRules = append(cd.Document.TransitionRules, rule)
 	cd.Modified = true
@@ -434,15 +449,93 @@ func (cd CoreDocument) GetComputeFieldsRules() []*coredocumentpb.TransitionRule
 		computeFields = append(computeFields, &coredocumentpb.TransitionRule{
 			RuleKey:            rule.RuleKey,
 			Action:             rule.Action,
+			Field:              copyBytes(rule.Field),
 			ComputeFields:      copyByteSlice(rule.ComputeFields),
 			ComputeTargetField: copyBytes(rule.ComputeTargetField),
 			ComputeCode:        copyBytes(rule.ComputeCode),
+			OutputType:         rule.OutputType,
 		})
 	}
 
 	return computeFields
 }
 
+// AddValidationRule adds a new validation rule.
+// expression is the boolean expression that every version of the document must satisfy.
+func (cd *CoreDocument) AddValidationRule(expression string) (*coredocumentpb.TransitionRule, error) {
+	if _, err := parseValidationRule(expression); err != nil {
+		return nil, err
+	}
+
+	rule := &coredocumentpb.TransitionRule{
+		RuleKey:    utils.RandomSlice(32),
+		Action:     coredocumentpb.TransitionAction_TRANSITION_ACTION_VALIDATE,
+		Expression: expression,
+	}
+	cd.Document.TransitionRules = append(cd.Document.TransitionRules, rule)
+	cd.Modified = true
+	return rule, nil
+}
+
+// GetValidationRules returns all the validation rules from the document.
+func (cd CoreDocument) GetValidationRules() []*coredocumentpb.TransitionRule {
+	var validationRules []*coredocumentpb.TransitionRule
+	for _, rule := range cd.Document.TransitionRules {
+		if rule.Action != coredocumentpb.TransitionAction_TRANSITION_ACTION_VALIDATE {
+			continue
+		}
+
+		validationRules = append(validationRules, &coredocumentpb.TransitionRule{
+			RuleKey:    copyBytes(rule.RuleKey),
+			Action:     rule.Action,
+			Expression: rule.Expression,
+		})
+	}
+
+	return validationRules
+}
+
+// DecodedTransitionRule is a transition rule with the label of the attribute that it grants write access to.
+type DecodedTransitionRule struct {
+	*coredocumentpb.TransitionRule
+
+	// AttributeLabel is empty if the rule is not an attribute rule or the attribute is not part of the document.
+	AttributeLabel string
+}
+
+// GetTransitionRules returns all the transition rules of the document with the attribute labels decoded.
+func (cd *CoreDocument) GetTransitionRules() []DecodedTransitionRule {
+	var rules []DecodedTransitionRule
+	for _, rule := range cd.Document.TransitionRules {
+		decodedRule := DecodedTransitionRule{TransitionRule: rule}
+		if key, ok := attrKeyFromFieldPrefix(rule); ok {
+			if attr, ok := cd.Attributes[key]; ok {
+				decodedRule.AttributeLabel = attr.KeyLabel
+			}
+		}
+
+		rules = append(rules, decodedRule)
+	}
+
+	return rules
+}
+
+// attrKeyFromFieldPrefix returns the attribute key of the rules created by AddTransitionRuleForAttribute.
+func attrKeyFromFieldPrefix(rule *coredocumentpb.TransitionRule) (key AttrKey, ok bool) {
+	if rule.MatchType != coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_PREFIX ||
+		rule.Action != coredocumentpb.TransitionAction_TRANSITION_ACTION_EDIT {
+		return key, false
+	}
+
+	// the field ends with the attribute key
+	if len(rule.Field) < len(key) {
+		return key, false
+	}
+
+	copy(key[:], rule.Field[len(rule.Field)-len(key):])
+	return key, bytes.Equal(getAttributeFieldPrefix(key), rule.Field)
+}
+
 // GetTransitionRule returns the transition rule associated with ruleID in the document.
 func (cd *CoreDocument) GetTransitionRule(ruleID []byte) (*coredocumentpb.TransitionRule, error) {
 	for _, r := range cd.Document.TransitionRules {
@@ -485,6 +578,54 @@ func (cd *CoreDocument) deleteRoleFromDefaultRules(roleID []byte) {
 	}
 }
 
+// isOnlyRoleOfDefaultRules checks if the role is the only role of the read sign rules, of the edit rule of the
+// core document or of the default field rules.
+func (cd *CoreDocument) isOnlyRoleOfDefaultRules(roleKey []byte) bool {
+	isOnlyRole := func(roles [][]byte) bool {
+		return len(roles) == 1 && bytes.Equal(roles[0], roleKey)
+	}
+
+	for _, rule := range cd.Document.ReadRules {
+		if rule.Action == coredocumentpb.Action_ACTION_READ_SIGN && isOnlyRole(rule.Roles) {
+			return true
+		}
+	}
+
+	fieldMap := defaultRuleFieldProps()
+	cdPrefix := CompactProperties(CDTreePrefix)
+	for _, rule := range cd.Document.TransitionRules {
+		if rule.Action != coredocumentpb.TransitionAction_TRANSITION_ACTION_EDIT || !isOnlyRole(rule.Roles) {
+			continue
+		}
+
+		if _, ok := fieldMap[hexutil.Encode(rule.Field)]; ok || bytes.Equal(rule.Field, cdPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deleteRoleFromTransitionRules removes the role from the transition rules and deletes the rules that are left
+// without roles. Rules that never had roles, such as compute fields and validation rules, are kept.
+func (cd *CoreDocument) deleteRoleFromTransitionRules(roleKey []byte) {
+	var rules []*coredocumentpb.TransitionRule
+	for _, rule := range cd.Document.TransitionRules {
+		if !byteutils.ContainsBytesInSlice(rule.Roles, roleKey) {
+			rules = append(rules, rule)
+			continue
+		}
+
+		rule.Roles = byteutils.RemoveBytesFromSlice(rule.Roles, roleKey)
+		if len(rule.Roles) > 0 {
+			rules = append(rules, rule)
+		}
+	}
+
+	cd.Document.TransitionRules = rules
+	cd.Modified = true
+}
+
 // deleteRule deletes the rule associated with the ruleID.
 // returns nil if the rule doesn't exist else the rule is deleted
 func (cd *CoreDocument) deleteRule(ruleID []byte) *coredocumentpb.TransitionRule {
@@ -96,12 +96,14 @@ func TestWriteACLs_getChangedFields_with_core_document(t *testing.T) {
 	// transition_rules.Field
 	// transition_rules.ComputeFields
 	// transition_rules.ComputeTargetField
-	// transition_rules.ComputeCode) x 2
+	// transition_rules.ComputeCode
+	// transition_rules.OutputType
+	// transition_rules.Expression) x 2
 	// roles + 2
 	oldTree := getTree(t, doc.Document, "", nil)
 	newTree := getTree(t, ndoc.Document, "", nil)
 	cf := GetChangedFields(oldTree, newTree)
-	assert.Len(t, cf, 23)
+	assert.Len(t, cf, 27)
 	rprop := append(ndoc.Document.Roles[0].RoleKey, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0)
 	rprop2 := append(ndoc.Document.Roles[1].RoleKey, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0)
 	eprops := map[string]struct{}{
@@ -129,6 +131,10 @@ func TestWriteACLs_getChangedFields_with_core_document(t *testing.T) {
 		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 6}):                         {},
 		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 7}):                         {},
 		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 8}):                         {},
+		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9}):                         {},
+		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10}):                        {},
+		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 9}):                         {},
+		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 10}):                        {},
 		hexutil.Encode(append([]byte{0, 0, 0, 1}, rprop...)):                                            {},
 		hexutil.Encode(append([]byte{0, 0, 0, 1}, rprop2...)):                                           {},
 	}
@@ -178,7 +184,7 @@ func TestWriteACLs_getChangedFields_with_core_document(t *testing.T) {
 	oldTree = getTree(t, doc.Document, "", nil)
 	newTree = getTree(t, ndoc.Document, "", nil)
 	cf = GetChangedFields(oldTree, newTree)
-	assert.Len(t, cf, 24)
+	assert.Len(t, cf, 28)
 	rprop = append(doc.Document.Roles[0].RoleKey, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0)
 	rprop2 = append(doc.Document.Roles[1].RoleKey, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0)
 	eprops = map[string]struct{}{
@@ -198,6 +204,10 @@ func TestWriteACLs_getChangedFields_with_core_document(t *testing.T) {
 		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 6}):                         {},
 		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 7}):                         {},
 		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 8}):                         {},
+		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9}):                         {},
+		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10}):                        {},
+		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 9}):                         {},
+		hexutil.Encode([]byte{0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 10}):                        {},
 		hexutil.Encode([]byte{0, 0, 0, 9}):                                                              {},
 		hexutil.Encode([]byte{0, 0, 0, 4}):                                                              {},
 		hexutil.Encode([]byte{0, 0, 0, 3}):                                                              {},
@@ -432,7 +442,7 @@ func TestWriteACLs_validateTransitions_roles_read_rules(t *testing.T) {
 	// 1. update to roles
 	// 2. update to read_rules
 	// 3. update to read_rules action
-	assert.Equal(t, 18, errors.Len(err))
+	assert.Equal(t, 22, errors.Len(err))
 
 	// check with some random collaborator who has no permission at all
 	randomIdentity, err := testingcommons.GetRandomAccountID()
@@ -444,9 +454,9 @@ func TestWriteACLs_validateTransitions_roles_read_rules(t *testing.T) {
 	// all the identifier changes = 6
 	// role changes = 2
 	// read_rule changes = 2
-	// transition rule changes = 10
+	// transition rule changes = 14
 	// total = 9
-	assert.Equal(t, 23, errors.Len(err))
+	assert.Equal(t, 27, errors.Len(err))
 }
 
 func TestWriteACLs_validate_transitions_nfts(t *testing.T) {
@@ -815,6 +825,31 @@ func Test_deleteRule(t *testing.T) {
 	assert.Len(t, cd.Document.TransitionRules, 5)
 }
 
+func TestCoreDocument_GetTransitionRules(t *testing.T) {
+	cd, rule, _ := setupRules(t)
+
+	// the attribute of the rule is not part of the document
+	rules := cd.GetTransitionRules()
+	assert.Len(t, rules, 8)
+	assert.Equal(t, rule, rules[7].TransitionRule)
+	assert.Empty(t, rules[7].AttributeLabel)
+
+	attr, err := NewStringAttribute("test1", AttrString, "value")
+	assert.NoError(t, err)
+
+	cd, err = cd.AddAttributes(CollaboratorsAccess{}, false, nil, attr)
+	assert.NoError(t, err)
+
+	rules = cd.GetTransitionRules()
+	assert.Len(t, rules, 8)
+	assert.Equal(t, "test1", rules[7].AttributeLabel)
+
+	// default rules are not attribute rules
+	for _, r := range rules[:7] {
+		assert.Empty(t, r.AttributeLabel)
+	}
+}
+
 func TestCoreDocument_DeleteTransitionRule(t *testing.T) {
 	cd, rule1, role := setupRules(t)
 
@@ -852,7 +887,7 @@ func TestCoreDocument_AddComputeFieldsRule(t *testing.T) {
 	// invalid wasm
 	wasmPath := path.AppendPathToProjectRoot("testingutils/compute_fields/without_allocate.wasm")
 	wasm := wasmLoader(t, wasmPath)
-	rules, err := cd.AddComputeFieldsRule(wasm, nil, "")
+	rules, err := cd.AddComputeFieldsRule(wasm, nil, "", "")
 	assert.Error(t, err)
 	assert.Nil(t, rules)
 	assert.Len(t, cd.GetComputeFieldsRules(), 0)
@@ -860,34 +895,47 @@ func TestCoreDocument_AddComputeFieldsRule(t *testing.T) {
 	// invalid attribute labels
 	wasmPath = path.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm")
 	wasm = wasmLoader(t, wasmPath)
-	rules, err = cd.AddComputeFieldsRule(wasm, nil, "result")
+	rules, err = cd.AddComputeFieldsRule(wasm, nil, "result", "")
 	assert.Error(t, err)
 	assert.Nil(t, rules)
 	assert.Len(t, cd.GetComputeFieldsRules(), 0)
 
-	rules, err = cd.AddComputeFieldsRule(wasm, []string{""}, "result")
+	rules, err = cd.AddComputeFieldsRule(wasm, []string{""}, "result", "")
 	assert.Error(t, err)
 	assert.True(t, errors.IsOfType(ErrEmptyAttrLabel, err))
 	assert.Nil(t, rules)
 	assert.Len(t, cd.GetComputeFieldsRules(), 0)
 
-	rules, err = cd.AddComputeFieldsRule(wasm, []string{"test"}, "")
+	rules, err = cd.AddComputeFieldsRule(wasm, []string{"test"}, "", "")
 	assert.Error(t, err)
 	assert.Nil(t, rules)
 	assert.Len(t, cd.GetComputeFieldsRules(), 0)
 
+	// unsupported output type
+	rules, err = cd.AddComputeFieldsRule(wasm, []string{"test"}, "result", AttrList)
+	assert.True(t, errors.IsOfType(ErrComputeFieldsOutputType, err))
+	assert.Nil(t, rules)
+	assert.Len(t, cd.GetComputeFieldsRules(), 0)
+
 	// add a compute fields rule
-	rules, err = cd.AddComputeFieldsRule(wasm, []string{"test"}, "result")
+	rules, err = cd.AddComputeFieldsRule(wasm, []string{"test"}, "result", "")
 	assert.NoError(t, err)
 	assert.Len(t, cd.GetComputeFieldsRules(), 1)
 	assert.Equal(t, rules, cd.GetComputeFieldsRules()[0])
+
+	// add a compute fields rule with an output type
+	rules, err = cd.AddComputeFieldsRule(wasm, []string{"test"}, "decimal_result", AttrDecimal)
+	assert.NoError(t, err)
+	assert.Len(t, cd.GetComputeFieldsRules(), 2)
+	assert.Equal(t, rules, cd.GetComputeFieldsRules()[1])
+	assert.Equal(t, AttrDecimal, ComputeFieldsOutputType(cd.GetComputeFieldsRules()[1]))
 }
 
 func TestCoreDocument_CollaboratorCanUpdate(t *testing.T) {
 	doc, _, id2, docType := prepareDocument(t)
 	wasmPath := path.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm")
 	wasm := wasmLoader(t, wasmPath)
-	_, err := doc.AddComputeFieldsRule(wasm, []string{"test", "test2", "test3"}, "result")
+	_, err := doc.AddComputeFieldsRule(wasm, []string{"test", "test2", "test3"}, "result", "")
 	assert.NoError(t, err)
 
 	// id2 has write access to only `test`, `test1`, `test2` attributes but not to `result` that is generated
@@ -900,7 +948,7 @@ func TestCoreDocument_CollaboratorCanUpdate(t *testing.T) {
 	}
 
 	// this should add new
-	err = doc.ExecuteComputeFields(computeFieldsTimeout)
+	err = doc.ExecuteComputeFields(computeFieldsGasLimit)
 	assert.NoError(t, err)
 	assert.Len(t, doc.Attributes, 1)
 	var key AttrKey
@@ -926,7 +974,7 @@ func TestCoreDocument_CollaboratorCanUpdate(t *testing.T) {
 	assert.NoError(t, err)
 
 	// simulate compute fields run
-	err = attrDoc.ExecuteComputeFields(computeFieldsTimeout)
+	err = attrDoc.ExecuteComputeFields(computeFieldsGasLimit)
 	assert.NoError(t, err)
 	assert.Len(t, attrDoc.Attributes, 4)
 	attr, err := attrDoc.GetAttribute(key)
@@ -938,3 +986,67 @@ func TestCoreDocument_CollaboratorCanUpdate(t *testing.T) {
 	err = doc.CollaboratorCanUpdate(ndoc, id2, docType)
 	assert.NoError(t, err)
 }
+
+func TestCoreDocument_CollaboratorCanUpdate_listElement(t *testing.T) {
+	doc, _, id2, docType := prepareDocument(t)
+	list, err := NewListAttribute("line_items", []AttrVal{
+		{Type: AttrString, Str: "first"},
+		{Type: AttrString, Str: "second"},
+	})
+	assert.NoError(t, err)
+	doc, err = doc.AddAttributes(CollaboratorsAccess{}, false, nil, list)
+	assert.NoError(t, err)
+
+	// id2 can only update the first line item
+	role, err := doc.AddRole("line_item_editor", []*types.AccountID{id2})
+	assert.NoError(t, err)
+	elemKey, err := AttrKeyFromLabel(ListElementLabel("line_items", 0))
+	assert.NoError(t, err)
+	_, err = doc.AddTransitionRuleForAttribute(role.RoleKey, elemKey)
+	assert.NoError(t, err)
+
+	updateElement := func(index int, value string) *CoreDocument {
+		elems := append([]AttrVal(nil), list.Value.List...)
+		elems[index] = AttrVal{Type: AttrString, Str: value}
+		updated, err := NewListAttribute("line_items", elems)
+		assert.NoError(t, err)
+		ndoc, err := doc.PrepareNewVersion([]byte(docType), CollaboratorsAccess{}, nil)
+		assert.NoError(t, err)
+		ndoc, err = ndoc.AddAttributes(CollaboratorsAccess{}, false, nil, updated)
+		assert.NoError(t, err)
+		return ndoc
+	}
+
+	assert.NoError(t, doc.CollaboratorCanUpdate(updateElement(0, "updated"), id2, docType))
+	assert.Error(t, doc.CollaboratorCanUpdate(updateElement(1, "updated"), id2, docType))
+}
+
+func TestCoreDocument_AddValidationRule(t *testing.T) {
+	cd, err := newCoreDocument()
+	assert.NoError(t, err)
+
+	// invalid expression
+	rule, err := cd.AddValidationRule(`new("amount")`)
+	assert.True(t, errors.IsOfType(ErrValidationRuleExpression, err))
+	assert.Nil(t, rule)
+	assert.Len(t, cd.GetValidationRules(), 0)
+
+	// compute fields rules are not validation rules
+	wasm := wasmLoader(t, path.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"))
+	_, err = cd.AddComputeFieldsRule(wasm, []string{"test"}, "result", "")
+	assert.NoError(t, err)
+	assert.Len(t, cd.GetValidationRules(), 0)
+
+	expression := `!exists(old("amount")) || new("amount") >= old("amount")`
+	rule, err = cd.AddValidationRule(expression)
+	assert.NoError(t, err)
+	assert.True(t, cd.Modified)
+	assert.Equal(t, coredocumentpb.TransitionAction_TRANSITION_ACTION_VALIDATE, rule.Action)
+	assert.Equal(t, expression, rule.Expression)
+	assert.Len(t, cd.GetValidationRules(), 1)
+	assert.Equal(t, rule, cd.GetValidationRules()[0])
+
+	// the returned rules are copies
+	cd.GetValidationRules()[0].Expression = "x"
+	assert.Equal(t, expression, cd.GetValidationRules()[0].Expression)
+}
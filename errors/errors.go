@@ -136,11 +136,7 @@ func (t *typedError) IsOfType(terr error) bool {
 		return true
 	}
 
-	if cterr, ok := t.ctxErr.(TypedError); ok {
-		return cterr.IsOfType(terr)
-	}
-
-	return t.ctxErr.Error() == terr.Error()
+	return IsOfType(terr, t.ctxErr)
 }
 
 // Mask returns a mask to hide the actual error to prevent guessing attacks using error messages on p2p
@@ -155,6 +151,15 @@ func IsOfType(terr, err error) bool {
 		return errt.IsOfType(terr)
 	}
 
+	// a list error is of type terr if any of its errors is
+	if errl, ok := err.(listError); ok {
+		for _, e := range errl {
+			if IsOfType(terr, e) {
+				return true
+			}
+		}
+	}
+
 	if serr, ok := status.FromError(err); ok {
 		return serr.Message() == terr.Error()
 	}
@@ -113,6 +113,13 @@ func TestIsOfType(t *testing.T) {
 	terr = NewTypedError(errBadErr, lerr)
 	assert.True(t, IsOfType(errBadErr, terr))
 
+	// typed error in a list error
+	lerr = AppendError(serr, NewTypedError(ErrUnknown, serr))
+	assert.True(t, IsOfType(ErrUnknown, lerr))
+	assert.False(t, IsOfType(errBadErr, lerr))
+	terr = NewTypedError(errBadErr, lerr)
+	assert.True(t, IsOfType(ErrUnknown, terr))
+
 	// status err
 	serr = status.Error(codes.Unknown, errBadErr.Error())
 	assert.True(t, IsOfType(errBadErr, serr))
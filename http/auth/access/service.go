@@ -137,7 +137,7 @@ type adminValidationService struct {
 }
 
 var (
-	adminPathRegex = regexp.MustCompile(`^/v2/accounts(|/generate|/0x[a-fA-F0-9]+)$`)
+	adminPathRegex = regexp.MustCompile(`^(/v2/accounts(|/generate|/0x[a-fA-F0-9]+)|/v3/operator/(balance|peers))$`)
 )
 
 func getAdminValidationService(
@@ -277,6 +277,18 @@ func Test_adminValidationService_Matches(t *testing.T) {
 			Path:          "/v2/accounts/0xabc0123/sign",
 			MatchExpected: false,
 		},
+		{
+			Path:          "/v3/operator/balance",
+			MatchExpected: true,
+		},
+		{
+			Path:          "/v3/operator/peers",
+			MatchExpected: true,
+		},
+		{
+			Path:          "/v3/operator/jobs",
+			MatchExpected: false,
+		},
 	}
 
 	for _, test := range tests {
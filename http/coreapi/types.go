@@ -8,6 +8,7 @@ import (
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/generic"
 	"github.com/centrifuge/pod/errors"
 	v2 "github.com/centrifuge/pod/identity/v2"
 	nftv3 "github.com/centrifuge/pod/nft/v3"
@@ -25,6 +26,28 @@ type MonetaryValue struct {
 	ID      string             `json:"id"`
 }
 
+// ReferenceValue holds the document referenced by a reference attribute.
+// VersionID and DocumentRoot are optional and pin the reference to a specific anchored version.
+type ReferenceValue struct {
+	DocumentID   byteutils.HexBytes `json:"document_id" swaggertype:"primitive,string"`
+	VersionID    byteutils.HexBytes `json:"version_id,omitempty" swaggertype:"primitive,string"`
+	DocumentRoot byteutils.HexBytes `json:"document_root,omitempty" swaggertype:"primitive,string"`
+}
+
+// AttachmentValue describes the file of an attachment attribute, which is stored off-document.
+type AttachmentValue struct {
+	Hash     byteutils.HexBytes `json:"hash" swaggertype:"primitive,string"`
+	Size     uint64             `json:"size"`
+	MIMEType string             `json:"mime_type"`
+}
+
+// AttachmentResponse describes an uploaded attachment file.
+// CID is the IPFS content identifier of the file, if it was pinned.
+type AttachmentResponse struct {
+	AttachmentValue
+	CID string `json:"cid,omitempty"`
+}
+
 // SignedValue contains the Identity of who signed the attribute and value which was signed
 type SignedValue struct {
 	Identity *types.AccountID   `json:"identity" swaggertype:"primitive,string"`
@@ -36,11 +59,18 @@ type AttributeMapRequest map[string]AttributeRequest
 
 // CreateDocumentRequest defines the payload for creating documents.
 type CreateDocumentRequest struct {
-	Scheme      string              `json:"scheme" enums:"generic,entity"`
+	Scheme      string              `json:"scheme" enums:"generic,entity,invoice"`
 	ReadAccess  []*types.AccountID  `json:"read_access" swaggertype:"array,string"`
 	WriteAccess []*types.AccountID  `json:"write_access" swaggertype:"array,string"`
 	Data        interface{}         `json:"data"`
 	Attributes  AttributeMapRequest `json:"attributes"`
+
+	// SignaturePolicy defines the signatures that are required before the document is anchored.
+	// The signatures are collected on a best effort basis if no policy is provided.
+	SignaturePolicy *documents.SignaturePolicy `json:"signature_policy,omitempty"`
+
+	// Schema is the ID of a registered schema that the attributes of a generic document must satisfy.
+	Schema string `json:"schema,omitempty"`
 }
 
 // GenerateAccountPayload holds required fields to generate account with defaults.
@@ -60,10 +90,18 @@ func (g *GenerateAccountPayload) ToCreateIdentityRequest() *v2.CreateIdentityReq
 // Type type of the attribute
 // Value simple value of the attribute
 // MonetaryValue value for only monetary attribute
+// ReferenceValue value for only reference attribute
+// AttachmentValue value for only attachment attribute
+// List elements of a list attribute
+// Map elements of a map attribute
 type AttributeRequest struct {
-	Type          string         `json:"type" enums:"integer,decimal,string,bytes,timestamp,monetary"`
-	Value         string         `json:"value"`
-	MonetaryValue *MonetaryValue `json:"monetary_value,omitempty"`
+	Type            string                      `json:"type" enums:"integer,decimal,string,bytes,timestamp,monetary,reference,attachment,list,map"`
+	Value           string                      `json:"value"`
+	MonetaryValue   *MonetaryValue              `json:"monetary_value,omitempty"`
+	ReferenceValue  *ReferenceValue             `json:"reference_value,omitempty"`
+	AttachmentValue *AttachmentValue            `json:"attachment_value,omitempty"`
+	List            []AttributeRequest          `json:"list,omitempty"`
+	Map             map[string]AttributeRequest `json:"map,omitempty"`
 }
 
 // AttributeResponse adds key to the attribute.
@@ -101,7 +139,7 @@ type ResponseHeader struct {
 // DocumentResponse is the common response for Document APIs.
 type DocumentResponse struct {
 	Header     ResponseHeader       `json:"header"`
-	Scheme     string               `json:"scheme" enums:"generic,entity"`
+	Scheme     string               `json:"scheme" enums:"generic,entity,invoice"`
 	Data       interface{}          `json:"data"`
 	Attributes AttributeMapResponse `json:"attributes"`
 }
@@ -110,22 +148,9 @@ type DocumentResponse struct {
 func ToDocumentAttributes(cattrs map[string]AttributeRequest) (map[documents.AttrKey]documents.Attribute, error) {
 	attrs := make(map[documents.AttrKey]documents.Attribute)
 	for k, v := range cattrs {
-		var attr documents.Attribute
-		var err error
-		switch documents.AttributeType(v.Type) {
-		case documents.AttrMonetary:
-			if v.MonetaryValue == nil {
-				return nil, errors.NewTypedError(documents.ErrWrongAttrFormat, errors.New("empty value field"))
-			}
-			attr, err = documents.NewMonetaryAttribute(k, v.MonetaryValue.Value, v.MonetaryValue.ChainID.Bytes(), v.MonetaryValue.ID)
-			if err != nil {
-				return nil, err
-			}
-		default:
-			attr, err = documents.NewStringAttribute(k, documents.AttributeType(v.Type), v.Value)
-			if err != nil {
-				return nil, err
-			}
+		attr, err := toDocumentAttribute(k, v)
+		if err != nil {
+			return nil, err
 		}
 
 		attrs[attr.Key] = attr
@@ -134,6 +159,60 @@ func ToDocumentAttributes(cattrs map[string]AttributeRequest) (map[documents.Att
 	return attrs, nil
 }
 
+// toDocumentAttribute converts a single AttributeRequest to a document attribute.
+// The elements of list and map attributes are converted recursively.
+func toDocumentAttribute(label string, v AttributeRequest) (documents.Attribute, error) {
+	switch documents.AttributeType(v.Type) {
+	case documents.AttrMonetary:
+		if v.MonetaryValue == nil {
+			return documents.Attribute{}, errors.NewTypedError(documents.ErrWrongAttrFormat, errors.New("empty value field"))
+		}
+		return documents.NewMonetaryAttribute(label, v.MonetaryValue.Value, v.MonetaryValue.ChainID.Bytes(), v.MonetaryValue.ID)
+	case documents.AttrReference:
+		if v.ReferenceValue == nil {
+			return documents.Attribute{}, errors.NewTypedError(documents.ErrWrongAttrFormat, errors.New("empty reference value"))
+		}
+		return documents.NewReferenceAttribute(label, documents.Reference{
+			DocumentID:   v.ReferenceValue.DocumentID,
+			VersionID:    v.ReferenceValue.VersionID,
+			DocumentRoot: v.ReferenceValue.DocumentRoot,
+		})
+	case documents.AttrAttachment:
+		if v.AttachmentValue == nil {
+			return documents.Attribute{}, errors.NewTypedError(documents.ErrWrongAttrFormat, errors.New("empty attachment value"))
+		}
+		return documents.NewAttachmentAttribute(label, documents.Attachment{
+			Hash:     v.AttachmentValue.Hash,
+			Size:     v.AttachmentValue.Size,
+			MIMEType: v.AttachmentValue.MIMEType,
+		})
+	case documents.AttrList:
+		elems := make([]documents.AttrVal, 0, len(v.List))
+		for i, e := range v.List {
+			elem, err := toDocumentAttribute(documents.ListElementLabel(label, i), e)
+			if err != nil {
+				return documents.Attribute{}, err
+			}
+
+			elems = append(elems, elem.Value)
+		}
+		return documents.NewListAttribute(label, elems)
+	case documents.AttrMap:
+		elems := make(map[string]documents.AttrVal, len(v.Map))
+		for k, e := range v.Map {
+			elem, err := toDocumentAttribute(documents.MapElementLabel(label, k), e)
+			if err != nil {
+				return documents.Attribute{}, err
+			}
+
+			elems[k] = elem.Value
+		}
+		return documents.NewMapAttribute(label, elems)
+	default:
+		return documents.NewStringAttribute(label, documents.AttributeType(v.Type), v.Value)
+	}
+}
+
 // ToDocumentsCreatePayload converts CoreAPI create payload to documents payload.
 func ToDocumentsCreatePayload(request CreateDocumentRequest) (documents.CreatePayload, error) {
 	payload := documents.CreatePayload{
@@ -142,6 +221,7 @@ func ToDocumentsCreatePayload(request CreateDocumentRequest) (documents.CreatePa
 			ReadCollaborators:      request.ReadAccess,
 			ReadWriteCollaborators: request.WriteAccess,
 		},
+		SignaturePolicy: request.SignaturePolicy,
 	}
 
 	data, err := json.Marshal(request.Data)
@@ -156,6 +236,19 @@ func ToDocumentsCreatePayload(request CreateDocumentRequest) (documents.CreatePa
 	}
 	payload.Attributes = attrs
 
+	if request.Schema != "" {
+		if request.Scheme != generic.Scheme {
+			return payload, errors.New("schemas are only supported by %s documents", generic.Scheme)
+		}
+
+		schemaAttr, err := documents.NewStringAttribute(generic.SchemaAttributeLabel, documents.AttrString, request.Schema)
+		if err != nil {
+			return payload, err
+		}
+
+		payload.Attributes[schemaAttr.Key] = schemaAttr
+	}
+
 	return payload, nil
 }
 
@@ -187,46 +280,101 @@ func convertNFTs(nfts []*coredocumentpb.NFT) ([]*NFT, error) {
 func toAttributeMapResponse(attrs []documents.Attribute) (AttributeMapResponse, error) {
 	m := make(AttributeMapResponse)
 	for _, v := range attrs {
-		vx := v // convert to value
-		attrRes := AttributeResponse{
-			Key: vx.Key[:],
+		attrRes, err := ToAttributeResponse(v)
+		if err != nil {
+			return nil, err
 		}
-		switch vx.Value.Type {
-		case documents.AttrMonetary:
-			id := string(vx.Value.Monetary.ID)
-			if vx.Value.Monetary.Type == documents.MonetaryToken {
-				id = hexutil.Encode(vx.Value.Monetary.ID)
-			}
-			attrRes.AttributeRequest = AttributeRequest{
-				Type: vx.Value.Type.String(),
-				MonetaryValue: &MonetaryValue{
-					Value:   vx.Value.Monetary.Value,
-					ChainID: vx.Value.Monetary.ChainID,
-					ID:      id,
-				},
-			}
-		case documents.AttrSigned:
-			signed := SignedValue{
-				Identity: v.Value.Signed.Identity,
-				Value:    v.Value.Signed.Value,
-			}
-			attrRes.SignedValue = signed
-			attrRes.Type = v.Value.Type.String()
-		default:
-			val, err := vx.Value.String()
+
+		m[v.KeyLabel] = attrRes
+	}
+
+	return m, nil
+}
+
+// ToAttributeResponse converts the document attribute to the attribute response.
+func ToAttributeResponse(attr documents.Attribute) (AttributeResponse, error) {
+	attrRes := AttributeResponse{
+		Key: attr.Key[:],
+	}
+	switch attr.Value.Type {
+	case documents.AttrMonetary:
+		id := string(attr.Value.Monetary.ID)
+		if attr.Value.Monetary.Type == documents.MonetaryToken {
+			id = hexutil.Encode(attr.Value.Monetary.ID)
+		}
+		attrRes.AttributeRequest = AttributeRequest{
+			Type: attr.Value.Type.String(),
+			MonetaryValue: &MonetaryValue{
+				Value:   attr.Value.Monetary.Value,
+				ChainID: attr.Value.Monetary.ChainID,
+				ID:      id,
+			},
+		}
+	case documents.AttrReference:
+		attrRes.AttributeRequest = AttributeRequest{
+			Type: attr.Value.Type.String(),
+			ReferenceValue: &ReferenceValue{
+				DocumentID:   attr.Value.Reference.DocumentID,
+				VersionID:    attr.Value.Reference.VersionID,
+				DocumentRoot: attr.Value.Reference.DocumentRoot,
+			},
+		}
+	case documents.AttrAttachment:
+		attrRes.AttributeRequest = AttributeRequest{
+			Type:            attr.Value.Type.String(),
+			AttachmentValue: toAttachmentValue(attr.Value.Attachment),
+		}
+	case documents.AttrSigned:
+		signed := SignedValue{
+			Identity: attr.Value.Signed.Identity,
+			Value:    attr.Value.Signed.Value,
+		}
+		attrRes.SignedValue = signed
+		attrRes.Type = attr.Value.Type.String()
+	case documents.AttrList:
+		attrRes.AttributeRequest = AttributeRequest{
+			Type: attr.Value.Type.String(),
+			List: make([]AttributeRequest, 0, len(attr.Value.List)),
+		}
+		for i, elem := range attr.Value.List {
+			elemRes, err := ToAttributeResponse(documents.Attribute{
+				KeyLabel: documents.ListElementLabel(attr.KeyLabel, i),
+				Value:    elem,
+			})
 			if err != nil {
-				return nil, err
-			}
-			attrRes.AttributeRequest = AttributeRequest{
-				Type:  vx.Value.Type.String(),
-				Value: val,
+				return attrRes, err
 			}
+
+			attrRes.List = append(attrRes.List, elemRes.AttributeRequest)
+		}
+	case documents.AttrMap:
+		attrRes.AttributeRequest = AttributeRequest{
+			Type: attr.Value.Type.String(),
+			Map:  make(map[string]AttributeRequest, len(attr.Value.Map)),
 		}
+		for k, elem := range attr.Value.Map {
+			elemRes, err := ToAttributeResponse(documents.Attribute{
+				KeyLabel: documents.MapElementLabel(attr.KeyLabel, k),
+				Value:    elem,
+			})
+			if err != nil {
+				return attrRes, err
+			}
 
-		m[vx.KeyLabel] = attrRes
+			attrRes.Map[k] = elemRes.AttributeRequest
+		}
+	default:
+		val, err := attr.Value.String()
+		if err != nil {
+			return attrRes, err
+		}
+		attrRes.AttributeRequest = AttributeRequest{
+			Type:  attr.Value.Type.String(),
+			Value: val,
+		}
 	}
 
-	return m, nil
+	return attrRes, nil
 }
 
 // DeriveResponseHeader derives an appropriate response header
@@ -320,6 +468,49 @@ func ConvertProofs(proof *documents.DocumentProof) ProofsResponse {
 	}
 }
 
+func toAttachmentValue(att documents.Attachment) *AttachmentValue {
+	return &AttachmentValue{
+		Hash:     att.Hash,
+		Size:     att.Size,
+		MIMEType: att.MIMEType,
+	}
+}
+
+// ToAttachmentResponse converts the attachment and the IPFS CID of its file to AttachmentResponse.
+func ToAttachmentResponse(att documents.Attachment, cid string) AttachmentResponse {
+	return AttachmentResponse{
+		AttachmentValue: *toAttachmentValue(att),
+		CID:             cid,
+	}
+}
+
+// ReferenceProofsRequest holds the fields for which proofs are generated for a document and for the
+// document referenced by one of its attributes.
+type ReferenceProofsRequest struct {
+	Attribute        string   `json:"attribute"`
+	Fields           []string `json:"fields"`
+	ReferencedFields []string `json:"referenced_fields"`
+}
+
+// ReferenceProofsResponse holds the proofs of a document and of the document it references, together with the
+// version and document root of the referenced document that were proved.
+type ReferenceProofsResponse struct {
+	Document               ProofsResponse     `json:"document"`
+	ReferencedDocument     ProofsResponse     `json:"referenced_document"`
+	ReferencedVersionID    byteutils.HexBytes `json:"referenced_version_id" swaggertype:"primitive,string"`
+	ReferencedDocumentRoot byteutils.HexBytes `json:"referenced_document_root" swaggertype:"primitive,string"`
+}
+
+// ConvertReferenceProofs converts documents.ReferenceProof to ReferenceProofsResponse
+func ConvertReferenceProofs(proof *documents.ReferenceProof) ReferenceProofsResponse {
+	return ReferenceProofsResponse{
+		Document:               ConvertProofs(proof.Document),
+		ReferencedDocument:     ConvertProofs(proof.ReferencedDocument),
+		ReferencedVersionID:    proof.ReferencedVersionID,
+		ReferencedDocumentRoot: proof.ReferencedDocumentRoot,
+	}
+}
+
 // SignRequest holds the payload to be signed.
 type SignRequest struct {
 	Payload byteutils.HexBytes `json:"payload" swaggertype:"primitive,string"`
@@ -340,9 +531,10 @@ type Account struct {
 	WebhookURL       string `json:"webhook_url"`
 	PrecommitEnabled bool   `json:"precommit_enabled"`
 
-	DocumentSigningPublicKey byteutils.HexBytes `json:"document_signing_public_key"`
-	P2PPublicSigningKey      byteutils.HexBytes `json:"p2p_public_signing_key"`
-	PodOperatorAccountID     *types.AccountID   `json:"pod_operator_account_id"`
+	DocumentSigningPublicKey    byteutils.HexBytes `json:"document_signing_public_key"`
+	DocumentEncryptionPublicKey byteutils.HexBytes `json:"document_encryption_public_key"`
+	P2PPublicSigningKey         byteutils.HexBytes `json:"p2p_public_signing_key"`
+	PodOperatorAccountID        *types.AccountID   `json:"pod_operator_account_id"`
 }
 
 // Accounts holds a list of accounts
@@ -414,3 +606,28 @@ type CreateNFTCollectionV3Response struct {
 type PodOperatorResponse struct {
 	PodOperatorAccountID *types.AccountID `json:"pod_operator_account_id" swaggertype:"primitive,string"`
 }
+
+// OperatorBalanceResponse is the response object for a v3/operator/balance GET request.
+type OperatorBalanceResponse struct {
+	Operator             *types.AccountID `json:"operator" swaggertype:"primitive,string"`
+	FreeBalance          string           `json:"free_balance"`
+	PendingJobs          int              `json:"pending_jobs"`
+	EstimatedPendingCost string           `json:"estimated_pending_cost"`
+	LowBalanceThreshold  string           `json:"low_balance_threshold"`
+}
+
+// PeerResponse holds the details of a peer known by the pod.
+type PeerResponse struct {
+	PeerID    string           `json:"peer_id"`
+	AccountID *types.AccountID `json:"account_id,omitempty" swaggertype:"primitive,string"`
+	Addresses []string         `json:"addresses"`
+	Connected bool             `json:"connected"`
+	Static    bool             `json:"static"`
+	Latency   string           `json:"latency,omitempty"`
+	LastSeen  string           `json:"last_seen,omitempty"`
+}
+
+// OperatorPeersResponse is the response object for a v3/operator/peers GET request.
+type OperatorPeersResponse struct {
+	Peers []PeerResponse `json:"peers"`
+}
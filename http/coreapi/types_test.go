@@ -12,6 +12,7 @@ import (
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/generic"
 	"github.com/centrifuge/pod/errors"
 	testingcommons "github.com/centrifuge/pod/testingutils/common"
 	"github.com/centrifuge/pod/utils"
@@ -175,6 +176,28 @@ func TestTypes_toDocumentCreatePayload(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestTypes_toDocumentCreatePayload_Schema(t *testing.T) {
+	request := CreateDocumentRequest{Scheme: generic.Scheme, Schema: "schema-id"}
+
+	payload, err := ToDocumentsCreatePayload(request)
+	assert.NoError(t, err)
+
+	schemaKey, err := documents.AttrKeyFromLabel(generic.SchemaAttributeLabel)
+	assert.NoError(t, err)
+
+	schemaAttr, ok := payload.Attributes[schemaKey]
+	assert.True(t, ok)
+	assert.Equal(t, documents.AttrString, schemaAttr.Value.Type)
+	assert.Equal(t, "schema-id", schemaAttr.Value.Str)
+
+	// schemas are not supported by other schemes
+	request.Scheme = "invoice"
+	request.Data = invoiceData()
+
+	_, err = ToDocumentsCreatePayload(request)
+	assert.Error(t, err)
+}
+
 func TestTypes_convertNFTs(t *testing.T) {
 	collectionID1 := types.U64(rand.Uint64())
 	collectionID2 := types.U64(rand.Uint64())
@@ -356,6 +379,132 @@ func TestTypes_toAttributeMapResponse(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestTypes_toAttributeMapResponse_ListAndMap(t *testing.T) {
+	dec, err := documents.NewDecimal("10.5")
+	assert.NoError(t, err)
+
+	attrs := AttributeMapRequest{
+		"line_items": {
+			Type: "list",
+			List: []AttributeRequest{
+				{
+					Type: "map",
+					Map: map[string]AttributeRequest{
+						"description": {Type: "string", Value: "widget"},
+						"amount": {
+							Type:          "monetary",
+							MonetaryValue: &MonetaryValue{ID: "USD", Value: dec, ChainID: []byte{1}},
+						},
+					},
+				},
+				{Type: "string", Value: "note"},
+			},
+		},
+	}
+
+	atts, err := ToDocumentAttributes(attrs)
+	assert.NoError(t, err)
+	assert.Len(t, atts, 1)
+
+	var attrList []documents.Attribute
+	for _, v := range atts {
+		assert.Equal(t, documents.AttrList, v.Value.Type)
+		assert.Len(t, v.Value.List, 2)
+		attrList = append(attrList, v)
+	}
+
+	cattrs, err := toAttributeMapResponse(attrList)
+	assert.NoError(t, err)
+	assert.Equal(t, attrs["line_items"].List, cattrs["line_items"].List)
+	assert.Equal(t, "list", cattrs["line_items"].Type)
+
+	// invalid element
+	attrs["line_items"].List[1] = AttributeRequest{Type: "unknown", Value: "some value"}
+	_, err = ToDocumentAttributes(attrs)
+	assert.Error(t, err)
+
+	attrs["line_items"] = AttributeRequest{Type: "map", Map: map[string]AttributeRequest{
+		"empty": {Type: "monetary"},
+	}}
+	_, err = ToDocumentAttributes(attrs)
+	assert.Error(t, err)
+}
+
+func TestTypes_toAttributeMapResponse_Reference(t *testing.T) {
+	attrs := AttributeMapRequest{
+		"invoice": {
+			Type: "reference",
+			ReferenceValue: &ReferenceValue{
+				DocumentID: utils.RandomSlice(32),
+				VersionID:  utils.RandomSlice(32),
+			},
+		},
+	}
+
+	atts, err := ToDocumentAttributes(attrs)
+	assert.NoError(t, err)
+	assert.Len(t, atts, 1)
+
+	var attrList []documents.Attribute
+	for _, v := range atts {
+		assert.Equal(t, documents.AttrReference, v.Value.Type)
+		attrList = append(attrList, v)
+	}
+
+	cattrs, err := toAttributeMapResponse(attrList)
+	assert.NoError(t, err)
+	assert.Equal(t, attrs["invoice"].ReferenceValue.DocumentID, cattrs["invoice"].ReferenceValue.DocumentID)
+	assert.Equal(t, attrs["invoice"].ReferenceValue.VersionID, cattrs["invoice"].ReferenceValue.VersionID)
+	assert.Empty(t, cattrs["invoice"].ReferenceValue.DocumentRoot)
+
+	// missing reference value
+	attrs["invoice"] = AttributeRequest{Type: "reference"}
+	_, err = ToDocumentAttributes(attrs)
+	assert.True(t, errors.IsOfType(documents.ErrWrongAttrFormat, err))
+
+	// invalid document ID
+	attrs["invoice"] = AttributeRequest{Type: "reference", ReferenceValue: &ReferenceValue{DocumentID: []byte{1}}}
+	_, err = ToDocumentAttributes(attrs)
+	assert.True(t, errors.IsOfType(documents.ErrWrongAttrFormat, err))
+}
+
+func TestTypes_toAttributeMapResponse_Attachment(t *testing.T) {
+	attrs := AttributeMapRequest{
+		"invoice_pdf": {
+			Type: "attachment",
+			AttachmentValue: &AttachmentValue{
+				Hash:     utils.RandomSlice(32),
+				Size:     1024,
+				MIMEType: "application/pdf",
+			},
+		},
+	}
+
+	atts, err := ToDocumentAttributes(attrs)
+	assert.NoError(t, err)
+	assert.Len(t, atts, 1)
+
+	var attrList []documents.Attribute
+	for _, v := range atts {
+		assert.Equal(t, documents.AttrAttachment, v.Value.Type)
+		attrList = append(attrList, v)
+	}
+
+	cattrs, err := toAttributeMapResponse(attrList)
+	assert.NoError(t, err)
+	assert.Equal(t, attrs["invoice_pdf"].AttachmentValue, cattrs["invoice_pdf"].AttachmentValue)
+
+	// missing attachment value
+	attrs["invoice_pdf"] = AttributeRequest{Type: "attachment"}
+	_, err = ToDocumentAttributes(attrs)
+	assert.True(t, errors.IsOfType(documents.ErrWrongAttrFormat, err))
+
+	// missing MIME type
+	attrs["invoice_pdf"] = AttributeRequest{Type: "attachment", AttachmentValue: &AttachmentValue{Hash: utils.RandomSlice(32)}}
+	_, err = ToDocumentAttributes(attrs)
+	assert.True(t, errors.IsOfType(documents.ErrWrongAttrFormat, err))
+}
+
 func invoiceData() map[string]interface{} {
 	return map[string]interface{}{
 		"number":       "12345",
@@ -161,7 +161,7 @@ func TestRouter(t *testing.T) {
 	// health pattern
 	assert.Equal(t, "/ping", r.Routes()[0].Pattern)
 	// v2 routes
-	assert.Len(t, r.Routes()[1].SubRoutes.Routes(), 25)
+	assert.Len(t, r.Routes()[1].SubRoutes.Routes(), 33)
 	// v3 routes
-	assert.Len(t, r.Routes()[2].SubRoutes.Routes(), 7)
+	assert.Len(t, r.Routes()[2].SubRoutes.Routes(), 9)
 }
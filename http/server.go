@@ -11,6 +11,7 @@ import (
 	"github.com/centrifuge/pod/utils/httputils"
 	"github.com/go-chi/render"
 	logging "github.com/ipfs/go-log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/net/context"
 )
 
@@ -57,6 +58,10 @@ func (c apiServer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr cha
 		Handler: mux,
 	}
 
+	if metricsAddr := c.config.GetMetricsAddress(); metricsAddr != "" {
+		startMetricsServer(ctx, metricsAddr)
+	}
+
 	startUpErrOut := make(chan error)
 	go func(startUpErrInner chan<- error) {
 		log.Infof("HTTP API running at: %s\n", c.config.GetServerAddress())
@@ -93,3 +98,31 @@ func (c apiServer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr cha
 		return
 	}
 }
+
+// startMetricsServer serves the prometheus metrics on a dedicated address until the context is done.
+// The metrics endpoint is not authenticated, hence it's not part of the API router.
+func startMetricsServer(ctx context.Context, addr string) {
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: promhttp.Handler(),
+	}
+
+	go func() {
+		log.Infof("Metrics running at: %s\n", addr)
+
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorf("Metrics server error: %s", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+
+		ctxn, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+
+		if err := srv.Shutdown(ctxn); err != nil {
+			log.Errorf("Couldn't shut down metrics server: %s", err)
+		}
+	}()
+}
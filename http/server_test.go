@@ -4,6 +4,9 @@ package http
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"net/http"
 	"sync"
 	"testing"
 	"time"
@@ -49,6 +52,10 @@ func TestServer_Start(t *testing.T) {
 		Return(true).
 		Once()
 
+	configMock.On("GetMetricsAddress").
+		Return("").
+		Once()
+
 	configMock.On("GetNetworkString").
 		Return("network").
 		Once()
@@ -133,6 +140,10 @@ func TestServer_Start_HTTPServerError(t *testing.T) {
 		Return(true).
 		Once()
 
+	configMock.On("GetMetricsAddress").
+		Return("").
+		Once()
+
 	configMock.On("GetNetworkString").
 		Return("network").
 		Once()
@@ -188,6 +199,10 @@ func TestServer_Start_CanceledContext(t *testing.T) {
 		Return(true).
 		Once()
 
+	configMock.On("GetMetricsAddress").
+		Return("").
+		Once()
+
 	configMock.On("GetNetworkString").
 		Return("network").
 		Once()
@@ -207,3 +222,39 @@ func TestServer_Start_CanceledContext(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestStartMetricsServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	addr := lis.Addr().String()
+	assert.NoError(t, lis.Close())
+
+	ctx, canc := context.WithCancel(context.Background())
+
+	startMetricsServer(ctx, addr)
+
+	var res *http.Response
+
+	for i := 0; i < 50; i++ {
+		res, err = http.Get(fmt.Sprintf("http://%s/metrics", addr))
+
+		if err == nil {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.NoError(t, res.Body.Close())
+
+	canc()
+
+	assert.Eventually(t, func() bool {
+		_, err := http.Get(fmt.Sprintf("http://%s/metrics", addr))
+
+		return err != nil
+	}, 5*time.Second, 100*time.Millisecond)
+}
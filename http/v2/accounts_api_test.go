@@ -16,8 +16,10 @@ import (
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/contextutil"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/documents/entity"
 	"github.com/centrifuge/pod/documents/entityrelationship"
+	"github.com/centrifuge/pod/documents/generic"
 	"github.com/centrifuge/pod/errors"
 	"github.com/centrifuge/pod/http/coreapi"
 	v2 "github.com/centrifuge/pod/identity/v2"
@@ -52,6 +54,7 @@ func TestHandler_GenerateAccount(t *testing.T) {
 	webhookURL := "https://centrifuge.io"
 	precommitEnabled := true
 	documentSigningPublicKey := utils.RandomSlice(32)
+	documentEncryptionPublicKey := utils.RandomSlice(32)
 
 	payload := coreapi.GenerateAccountPayload{
 		Account: coreapi.Account{
@@ -85,6 +88,9 @@ func TestHandler_GenerateAccount(t *testing.T) {
 	accountMock.On("GetSigningPublicKey").
 		Return(documentSigningPublicKey).
 		Once()
+	accountMock.On("GetEncryptionPublicKey").
+		Return(documentEncryptionPublicKey).
+		Once()
 
 	pubKey, _, err := testingcommons.GetTestSigningKeys()
 	assert.NoError(t, err)
@@ -111,6 +117,7 @@ func TestHandler_GenerateAccount(t *testing.T) {
 	assert.Equal(t, webhookURL, resAccount.WebhookURL)
 	assert.Equal(t, precommitEnabled, resAccount.PrecommitEnabled)
 	assert.Equal(t, documentSigningPublicKey, resAccount.DocumentSigningPublicKey.Bytes())
+	assert.Equal(t, documentEncryptionPublicKey, resAccount.DocumentEncryptionPublicKey.Bytes())
 	assert.Equal(t, rawPubKey, resAccount.P2PPublicSigningKey.Bytes())
 	assert.Equal(t, podOperator.GetAccountID(), resAccount.PodOperatorAccountID)
 }
@@ -396,6 +403,7 @@ func TestHandler_GetSelf(t *testing.T) {
 	webhookURL := "https://centrifuge.io"
 	precommitEnabled := true
 	documentSigningPublicKey := utils.RandomSlice(32)
+	documentEncryptionPublicKey := utils.RandomSlice(32)
 
 	accountMock.On("GetIdentity").
 		Return(randomAccountID).
@@ -409,6 +417,9 @@ func TestHandler_GetSelf(t *testing.T) {
 	accountMock.On("GetSigningPublicKey").
 		Return(documentSigningPublicKey).
 		Once()
+	accountMock.On("GetEncryptionPublicKey").
+		Return(documentEncryptionPublicKey).
+		Once()
 
 	pubKey, _, err := testingcommons.GetTestSigningKeys()
 	assert.NoError(t, err)
@@ -435,6 +446,7 @@ func TestHandler_GetSelf(t *testing.T) {
 	assert.Equal(t, webhookURL, resAccount.WebhookURL)
 	assert.Equal(t, precommitEnabled, resAccount.PrecommitEnabled)
 	assert.Equal(t, documentSigningPublicKey, resAccount.DocumentSigningPublicKey.Bytes())
+	assert.Equal(t, documentEncryptionPublicKey, resAccount.DocumentEncryptionPublicKey.Bytes())
 	assert.Equal(t, rawPubKey, resAccount.P2PPublicSigningKey.Bytes())
 	assert.Equal(t, podOperator.GetAccountID(), resAccount.PodOperatorAccountID)
 }
@@ -494,6 +506,7 @@ func TestHandler_GetAccount(t *testing.T) {
 	webhookURL := "https://centrifuge.io"
 	precommitEnabled := true
 	documentSigningPublicKey := utils.RandomSlice(32)
+	documentEncryptionPublicKey := utils.RandomSlice(32)
 
 	accountMock.On("GetIdentity").
 		Return(randomAccountID).
@@ -507,6 +520,9 @@ func TestHandler_GetAccount(t *testing.T) {
 	accountMock.On("GetSigningPublicKey").
 		Return(documentSigningPublicKey).
 		Once()
+	accountMock.On("GetEncryptionPublicKey").
+		Return(documentEncryptionPublicKey).
+		Once()
 
 	pubKey, _, err := testingcommons.GetTestSigningKeys()
 	assert.NoError(t, err)
@@ -533,6 +549,7 @@ func TestHandler_GetAccount(t *testing.T) {
 	assert.Equal(t, webhookURL, resAccount.WebhookURL)
 	assert.Equal(t, precommitEnabled, resAccount.PrecommitEnabled)
 	assert.Equal(t, documentSigningPublicKey, resAccount.DocumentSigningPublicKey.Bytes())
+	assert.Equal(t, documentEncryptionPublicKey, resAccount.DocumentEncryptionPublicKey.Bytes())
 	assert.Equal(t, rawPubKey, resAccount.P2PPublicSigningKey.Bytes())
 	assert.Equal(t, podOperator.GetAccountID(), resAccount.PodOperatorAccountID)
 }
@@ -590,10 +607,12 @@ func TestHandler_GetAccounts(t *testing.T) {
 	accountID1, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 	signingPublicKey1 := utils.RandomSlice(32)
+	encryptionPublicKey1 := utils.RandomSlice(32)
 	webhookURL1 := "https://centrifuge.io/1"
 	accountID2, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 	signingPublicKey2 := utils.RandomSlice(32)
+	encryptionPublicKey2 := utils.RandomSlice(32)
 	webhookURL2 := "https://centrifuge.io/2"
 
 	accountMock1 := config.NewAccountMock(t)
@@ -605,6 +624,8 @@ func TestHandler_GetAccounts(t *testing.T) {
 		Return(true)
 	accountMock1.On("GetSigningPublicKey").
 		Return(signingPublicKey1)
+	accountMock1.On("GetEncryptionPublicKey").
+		Return(encryptionPublicKey1)
 	accountMock2 := config.NewAccountMock(t)
 	accountMock2.On("GetIdentity").
 		Return(accountID2)
@@ -614,6 +635,8 @@ func TestHandler_GetAccounts(t *testing.T) {
 		Return(false)
 	accountMock2.On("GetSigningPublicKey").
 		Return(signingPublicKey2)
+	accountMock2.On("GetEncryptionPublicKey").
+		Return(encryptionPublicKey2)
 
 	configAccounts := []config.Account{accountMock1, accountMock2}
 
@@ -644,6 +667,7 @@ func TestHandler_GetAccounts(t *testing.T) {
 
 	assert.Equal(t, configAccounts[0].GetIdentity(), resAccounts.Data[0].Identity)
 	assert.Equal(t, configAccounts[0].GetSigningPublicKey(), resAccounts.Data[0].DocumentSigningPublicKey.Bytes())
+	assert.Equal(t, configAccounts[0].GetEncryptionPublicKey(), resAccounts.Data[0].DocumentEncryptionPublicKey.Bytes())
 	assert.Equal(t, configAccounts[0].GetWebhookURL(), resAccounts.Data[0].WebhookURL)
 	assert.Equal(t, configAccounts[0].GetPrecommitEnabled(), resAccounts.Data[0].PrecommitEnabled)
 	assert.Equal(t, rawPubKey, resAccounts.Data[0].P2PPublicSigningKey.Bytes())
@@ -651,6 +675,7 @@ func TestHandler_GetAccounts(t *testing.T) {
 
 	assert.Equal(t, configAccounts[1].GetIdentity(), resAccounts.Data[1].Identity)
 	assert.Equal(t, configAccounts[1].GetSigningPublicKey(), resAccounts.Data[1].DocumentSigningPublicKey.Bytes())
+	assert.Equal(t, configAccounts[1].GetEncryptionPublicKey(), resAccounts.Data[1].DocumentEncryptionPublicKey.Bytes())
 	assert.Equal(t, configAccounts[1].GetWebhookURL(), resAccounts.Data[1].WebhookURL)
 	assert.Equal(t, configAccounts[1].GetPrecommitEnabled(), resAccounts.Data[1].PrecommitEnabled)
 	assert.Equal(t, rawPubKey, resAccounts.Data[1].P2PPublicSigningKey.Bytes())
@@ -692,6 +717,9 @@ func getServiceWithMocks(t *testing.T) (*Service, []any) {
 	identityServiceMock := v2.NewServiceMock(t)
 	entityRelationshipServiceMock := entityrelationship.NewServiceMock(t)
 	documentServiceMock := documents.NewServiceMock(t)
+	p2pClientMock := documents.NewClientMock(t)
+	schemaRegistryMock := generic.NewSchemaRegistryMock(t)
+	attachmentServiceMock := attachments.NewServiceMock(t)
 
 	configMock := config.NewConfigurationMock(t)
 
@@ -720,6 +748,9 @@ func getServiceWithMocks(t *testing.T) (*Service, []any) {
 		identityServiceMock,
 		entityRelationshipServiceMock,
 		documentServiceMock,
+		p2pClientMock,
+		schemaRegistryMock,
+		attachmentServiceMock,
 	)
 	assert.NoError(t, err)
 
@@ -730,5 +761,8 @@ func getServiceWithMocks(t *testing.T) (*Service, []any) {
 		identityServiceMock,
 		entityRelationshipServiceMock,
 		documentServiceMock,
+		p2pClientMock,
+		schemaRegistryMock,
+		attachmentServiceMock,
 	}
 }
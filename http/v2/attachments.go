@@ -0,0 +1,133 @@
+package v2
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/centrifuge/pod/documents/attachments"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/http/coreapi"
+	"github.com/centrifuge/pod/utils/httputils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+)
+
+const (
+	// AttachmentLabelParam is the key for the label of an attachment attribute in the API path.
+	AttachmentLabelParam = "attribute_label"
+
+	// pinQueryParam is the query parameter that requests the pinning of an uploaded attachment to IPFS.
+	pinQueryParam = "pin"
+)
+
+// UploadAttachment stores the file of an attachment.
+// @summary Uploads the file of an attachment.
+// @description Stores the file sent in the request body, using the Content-Type header as its MIME type. The returned attachment value can be used in attachment attributes of documents.
+// @id upload_attachment
+// @tags Attachments
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param pin query bool false "Pins the file to IPFS"
+// @param body body string true "File content"
+// @accept octet-stream
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @Failure 500 {object} httputils.HTTPError
+// @success 201 {object} coreapi.AttachmentResponse
+// @router /v2/attachments [post]
+func (h handler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	var pin bool
+	if v := r.URL.Query().Get(pinQueryParam); v != "" {
+		pin, err = strconv.ParseBool(v)
+		if err != nil {
+			code = http.StatusBadRequest
+			log.Error(err)
+			return
+		}
+	}
+
+	// One extra byte is read so that files that are too large are rejected.
+	data, err := io.ReadAll(io.LimitReader(r.Body, attachments.MaxAttachmentSize+1))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		return
+	}
+
+	blob, err := h.srv.UploadAttachment(r.Context(), data, r.Header.Get("Content-Type"), pin)
+	if err != nil {
+		code = http.StatusInternalServerError
+		if errors.IsOfType(attachments.ErrInvalidAttachment, err) || errors.IsOfType(attachments.ErrAttachmentTooLarge, err) {
+			code = http.StatusBadRequest
+		}
+
+		log.Error(err)
+		return
+	}
+
+	att, err := blob.Attachment()
+	if err != nil {
+		code = http.StatusInternalServerError
+		log.Error(err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, coreapi.ToAttachmentResponse(att, blob.CID))
+}
+
+// DownloadAttachment returns the file of an attachment attribute of the document.
+// @summary Downloads the file of an attachment attribute of the document.
+// @description Returns the file of the attachment attribute with the label, from the latest version of the document. The file is retrieved from the collaborators of the document if it's not stored by the node.
+// @id download_attachment
+// @tags Attachments
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param document_id path string true "Document Identifier"
+// @param attribute_label path string true "Attachment attribute label"
+// @produce octet-stream
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 404 {object} httputils.HTTPError
+// @success 200 {string} string
+// @router /v2/documents/{document_id}/attachments/{attribute_label} [get]
+func (h handler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	docID, err := hexutil.Decode(chi.URLParam(r, coreapi.DocumentIDParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		err = coreapi.ErrInvalidDocumentID
+		return
+	}
+
+	label, err := url.PathUnescape(chi.URLParam(r, AttachmentLabelParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		return
+	}
+
+	blob, err := h.srv.DownloadAttachment(r.Context(), docID, label)
+	if err != nil {
+		code = http.StatusNotFound
+		log.Error(err)
+		return
+	}
+
+	w.Header().Set("Content-Type", blob.MIMEType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(blob.Data)))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(blob.Data); err != nil {
+		log.Errorf("Couldn't write attachment: %s", err)
+	}
+}
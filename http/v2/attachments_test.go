@@ -0,0 +1,189 @@
+//go:build unit
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/centrifuge/pod/documents/attachments"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/http/coreapi"
+	genericUtils "github.com/centrifuge/pod/testingutils/generic"
+	"github.com/centrifuge/pod/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getAttachmentTestServer(t *testing.T) (*httptest.Server, *attachments.ServiceMock) {
+	service, mocks := getServiceWithMocks(t)
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+
+	return testServer, genericUtils.GetMock[*attachments.ServiceMock](mocks)
+}
+
+func TestHandler_UploadAttachment(t *testing.T) {
+	testServer, attachmentSrvMock := getAttachmentTestServer(t)
+	defer testServer.Close()
+
+	data := utils.RandomSlice(128)
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		fmt.Sprintf("%s/attachments?pin=true", testServer.URL),
+		bytes.NewReader(data),
+	)
+	assert.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/pdf")
+
+	blob, err := attachments.NewBlob(data, "application/pdf")
+	assert.NoError(t, err)
+
+	blob.CID = "cid"
+
+	attachmentSrvMock.On("Upload", mock.Anything, data, "application/pdf", true).
+		Return(blob, nil).
+		Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var attachmentRes coreapi.AttachmentResponse
+
+	err = json.Unmarshal(resBody, &attachmentRes)
+	assert.NoError(t, err)
+	assert.Equal(t, blob.Hash, attachmentRes.Hash.Bytes())
+	assert.Equal(t, uint64(len(data)), attachmentRes.Size)
+	assert.Equal(t, "application/pdf", attachmentRes.MIMEType)
+	assert.Equal(t, "cid", attachmentRes.CID)
+}
+
+func TestHandler_UploadAttachment_InvalidPinParam(t *testing.T) {
+	testServer, _ := getAttachmentTestServer(t)
+	defer testServer.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		fmt.Sprintf("%s/attachments?pin=maybe", testServer.URL),
+		bytes.NewReader(utils.RandomSlice(128)),
+	)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_UploadAttachment_ServiceError(t *testing.T) {
+	testServer, attachmentSrvMock := getAttachmentTestServer(t)
+	defer testServer.Close()
+
+	data := utils.RandomSlice(128)
+
+	attachmentSrvMock.On("Upload", mock.Anything, data, "", false).
+		Return(nil, errors.NewTypedError(attachments.ErrInvalidAttachment, errors.New("error"))).
+		Once()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		fmt.Sprintf("%s/attachments", testServer.URL),
+		bytes.NewReader(data),
+	)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	attachmentSrvMock.On("Upload", mock.Anything, data, "", false).
+		Return(nil, attachments.ErrAttachmentPersistence).
+		Once()
+
+	req, err = http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		fmt.Sprintf("%s/attachments", testServer.URL),
+		bytes.NewReader(data),
+	)
+	assert.NoError(t, err)
+
+	res, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
+
+func TestHandler_DownloadAttachment(t *testing.T) {
+	testServer, attachmentSrvMock := getAttachmentTestServer(t)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+	label := "pdfs[0]"
+
+	blob, err := attachments.NewBlob(utils.RandomSlice(128), "application/pdf")
+	assert.NoError(t, err)
+
+	attachmentSrvMock.On("Download", mock.Anything, documentID, label).
+		Return(blob, nil).
+		Once()
+
+	res, err := http.Get(
+		fmt.Sprintf("%s/documents/%s/attachments/%s", testServer.URL, hexutil.Encode(documentID), url.PathEscape(label)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/pdf", res.Header.Get("Content-Type"))
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, blob.Data, resBody)
+}
+
+func TestHandler_DownloadAttachment_InvalidDocIDParam(t *testing.T) {
+	testServer, _ := getAttachmentTestServer(t)
+	defer testServer.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/documents/invalid-id/attachments/invoice_pdf", testServer.URL))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_DownloadAttachment_ServiceError(t *testing.T) {
+	testServer, attachmentSrvMock := getAttachmentTestServer(t)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	attachmentSrvMock.On("Download", mock.Anything, documentID, "invoice_pdf").
+		Return(nil, attachments.ErrAttachmentNotAvailable).
+		Once()
+
+	res, err := http.Get(fmt.Sprintf("%s/documents/%s/attachments/invoice_pdf", testServer.URL, hexutil.Encode(documentID)))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
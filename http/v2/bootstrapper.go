@@ -4,11 +4,15 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/centrifuge/pod/bootstrap"
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/documents/entity"
 	"github.com/centrifuge/pod/documents/entityrelationship"
+	"github.com/centrifuge/pod/documents/generic"
 	v2 "github.com/centrifuge/pod/identity/v2"
+	"github.com/centrifuge/pod/ipfs"
 	"github.com/centrifuge/pod/jobs"
 	"github.com/centrifuge/pod/pending"
 )
@@ -62,6 +66,30 @@ func (b Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("identity service not initialised")
 	}
 
+	p2pClient, ok := ctx[bootstrap.BootstrappedPeer].(documents.Client)
+
+	if !ok {
+		return errors.New("p2p client not initialised")
+	}
+
+	schemaRegistry, ok := ctx[generic.BootstrappedSchemaRegistry].(generic.SchemaRegistry)
+
+	if !ok {
+		return errors.New("generic schema registry not initialised")
+	}
+
+	attachmentStore, ok := ctx[attachments.BootstrappedStore].(attachments.Store)
+
+	if !ok {
+		return errors.New("attachment store not initialised")
+	}
+
+	pinningService, ok := ctx[ipfs.BootstrappedIPFSPinningService].(ipfs.PinningServiceClient)
+
+	if !ok {
+		return errors.New("ipfs pinning service not initialised")
+	}
+
 	service, err := NewService(
 		pendingDocSrv,
 		dispatcher,
@@ -70,6 +98,9 @@ func (b Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		identityService,
 		erSrv,
 		docSrv,
+		p2pClient,
+		schemaRegistry,
+		attachments.NewService(attachmentStore, docSrv, p2pClient, pinningService),
 	)
 
 	if err != nil {
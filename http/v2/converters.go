@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/http/coreapi"
 	"github.com/centrifuge/pod/utils/byteutils"
@@ -41,15 +42,63 @@ func unmarshalBody(r *http.Request, val interface{}) error {
 	return json.Unmarshal(data, val)
 }
 
-func toClientRole(r *coredocumentpb.Role) Role {
-	return Role{
-		ID:            r.RoleKey,
-		Collaborators: byteutils.ToHexByteSlice(r.Collaborators),
+func toClientRole(r *coredocumentpb.Role) (Role, error) {
+	role := Role{
+		ID: r.RoleKey,
 	}
+
+	for _, c := range r.Collaborators {
+		accountID, err := types.NewAccountID(c)
+		if err != nil {
+			return Role{}, err
+		}
+
+		role.Collaborators = append(role.Collaborators, accountID)
+	}
+
+	for _, n := range r.Nfts {
+		collectionID, itemID, err := documents.DecodeNFT(n)
+		if err != nil {
+			return Role{}, err
+		}
+
+		role.NFTs = append(role.NFTs, &coreapi.NFT{
+			CollectionID: collectionID,
+			ItemID:       itemID.String(),
+		})
+	}
+
+	return role, nil
+}
+
+func toClientRoles(roles []*coredocumentpb.Role) (Roles, error) {
+	res := Roles{Roles: []Role{}}
+	for _, r := range roles {
+		role, err := toClientRole(r)
+		if err != nil {
+			return Roles{}, err
+		}
+
+		res.Roles = append(res.Roles, role)
+	}
+
+	return res, nil
+}
+
+func toClientReadRules(rules []*coredocumentpb.ReadRule) ReadRules {
+	res := ReadRules{Rules: []ReadRule{}}
+	for _, r := range rules {
+		res.Rules = append(res.Rules, ReadRule{
+			Roles:  byteutils.ToHexByteSlice(r.Roles),
+			Action: coredocumentpb.Action_name[int32(r.Action)],
+		})
+	}
+
+	return res
 }
 
 func toClientRule(r *coredocumentpb.TransitionRule) TransitionRule {
-	return TransitionRule{
+	rule := TransitionRule{
 		RuleID:               r.RuleKey,
 		Action:               coredocumentpb.TransitionAction_name[int32(r.Action)],
 		Roles:                byteutils.ToHexByteSlice(r.Roles),
@@ -58,6 +107,15 @@ func toClientRule(r *coredocumentpb.TransitionRule) TransitionRule {
 		Wasm:                 r.ComputeCode,
 		TargetAttributeLabel: r.ComputeTargetField,
 	}
+
+	switch r.Action {
+	case coredocumentpb.TransitionAction_TRANSITION_ACTION_COMPUTE:
+		rule.OutputType = documents.ComputeFieldsOutputType(r).String()
+	case coredocumentpb.TransitionAction_TRANSITION_ACTION_VALIDATE:
+		rule.Expression = r.Expression
+	}
+
+	return rule
 }
 
 func toClientRules(rules []*coredocumentpb.TransitionRule) (tr TransitionRules) {
@@ -68,6 +126,17 @@ func toClientRules(rules []*coredocumentpb.TransitionRule) (tr TransitionRules)
 	return tr
 }
 
+func toClientDecodedRules(rules []documents.DecodedTransitionRule) TransitionRules {
+	tr := TransitionRules{Rules: []TransitionRule{}}
+	for _, r := range rules {
+		rule := toClientRule(r.TransitionRule)
+		rule.AttributeLabel = r.AttributeLabel
+		tr.Rules = append(tr.Rules, rule)
+	}
+
+	return tr
+}
+
 func toDocumentAttributes(attrs coreapi.AttributeMapRequest) ([]documents.Attribute, error) {
 	cattrs, err := coreapi.ToDocumentAttributes(attrs)
 	if err != nil {
@@ -0,0 +1,104 @@
+package v2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/http/coreapi"
+	"github.com/centrifuge/pod/utils/httputils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+)
+
+// DeliveryStatus is the status of the delivery of a document version to a collaborator.
+type DeliveryStatus struct {
+	Recipient   *types.AccountID `json:"recipient" swaggertype:"primitive,string"`
+	State       string           `json:"state" enums:"pending,delivered,failed"`
+	Attempts    int              `json:"attempts"`
+	LastAttempt time.Time        `json:"last_attempt"`
+	NextAttempt *time.Time       `json:"next_attempt,omitempty"`
+	LastError   string           `json:"last_error,omitempty"`
+}
+
+// DeliveryStatusResponse holds the delivery status of a document version for each collaborator.
+type DeliveryStatusResponse struct {
+	Data []DeliveryStatus `json:"data"`
+}
+
+func toClientDeliveryStatus(statuses []*documents.DeliveryStatus) DeliveryStatusResponse {
+	res := DeliveryStatusResponse{Data: []DeliveryStatus{}}
+
+	for _, status := range statuses {
+		clientStatus := DeliveryStatus{
+			Recipient:   status.Recipient,
+			State:       string(status.State),
+			Attempts:    status.Attempts,
+			LastAttempt: status.LastAttempt,
+			LastError:   status.LastError,
+		}
+
+		if status.State == documents.DeliveryStatePending {
+			nextAttempt := status.NextAttempt
+			clientStatus.NextAttempt = &nextAttempt
+		}
+
+		res.Data = append(res.Data, clientStatus)
+	}
+
+	return res
+}
+
+// GetDocumentDeliveryStatus returns the delivery status of the document version for each collaborator.
+// @summary Returns the delivery status of the document version for each collaborator.
+// @description Returns the delivery status of the document version for each collaborator.
+// @id get_document_delivery_status
+// @tags Documents
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param document_id path string true "Document Identifier"
+// @param version_id path string true "Document Version Identifier"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @Failure 404 {object} httputils.HTTPError
+// @Failure 500 {object} httputils.HTTPError
+// @success 200 {object} v2.DeliveryStatusResponse
+// @router /v2/documents/{document_id}/versions/{version_id}/delivery [get]
+func (h handler) GetDocumentDeliveryStatus(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	ids := make([][]byte, 2)
+	for i, idStr := range []string{chi.URLParam(r, coreapi.DocumentIDParam), chi.URLParam(r, coreapi.VersionIDParam)} {
+		var id []byte
+		id, err = hexutil.Decode(idStr)
+		if err != nil {
+			code = http.StatusBadRequest
+			log.Error(err)
+			err = coreapi.ErrInvalidDocumentID
+			return
+		}
+
+		ids[i] = id
+	}
+
+	statuses, err := h.srv.GetDocumentDeliveryStatus(r.Context(), ids[0], ids[1])
+	if err != nil {
+		code = http.StatusInternalServerError
+		log.Error(err)
+
+		if errors.IsOfType(documents.ErrDocumentNotFound, err) {
+			code = http.StatusNotFound
+			err = coreapi.ErrDocumentNotFound
+		}
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, toClientDeliveryStatus(statuses))
+}
@@ -0,0 +1,235 @@
+//go:build unit
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	genericUtils "github.com/centrifuge/pod/testingutils/generic"
+	"github.com/centrifuge/pod/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_GetDocumentDeliveryStatus(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+	documentVersion := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/versions/%s/delivery",
+		testServer.URL,
+		hexutil.Encode(documentID),
+		hexutil.Encode(documentVersion),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On(
+		"GetVersion",
+		mock.Anything,
+		documentID,
+		documentVersion,
+	).Return(documents.NewDocumentMock(t), nil).Once()
+
+	deliveredRecipient, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	pendingRecipient, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	lastAttempt := time.Now().Add(-time.Minute).UTC()
+	nextAttempt := time.Now().Add(time.Minute).UTC()
+
+	genericUtils.GetMock[*documents.ClientMock](mocks).On(
+		"GetDeliveryStatus",
+		mock.Anything,
+		documentID,
+		documentVersion,
+	).Return([]*documents.DeliveryStatus{
+		{
+			Recipient:   deliveredRecipient,
+			State:       documents.DeliveryStateDelivered,
+			Attempts:    1,
+			LastAttempt: lastAttempt,
+		},
+		{
+			Recipient:   pendingRecipient,
+			State:       documents.DeliveryStatePending,
+			Attempts:    2,
+			LastAttempt: lastAttempt,
+			NextAttempt: nextAttempt,
+			LastError:   "error",
+		},
+	}, nil).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var deliveryRes DeliveryStatusResponse
+
+	err = json.Unmarshal(resBody, &deliveryRes)
+	assert.NoError(t, err)
+
+	assert.Len(t, deliveryRes.Data, 2)
+
+	assert.Equal(t, deliveredRecipient, deliveryRes.Data[0].Recipient)
+	assert.Equal(t, string(documents.DeliveryStateDelivered), deliveryRes.Data[0].State)
+	assert.Equal(t, 1, deliveryRes.Data[0].Attempts)
+	assert.True(t, lastAttempt.Equal(deliveryRes.Data[0].LastAttempt))
+	assert.Nil(t, deliveryRes.Data[0].NextAttempt)
+	assert.Empty(t, deliveryRes.Data[0].LastError)
+
+	assert.Equal(t, pendingRecipient, deliveryRes.Data[1].Recipient)
+	assert.Equal(t, string(documents.DeliveryStatePending), deliveryRes.Data[1].State)
+	assert.Equal(t, 2, deliveryRes.Data[1].Attempts)
+	assert.True(t, nextAttempt.Equal(*deliveryRes.Data[1].NextAttempt))
+	assert.Equal(t, "error", deliveryRes.Data[1].LastError)
+}
+
+func TestHandler_GetDocumentDeliveryStatus_InvalidDocIDParam(t *testing.T) {
+	service, _ := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/versions/%s/delivery",
+		testServer.URL,
+		"invalid-doc-id-param",
+		hexutil.Encode(utils.RandomSlice(32)),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_GetDocumentDeliveryStatus_DocumentNotFound(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+	documentVersion := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/versions/%s/delivery",
+		testServer.URL,
+		hexutil.Encode(documentID),
+		hexutil.Encode(documentVersion),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On(
+		"GetVersion",
+		mock.Anything,
+		documentID,
+		documentVersion,
+	).Return(nil, errors.NewTypedError(documents.ErrDocumentNotFound, errors.New("error"))).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestHandler_GetDocumentDeliveryStatus_ClientError(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+	documentVersion := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/versions/%s/delivery",
+		testServer.URL,
+		hexutil.Encode(documentID),
+		hexutil.Encode(documentVersion),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On(
+		"GetVersion",
+		mock.Anything,
+		documentID,
+		documentVersion,
+	).Return(documents.NewDocumentMock(t), nil).Once()
+
+	genericUtils.GetMock[*documents.ClientMock](mocks).On(
+		"GetDeliveryStatus",
+		mock.Anything,
+		documentID,
+		documentVersion,
+	).Return(nil, errors.New("error")).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
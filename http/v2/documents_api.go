@@ -21,7 +21,7 @@ type CreateDocumentRequest struct {
 
 // CloneDocumentRequest defines the payload for creating documents.
 type CloneDocumentRequest struct {
-	Scheme string `json:"scheme" enums:"generic,entity"`
+	Scheme string `json:"scheme" enums:"generic,entity,invoice"`
 }
 
 // UpdateDocumentRequest defines the payload to patch an existing document.
@@ -527,3 +527,49 @@ func (h handler) GenerateProofsForVersion(w http.ResponseWriter, r *http.Request
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, coreapi.ConvertProofs(proofs))
 }
+
+// GenerateReferenceProofs returns proofs for the fields from latest version of the document and for the fields
+// of the document referenced by the given attribute.
+// @summary Generates proofs spanning a document and the document referenced by one of its attributes.
+// @description Generates proofs for the fields from latest version of the document, including the reference attribute, and proofs for the fields of the referenced document version.
+// @id generate_document_reference_proofs
+// @tags Documents
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param document_id path string true "Document Identifier"
+// @param body body coreapi.ReferenceProofsRequest true "Document reference proof request"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @success 200 {object} coreapi.ReferenceProofsResponse
+// @router /v2/documents/{document_id}/reference_proofs [post]
+func (h handler) GenerateReferenceProofs(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	docID, err := hexutil.Decode(chi.URLParam(r, coreapi.DocumentIDParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		err = coreapi.ErrInvalidDocumentID
+		return
+	}
+
+	var req coreapi.ReferenceProofsRequest
+	err = unmarshalBody(r, &req)
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		return
+	}
+
+	proofs, err := h.srv.GenerateReferenceProofs(r.Context(), docID, req.Attribute, req.Fields, req.ReferencedFields)
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, coreapi.ConvertReferenceProofs(proofs))
+}
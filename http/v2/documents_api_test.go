@@ -2340,3 +2340,162 @@ func TestHandler_GenerateProofsForVersion_DocumentSrvError(t *testing.T) {
 func documentData() map[string]interface{} {
 	return map[string]interface{}{}
 }
+
+func TestHandler_GenerateReferenceProofs(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	payload := coreapi.ReferenceProofsRequest{
+		Attribute:        "invoice",
+		Fields:           []string{"field1"},
+		ReferencedFields: []string{"field2"},
+	}
+
+	b, err := json.Marshal(payload)
+	assert.NoError(t, err)
+
+	testURL := fmt.Sprintf("%s/documents/%s/reference_proofs", testServer.URL, hexutil.Encode(documentID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, testURL, bytes.NewReader(b))
+	assert.NoError(t, err)
+
+	newProof := func() *documents.DocumentProof {
+		return &documents.DocumentProof{
+			DocumentID: utils.RandomSlice(32),
+			VersionID:  utils.RandomSlice(32),
+			State:      "state",
+			FieldProofs: []*proofspb.Proof{
+				{
+					Property: &proofspb.Proof_CompactName{
+						CompactName: utils.RandomSlice(32),
+					},
+					Value: utils.RandomSlice(32),
+					Salt:  utils.RandomSlice(32),
+					Hash:  utils.RandomSlice(32),
+					SortedHashes: [][]byte{
+						utils.RandomSlice(32),
+					},
+				},
+			},
+		}
+	}
+
+	referencedDocumentProof := newProof()
+
+	referenceProof := &documents.ReferenceProof{
+		Document:               newProof(),
+		ReferencedDocument:     referencedDocumentProof,
+		ReferencedVersionID:    referencedDocumentProof.VersionID,
+		ReferencedDocumentRoot: utils.RandomSlice(32),
+	}
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On(
+		"CreateReferenceProofs",
+		mock.Anything,
+		documentID,
+		payload.Attribute,
+		payload.Fields,
+		payload.ReferencedFields,
+	).Return(referenceProof, nil).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var proofRes coreapi.ReferenceProofsResponse
+	err = json.Unmarshal(resBody, &proofRes)
+	assert.NoError(t, err)
+
+	expectedProofs := coreapi.ConvertReferenceProofs(referenceProof)
+
+	assert.Equal(t, expectedProofs.Document.Header, proofRes.Document.Header)
+	assert.Equal(t, expectedProofs.Document.FieldProofs, proofRes.Document.FieldProofs)
+	assert.Equal(t, expectedProofs.ReferencedDocument.Header, proofRes.ReferencedDocument.Header)
+	assert.Equal(t, expectedProofs.ReferencedDocument.FieldProofs, proofRes.ReferencedDocument.FieldProofs)
+	assert.Equal(t, expectedProofs.ReferencedVersionID, proofRes.ReferencedVersionID)
+	assert.Equal(t, expectedProofs.ReferencedDocumentRoot, proofRes.ReferencedDocumentRoot)
+}
+
+func TestHandler_GenerateReferenceProofs_InvalidDocIDParam(t *testing.T) {
+	service, _ := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	testURL := fmt.Sprintf("%s/documents/%s/reference_proofs", testServer.URL, "invalid-doc-id-param")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_GenerateReferenceProofs_DocumentSrvError(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	payload := coreapi.ReferenceProofsRequest{
+		Attribute: "invoice",
+	}
+
+	b, err := json.Marshal(payload)
+	assert.NoError(t, err)
+
+	testURL := fmt.Sprintf("%s/documents/%s/reference_proofs", testServer.URL, hexutil.Encode(documentID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, testURL, bytes.NewReader(b))
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).On(
+		"CreateReferenceProofs",
+		mock.Anything,
+		documentID,
+		payload.Attribute,
+		[]string(nil),
+		[]string(nil),
+	).Return(nil, errors.New("error")).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
@@ -25,14 +25,21 @@ func Register(ctx map[string]interface{}, r chi.Router) {
 	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/pending", h.GetPendingDocument)
 	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/committed", h.GetCommittedDocument)
 	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/versions/{"+coreapi.VersionIDParam+"}", h.GetDocumentVersion)
+	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/versions/{"+coreapi.VersionIDParam+"}/delivery",
+		h.GetDocumentDeliveryStatus)
 	r.Post("/documents/{"+coreapi.DocumentIDParam+"}/signed_attribute", h.AddSignedAttribute)
 	r.Delete("/documents/{"+coreapi.DocumentIDParam+"}/collaborators", h.RemoveCollaborators)
+	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/roles", h.GetRoles)
 	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/roles/{"+RoleIDParam+"}", h.GetRole)
 	r.Post("/documents/{"+coreapi.DocumentIDParam+"}/roles", h.AddRole)
 	r.Patch("/documents/{"+coreapi.DocumentIDParam+"}/roles/{"+RoleIDParam+"}", h.UpdateRole)
+	r.Delete("/documents/{"+coreapi.DocumentIDParam+"}/roles/{"+RoleIDParam+"}", h.DeleteRole)
+	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/read_rules", h.GetReadRules)
+	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/transition_rules", h.GetTransitionRules)
 	r.Post("/documents/{"+coreapi.DocumentIDParam+"}/transition_rules", h.AddTransitionRules)
 	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/transition_rules/{"+RuleIDParam+"}", h.GetTransitionRule)
 	r.Delete("/documents/{"+coreapi.DocumentIDParam+"}/transition_rules/{"+RuleIDParam+"}", h.DeleteTransitionRule)
+	r.Post("/documents/{"+coreapi.DocumentIDParam+"}/compute_fields/dry_run", h.DryRunComputeFields)
 	r.Post("/documents/{"+coreapi.DocumentIDParam+"}/attributes", h.AddAttributes)
 	r.Delete("/documents/{"+coreapi.DocumentIDParam+"}/attributes/{"+AttributeKeyParam+"}", h.DeleteAttribute)
 	r.Get("/jobs/{"+jobIDParam+"}", h.Job)
@@ -43,7 +50,13 @@ func Register(ctx map[string]interface{}, r chi.Router) {
 	r.Post("/accounts/{"+coreapi.AccountIDParam+"}/sign", h.SignPayload)
 	r.Get("/relationships/{"+coreapi.DocumentIDParam+"}/entity", h.GetEntityThroughRelationship)
 	r.Get("/entities/{"+coreapi.DocumentIDParam+"}/relationships", h.GetEntityRelationships)
+	r.Post("/schemas", h.RegisterSchema)
+	r.Get("/schemas", h.GetSchemas)
+	r.Get("/schemas/{"+SchemaIDParam+"}", h.GetSchema)
 	r.Post("/documents/{"+coreapi.DocumentIDParam+"}/proofs", h.GenerateProofs)
 	r.Post("/documents/{"+coreapi.DocumentIDParam+"}/versions/{"+coreapi.VersionIDParam+"}/proofs",
 		h.GenerateProofsForVersion)
+	r.Post("/documents/{"+coreapi.DocumentIDParam+"}/reference_proofs", h.GenerateReferenceProofs)
+	r.Post("/attachments", h.UploadAttachment)
+	r.Get("/documents/{"+coreapi.DocumentIDParam+"}/attachments/{"+AttachmentLabelParam+"}", h.DownloadAttachment)
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
 	"github.com/centrifuge/pod/http/coreapi"
 	"github.com/centrifuge/pod/utils/byteutils"
@@ -21,8 +22,14 @@ const ErrInvalidRoleID = errors.Error("invalid role ID")
 
 // Role is a single role in the document.
 type Role struct {
-	ID            byteutils.HexBytes   `json:"id" swaggertype:"primitive,string"`
-	Collaborators []byteutils.HexBytes `json:"collaborators" swaggertype:"array,string"`
+	ID            byteutils.HexBytes `json:"id" swaggertype:"primitive,string"`
+	Collaborators []*types.AccountID `json:"collaborators" swaggertype:"array,string"`
+	NFTs          []*coreapi.NFT     `json:"nfts,omitempty"`
+}
+
+// Roles holds the list of roles in the document.
+type Roles struct {
+	Roles []Role `json:"roles"`
 }
 
 // AddRole used for marshalling add request for role.
@@ -75,8 +82,60 @@ func (h handler) GetRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	role, err := toClientRole(rl)
+	if err != nil {
+		code = http.StatusInternalServerError
+		log.Error(err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, role)
+}
+
+// GetRoles returns all the roles in the document
+// @summary Returns all the roles in the document.
+// @description Returns all the roles in the latest version of the document, with the collaborators and NFTs of each role.
+// @id get_roles
+// @tags Documents
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param document_id path string true "Document Identifier"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @Failure 404 {object} httputils.HTTPError
+// @Failure 500 {object} httputils.HTTPError
+// @success 200 {object} v2.Roles
+// @router /v2/documents/{document_id}/roles [get]
+func (h handler) GetRoles(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	docID, err := hexutil.Decode(chi.URLParam(r, coreapi.DocumentIDParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		err = coreapi.ErrInvalidDocumentID
+		return
+	}
+
+	rls, err := h.srv.GetRoles(r.Context(), docID)
+	if err != nil {
+		code = http.StatusNotFound
+		log.Error(err)
+		return
+	}
+
+	roles, err := toClientRoles(rls)
+	if err != nil {
+		code = http.StatusInternalServerError
+		log.Error(err)
+		return
+	}
+
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, toClientRole(rl))
+	render.JSON(w, r, roles)
 }
 
 // AddRole adds a new role to the document.
@@ -122,8 +181,15 @@ func (h handler) AddRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	role, err := toClientRole(nrl)
+	if err != nil {
+		code = http.StatusInternalServerError
+		log.Error(err)
+		return
+	}
+
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, toClientRole(nrl))
+	render.JSON(w, r, role)
 }
 
 // UpdateRole holds the collaborators that are to be replaced with older one in the role.
@@ -182,6 +248,62 @@ func (h handler) UpdateRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	role, err := toClientRole(rl)
+	if err != nil {
+		code = http.StatusInternalServerError
+		log.Error(err)
+		return
+	}
+
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, toClientRole(rl))
+	render.JSON(w, r, role)
+}
+
+// DeleteRole deletes the role from the document.
+// @summary Deletes the role from the document.
+// @description Deletes the role from the document. The role is removed from the read and transition rules, rules that are left without roles are deleted. The roles that are the only role of the default rules cannot be deleted.
+// @id delete_role
+// @tags Documents
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param document_id path string true "Document Identifier"
+// @param role_id path string true "Role ID"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @Failure 404 {object} httputils.HTTPError
+// @success 204
+// @router /v2/documents/{document_id}/roles/{role_id} [delete]
+func (h handler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	docID, err := hexutil.Decode(chi.URLParam(r, coreapi.DocumentIDParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		err = coreapi.ErrInvalidDocumentID
+		return
+	}
+
+	roleID, err := hexutil.Decode(chi.URLParam(r, RoleIDParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		err = ErrInvalidRoleID
+		return
+	}
+
+	err = h.srv.DeleteRole(r.Context(), docID, roleID)
+	if err != nil {
+		code = http.StatusNotFound
+		if errors.IsOfType(documents.ErrDefaultRulesRole, err) {
+			code = http.StatusBadRequest
+		}
+
+		log.Error(err)
+		return
+	}
+
+	render.NoContent(w, r)
 }
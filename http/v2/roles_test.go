@@ -9,16 +9,21 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/http/coreapi"
 	"github.com/centrifuge/pod/pending"
 	testingcommons "github.com/centrifuge/pod/testingutils/common"
 	genericUtils "github.com/centrifuge/pod/testingutils/generic"
 	"github.com/centrifuge/pod/utils"
+	"github.com/centrifuge/pod/utils/byteutils"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-chi/chi"
 	"github.com/stretchr/testify/assert"
@@ -59,7 +64,7 @@ func TestHandler_GetRole(t *testing.T) {
 			utils.RandomSlice(32),
 		},
 		Nfts: [][]byte{
-			utils.RandomSlice(32),
+			utils.RandomSlice(24),
 		},
 	}
 
@@ -77,7 +82,8 @@ func TestHandler_GetRole(t *testing.T) {
 	resBody, err := ioutil.ReadAll(res.Body)
 	assert.NoError(t, err)
 
-	expectedRoleRes := toClientRole(role)
+	expectedRoleRes, err := toClientRole(role)
+	assert.NoError(t, err)
 
 	var roleRes Role
 
@@ -241,7 +247,7 @@ func TestHandler_AddRole(t *testing.T) {
 			utils.RandomSlice(32),
 		},
 		Nfts: [][]byte{
-			utils.RandomSlice(32),
+			utils.RandomSlice(24),
 		},
 	}
 
@@ -260,7 +266,8 @@ func TestHandler_AddRole(t *testing.T) {
 	resBody, err := ioutil.ReadAll(res.Body)
 	assert.NoError(t, err)
 
-	expectedRoleRes := toClientRole(role)
+	expectedRoleRes, err := toClientRole(role)
+	assert.NoError(t, err)
 
 	var roleRes Role
 
@@ -433,7 +440,7 @@ func TestHandler_UpdateRole(t *testing.T) {
 			utils.RandomSlice(32),
 		},
 		Nfts: [][]byte{
-			utils.RandomSlice(32),
+			utils.RandomSlice(24),
 		},
 	}
 
@@ -452,7 +459,8 @@ func TestHandler_UpdateRole(t *testing.T) {
 	resBody, err := ioutil.ReadAll(res.Body)
 	assert.NoError(t, err)
 
-	expectedRoleRes := toClientRole(role)
+	expectedRoleRes, err := toClientRole(role)
+	assert.NoError(t, err)
 
 	var roleRes Role
 
@@ -613,3 +621,371 @@ func TestHandler_UpdateRole_PendingDocSrvError(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusNotFound, res.StatusCode)
 }
+
+func TestHandler_GetRoles(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles",
+		testServer.URL,
+		hexutil.Encode(documentID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	encodedCollectionID, err := codec.Encode(types.U64(1))
+	assert.NoError(t, err)
+
+	encodedItemID, err := codec.Encode(types.NewU128(*big.NewInt(2)))
+	assert.NoError(t, err)
+
+	roles := []*coredocumentpb.Role{
+		{
+			RoleKey: utils.RandomSlice(32),
+			Collaborators: [][]byte{
+				accountID.ToBytes(),
+			},
+		},
+		{
+			RoleKey: utils.RandomSlice(32),
+			Nfts: [][]byte{
+				append(encodedCollectionID, encodedItemID...),
+			},
+		},
+	}
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"GetRoles",
+		mock.Anything,
+		documentID,
+	).Return(roles, nil).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var rolesRes Roles
+
+	err = json.Unmarshal(resBody, &rolesRes)
+	assert.NoError(t, err)
+
+	assert.Len(t, rolesRes.Roles, 2)
+	assert.Equal(t, byteutils.HexBytes(roles[0].RoleKey), rolesRes.Roles[0].ID)
+	assert.Equal(t, []*types.AccountID{accountID}, rolesRes.Roles[0].Collaborators)
+	assert.Nil(t, rolesRes.Roles[0].NFTs)
+	assert.Equal(t, byteutils.HexBytes(roles[1].RoleKey), rolesRes.Roles[1].ID)
+	assert.Equal(t, []*coreapi.NFT{{CollectionID: 1, ItemID: "2"}}, rolesRes.Roles[1].NFTs)
+}
+
+func TestHandler_GetRoles_InvalidDocIDParam(t *testing.T) {
+	service, _ := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles",
+		testServer.URL,
+		"invalid-doc-id-param",
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_GetRoles_PendingDocSrvError(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles",
+		testServer.URL,
+		hexutil.Encode(documentID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"GetRoles",
+		mock.Anything,
+		documentID,
+	).Return(nil, errors.New("error")).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestHandler_GetRoles_InvalidNFT(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles",
+		testServer.URL,
+		hexutil.Encode(documentID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	roles := []*coredocumentpb.Role{
+		{
+			RoleKey: utils.RandomSlice(32),
+			Nfts: [][]byte{
+				utils.RandomSlice(32),
+			},
+		},
+	}
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"GetRoles",
+		mock.Anything,
+		documentID,
+	).Return(roles, nil).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
+
+func TestHandler_DeleteRole(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+	roleID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles/%s",
+		testServer.URL,
+		hexutil.Encode(documentID),
+		hexutil.Encode(roleID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"DeleteRole",
+		mock.Anything,
+		documentID,
+		roleID,
+	).Return(nil).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func TestHandler_DeleteRole_InvalidDocIDParam(t *testing.T) {
+	service, _ := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles/%s",
+		testServer.URL,
+		"invalid-doc-id-param",
+		hexutil.Encode(utils.RandomSlice(32)),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_DeleteRole_InvalidRoleIDParam(t *testing.T) {
+	service, _ := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles/%s",
+		testServer.URL,
+		hexutil.Encode(utils.RandomSlice(32)),
+		"invalid-role-id-param",
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_DeleteRole_PendingDocSrvError(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+	roleID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles/%s",
+		testServer.URL,
+		hexutil.Encode(documentID),
+		hexutil.Encode(roleID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"DeleteRole",
+		mock.Anything,
+		documentID,
+		roleID,
+	).Return(errors.New("error")).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestHandler_DeleteRole_DefaultRulesRole(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+	roleID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/roles/%s",
+		testServer.URL,
+		hexutil.Encode(documentID),
+		hexutil.Encode(roleID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"DeleteRole",
+		mock.Anything,
+		documentID,
+		roleID,
+	).Return(documents.ErrDefaultRulesRole).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
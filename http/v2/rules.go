@@ -3,6 +3,7 @@ package v2
 import (
 	"net/http"
 
+	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
 	"github.com/centrifuge/pod/http/coreapi"
 	"github.com/centrifuge/pod/pending"
@@ -28,6 +29,18 @@ type TransitionRule struct {
 	AttributeLabels      []byteutils.HexBytes `json:"attribute_labels,omitempty" swaggertype:"array,string"`
 	Wasm                 byteutils.HexBytes   `json:"wasm,omitempty" swaggertype:"primitive,string"`
 	TargetAttributeLabel byteutils.HexBytes   `json:"target_attribute_label,omitempty"`
+	OutputType           string               `json:"output_type,omitempty"`
+	Expression           string               `json:"expression,omitempty"`
+
+	// AttributeLabel is the label of the attribute that the rule grants write access to.
+	AttributeLabel string `json:"attribute_label,omitempty"`
+}
+
+// ComputeFieldsDryRunResponse holds the outcome of a compute fields WASM execution.
+type ComputeFieldsDryRunResponse struct {
+	Result  coreapi.AttributeRequest `json:"result"`
+	GasUsed uint64                   `json:"gas_used"`
+	Error   string                   `json:"error,omitempty"`
 }
 
 // TransitionRules holds the list of transition rule.
@@ -35,6 +48,17 @@ type TransitionRules struct {
 	Rules []TransitionRule `json:"rules"`
 }
 
+// ReadRule holds the roles that are given read access by the action.
+type ReadRule struct {
+	Roles  []byteutils.HexBytes `json:"roles" swaggertype:"array,string"`
+	Action string               `json:"action"`
+}
+
+// ReadRules holds the list of read rules.
+type ReadRules struct {
+	Rules []ReadRule `json:"rules"`
+}
+
 // AddTransitionRules adds a new transition rules to the document.
 // @summary Adds a transition new rules to the document.
 // @description Adds a new transition rules to the document.
@@ -128,6 +152,80 @@ func (h handler) GetTransitionRule(w http.ResponseWriter, r *http.Request) {
 	render.JSON(w, r, toClientRule(rule))
 }
 
+// GetTransitionRules returns all the transition rules in the document
+// @summary Returns all the transition rules in the document.
+// @description Returns all the transition rules in the latest version of the document. Attribute rules include the label of the attribute.
+// @id get_transition_rules
+// @tags Documents
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param document_id path string true "Document Identifier"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @Failure 404 {object} httputils.HTTPError
+// @success 200 {object} v2.TransitionRules
+// @router /v2/documents/{document_id}/transition_rules [get]
+func (h handler) GetTransitionRules(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	docID, err := hexutil.Decode(chi.URLParam(r, coreapi.DocumentIDParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		err = coreapi.ErrInvalidDocumentID
+		return
+	}
+
+	rules, err := h.srv.GetTransitionRules(r.Context(), docID)
+	if err != nil {
+		code = http.StatusNotFound
+		log.Error(err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, toClientDecodedRules(rules))
+}
+
+// GetReadRules returns all the read rules in the document
+// @summary Returns all the read rules in the document.
+// @description Returns all the read rules in the latest version of the document.
+// @id get_read_rules
+// @tags Documents
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param document_id path string true "Document Identifier"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @Failure 404 {object} httputils.HTTPError
+// @success 200 {object} v2.ReadRules
+// @router /v2/documents/{document_id}/read_rules [get]
+func (h handler) GetReadRules(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	docID, err := hexutil.Decode(chi.URLParam(r, coreapi.DocumentIDParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		err = coreapi.ErrInvalidDocumentID
+		return
+	}
+
+	rules, err := h.srv.GetReadRules(r.Context(), docID)
+	if err != nil {
+		code = http.StatusNotFound
+		log.Error(err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, toClientReadRules(rules))
+}
+
 // DeleteTransitionRule deletes the transition rule associated with ruleID from the document.
 // @summary Deletes the transition rule associated with ruleID from the document.
 // @description Deletes the transition rule associated with ruleID from the document.
@@ -172,3 +270,69 @@ func (h handler) DeleteTransitionRule(w http.ResponseWriter, r *http.Request) {
 
 	render.NoContent(w, r)
 }
+
+// DryRunComputeFields executes a compute fields WASM against the document.
+// @summary Executes a compute fields WASM against the document.
+// @description Executes the WASM with the attributes of the latest version of the document, without adding a compute fields rule. The target attribute label is ignored.
+// @id dry_run_compute_fields
+// @tags Documents
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param document_id path string true "Document Identifier"
+// @param body body pending.ComputeFieldsRule true "Compute fields rule"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @Failure 404 {object} httputils.HTTPError
+// @success 200 {object} v2.ComputeFieldsDryRunResponse
+// @router /v2/documents/{document_id}/compute_fields/dry_run [post]
+func (h handler) DryRunComputeFields(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	docID, err := hexutil.Decode(chi.URLParam(r, coreapi.DocumentIDParam))
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		err = coreapi.ErrInvalidDocumentID
+		return
+	}
+
+	var rule pending.ComputeFieldsRule
+	err = unmarshalBody(r, &rule)
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		return
+	}
+
+	execution, err := h.srv.DryRunComputeFieldsRule(r.Context(), docID, rule)
+	if err != nil {
+		code = http.StatusBadRequest
+		if errors.IsOfType(documents.ErrDocumentNotFound, err) {
+			code = http.StatusNotFound
+		}
+
+		log.Error(err)
+		return
+	}
+
+	result, err := coreapi.ToAttributeResponse(documents.Attribute{Value: execution.Result})
+	if err != nil {
+		code = http.StatusInternalServerError
+		log.Error(err)
+		return
+	}
+
+	res := ComputeFieldsDryRunResponse{
+		Result:  result.AttributeRequest,
+		GasUsed: execution.GasUsed,
+	}
+
+	if execution.Err != nil {
+		res.Error = execution.Err.Error()
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, res)
+}
@@ -13,7 +13,9 @@ import (
 	"testing"
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/http/coreapi"
 	"github.com/centrifuge/pod/pending"
 	genericUtils "github.com/centrifuge/pod/testingutils/generic"
 	"github.com/centrifuge/pod/utils"
@@ -57,6 +59,11 @@ func TestHandler_AddTransitionRules(t *testing.T) {
 				TargetAttributeLabel: "target_label1",
 			},
 		},
+		ValidationRules: []pending.ValidationRule{
+			{
+				Expression: `new("target_label1") > 0`,
+			},
+		},
 	}
 
 	b, err := json.Marshal(payload)
@@ -86,6 +93,21 @@ func TestHandler_AddTransitionRules(t *testing.T) {
 			ComputeTargetField: utils.RandomSlice(32),
 			ComputeCode:        utils.RandomSlice(32),
 		},
+		{
+			RuleKey:    utils.RandomSlice(32),
+			Action:     coredocumentpb.TransitionAction_TRANSITION_ACTION_VALIDATE,
+			Expression: `new("target_label1") > 0`,
+		},
+		{
+			RuleKey: utils.RandomSlice(32),
+			Action:  coredocumentpb.TransitionAction_TRANSITION_ACTION_COMPUTE,
+			ComputeFields: [][]byte{
+				utils.RandomSlice(32),
+			},
+			ComputeTargetField: []byte("decimal_result"),
+			ComputeCode:        utils.RandomSlice(32),
+			OutputType:         coredocumentpb.AttributeType_ATTRIBUTE_TYPE_DECIMAL,
+		},
 	}
 
 	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
@@ -110,6 +132,10 @@ func TestHandler_AddTransitionRules(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, expectedRuleRes, ruleRes)
+	assert.Equal(t, coredocumentpb.TransitionAction_TRANSITION_ACTION_VALIDATE.String(), ruleRes.Rules[1].Action)
+	assert.Equal(t, `new("target_label1") > 0`, ruleRes.Rules[1].Expression)
+	assert.Nil(t, ruleRes.Rules[1].Field)
+	assert.Equal(t, documents.AttrDecimal.String(), ruleRes.Rules[2].OutputType)
 }
 
 func TestHandler_AddTransitionRules_InvalidDocIDParam(t *testing.T) {
@@ -546,3 +572,402 @@ func TestHandler_DeleteTransitionRule_PendingDocSrvError(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusNotFound, res.StatusCode)
 }
+
+func TestHandler_DryRunComputeFields(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	payload := pending.ComputeFieldsRule{
+		WASM:            byteutils.HexBytes(utils.RandomSlice(32)),
+		AttributeLabels: []string{"label1"},
+		OutputType:      documents.AttrDecimal,
+	}
+
+	b, err := json.Marshal(payload)
+	assert.NoError(t, err)
+
+	testURL := fmt.Sprintf("%s/documents/%s/compute_fields/dry_run", testServer.URL, hexutil.Encode(documentID))
+
+	zero, err := documents.NewDecimal("0")
+	assert.NoError(t, err)
+
+	execution := documents.ComputeFieldsExecution{
+		Result:  documents.AttrVal{Type: documents.AttrDecimal, Decimal: zero},
+		GasUsed: 100,
+		Err:     documents.ErrComputeFieldsOutOfGas,
+	}
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"DryRunComputeFieldsRule",
+		mock.Anything,
+		documentID,
+		payload,
+	).Return(execution, nil).Once()
+
+	res, err := http.Post(testURL, "application/json", bytes.NewReader(b))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var dryRunRes ComputeFieldsDryRunResponse
+
+	err = json.Unmarshal(resBody, &dryRunRes)
+	assert.NoError(t, err)
+	assert.Equal(t, ComputeFieldsDryRunResponse{
+		Result: coreapi.AttributeRequest{
+			Type:  documents.AttrDecimal.String(),
+			Value: "0",
+		},
+		GasUsed: 100,
+		Error:   documents.ErrComputeFieldsOutOfGas.Error(),
+	}, dryRunRes)
+}
+
+func TestHandler_DryRunComputeFields_InvalidRequest(t *testing.T) {
+	service, _ := getServiceWithMocks(t)
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	res, err := http.Post(
+		fmt.Sprintf("%s/documents/invalid-id/compute_fields/dry_run", testServer.URL),
+		"application/json",
+		bytes.NewReader([]byte("{}")),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	res, err = http.Post(
+		fmt.Sprintf("%s/documents/%s/compute_fields/dry_run", testServer.URL, hexutil.Encode(utils.RandomSlice(32))),
+		"application/json",
+		bytes.NewReader([]byte("invalid-payload")),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_DryRunComputeFields_PendingDocSrvError(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+	testURL := fmt.Sprintf("%s/documents/%s/compute_fields/dry_run", testServer.URL, hexutil.Encode(documentID))
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"DryRunComputeFieldsRule",
+		mock.Anything,
+		documentID,
+		pending.ComputeFieldsRule{},
+	).Return(documents.ComputeFieldsExecution{}, documents.ErrDocumentNotFound).Once()
+
+	res, err := http.Post(testURL, "application/json", bytes.NewReader([]byte("{}")))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"DryRunComputeFieldsRule",
+		mock.Anything,
+		documentID,
+		pending.ComputeFieldsRule{},
+	).Return(documents.ComputeFieldsExecution{}, errors.AppendError(nil, documents.ErrComputeFieldsInvalidWASM)).Once()
+
+	res, err = http.Post(testURL, "application/json", bytes.NewReader([]byte("{}")))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_GetTransitionRules(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/transition_rules",
+		testServer.URL,
+		hexutil.Encode(documentID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	attributeRule := &coredocumentpb.TransitionRule{
+		RuleKey: utils.RandomSlice(32),
+		Roles: [][]byte{
+			utils.RandomSlice(32),
+		},
+		MatchType: coredocumentpb.FieldMatchType_FIELD_MATCH_TYPE_PREFIX,
+		Field:     utils.RandomSlice(32),
+		Action:    coredocumentpb.TransitionAction_TRANSITION_ACTION_EDIT,
+	}
+
+	transitionRules := []documents.DecodedTransitionRule{
+		{
+			TransitionRule: attributeRule,
+			AttributeLabel: "label1",
+		},
+	}
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"GetTransitionRules",
+		mock.Anything,
+		documentID,
+	).Return(transitionRules, nil).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var ruleRes TransitionRules
+
+	err = json.Unmarshal(resBody, &ruleRes)
+	assert.NoError(t, err)
+
+	expectedRule := toClientRule(attributeRule)
+	expectedRule.AttributeLabel = "label1"
+
+	assert.Equal(t, TransitionRules{Rules: []TransitionRule{expectedRule}}, ruleRes)
+}
+
+func TestHandler_GetTransitionRules_InvalidDocIDParam(t *testing.T) {
+	service, _ := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/transition_rules",
+		testServer.URL,
+		"invalid-doc-id-param",
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_GetTransitionRules_PendingDocSrvError(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/transition_rules",
+		testServer.URL,
+		hexutil.Encode(documentID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"GetTransitionRules",
+		mock.Anything,
+		documentID,
+	).Return(nil, errors.New("error")).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestHandler_GetReadRules(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/read_rules",
+		testServer.URL,
+		hexutil.Encode(documentID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	roleKey := utils.RandomSlice(32)
+
+	readRules := []*coredocumentpb.ReadRule{
+		{
+			Roles:  [][]byte{roleKey},
+			Action: coredocumentpb.Action_ACTION_READ_SIGN,
+		},
+	}
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"GetReadRules",
+		mock.Anything,
+		documentID,
+	).Return(readRules, nil).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var ruleRes ReadRules
+
+	err = json.Unmarshal(resBody, &ruleRes)
+	assert.NoError(t, err)
+
+	expectedRes := ReadRules{
+		Rules: []ReadRule{
+			{
+				Roles:  []byteutils.HexBytes{roleKey},
+				Action: "ACTION_READ_SIGN",
+			},
+		},
+	}
+
+	assert.Equal(t, expectedRes, ruleRes)
+}
+
+func TestHandler_GetReadRules_InvalidDocIDParam(t *testing.T) {
+	service, _ := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/read_rules",
+		testServer.URL,
+		"invalid-doc-id-param",
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_GetReadRules_PendingDocSrvError(t *testing.T) {
+	service, mocks := getServiceWithMocks(t)
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	documentID := utils.RandomSlice(32)
+
+	testURL := fmt.Sprintf(
+		"%s/documents/%s/read_rules",
+		testServer.URL,
+		hexutil.Encode(documentID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*pending.ServiceMock](mocks).On(
+		"GetReadRules",
+		mock.Anything,
+		documentID,
+	).Return(nil, errors.New("error")).Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
@@ -0,0 +1,141 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/documents/generic"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/http/coreapi"
+	"github.com/centrifuge/pod/utils/httputils"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+)
+
+// SchemaIDParam is the key for schemaID in the API path.
+const SchemaIDParam = "schema_id"
+
+// RegisterSchema registers a schema for generic documents.
+// @summary Registers a schema for generic documents.
+// @description Registers a schema that generic documents can reference on create. Registering the same schema twice returns the existing one.
+// @id register_schema
+// @tags Schemas
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param body body generic.Schema true "Schema Registration Request"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 400 {object} httputils.HTTPError
+// @Failure 500 {object} httputils.HTTPError
+// @success 201 {object} generic.Schema
+// @router /v2/schemas [post]
+func (h handler) RegisterSchema(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	identity, err := contextutil.Identity(r.Context())
+	if err != nil {
+		code = http.StatusForbidden
+		log.Error(err)
+		err = coreapi.ErrAccountNotFound
+		return
+	}
+
+	var schema generic.Schema
+	err = unmarshalBody(r, &schema)
+	if err != nil {
+		code = http.StatusBadRequest
+		log.Error(err)
+		return
+	}
+
+	res, err := h.srv.RegisterSchema(identity, &schema)
+	if err != nil {
+		code = http.StatusBadRequest
+		if !errors.IsOfType(generic.ErrInvalidSchema, err) {
+			code = http.StatusInternalServerError
+		}
+
+		log.Error(err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, res)
+}
+
+// GetSchemas returns the schemas registered by the account.
+// @summary Returns the schemas registered by the account.
+// @description Returns the schemas registered by the account.
+// @id get_schemas
+// @tags Schemas
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 500 {object} httputils.HTTPError
+// @success 200 {array} generic.Schema
+// @router /v2/schemas [get]
+func (h handler) GetSchemas(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	identity, err := contextutil.Identity(r.Context())
+	if err != nil {
+		code = http.StatusForbidden
+		log.Error(err)
+		err = coreapi.ErrAccountNotFound
+		return
+	}
+
+	schemas, err := h.srv.GetSchemas(identity)
+	if err != nil {
+		code = http.StatusInternalServerError
+		log.Error(err)
+		return
+	}
+
+	if schemas == nil {
+		schemas = []*generic.Schema{}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, schemas)
+}
+
+// GetSchema returns the schema registered by the account.
+// @summary Returns the schema registered by the account.
+// @description Returns the schema registered by the account.
+// @id get_schema
+// @tags Schemas
+// @param authorization header string true "Hex encoded centrifuge ID of the account for the intended API action"
+// @param schema_id path string true "Schema ID"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 404 {object} httputils.HTTPError
+// @success 200 {object} generic.Schema
+// @router /v2/schemas/{schema_id} [get]
+func (h handler) GetSchema(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	identity, err := contextutil.Identity(r.Context())
+	if err != nil {
+		code = http.StatusForbidden
+		log.Error(err)
+		err = coreapi.ErrAccountNotFound
+		return
+	}
+
+	schema, err := h.srv.GetSchema(identity, chi.URLParam(r, SchemaIDParam))
+	if err != nil {
+		code = http.StatusNotFound
+		log.Error(err)
+		err = generic.ErrSchemaNotFound
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, schema)
+}
@@ -0,0 +1,341 @@
+//go:build unit
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/generic"
+	"github.com/centrifuge/pod/errors"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	genericUtils "github.com/centrifuge/pod/testingutils/generic"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+)
+
+func getSchemaTestServer(t *testing.T, withAccount bool) (*httptest.Server, *types.AccountID, *generic.SchemaRegistryMock) {
+	service, mocks := getServiceWithMocks(t)
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	if withAccount {
+		accountMock := config.NewAccountMock(t)
+		accountMock.On("GetIdentity").
+			Return(accountID)
+
+		// Mimic the auth handler by adding the account to context.
+		router.Use(func(h http.Handler) http.Handler {
+			return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				ctx := contextutil.WithAccount(request.Context(), accountMock)
+
+				h.ServeHTTP(writer, request.WithContext(ctx))
+			})
+		})
+	}
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+
+	return testServer, accountID, genericUtils.GetMock[*generic.SchemaRegistryMock](mocks)
+}
+
+func getTestHandlerSchema() *generic.Schema {
+	return &generic.Schema{
+		Name: "invoice",
+		Attributes: []generic.SchemaAttribute{
+			{
+				Label:    "number",
+				Type:     documents.AttrString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func TestHandler_RegisterSchema(t *testing.T) {
+	testServer, accountID, schemaRegistryMock := getSchemaTestServer(t, true)
+	defer testServer.Close()
+
+	schema := getTestHandlerSchema()
+
+	b, err := json.Marshal(schema)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		fmt.Sprintf("%s/schemas", testServer.URL),
+		bytes.NewReader(b),
+	)
+	assert.NoError(t, err)
+
+	registered := *schema
+	registered.ID = "schema-id"
+
+	schemaRegistryMock.On("RegisterSchema", accountID, schema).
+		Return(&registered, nil).
+		Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var schemaRes generic.Schema
+
+	err = json.Unmarshal(resBody, &schemaRes)
+	assert.NoError(t, err)
+	assert.Equal(t, registered, schemaRes)
+}
+
+func TestHandler_RegisterSchema_NoAccount(t *testing.T) {
+	testServer, _, _ := getSchemaTestServer(t, false)
+	defer testServer.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		fmt.Sprintf("%s/schemas", testServer.URL),
+		bytes.NewReader([]byte("{}")),
+	)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func TestHandler_RegisterSchema_InvalidBody(t *testing.T) {
+	testServer, _, _ := getSchemaTestServer(t, true)
+	defer testServer.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		fmt.Sprintf("%s/schemas", testServer.URL),
+		bytes.NewReader([]byte("invalid-body")),
+	)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestHandler_RegisterSchema_RegistryError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{
+			name:         "invalid schema",
+			err:          errors.NewTypedError(generic.ErrInvalidSchema, errors.New("error")),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "persistence error",
+			err:          errors.NewTypedError(generic.ErrSchemaPersistence, errors.New("error")),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testServer, accountID, schemaRegistryMock := getSchemaTestServer(t, true)
+			defer testServer.Close()
+
+			schema := getTestHandlerSchema()
+
+			b, err := json.Marshal(schema)
+			assert.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(
+				context.Background(),
+				http.MethodPost,
+				fmt.Sprintf("%s/schemas", testServer.URL),
+				bytes.NewReader(b),
+			)
+			assert.NoError(t, err)
+
+			schemaRegistryMock.On("RegisterSchema", accountID, schema).
+				Return(nil, test.err).
+				Once()
+
+			res, err := http.DefaultClient.Do(req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, res.StatusCode)
+		})
+	}
+}
+
+func TestHandler_GetSchemas(t *testing.T) {
+	testServer, accountID, schemaRegistryMock := getSchemaTestServer(t, true)
+	defer testServer.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodGet,
+		fmt.Sprintf("%s/schemas", testServer.URL),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	schema := getTestHandlerSchema()
+	schema.ID = "schema-id"
+
+	schemaRegistryMock.On("GetSchemas", accountID).
+		Return([]*generic.Schema{schema}, nil).
+		Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var schemasRes []*generic.Schema
+
+	err = json.Unmarshal(resBody, &schemasRes)
+	assert.NoError(t, err)
+	assert.Equal(t, []*generic.Schema{schema}, schemasRes)
+}
+
+func TestHandler_GetSchemas_NoSchemas(t *testing.T) {
+	testServer, accountID, schemaRegistryMock := getSchemaTestServer(t, true)
+	defer testServer.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodGet,
+		fmt.Sprintf("%s/schemas", testServer.URL),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	schemaRegistryMock.On("GetSchemas", accountID).
+		Return(nil, nil).
+		Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]\n", string(resBody))
+}
+
+func TestHandler_GetSchemas_RegistryError(t *testing.T) {
+	testServer, accountID, schemaRegistryMock := getSchemaTestServer(t, true)
+	defer testServer.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodGet,
+		fmt.Sprintf("%s/schemas", testServer.URL),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	schemaRegistryMock.On("GetSchemas", accountID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
+
+func TestHandler_GetSchema(t *testing.T) {
+	testServer, accountID, schemaRegistryMock := getSchemaTestServer(t, true)
+	defer testServer.Close()
+
+	schema := getTestHandlerSchema()
+	schema.ID = "schema-id"
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodGet,
+		fmt.Sprintf("%s/schemas/%s", testServer.URL, schema.ID),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	schemaRegistryMock.On("GetSchema", accountID, schema.ID).
+		Return(schema, nil).
+		Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var schemaRes generic.Schema
+
+	err = json.Unmarshal(resBody, &schemaRes)
+	assert.NoError(t, err)
+	assert.Equal(t, *schema, schemaRes)
+}
+
+func TestHandler_GetSchema_NoAccount(t *testing.T) {
+	testServer, _, _ := getSchemaTestServer(t, false)
+	defer testServer.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodGet,
+		fmt.Sprintf("%s/schemas/%s", testServer.URL, "schema-id"),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func TestHandler_GetSchema_NotFound(t *testing.T) {
+	testServer, accountID, schemaRegistryMock := getSchemaTestServer(t, true)
+	defer testServer.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodGet,
+		fmt.Sprintf("%s/schemas/%s", testServer.URL, "schema-id"),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	schemaRegistryMock.On("GetSchema", accountID, "schema-id").
+		Return(nil, generic.ErrSchemaNotFound).
+		Once()
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
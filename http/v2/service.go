@@ -10,8 +10,10 @@ import (
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/crypto"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/documents/entity"
 	"github.com/centrifuge/pod/documents/entityrelationship"
+	"github.com/centrifuge/pod/documents/generic"
 	"github.com/centrifuge/pod/http/coreapi"
 	v2 "github.com/centrifuge/pod/identity/v2"
 	"github.com/centrifuge/pod/jobs"
@@ -27,6 +29,9 @@ type Service struct {
 	identityService v2.Service
 	erSrv           entityrelationship.Service
 	docSrv          documents.Service
+	p2pClient       documents.Client
+	schemaRegistry  generic.SchemaRegistry
+	attachmentSrv   attachments.Service
 
 	p2pPublicKey         []byte
 	podOperatorAccountID *types.AccountID
@@ -40,6 +45,9 @@ func NewService(
 	identityService v2.Service,
 	erSrv entityrelationship.Service,
 	docSrv documents.Service,
+	p2pClient documents.Client,
+	schemaRegistry generic.SchemaRegistry,
+	attachmentSrv attachments.Service,
 ) (*Service, error) {
 	p2pPublicKey, err := getP2PPublicKey(cfgService)
 
@@ -60,6 +68,9 @@ func NewService(
 		entitySrv:            entitySrv,
 		erSrv:                erSrv,
 		docSrv:               docSrv,
+		p2pClient:            p2pClient,
+		schemaRegistry:       schemaRegistry,
+		attachmentSrv:        attachmentSrv,
 		identityService:      identityService,
 		p2pPublicKey:         p2pPublicKey,
 		podOperatorAccountID: podOperatorAccountID,
@@ -122,6 +133,21 @@ func (s *Service) UpdateRole(ctx context.Context, docID, roleID []byte, dids []*
 	return s.pendingDocSrv.UpdateRole(ctx, docID, roleID, dids)
 }
 
+// GetRoles returns all the roles of the document
+func (s *Service) GetRoles(ctx context.Context, docID []byte) ([]*coredocumentpb.Role, error) {
+	return s.pendingDocSrv.GetRoles(ctx, docID)
+}
+
+// DeleteRole deletes the role from the document
+func (s *Service) DeleteRole(ctx context.Context, docID, roleID []byte) error {
+	return s.pendingDocSrv.DeleteRole(ctx, docID, roleID)
+}
+
+// GetReadRules returns all the read rules of the document
+func (s *Service) GetReadRules(ctx context.Context, docID []byte) ([]*coredocumentpb.ReadRule, error) {
+	return s.pendingDocSrv.GetReadRules(ctx, docID)
+}
+
 // AddTransitionRules adds new rules to the document
 func (s *Service) AddTransitionRules(
 	ctx context.Context,
@@ -131,16 +157,45 @@ func (s *Service) AddTransitionRules(
 	return s.pendingDocSrv.AddTransitionRules(ctx, docID, addRules)
 }
 
+// RegisterSchema registers the generic document schema for the account.
+func (s *Service) RegisterSchema(accountID *types.AccountID, schema *generic.Schema) (*generic.Schema, error) {
+	return s.schemaRegistry.RegisterSchema(accountID, schema)
+}
+
+// GetSchema returns the generic document schema registered by the account.
+func (s *Service) GetSchema(accountID *types.AccountID, schemaID string) (*generic.Schema, error) {
+	return s.schemaRegistry.GetSchema(accountID, schemaID)
+}
+
+// GetSchemas returns all the generic document schemas registered by the account.
+func (s *Service) GetSchemas(accountID *types.AccountID) ([]*generic.Schema, error) {
+	return s.schemaRegistry.GetSchemas(accountID)
+}
+
 // GetTransitionRule returns the transition rule associated with ruleID in the document.
 func (s *Service) GetTransitionRule(ctx context.Context, docID, ruleID []byte) (*coredocumentpb.TransitionRule, error) {
 	return s.pendingDocSrv.GetTransitionRule(ctx, docID, ruleID)
 }
 
+// GetTransitionRules returns all the transition rules of the document.
+func (s *Service) GetTransitionRules(ctx context.Context, docID []byte) ([]documents.DecodedTransitionRule, error) {
+	return s.pendingDocSrv.GetTransitionRules(ctx, docID)
+}
+
 // DeleteTransitionRule deletes the transition rule associated with ruleID from the document.
 func (s *Service) DeleteTransitionRule(ctx context.Context, docID, ruleID []byte) error {
 	return s.pendingDocSrv.DeleteTransitionRule(ctx, docID, ruleID)
 }
 
+// DryRunComputeFieldsRule executes the WASM of the compute fields rule against the document.
+func (s *Service) DryRunComputeFieldsRule(
+	ctx context.Context,
+	docID []byte,
+	rule pending.ComputeFieldsRule,
+) (documents.ComputeFieldsExecution, error) {
+	return s.pendingDocSrv.DryRunComputeFieldsRule(ctx, docID, rule)
+}
+
 // AddAttributes add attributes to pending document
 func (s *Service) AddAttributes(ctx context.Context, docID []byte, attrs []documents.Attribute) (documents.Document, error) {
 	return s.pendingDocSrv.AddAttributes(ctx, docID, attrs)
@@ -202,6 +257,36 @@ func (s *Service) GenerateProofsForVersion(ctx context.Context, docID, versionID
 	return s.docSrv.CreateProofsForVersion(ctx, docID, versionID, fields)
 }
 
+// GenerateReferenceProofs returns the proofs for the latest version of the document together with the proofs
+// for the document referenced by the attribute.
+func (s *Service) GenerateReferenceProofs(
+	ctx context.Context,
+	docID []byte,
+	attrLabel string,
+	fields, referencedFields []string,
+) (*documents.ReferenceProof, error) {
+	return s.docSrv.CreateReferenceProofs(ctx, docID, attrLabel, fields, referencedFields)
+}
+
+// UploadAttachment stores the file of an attachment, and pins it to IPFS if requested.
+func (s *Service) UploadAttachment(ctx context.Context, data []byte, mimeType string, pin bool) (*attachments.Blob, error) {
+	return s.attachmentSrv.Upload(ctx, data, mimeType, pin)
+}
+
+// DownloadAttachment returns the file of the attachment attribute of the latest version of the document.
+func (s *Service) DownloadAttachment(ctx context.Context, docID []byte, attrLabel string) (*attachments.Blob, error) {
+	return s.attachmentSrv.Download(ctx, docID, attrLabel)
+}
+
+// GetDocumentDeliveryStatus returns the delivery status of the document version for each collaborator.
+func (s *Service) GetDocumentDeliveryStatus(ctx context.Context, docID, versionID []byte) ([]*documents.DeliveryStatus, error) {
+	if _, err := s.docSrv.GetVersion(ctx, docID, versionID); err != nil {
+		return nil, err
+	}
+
+	return s.p2pClient.GetDeliveryStatus(ctx, docID, versionID)
+}
+
 func (s *Service) ToClientAccounts(accounts ...config.Account) []coreapi.Account {
 	var res []coreapi.Account
 
@@ -214,12 +299,13 @@ func (s *Service) ToClientAccounts(accounts ...config.Account) []coreapi.Account
 
 func toClientAccount(account config.Account, p2pPublicKey []byte, podOperatorAccountID *types.AccountID) coreapi.Account {
 	return coreapi.Account{
-		Identity:                 account.GetIdentity(),
-		WebhookURL:               account.GetWebhookURL(),
-		PrecommitEnabled:         account.GetPrecommitEnabled(),
-		DocumentSigningPublicKey: account.GetSigningPublicKey(),
-		P2PPublicSigningKey:      p2pPublicKey,
-		PodOperatorAccountID:     podOperatorAccountID,
+		Identity:                    account.GetIdentity(),
+		WebhookURL:                  account.GetWebhookURL(),
+		PrecommitEnabled:            account.GetPrecommitEnabled(),
+		DocumentSigningPublicKey:    account.GetSigningPublicKey(),
+		DocumentEncryptionPublicKey: account.GetEncryptionPublicKey(),
+		P2PPublicSigningKey:         p2pPublicKey,
+		PodOperatorAccountID:        podOperatorAccountID,
 	}
 }
 
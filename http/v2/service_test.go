@@ -7,8 +7,10 @@ import (
 
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/documents/entity"
 	"github.com/centrifuge/pod/documents/entityrelationship"
+	"github.com/centrifuge/pod/documents/generic"
 	"github.com/centrifuge/pod/errors"
 	v2 "github.com/centrifuge/pod/identity/v2"
 	"github.com/centrifuge/pod/jobs"
@@ -25,6 +27,9 @@ func TestNewService_Errors(t *testing.T) {
 	identityServiceMock := v2.NewServiceMock(t)
 	entityRelationshipServiceMock := entityrelationship.NewServiceMock(t)
 	documentServiceMock := documents.NewServiceMock(t)
+	p2pClientMock := documents.NewClientMock(t)
+	schemaRegistryMock := generic.NewSchemaRegistryMock(t)
+	attachmentServiceMock := attachments.NewServiceMock(t)
 
 	cfgServiceMock.On("GetConfig").
 		Return(nil, errors.New("error")).
@@ -38,6 +43,9 @@ func TestNewService_Errors(t *testing.T) {
 		identityServiceMock,
 		entityRelationshipServiceMock,
 		documentServiceMock,
+		p2pClientMock,
+		schemaRegistryMock,
+		attachmentServiceMock,
 	)
 	assert.NotNil(t, err)
 
@@ -59,6 +67,9 @@ func TestNewService_Errors(t *testing.T) {
 		identityServiceMock,
 		entityRelationshipServiceMock,
 		documentServiceMock,
+		p2pClientMock,
+		schemaRegistryMock,
+		attachmentServiceMock,
 	)
 	assert.NotNil(t, err)
 
@@ -79,6 +90,9 @@ func TestNewService_Errors(t *testing.T) {
 		identityServiceMock,
 		entityRelationshipServiceMock,
 		documentServiceMock,
+		p2pClientMock,
+		schemaRegistryMock,
+		attachmentServiceMock,
 	)
 	assert.NotNil(t, err)
 }
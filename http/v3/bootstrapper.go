@@ -1,9 +1,12 @@
 package v3
 
 import (
+	"github.com/centrifuge/pod/balance"
+	"github.com/centrifuge/pod/bootstrap"
 	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
 	nftv3 "github.com/centrifuge/pod/nft/v3"
+	"github.com/centrifuge/pod/p2p"
 )
 
 // BootstrappedService key maps to the Service implementation in Bootstrap context.
@@ -25,9 +28,23 @@ func (b *Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("document service not initialised")
 	}
 
+	balanceSrv, ok := ctx[balance.BootstrappedBalanceService].(balance.Service)
+
+	if !ok {
+		return errors.New("balance service not initialised")
+	}
+
+	peerLister, ok := ctx[bootstrap.BootstrappedPeer].(p2p.PeerLister)
+
+	if !ok {
+		return errors.New("p2p peer not initialised")
+	}
+
 	ctx[BootstrappedService] = &Service{
-		docSrv:   docSrv,
-		nftSrvV3: nftSrvV3,
+		docSrv:     docSrv,
+		nftSrvV3:   nftSrvV3,
+		balanceSrv: balanceSrv,
+		peerLister: peerLister,
 	}
 
 	return nil
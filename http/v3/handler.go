@@ -27,4 +27,6 @@ func Register(ctx map[string]interface{}, r chi.Router) {
 	r.Get("/nfts/collections/{"+coreapi.CollectionIDParam+"}/items/{"+coreapi.ItemIDParam+"}/metadata", h.MetadataOfNFT)
 	r.Get("/nfts/collections/{"+coreapi.CollectionIDParam+"}/items/{"+coreapi.ItemIDParam+"}/attribute/{"+coreapi.AttributeNameParam+"}", h.AttributeOfNFT)
 	r.Get("/investor/assets", h.GetAsset)
+	r.Get("/operator/balance", h.GetOperatorBalance)
+	r.Get("/operator/peers", h.GetOperatorPeers)
 }
@@ -31,7 +31,7 @@ func TestHandler_CommitAndMintNFT(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -115,7 +115,7 @@ func TestHandler_CommitAndMintNFT_InvalidCollectionIDParam(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -148,7 +148,7 @@ func TestHandler_CommitAndMintNFT_InvalidPayload(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -179,7 +179,7 @@ func TestHandler_CommitAndMintNFT_NFTServiceError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -239,7 +239,7 @@ func TestHandler_MintNFT(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -323,7 +323,7 @@ func TestHandler_MintNFT_InvalidCollectionIDParam(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -356,7 +356,7 @@ func TestHandler_MintNFT_InvalidPayload(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -387,7 +387,7 @@ func TestHandler_MintNFT_NFTServiceError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -447,7 +447,7 @@ func TestHandler_GetNFTOwner(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -498,7 +498,7 @@ func TestHandler_GetNFTOwner_InvalidCollectionIDParam(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -530,7 +530,7 @@ func TestHandler_GetNFTOwner_InvalidItemIDParam(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -562,7 +562,7 @@ func TestHandler_GetNFTOwner_NFTService_GenericError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -598,7 +598,7 @@ func TestHandler_GetNFTOwner_NFTService_NotFoundError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -634,7 +634,7 @@ func TestHandler_CreateNFTCollection(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -694,7 +694,7 @@ func TestHandler_CreateNFTCollection_InvalidPayload(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -723,7 +723,7 @@ func TestHandler_CreateNFTCollection_NFTServiceError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -765,7 +765,7 @@ func TestHandler_MetadataOfNFT(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -822,7 +822,7 @@ func TestHandler_MetadataOfNFT_InvalidCollectionIDParam(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -854,7 +854,7 @@ func TestHandler_MetadataOfNFT_InvalidItemIDParam(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -886,7 +886,7 @@ func TestHandler_MetadataOfNFT_NFTService_GenericError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -922,7 +922,7 @@ func TestHandler_MetadataOfNFT_NFTService_NotFoundError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -958,7 +958,7 @@ func TestHandler_AttributeOfNFT(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -1013,7 +1013,7 @@ func TestHandler_AttributeOfNFT_InvalidCollectionIDParam(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -1052,7 +1052,7 @@ func TestHandler_AttributeOfNFT_InvalidItemIDParam(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -1091,7 +1091,7 @@ func TestHandler_AttributeOfNFT_NFTService_GenericError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
@@ -1134,7 +1134,7 @@ func TestHandler_AttributeOfNFT_NFTService_NotFoundError(t *testing.T) {
 	docServiceMock := documents.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
 
-	service := &Service{docServiceMock, nftServiceMock}
+	service := &Service{docServiceMock, nftServiceMock, nil, nil}
 
 	ctx := context.Background()
 
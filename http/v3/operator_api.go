@@ -0,0 +1,98 @@
+package v3
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/centrifuge/pod/http/coreapi"
+	"github.com/centrifuge/pod/utils/httputils"
+	"github.com/go-chi/render"
+)
+
+// GetOperatorBalance returns the balance of the pod operator and the estimated cost of the pending jobs.
+// @summary Returns the balance of the pod operator.
+// @description Returns the balance of the pod operator and the estimated cost of the pending jobs.
+// @id get_operator_balance
+// @tags Operator
+// @param authorization header string true "Bearer <JW3T token>"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 500 {object} httputils.HTTPError
+// @success 200 {object} coreapi.OperatorBalanceResponse
+// @router /v3/operator/balance [get]
+func (h *handler) GetOperatorBalance(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	operatorBalance, err := h.srv.GetOperatorBalance()
+
+	if err != nil {
+		code = http.StatusInternalServerError
+		h.log.Error(err)
+		return
+	}
+
+	res := coreapi.OperatorBalanceResponse{
+		Operator:             operatorBalance.Operator,
+		FreeBalance:          operatorBalance.FreeBalance.String(),
+		PendingJobs:          operatorBalance.PendingJobs,
+		EstimatedPendingCost: operatorBalance.EstimatedPendingCost.String(),
+		LowBalanceThreshold:  operatorBalance.LowBalanceThreshold.String(),
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, res)
+}
+
+// GetOperatorPeers returns the peers known by the pod.
+// @summary Returns the peers known by the pod.
+// @description Returns the connected peers, the peers of the collaborators stored in the address book and the static peers, together with their latency and last seen times.
+// @id get_operator_peers
+// @tags Operator
+// @param authorization header string true "Bearer <JW3T token>"
+// @produce json
+// @Failure 403 {object} httputils.HTTPError
+// @Failure 500 {object} httputils.HTTPError
+// @success 200 {object} coreapi.OperatorPeersResponse
+// @router /v3/operator/peers [get]
+func (h *handler) GetOperatorPeers(w http.ResponseWriter, r *http.Request) {
+	var err error
+	var code int
+	defer httputils.RespondIfError(&code, &err, w, r)
+
+	peers, err := h.srv.GetPeers()
+
+	if err != nil {
+		code = http.StatusInternalServerError
+		h.log.Error(err)
+		return
+	}
+
+	res := coreapi.OperatorPeersResponse{
+		Peers: make([]coreapi.PeerResponse, 0, len(peers)),
+	}
+
+	for _, peer := range peers {
+		peerRes := coreapi.PeerResponse{
+			PeerID:    peer.PeerID.Pretty(),
+			AccountID: peer.AccountID,
+			Addresses: peer.Addrs,
+			Connected: peer.Connected,
+			Static:    peer.Static,
+		}
+
+		if peer.Latency > 0 {
+			peerRes.Latency = peer.Latency.String()
+		}
+
+		if !peer.LastSeen.IsZero() {
+			peerRes.LastSeen = peer.LastSeen.UTC().Format(time.RFC3339)
+		}
+
+		res.Peers = append(res.Peers, peerRes)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, res)
+}
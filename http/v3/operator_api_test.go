@@ -0,0 +1,224 @@
+//go:build unit
+
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/pod/balance"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/http/coreapi"
+	"github.com/centrifuge/pod/p2p"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/go-chi/chi"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_GetOperatorBalance(t *testing.T) {
+	balanceServiceMock := balance.NewServiceMock(t)
+
+	service := &Service{balanceSrv: balanceServiceMock}
+
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	operator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	operatorBalance := &balance.OperatorBalance{
+		Operator:             operator,
+		FreeBalance:          big.NewInt(1000),
+		PendingJobs:          2,
+		EstimatedPendingCost: big.NewInt(20),
+		LowBalanceThreshold:  big.NewInt(100),
+	}
+
+	balanceServiceMock.On("GetOperatorBalance").
+		Return(operatorBalance, nil).
+		Once()
+
+	testURL := fmt.Sprintf("%s/operator/balance", testServer.URL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var coreapiRes coreapi.OperatorBalanceResponse
+
+	err = json.Unmarshal(resBody, &coreapiRes)
+	assert.NoError(t, err)
+
+	assert.Equal(t, operator, coreapiRes.Operator)
+	assert.Equal(t, "1000", coreapiRes.FreeBalance)
+	assert.Equal(t, 2, coreapiRes.PendingJobs)
+	assert.Equal(t, "20", coreapiRes.EstimatedPendingCost)
+	assert.Equal(t, "100", coreapiRes.LowBalanceThreshold)
+}
+
+func TestHandler_GetOperatorBalance_ServiceError(t *testing.T) {
+	balanceServiceMock := balance.NewServiceMock(t)
+
+	service := &Service{balanceSrv: balanceServiceMock}
+
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	balanceServiceMock.On("GetOperatorBalance").
+		Return(nil, errors.New("error")).
+		Once()
+
+	testURL := fmt.Sprintf("%s/operator/balance", testServer.URL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
+
+func TestHandler_GetOperatorPeers(t *testing.T) {
+	peerListerMock := p2p.NewPeerListerMock(t)
+
+	service := &Service{peerLister: peerListerMock}
+
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	lastSeen := time.Now()
+
+	peers := []*p2p.PeerInfo{
+		{
+			PeerID:    libp2ppeer.ID("connected-peer"),
+			AccountID: accountID,
+			Addrs:     []string{"/ip4/127.0.0.1/tcp/38202"},
+			Connected: true,
+			Latency:   2 * time.Millisecond,
+			LastSeen:  lastSeen,
+		},
+		{
+			PeerID: libp2ppeer.ID("static-peer"),
+			Addrs:  []string{"/ip4/127.0.0.1/tcp/38203"},
+			Static: true,
+		},
+	}
+
+	peerListerMock.On("GetPeers").
+		Return(peers, nil).
+		Once()
+
+	testURL := fmt.Sprintf("%s/operator/peers", testServer.URL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	var coreapiRes coreapi.OperatorPeersResponse
+
+	err = json.Unmarshal(resBody, &coreapiRes)
+	assert.NoError(t, err)
+	assert.Len(t, coreapiRes.Peers, 2)
+
+	connectedPeer := coreapiRes.Peers[0]
+	assert.Equal(t, peers[0].PeerID.Pretty(), connectedPeer.PeerID)
+	assert.Equal(t, accountID, connectedPeer.AccountID)
+	assert.Equal(t, peers[0].Addrs, connectedPeer.Addresses)
+	assert.True(t, connectedPeer.Connected)
+	assert.False(t, connectedPeer.Static)
+	assert.Equal(t, "2ms", connectedPeer.Latency)
+	assert.Equal(t, lastSeen.UTC().Format(time.RFC3339), connectedPeer.LastSeen)
+
+	staticPeer := coreapiRes.Peers[1]
+	assert.Equal(t, peers[1].PeerID.Pretty(), staticPeer.PeerID)
+	assert.Nil(t, staticPeer.AccountID)
+	assert.False(t, staticPeer.Connected)
+	assert.True(t, staticPeer.Static)
+	assert.Empty(t, staticPeer.Latency)
+	assert.Empty(t, staticPeer.LastSeen)
+}
+
+func TestHandler_GetOperatorPeers_ServiceError(t *testing.T) {
+	peerListerMock := p2p.NewPeerListerMock(t)
+
+	service := &Service{peerLister: peerListerMock}
+
+	ctx := context.Background()
+
+	serviceContext := map[string]any{
+		BootstrappedService: service,
+	}
+
+	router := chi.NewRouter()
+
+	Register(serviceContext, router)
+
+	testServer := httptest.NewServer(router)
+	defer testServer.Close()
+
+	peerListerMock.On("GetPeers").
+		Return(nil, errors.New("error")).
+		Once()
+
+	testURL := fmt.Sprintf("%s/operator/peers", testServer.URL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+	assert.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
@@ -3,7 +3,9 @@ package v3
 import (
 	"context"
 
+	"github.com/centrifuge/pod/balance"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/p2p"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	nftv3 "github.com/centrifuge/pod/nft/v3"
@@ -11,8 +13,10 @@ import (
 
 // Service is the entry point for all the V3 APIs.
 type Service struct {
-	docSrv   documents.Service
-	nftSrvV3 nftv3.Service
+	docSrv     documents.Service
+	nftSrvV3   nftv3.Service
+	balanceSrv balance.Service
+	peerLister p2p.PeerLister
 }
 
 // MintNFT mints an NFT for the document provided in the request.
@@ -43,3 +47,13 @@ func (s *Service) GetItemAttribute(collectionID types.U64, itemID types.U128, ke
 func (s *Service) GetDocument(ctx context.Context, documentID []byte) (documents.Document, error) {
 	return s.docSrv.GetCurrentVersion(ctx, documentID)
 }
+
+// GetOperatorBalance returns the pod operator balance and the estimated cost of the pending jobs.
+func (s *Service) GetOperatorBalance() (*balance.OperatorBalance, error) {
+	return s.balanceSrv.GetOperatorBalance()
+}
+
+// GetPeers returns the peers known by the pod.
+func (s *Service) GetPeers() ([]*p2p.PeerInfo, error) {
+	return s.peerLister.GetPeers()
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/errors"
 	"github.com/centrifuge/pod/pallets"
+	"github.com/centrifuge/pod/pallets/cache"
 	"github.com/centrifuge/pod/pallets/keystore"
 	"github.com/centrifuge/pod/pallets/proxy"
 	"github.com/libp2p/go-libp2p-core/protocol"
@@ -50,6 +51,22 @@ func (b *Bootstrapper) Bootstrap(context map[string]interface{}) error {
 
 	identityServiceV2 := NewService(cfgService, centAPI, keystoreAPI, proxyAPI, protocolIDDispatcher)
 
+	if invalidator, ok := context[pallets.BootstrappedCacheInvalidator].(*cache.Invalidator); ok {
+		cfg, err := config.RetrieveConfig(false, context)
+
+		if err != nil {
+			return err
+		}
+
+		blockNumberProvider, ok := context[centchain.BootstrappedEventListener].(cache.BlockNumberProvider)
+
+		if !ok {
+			return errors.New("centchain event listener not initialised")
+		}
+
+		identityServiceV2 = NewCachedService(identityServiceV2, cfg.GetCentChainCacheTTL(), blockNumberProvider, invalidator)
+	}
+
 	context[BootstrappedIdentityServiceV2] = identityServiceV2
 
 	return nil
@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/pallets/cache"
+)
+
+const (
+	killedAccountEventName = "KilledAccount"
+
+	killedAccountFieldName = "account"
+)
+
+// killedAccountKeyMatchFn matches the account that was killed.
+func killedAccountKeyMatchFn(event *centchain.Event) (func(key types.AccountID) bool, bool) {
+	if event.EventName() != killedAccountEventName {
+		return nil, true
+	}
+
+	accountID, ok := cache.GetAccountIDEventField(event, killedAccountFieldName)
+
+	if !ok {
+		return nil, false
+	}
+
+	return func(key types.AccountID) bool {
+		return key == accountID
+	}, true
+}
+
+type cachedService struct {
+	Service
+
+	validAccountsCache *cache.Cache[types.AccountID, struct{}]
+}
+
+// NewCachedService returns a Service that caches the accounts that were successfully validated.
+// The cached accounts are invalidated when they are killed or when their proxies change.
+func NewCachedService(
+	service Service,
+	ttl time.Duration,
+	blockNumberProvider cache.BlockNumberProvider,
+	invalidator *cache.Invalidator,
+) Service {
+	validAccountsCache := cache.New[types.AccountID, struct{}]("identity_valid_accounts", ttl, blockNumberProvider)
+
+	cache.RegisterCache(invalidator, validAccountsCache, cache.ProxyKeyMatchFn, centchain.ProxyPalletName)
+	cache.RegisterCache(invalidator, validAccountsCache, killedAccountKeyMatchFn, centchain.SystemPalletName)
+
+	return &cachedService{
+		Service:            service,
+		validAccountsCache: validAccountsCache,
+	}
+}
+
+func (s *cachedService) ValidateAccount(accountID *types.AccountID) error {
+	if accountID == nil {
+		return s.Service.ValidateAccount(accountID)
+	}
+
+	_, err := s.validAccountsCache.GetOrLoad(*accountID, func() (struct{}, error) {
+		return struct{}{}, s.Service.ValidateAccount(accountID)
+	})
+
+	return err
+}
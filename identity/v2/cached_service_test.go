@@ -0,0 +1,84 @@
+//go:build unit
+
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/pallets/cache"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachedService_ValidateAccount(t *testing.T) {
+	serviceMock := NewServiceMock(t)
+	blockNumberProviderMock := cache.NewBlockNumberProviderMock(t)
+	eventDispatcher := dispatcher.NewDispatcherMock[*centchain.Event](t)
+
+	invalidator := cache.NewInvalidator(eventDispatcher)
+
+	service := NewCachedService(serviceMock, time.Minute, blockNumberProviderMock, invalidator)
+
+	accountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	// Invalid accounts should not be cached.
+	serviceMock.On("ValidateAccount", accountID).
+		Return(ErrInvalidAccount).
+		Once()
+
+	err = service.ValidateAccount(accountID)
+	assert.ErrorIs(t, err, ErrInvalidAccount)
+
+	serviceMock.On("ValidateAccount", accountID).
+		Return(nil).
+		Once()
+
+	err = service.ValidateAccount(accountID)
+	assert.NoError(t, err)
+
+	// Valid account should be cached.
+	err = service.ValidateAccount(accountID)
+	assert.NoError(t, err)
+
+	// Nil account IDs are not cached.
+	serviceMock.On("ValidateAccount", (*types.AccountID)(nil)).
+		Return(errors.New("error")).
+		Once()
+
+	err = service.ValidateAccount(nil)
+	assert.Error(t, err)
+}
+
+func Test_killedAccountKeyMatchFn(t *testing.T) {
+	accountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	otherAccountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	matchFn, ok := killedAccountKeyMatchFn(&centchain.Event{
+		Name:   "System.KilledAccount",
+		Fields: registry.DecodedFields{{Name: "sp_core.crypto.AccountId32.account", Value: *accountID}},
+	})
+	assert.True(t, ok)
+	assert.True(t, matchFn(*accountID))
+	assert.False(t, matchFn(*otherAccountID))
+
+	// Other system events don't touch the accounts.
+	matchFn, ok = killedAccountKeyMatchFn(&centchain.Event{Name: "System.ExtrinsicSuccess"})
+	assert.True(t, ok)
+	assert.Nil(t, matchFn)
+
+	_, ok = killedAccountKeyMatchFn(&centchain.Event{Name: "System.KilledAccount"})
+	assert.False(t, ok)
+}
@@ -90,10 +90,20 @@ func (s *service) CreateIdentity(ctx context.Context, req *CreateIdentityRequest
 		return nil, ErrSigningKeyPairGeneration
 	}
 
+	encryptionPublicKey, encryptionPrivateKey, err := crypto.GenerateEncryptionKeyPair()
+
+	if err != nil {
+		log.Errorf("Couldn't generate document encryption key pair: %s", err)
+
+		return nil, ErrEncryptionKeyPairGeneration
+	}
+
 	acc, err := configstore.NewAccount(
 		req.Identity,
 		signingPublicKey,
 		signingPrivateKey,
+		encryptionPublicKey,
+		encryptionPrivateKey,
 		req.WebhookURL,
 		req.PrecommitEnabled,
 	)
@@ -1,6 +1,7 @@
 package v3
 
 import (
+	"github.com/centrifuge/pod/balance"
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
@@ -95,6 +96,12 @@ func (*Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		api:         uniquesAPI,
 	})
 
+	if balanceSrv, ok := ctx[balance.BootstrappedBalanceService].(balance.Service); ok {
+		balanceSrv.RegisterJobCall(mintNFTForPendingDocV3Job, getMintJobCallProviderFn)
+		balanceSrv.RegisterJobCall(mintNFTForCommittedDocV3Job, getMintJobCallProviderFn)
+		balanceSrv.RegisterJobCall(createNFTCollectionV3Job, getCreateCollectionJobCallProviderFn)
+	}
+
 	nftService := NewService(
 		pendingDocsSrv,
 		docSrv,
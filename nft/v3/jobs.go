@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/gocelery/v2"
@@ -561,6 +562,65 @@ func getSetAttributeCallProviderFn(
 	}
 }
 
+const (
+	// estimationIPFSPathLength is the length of an IPFS path that contains a CIDv0.
+	estimationIPFSPathLength = 52
+
+	// estimationDocumentIDLength is the length of document and version identifiers.
+	estimationDocumentIDLength = 32
+)
+
+// getMintJobCallProviderFn returns a provider for the batch call submitted by the NFT mint jobs,
+// the placeholder arguments are only used for estimating the fee of the job.
+func getMintJobCallProviderFn(accountID *types.AccountID) centchain.CallProviderFn {
+	var (
+		collectionID types.U64
+		itemID       = types.NewU128(*big.NewInt(0))
+	)
+
+	return utility.BatchCalls(
+		getMintNFTCallProviderFn(collectionID, itemID, accountID),
+		getSetMetadataCallProviderFn(collectionID, itemID, make([]byte, estimationIPFSPathLength), false),
+		getSetAttributeCallProviderFn(
+			collectionID,
+			itemID,
+			[]byte(DocumentIDAttributeKey),
+			make([]byte, estimationDocumentIDLength),
+		),
+		getSetAttributeCallProviderFn(
+			collectionID,
+			itemID,
+			[]byte(DocumentVersionAttributeKey),
+			make([]byte, estimationDocumentIDLength),
+		),
+	)
+}
+
+// getCreateCollectionJobCallProviderFn returns a provider for the call submitted by the create collection job,
+// the placeholder arguments are only used for estimating the fee of the job.
+func getCreateCollectionJobCallProviderFn(accountID *types.AccountID) centchain.CallProviderFn {
+	return func(meta *types.Metadata) (*types.Call, error) {
+		adminMultiAddress, err := types.NewMultiAddressFromAccountID(accountID.ToBytes())
+
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create admin multi address: %w", err)
+		}
+
+		call, err := types.NewCall(
+			meta,
+			uniques.CreateCollectionCall,
+			types.U64(0),
+			adminMultiAddress,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create CreateCollection call: %w", err)
+		}
+
+		return &call, nil
+	}
+}
+
 func GetDocAttributes(doc documents.Document, attrLabels []string) (map[string]string, error) {
 	attrMap := make(map[string]string)
 
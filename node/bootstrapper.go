@@ -6,8 +6,10 @@ import (
 	"os/signal"
 
 	"github.com/centrifuge/pod/bootstrap"
+	"github.com/centrifuge/pod/centchain"
 	"github.com/centrifuge/pod/errors"
 	"github.com/centrifuge/pod/jobs"
+	"github.com/centrifuge/pod/p2p"
 	"github.com/centrifuge/pod/storage"
 )
 
@@ -68,7 +70,36 @@ func GetServers(ctx map[string]interface{}) ([]Server, error) {
 		return nil, errors.New("dispatcher server not initialised")
 	}
 
+	eventListener, ok := ctx[centchain.BootstrappedEventListener].(Server)
+	if !ok {
+		return nil, errors.New("centchain event listener not initialised")
+	}
+
+	connectionManager, ok := ctx[centchain.BootstrappedConnectionManager].(Server)
+	if !ok {
+		return nil, errors.New("centchain connection manager not initialised")
+	}
+
+	deliveryManager, ok := ctx[p2p.BootstrappedDeliveryManager].(Server)
+	if !ok {
+		return nil, errors.New("p2p delivery manager not initialised")
+	}
+
+	documentSyncer, ok := ctx[p2p.BootstrappedDocumentSyncer].(Server)
+	if !ok {
+		return nil, errors.New("p2p document syncer not initialised")
+	}
+
 	var servers []Server
-	servers = append(servers, p2pSrv, apiSrv, dispatcher)
+	servers = append(
+		servers,
+		p2pSrv,
+		apiSrv,
+		dispatcher,
+		eventListener,
+		connectionManager,
+		deliveryManager,
+		documentSyncer,
+	)
 	return servers, nil
 }
@@ -22,6 +22,7 @@ type EventType string
 const (
 	EventTypeJob      EventType = "job"
 	EventTypeDocument EventType = "document"
+	EventTypeBalance  EventType = "balance"
 )
 
 type JobMessage struct {
@@ -39,9 +40,16 @@ type DocumentMessage struct {
 	To        byteutils.HexBytes `json:"to" swaggertype:"primitive,string"`         // document sent to
 }
 
+type BalanceMessage struct {
+	Operator             byteutils.HexBytes `json:"operator" swaggertype:"primitive,string"` // pod operator account
+	FreeBalance          string             `json:"free_balance"`                            // free balance of the pod operator
+	EstimatedPendingCost string             `json:"estimated_pending_cost"`                  // estimated cost of the pending jobs
+	Threshold            string             `json:"threshold"`                               // low balance threshold
+}
+
 // Message is the payload used to send the notifications.
 type Message struct {
-	EventType  EventType `json:"event_type" enums:"job,document"`
+	EventType  EventType `json:"event_type" enums:"job,document,balance"`
 	RecordedAt time.Time `json:"recorded_at" swaggertype:"primitive,string"`
 
 	// Job contains jobs specific details. Ensure event type is job
@@ -49,6 +57,9 @@ type Message struct {
 
 	// Document contains recently received document. Ensure event type is document
 	Document *DocumentMessage `json:"document,omitempty"`
+
+	// Balance contains the pod operator balance details. Ensure event type is balance
+	Balance *BalanceMessage `json:"balance,omitempty"`
 }
 
 func (m Message) String() string {
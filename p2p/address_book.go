@@ -0,0 +1,247 @@
+package p2p
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage"
+	libp2pPeer "github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// addressBookPrefix is the DB prefix of the address book entries.
+	addressBookPrefix = "p2p_address_book_"
+
+	// seenWriteInterval is the minimum interval between two writes of the last seen time of a peer.
+	seenWriteInterval = time.Minute
+)
+
+// addressBookEntry holds the resolved addresses of the peer of an account.
+type addressBookEntry struct {
+	AccountID  *types.AccountID `json:"account_id"`
+	PeerID     libp2pPeer.ID    `json:"peer_id"`
+	Addrs      []string         `json:"addrs"`
+	ResolvedAt time.Time        `json:"resolved_at"`
+	LastSeen   time.Time        `json:"last_seen"`
+}
+
+// JSON marshals addressBookEntry to json bytes.
+func (e *addressBookEntry) JSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// FromJSON loads json bytes to addressBookEntry.
+func (e *addressBookEntry) FromJSON(data []byte) error {
+	return json.Unmarshal(data, e)
+}
+
+// Type returns the type of addressBookEntry.
+func (e *addressBookEntry) Type() reflect.Type {
+	return reflect.TypeOf(e)
+}
+
+// multiaddrs returns the valid addresses of the entry.
+func (e *addressBookEntry) multiaddrs() []ma.Multiaddr {
+	var addrs []ma.Multiaddr
+
+	for _, addr := range e.Addrs {
+		maddr, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			log.Warnf("Invalid address in address book: %s", err)
+
+			continue
+		}
+
+		addrs = append(addrs, maddr)
+	}
+
+	return addrs
+}
+
+// addressBook persists the addresses of the peers of the collaborators, so that they can be contacted
+// without a DHT lookup for every request.
+//
+// The entries are stored per account, an in-memory index maps the peer IDs to the accounts of the entries
+// so that connection events don't require a scan of the address book.
+type addressBook struct {
+	db storage.Repository
+	mu sync.Mutex
+
+	// peerAccounts holds the accounts of the entries of each peer.
+	peerAccounts map[libp2pPeer.ID]map[types.AccountID]struct{}
+
+	// seenWrites holds the last time the last seen time of a peer was persisted.
+	seenWrites map[libp2pPeer.ID]time.Time
+}
+
+func newAddressBook(db storage.Repository) *addressBook {
+	db.Register(new(addressBookEntry))
+
+	b := &addressBook{
+		db:           db,
+		peerAccounts: make(map[libp2pPeer.ID]map[types.AccountID]struct{}),
+		seenWrites:   make(map[libp2pPeer.ID]time.Time),
+	}
+
+	entries, err := b.getAll()
+	if err != nil {
+		log.Errorf("Couldn't load address book entries: %s", err)
+
+		return b
+	}
+
+	for _, entry := range entries {
+		b.index(entry.PeerID, entry.AccountID)
+	}
+
+	return b
+}
+
+// get returns the entry of the account.
+func (b *addressBook) get(accountID *types.AccountID) (*addressBookEntry, error) {
+	model, err := b.db.Get(getAddressBookKey(accountID))
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := model.(*addressBookEntry)
+	if !ok {
+		return nil, errors.New("invalid address book entry type")
+	}
+
+	return entry, nil
+}
+
+// getAll returns all the entries of the address book.
+func (b *addressBook) getAll() ([]*addressBookEntry, error) {
+	models, err := b.db.GetAllByPrefix(addressBookPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*addressBookEntry
+
+	for _, model := range models {
+		entry, ok := model.(*addressBookEntry)
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// put stores the resolved addresses of the peer of the account.
+func (b *addressBook) put(accountID *types.AccountID, peerID libp2pPeer.ID, addrs []ma.Multiaddr) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entry := &addressBookEntry{
+		AccountID:  accountID,
+		PeerID:     peerID,
+		ResolvedAt: time.Now(),
+	}
+
+	for _, addr := range addrs {
+		entry.Addrs = append(entry.Addrs, addr.String())
+	}
+
+	if existing, err := b.get(accountID); err == nil {
+		if existing.PeerID == peerID {
+			// Keep the last seen time if the peer of the account didn't change.
+			entry.LastSeen = existing.LastSeen
+		} else {
+			b.unindex(existing.PeerID, accountID)
+		}
+	}
+
+	if err := b.save(entry); err != nil {
+		return err
+	}
+
+	b.index(peerID, accountID)
+
+	return nil
+}
+
+// markSeen updates the last seen time of the entries with the provided peer ID.
+//
+// The writes are throttled per peer, the last seen time is persisted at most once per seenWriteInterval.
+func (b *addressBook) markSeen(peerID libp2pPeer.ID, seen time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	accounts, ok := b.peerAccounts[peerID]
+	if !ok {
+		return nil
+	}
+
+	if lastWrite, ok := b.seenWrites[peerID]; ok && seen.Sub(lastWrite) < seenWriteInterval {
+		return nil
+	}
+
+	for accountID := range accounts {
+		accountID := accountID
+
+		entry, err := b.get(&accountID)
+		if err != nil {
+			return err
+		}
+
+		entry.LastSeen = seen
+
+		if err := b.save(entry); err != nil {
+			return err
+		}
+	}
+
+	b.seenWrites[peerID] = seen
+
+	return nil
+}
+
+func (b *addressBook) index(peerID libp2pPeer.ID, accountID *types.AccountID) {
+	accounts, ok := b.peerAccounts[peerID]
+	if !ok {
+		accounts = make(map[types.AccountID]struct{})
+
+		b.peerAccounts[peerID] = accounts
+	}
+
+	accounts[*accountID] = struct{}{}
+}
+
+func (b *addressBook) unindex(peerID libp2pPeer.ID, accountID *types.AccountID) {
+	accounts, ok := b.peerAccounts[peerID]
+	if !ok {
+		return
+	}
+
+	delete(accounts, *accountID)
+
+	if len(accounts) == 0 {
+		delete(b.peerAccounts, peerID)
+		delete(b.seenWrites, peerID)
+	}
+}
+
+func (b *addressBook) save(entry *addressBookEntry) error {
+	key := getAddressBookKey(entry.AccountID)
+
+	if b.db.Exists(key) {
+		return b.db.Update(key, entry)
+	}
+
+	return b.db.Create(key, entry)
+}
+
+func getAddressBookKey(accountID *types.AccountID) []byte {
+	return []byte(addressBookPrefix + accountID.ToHexString())
+}
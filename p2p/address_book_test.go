@@ -0,0 +1,191 @@
+//go:build unit
+
+package p2p
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/crypto/ed25519"
+	p2pcommon "github.com/centrifuge/pod/p2p/common"
+	"github.com/centrifuge/pod/storage/leveldb"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAddressBookStoragePattern = "p2p-address-book-*"
+)
+
+func getTestAddressBook(t *testing.T) *addressBook {
+	randomStoragePath, err := testingcommons.GetRandomTestStoragePath(testAddressBookStoragePattern)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = os.RemoveAll(randomStoragePath)
+	})
+
+	db, err := leveldb.NewLevelDBStorage(randomStoragePath)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return newAddressBook(leveldb.NewLevelDBRepository(db))
+}
+
+func getTestPeerID(t *testing.T) libp2ppeer.ID {
+	pubKey, _, err := ed25519.GenerateSigningKeyPair()
+	assert.NoError(t, err)
+
+	peerID, err := p2pcommon.ParsePeerID(types.NewHash(pubKey))
+	assert.NoError(t, err)
+
+	return peerID
+}
+
+func TestAddressBook(t *testing.T) {
+	b := getTestAddressBook(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	res, err := b.get(accountID)
+	assert.Error(t, err)
+	assert.Nil(t, res)
+
+	peerID := getTestPeerID(t)
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/38202")
+	assert.NoError(t, err)
+
+	err = b.put(accountID, peerID, []ma.Multiaddr{addr})
+	assert.NoError(t, err)
+
+	res, err = b.get(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, accountID, res.AccountID)
+	assert.Equal(t, peerID, res.PeerID)
+	assert.Equal(t, []ma.Multiaddr{addr}, res.multiaddrs())
+	assert.True(t, res.LastSeen.IsZero())
+
+	seen := time.Now().Add(-time.Minute)
+
+	err = b.markSeen(peerID, seen)
+	assert.NoError(t, err)
+
+	res, err = b.get(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, seen.Unix(), res.LastSeen.Unix())
+
+	// The last seen time is kept when the addresses of the same peer are updated.
+	err = b.put(accountID, peerID, nil)
+	assert.NoError(t, err)
+
+	res, err = b.get(accountID)
+	assert.NoError(t, err)
+	assert.Empty(t, res.Addrs)
+	assert.Equal(t, seen.Unix(), res.LastSeen.Unix())
+
+	// The last seen time is reset when the peer of the account changes.
+	err = b.put(accountID, getTestPeerID(t), nil)
+	assert.NoError(t, err)
+
+	res, err = b.get(accountID)
+	assert.NoError(t, err)
+	assert.True(t, res.LastSeen.IsZero())
+
+	entries, err := b.getAll()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestAddressBook_MarkSeen(t *testing.T) {
+	b := getTestAddressBook(t)
+
+	accountID1, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountID2, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	peerID := getTestPeerID(t)
+
+	// Both accounts are served by the same peer.
+	err = b.put(accountID1, peerID, nil)
+	assert.NoError(t, err)
+
+	err = b.put(accountID2, peerID, nil)
+	assert.NoError(t, err)
+
+	seen := time.Now()
+
+	err = b.markSeen(peerID, seen)
+	assert.NoError(t, err)
+
+	for _, accountID := range []*types.AccountID{accountID1, accountID2} {
+		res, err := b.get(accountID)
+		assert.NoError(t, err)
+		assert.Equal(t, seen.Unix(), res.LastSeen.Unix())
+	}
+
+	// The writes are throttled.
+	err = b.markSeen(peerID, seen.Add(seenWriteInterval/2))
+	assert.NoError(t, err)
+
+	res, err := b.get(accountID1)
+	assert.NoError(t, err)
+	assert.Equal(t, seen.Unix(), res.LastSeen.Unix())
+
+	nextSeen := seen.Add(seenWriteInterval)
+
+	err = b.markSeen(peerID, nextSeen)
+	assert.NoError(t, err)
+
+	res, err = b.get(accountID1)
+	assert.NoError(t, err)
+	assert.Equal(t, nextSeen.Unix(), res.LastSeen.Unix())
+
+	// The entry of an account is no longer updated once its peer changes.
+	err = b.put(accountID2, getTestPeerID(t), nil)
+	assert.NoError(t, err)
+
+	err = b.markSeen(peerID, nextSeen.Add(seenWriteInterval))
+	assert.NoError(t, err)
+
+	res, err = b.get(accountID2)
+	assert.NoError(t, err)
+	assert.True(t, res.LastSeen.IsZero())
+
+	// Unknown peers are ignored.
+	err = b.markSeen(getTestPeerID(t), seen)
+	assert.NoError(t, err)
+}
+
+func TestAddressBook_IndexLoaded(t *testing.T) {
+	b := getTestAddressBook(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	peerID := getTestPeerID(t)
+
+	err = b.put(accountID, peerID, nil)
+	assert.NoError(t, err)
+
+	// The peer index is rebuilt from the stored entries.
+	b = newAddressBook(b.db)
+
+	seen := time.Now()
+
+	err = b.markSeen(peerID, seen)
+	assert.NoError(t, err)
+
+	res, err := b.get(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, seen.Unix(), res.LastSeen.Unix())
+}
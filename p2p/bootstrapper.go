@@ -8,15 +8,25 @@ import (
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/errors"
 	v2 "github.com/centrifuge/pod/identity/v2"
 	nftv3 "github.com/centrifuge/pod/nft/v3"
 	"github.com/centrifuge/pod/p2p/receiver"
 	"github.com/centrifuge/pod/pallets"
 	"github.com/centrifuge/pod/pallets/keystore"
+	"github.com/centrifuge/pod/storage"
 	"github.com/libp2p/go-libp2p-core/protocol"
 )
 
+const (
+	// BootstrappedDeliveryManager is the key to the anchored document delivery manager in bootstrap context.
+	BootstrappedDeliveryManager = "BootstrappedP2PDeliveryManager"
+
+	// BootstrappedDocumentSyncer is the key to the document syncer in bootstrap context.
+	BootstrappedDocumentSyncer = "BootstrappedP2PDocumentSyncer"
+)
+
 // Bootstrapper implements Bootstrapper with p2p details
 type Bootstrapper struct {
 	testPeerWg        sync.WaitGroup
@@ -61,6 +71,16 @@ func (b *Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		return errors.New("nft service not initialised")
 	}
 
+	db, ok := ctx[storage.BootstrappedDB].(storage.Repository)
+	if !ok {
+		return errors.New("storage not initialised")
+	}
+
+	attachmentStore, ok := ctx[attachments.BootstrappedStore].(attachments.Store)
+	if !ok {
+		return errors.New("attachment store not initialised")
+	}
+
 	handler := receiver.NewHandler(
 		cfg,
 		cfgService,
@@ -68,9 +88,10 @@ func (b *Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		docSrv,
 		identityService,
 		nftService,
+		attachmentStore,
 	)
 
-	ctx[bootstrap.BootstrappedPeer] = newPeer(
+	peer := newPeer(
 		cfg,
 		cfgService,
 		identityService,
@@ -78,5 +99,12 @@ func (b *Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
 		protocolIDDispatcher,
 		handler,
 	)
+
+	peer.deliveryManager = newDeliveryManager(db, cfg, cfgService, peer)
+	peer.addressBook = newAddressBook(db)
+
+	ctx[bootstrap.BootstrappedPeer] = peer
+	ctx[BootstrappedDeliveryManager] = peer.deliveryManager
+	ctx[BootstrappedDocumentSyncer] = newDocumentSyncer(cfg, cfgService, docSrv, peer)
 	return nil
 }
@@ -8,16 +8,20 @@ import (
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
 	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	protocolpb "github.com/centrifuge/centrifuge-protobufs/gen/go/protocol"
 	keystoreType "github.com/centrifuge/chain-custom-types/pkg/keystore"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/crypto"
 	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
 	p2pcommon "github.com/centrifuge/pod/p2p/common"
+	"github.com/centrifuge/pod/pallets/keystore"
 	"github.com/centrifuge/pod/version"
 	"github.com/golang/protobuf/proto"
 	libp2pPeer "github.com/libp2p/go-libp2p-core/peer"
 	pstore "github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/libp2p/go-libp2p-core/protocol"
 )
 
 func (s *p2pPeer) SendAnchoredDocument(ctx context.Context, receiverID *types.AccountID, req *p2ppb.AnchorDocumentRequest) (*p2ppb.AnchorDocumentResponse, error) {
@@ -55,18 +59,147 @@ func (s *p2pPeer) SendAnchoredDocument(ctx context.Context, receiverID *types.Ac
 		return nil, ErrPeerIDRetrieval
 	}
 
-	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, s.config.GetNetworkID(), p2pcommon.MessageTypeSendAnchoredDoc, req)
+	messageType := p2pcommon.MessageTypeSendAnchoredDoc
+
+	var body []byte
+
+	if s.config.IsP2PEncryptionEnabled() {
+		body, err = s.encryptAnchorDocumentRequest(receiverID, req)
+		if err != nil {
+			return nil, err
+		}
+
+		messageType = p2pcommon.MessageTypeSendEncryptedAnchoredDoc
+	} else {
+		body, err = proto.Marshal(req)
+		if err != nil {
+			log.Errorf("Couldn't encode request: %s", err)
+
+			return nil, ErrP2PEnvelopePreparation
+		}
+	}
+
+	if len(body) > s.config.GetP2PChunkingThreshold() {
+		return s.sendAnchoredDocumentChunks(ctx, pid, receiverID, messageType, body)
+	}
+
+	envelope, err := p2pcommon.PrepareP2PEnvelopeWithBody(ctx, s.config.GetNetworkID(), messageType, body)
 	if err != nil {
 		log.Errorf("Couldn't prepare P2P envelope: %s", err)
 
 		return nil, ErrP2PEnvelopePreparation
 	}
 
+	protocolID, err := s.negotiateProtocol(pid, receiverID, messageType)
+	if err != nil {
+		if messageType == p2pcommon.MessageTypeSendEncryptedAnchoredDoc && errors.IsOfType(ErrMessageTypeNotSupported, err) {
+			log.Errorf("Receiver %s doesn't support encrypted documents", receiverID.ToHexString())
+
+			return nil, ErrEncryptionNotSupported
+		}
+
+		return nil, err
+	}
+
+	recvEnvelope, err := s.sendEnvelope(ctx, pid, envelope, protocolID)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeAnchorDocumentResponse(recvEnvelope)
+}
+
+// sendAnchoredDocumentChunks sends the payload of a SendAnchoredDocument message in chunks. The receiver
+// acknowledges each chunk with the index of the next chunk it expects, so that a transfer that was interrupted,
+// and retried by the delivery manager, resumes from the chunks that were already received.
+func (s *p2pPeer) sendAnchoredDocumentChunks(
+	ctx context.Context,
+	pid libp2pPeer.ID,
+	receiverID *types.AccountID,
+	messageType p2pcommon.MessageType,
+	payload []byte,
+) (*p2ppb.AnchorDocumentResponse, error) {
+	chunks, err := p2pcommon.SplitIntoChunks(messageType, payload, s.config.GetP2PChunkSize())
+	if err != nil {
+		log.Errorf("Couldn't split document into chunks: %s", err)
+
+		return nil, ErrP2PEnvelopePreparation
+	}
+
+	envelope, err := s.prepareChunkEnvelope(ctx, chunks[0])
+	if err != nil {
+		return nil, err
+	}
+
+	protocolID, err := s.negotiateProtocol(pid, receiverID, p2pcommon.MessageTypeSendAnchoredDocChunk)
+	if err != nil {
+		return nil, err
+	}
+
+	// The receiver can ask for chunks that it already acknowledged if the transfer expired in the meantime,
+	// each chunk is sent at most twice to avoid sending chunks indefinitely.
+	for sent := 1; ; sent++ {
+		recvEnvelope, err := s.sendEnvelope(ctx, pid, envelope, protocolID)
+		if err != nil {
+			return nil, err
+		}
+
+		// The response to the last chunk is the response to the whole document.
+		if !p2pcommon.MessageTypeSendAnchoredDocChunkRep.Equals(recvEnvelope.Header.Type) {
+			return decodeAnchorDocumentResponse(recvEnvelope)
+		}
+
+		index, err := p2pcommon.DecodeChunkAck(recvEnvelope.GetBody())
+		if err != nil || int(index) >= len(chunks) {
+			log.Errorf("Invalid chunk acknowledgement: %v", err)
+
+			return nil, ErrInvalidChunkAck
+		}
+
+		if sent == 2*len(chunks) {
+			break
+		}
+
+		envelope, err = s.prepareChunkEnvelope(ctx, chunks[index])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Errorf("Chunked transfer didn't complete after sending %d chunks", 2*len(chunks))
+
+	return nil, ErrChunkedTransferIncomplete
+}
+
+func (s *p2pPeer) prepareChunkEnvelope(ctx context.Context, chunk *p2pcommon.Chunk) (*protocolpb.P2PEnvelope, error) {
+	envelope, err := p2pcommon.PrepareP2PEnvelopeWithBody(
+		ctx,
+		s.config.GetNetworkID(),
+		p2pcommon.MessageTypeSendAnchoredDocChunk,
+		chunk.Encode(),
+	)
+	if err != nil {
+		log.Errorf("Couldn't prepare P2P envelope: %s", err)
+
+		return nil, ErrP2PEnvelopePreparation
+	}
+
+	return envelope, nil
+}
+
+// sendEnvelope sends the envelope to the peer and resolves the response, errors returned by the peer are
+// converted to ErrP2PClient errors.
+func (s *p2pPeer) sendEnvelope(
+	ctx context.Context,
+	pid libp2pPeer.ID,
+	envelope *protocolpb.P2PEnvelope,
+	protocolID protocol.ID,
+) (*p2ppb.Envelope, error) {
 	recv, err := s.mes.SendMessage(
 		ctx,
 		pid,
 		envelope,
-		p2pcommon.ProtocolForIdentity(receiverID),
+		protocolID,
 	)
 
 	if err != nil {
@@ -91,6 +224,10 @@ func (s *p2pPeer) SendAnchoredDocument(ctx context.Context, receiverID *types.Ac
 		return nil, errors.NewTypedError(ErrP2PClient, clientErr)
 	}
 
+	return recvEnvelope, nil
+}
+
+func decodeAnchorDocumentResponse(recvEnvelope *p2ppb.Envelope) (*p2ppb.AnchorDocumentResponse, error) {
 	if !p2pcommon.MessageTypeSendAnchoredDocRep.Equals(recvEnvelope.Header.Type) {
 		log.Error("Incorrect response message type")
 
@@ -98,7 +235,7 @@ func (s *p2pPeer) SendAnchoredDocument(ctx context.Context, receiverID *types.Ac
 	}
 
 	r := new(p2ppb.AnchorDocumentResponse)
-	err = proto.Unmarshal(recvEnvelope.Body, r)
+	err := proto.Unmarshal(recvEnvelope.Body, r)
 	if err != nil {
 		log.Errorf("Couldn't decode response: %s", err)
 
@@ -108,7 +245,152 @@ func (s *p2pPeer) SendAnchoredDocument(ctx context.Context, receiverID *types.Ac
 	return r, nil
 }
 
+// encryptAnchorDocumentRequest encrypts the request for the document encryption key of the receiver, so that
+// only the receiver can read the document, even if the message is relayed by other peers.
+func (s *p2pPeer) encryptAnchorDocumentRequest(receiverID *types.AccountID, req *p2ppb.AnchorDocumentRequest) ([]byte, error) {
+	encryptionKey, err := s.keystoreAPI.GetLastKeyByPurpose(receiverID, keystore.KeyPurposeP2PDocumentEncryption)
+	if err != nil {
+		log.Errorf("Couldn't get document encryption key: %s", err)
+
+		return nil, ErrEncryptionKeyRetrieval
+	}
+
+	err = s.idService.ValidateKey(receiverID, encryptionKey[:], keystore.KeyPurposeP2PDocumentEncryption, time.Now())
+	if err != nil {
+		log.Errorf("Invalid document encryption key: %s", err)
+
+		return nil, ErrInvalidEncryptionKey
+	}
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		log.Errorf("Couldn't encode request: %s", err)
+
+		return nil, ErrDocumentEncryption
+	}
+
+	encryptedBody, err := crypto.EncryptMessage(encryptionKey[:], body, crypto.CurveX25519)
+	if err != nil {
+		log.Errorf("Couldn't encrypt request: %s", err)
+
+		return nil, ErrDocumentEncryption
+	}
+
+	return encryptedBody, nil
+}
+
+// QueueAnchoredDocument sends the anchored document to the receiver, the delivery is stored and retried
+// by the delivery manager if the receiver cannot be reached.
+func (s *p2pPeer) QueueAnchoredDocument(ctx context.Context, receiverID *types.AccountID, req *p2ppb.AnchorDocumentRequest) error {
+	return s.deliveryManager.Queue(ctx, receiverID, req)
+}
+
+// GetDeliveryStatus returns the delivery status of the anchored document version for each collaborator.
+func (s *p2pPeer) GetDeliveryStatus(ctx context.Context, documentID, versionID []byte) ([]*documents.DeliveryStatus, error) {
+	return s.deliveryManager.GetDeliveryStatus(ctx, documentID, versionID)
+}
+
 func (s *p2pPeer) GetDocumentRequest(ctx context.Context, documentOwner *types.AccountID, req *p2ppb.GetDocumentRequest) (*p2ppb.GetDocumentResponse, error) {
+	return s.getDocument(ctx, documentOwner, req, p2pcommon.MessageTypeGetDoc, p2pcommon.MessageTypeGetDocRep, s.handler.GetDocument)
+}
+
+// GetLatestVersionRequest requests the latest anchored version of a document from a collaborator.
+func (s *p2pPeer) GetLatestVersionRequest(ctx context.Context, collaborator *types.AccountID, req *p2ppb.GetDocumentRequest) (*p2ppb.GetDocumentResponse, error) {
+	return s.getDocument(
+		ctx,
+		collaborator,
+		req,
+		p2pcommon.MessageTypeGetLatestVersion,
+		p2pcommon.MessageTypeGetLatestVersionRep,
+		s.handler.GetLatestVersion,
+	)
+}
+
+// GetAttachmentRequest requests the content of an attachment of the document from a collaborator.
+func (s *p2pPeer) GetAttachmentRequest(ctx context.Context, collaborator *types.AccountID, documentID, hash []byte) ([]byte, error) {
+	sender, err := contextutil.Identity(ctx)
+	if err != nil {
+		log.Errorf("Couldn't get sender identity: %s", err)
+
+		return nil, errors.ErrContextIdentityRetrieval
+	}
+
+	req := &p2pcommon.AttachmentRequest{
+		DocumentID: documentID,
+		Hash:       hash,
+	}
+
+	acc, err := s.cfgService.GetAccount(collaborator.ToBytes())
+	if err == nil { // this is a local account
+		peerCtx, cancel := context.WithTimeout(ctx, s.config.GetP2PConnectionTimeout())
+		defer cancel()
+
+		localCtx := contextutil.WithAccount(peerCtx, acc)
+
+		return s.handler.GetAttachment(localCtx, req, sender)
+	}
+
+	err = s.idService.ValidateAccount(collaborator)
+	if err != nil {
+		log.Errorf("Couldn't validate collaborator account: %s", err)
+
+		return nil, ErrInvalidReceiverAccount
+	}
+
+	body, err := req.Encode()
+	if err != nil {
+		log.Errorf("Couldn't encode request: %s", err)
+
+		return nil, ErrP2PEnvelopePreparation
+	}
+
+	// this is a remote account
+	pid, err := s.getPeerID(ctx, collaborator)
+	if err != nil {
+		log.Errorf("Couldn't retrieve peer ID: %s", err)
+
+		return nil, ErrPeerIDRetrieval
+	}
+
+	envelope, err := p2pcommon.PrepareP2PEnvelopeWithBody(ctx, s.config.GetNetworkID(), p2pcommon.MessageTypeGetAttachment, body)
+	if err != nil {
+		log.Errorf("Couldn't prepare P2P envelope: %s", err)
+
+		return nil, ErrP2PEnvelopePreparation
+	}
+
+	protocolID, err := s.negotiateProtocol(pid, collaborator, p2pcommon.MessageTypeGetAttachment)
+	if err != nil {
+		return nil, err
+	}
+
+	recvEnvelope, err := s.sendEnvelope(ctx, pid, envelope, protocolID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p2pcommon.MessageTypeGetAttachmentRep.Equals(recvEnvelope.Header.Type) {
+		log.Error("Incorrect response message type")
+
+		return nil, ErrIncorrectResponseMessageType
+	}
+
+	return recvEnvelope.GetBody(), nil
+}
+
+// localDocumentGetter retrieves a document of a local account.
+type localDocumentGetter func(ctx context.Context, docReq *p2ppb.GetDocumentRequest, requester *types.AccountID) (*p2ppb.GetDocumentResponse, error)
+
+// getDocument requests a document from the document owner using the provided message types, the local getter
+// is used if the owner is an account of this node.
+func (s *p2pPeer) getDocument(
+	ctx context.Context,
+	documentOwner *types.AccountID,
+	req *p2ppb.GetDocumentRequest,
+	messageType p2pcommon.MessageType,
+	responseMessageType p2pcommon.MessageType,
+	localGetter localDocumentGetter,
+) (*p2ppb.GetDocumentResponse, error) {
 	sender, err := contextutil.Identity(ctx)
 	if err != nil {
 		log.Errorf("Couldn't get sender identity: %s", err)
@@ -123,7 +405,7 @@ func (s *p2pPeer) GetDocumentRequest(ctx context.Context, documentOwner *types.A
 
 		localCtx := contextutil.WithAccount(peerCtx, acc)
 
-		return s.handler.GetDocument(localCtx, req, sender)
+		return localGetter(localCtx, req, sender)
 	}
 
 	err = s.idService.ValidateAccount(documentOwner)
@@ -141,18 +423,23 @@ func (s *p2pPeer) GetDocumentRequest(ctx context.Context, documentOwner *types.A
 		return nil, ErrPeerIDRetrieval
 	}
 
-	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, s.config.GetNetworkID(), p2pcommon.MessageTypeGetDoc, req)
+	envelope, err := p2pcommon.PrepareP2PEnvelope(ctx, s.config.GetNetworkID(), messageType, req)
 	if err != nil {
 		log.Errorf("Couldn't prepare P2P envelope: %s", err)
 
 		return nil, ErrP2PEnvelopePreparation
 	}
 
+	protocolID, err := s.negotiateProtocol(pid, documentOwner, messageType)
+	if err != nil {
+		return nil, err
+	}
+
 	recv, err := s.mes.SendMessage(
 		ctx,
 		pid,
 		envelope,
-		p2pcommon.ProtocolForIdentity(documentOwner),
+		protocolID,
 	)
 
 	if err != nil {
@@ -177,7 +464,7 @@ func (s *p2pPeer) GetDocumentRequest(ctx context.Context, documentOwner *types.A
 		return nil, errors.NewTypedError(ErrP2PClient, clientErr)
 	}
 
-	if !p2pcommon.MessageTypeGetDocRep.Equals(recvEnvelope.Header.Type) {
+	if !responseMessageType.Equals(recvEnvelope.Header.Type) {
 		log.Error("Incorrect response message type")
 
 		return nil, ErrIncorrectResponseMessageType
@@ -210,14 +497,30 @@ func (s *p2pPeer) GetSignaturesForDocument(ctx context.Context, model documents.
 		return nil, nil, ErrSignerCollaboratorsRetrieval
 	}
 
+	return s.GetSignaturesFromCollaborators(ctx, model, signerCollaborators)
+}
+
+// GetSignaturesFromCollaborators requests the provided collaborators for the signature, verifies them, and returns those signatures.
+func (s *p2pPeer) GetSignaturesFromCollaborators(
+	ctx context.Context,
+	model documents.Document,
+	collaborators []*types.AccountID,
+) (signatures []*coredocumentpb.Signature, signatureCollectionErrors []error, err error) {
+	sender, err := contextutil.Identity(ctx)
+	if err != nil {
+		log.Errorf("Couldn't get sender identity: %s", err)
+
+		return nil, nil, errors.ErrContextIdentityRetrieval
+	}
+
 	peerCtx, cancel := context.WithTimeout(ctx, s.config.GetP2PConnectionTimeout())
 	defer cancel()
 
 	var wg sync.WaitGroup
 
-	signatureWrapChan := make(chan signatureResponseWrap, len(signerCollaborators))
+	signatureWrapChan := make(chan signatureResponseWrap, len(collaborators))
 
-	for _, collaborator := range signerCollaborators {
+	for _, collaborator := range collaborators {
 		collaborator := collaborator
 
 		wg.Add(1)
@@ -260,6 +563,44 @@ func (s *p2pPeer) GetSignaturesForDocument(ctx context.Context, model documents.
 	}
 }
 
+// negotiateProtocol returns the highest protocol version spoken by both the node and the remote peer
+// for the given account, and ensures that the message type can be sent using it.
+func (s *p2pPeer) negotiateProtocol(
+	pid libp2pPeer.ID,
+	accountID *types.AccountID,
+	messageType p2pcommon.MessageType,
+) (protocol.ID, error) {
+	protocols := p2pcommon.ProtocolsForIdentity(accountID)
+
+	protocolID, err := s.mes.NegotiateProtocol(pid, protocols...)
+	if err != nil && s.addRelayedAddrs(pid) {
+		log.Warnf("Couldn't negotiate protocol over a direct connection, retrying through relays: %s", err)
+
+		protocolID, err = s.mes.NegotiateProtocol(pid, protocols...)
+	}
+
+	if err != nil {
+		log.Errorf("Couldn't negotiate protocol: %s", err)
+
+		return "", ErrProtocolNegotiation
+	}
+
+	protocolVersion, err := p2pcommon.ExtractProtocolVersion(protocolID)
+	if err != nil {
+		log.Errorf("Couldn't extract protocol version: %s", err)
+
+		return "", ErrProtocolNegotiation
+	}
+
+	if !protocolVersion.SupportsMessageType(messageType) {
+		log.Errorf("Message type %s is not supported by protocol version %s", messageType, protocolVersion)
+
+		return "", ErrMessageTypeNotSupported
+	}
+
+	return protocolID, nil
+}
+
 // getPeerID returns peerID to contact the remote peer
 func (s *p2pPeer) getPeerID(ctx context.Context, accountID *types.AccountID) (libp2pPeer.ID, error) {
 	p2pKey, err := s.keystoreAPI.GetLastKeyByPurpose(accountID, keystoreType.KeyPurposeP2PDiscovery)
@@ -282,20 +623,43 @@ func (s *p2pPeer) getPeerID(ctx context.Context, accountID *types.AccountID) (li
 		return "", ErrPeerIDParsing
 	}
 
-	if !s.disablePeerStore {
-		ctx, canc := context.WithTimeout(ctx, s.config.GetP2PConnectionTimeout())
-		defer canc()
-
-		pinfo, err := s.dht.FindPeer(ctx, peerID)
-		if err != nil {
-			log.Errorf("Couldn't find peer: %s", err)
+	if s.disablePeerStore {
+		return peerID, nil
+	}
 
-			return "", ErrPeerNotFound
+	if staticPeer, ok := s.staticPeers[accountID.ToHexString()]; ok {
+		// The addresses of the static peers are added to the peer store on startup.
+		if staticPeer.ID == peerID {
+			return peerID, nil
 		}
 
-		// We have a peer ID and a targetAddr so we add it to the peer store
-		// so LibP2P knows how to contact it (this call might be redundant)
-		s.host.Peerstore().AddAddrs(peerID, pinfo.Addrs, pstore.PermanentAddrTTL)
+		log.Warnf("Static peer of %s doesn't match its P2P key, looking it up in the DHT", accountID.ToHexString())
+	}
+
+	if entry, err := s.addressBook.get(accountID); err == nil &&
+		entry.PeerID == peerID &&
+		time.Since(entry.ResolvedAt) < s.config.GetP2PAddressBookTTL() {
+		s.host.Peerstore().AddAddrs(peerID, entry.multiaddrs(), pstore.AddressTTL)
+
+		return peerID, nil
+	}
+
+	ctx, canc := context.WithTimeout(ctx, s.config.GetP2PConnectionTimeout())
+	defer canc()
+
+	pinfo, err := s.dht.FindPeer(ctx, peerID)
+	if err != nil {
+		log.Errorf("Couldn't find peer: %s", err)
+
+		return "", ErrPeerNotFound
+	}
+
+	// We have a peer ID and a targetAddr so we add it to the peer store
+	// so LibP2P knows how to contact it (this call might be redundant)
+	s.host.Peerstore().AddAddrs(peerID, pinfo.Addrs, pstore.PermanentAddrTTL)
+
+	if err := s.addressBook.put(accountID, peerID, pinfo.Addrs); err != nil {
+		log.Errorf("Couldn't store peer addresses: %s", err)
 	}
 
 	return peerID, nil
@@ -355,9 +719,14 @@ func (s *p2pPeer) getSignatureForDocument(ctx context.Context, model documents.D
 		return nil, ErrP2PEnvelopePreparation
 	}
 
+	protocolID, err := s.negotiateProtocol(receiverPeer, collaborator, p2pcommon.MessageTypeRequestSignature)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Infof("Requesting signature from %s\n", receiverPeer)
 
-	recv, err := s.mes.SendMessage(ctx, receiverPeer, envelope, p2pcommon.ProtocolForIdentity(collaborator))
+	recv, err := s.mes.SendMessage(ctx, receiverPeer, envelope, protocolID)
 
 	if err != nil {
 		log.Errorf("Couldn't send P2P message: %s", err)
@@ -4,6 +4,7 @@ package p2p
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/crypto"
 	"github.com/centrifuge/pod/crypto/ed25519"
 	"github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/documents"
@@ -31,6 +33,7 @@ import (
 	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
 	pstore "github.com/libp2p/go-libp2p-core/peerstore"
 	"github.com/libp2p/go-libp2p-core/protocol"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/proto"
@@ -65,6 +68,12 @@ func TestPeer_Client_SendAnchoredDocument(t *testing.T) {
 
 	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(defaultChunkingThreshold).Once()
+
 	anchorDocRes := &p2ppb.AnchorDocumentResponse{}
 
 	anchorsDocResBytes, err := proto.Marshal(anchorDocRes)
@@ -87,6 +96,8 @@ func TestPeer_Client_SendAnchoredDocument(t *testing.T) {
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -240,6 +251,14 @@ func TestPeer_Client_SendAnchoredDocument_MessengerError(t *testing.T) {
 
 	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(defaultChunkingThreshold).Once()
+
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -302,11 +321,19 @@ func TestPeer_Client_SendAnchoredDocument_ResolveEnvelopeError(t *testing.T) {
 
 	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(defaultChunkingThreshold).Once()
+
 	// Set invalid body to ensure an error when resolving the envelope.
 	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
 		Body: utils.RandomSlice(32),
 	}
 
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -369,6 +396,12 @@ func TestPeer_Client_SendAnchoredDocument_ReceiveEnvelopeError(t *testing.T) {
 
 	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(defaultChunkingThreshold).Once()
+
 	envelopeRes := &p2ppb.Envelope{
 		Header: &p2ppb.Header{
 			NetworkIdentifier: networkID,
@@ -387,6 +420,8 @@ func TestPeer_Client_SendAnchoredDocument_ReceiveEnvelopeError(t *testing.T) {
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -449,6 +484,12 @@ func TestPeer_Client_SendAnchoredDocument_ReceiveEnvelopeInvalidType(t *testing.
 
 	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(defaultChunkingThreshold).Once()
+
 	anchorDocRes := &p2ppb.AnchorDocumentResponse{}
 
 	anchorsDocResBytes, err := proto.Marshal(anchorDocRes)
@@ -472,6 +513,8 @@ func TestPeer_Client_SendAnchoredDocument_ReceiveEnvelopeInvalidType(t *testing.
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -534,6 +577,12 @@ func TestPeer_Client_SendAnchoredDocument_ResponseDecodeError(t *testing.T) {
 
 	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(defaultChunkingThreshold).Once()
+
 	envelopeRes := &p2ppb.Envelope{
 		Header: &p2ppb.Header{
 			NetworkIdentifier: networkID,
@@ -552,6 +601,8 @@ func TestPeer_Client_SendAnchoredDocument_ResponseDecodeError(t *testing.T) {
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -585,7 +636,7 @@ func TestPeer_Client_SendAnchoredDocument_ResponseDecodeError(t *testing.T) {
 	assert.Nil(t, res)
 }
 
-func TestPeer_Client_SendAnchoredDocument_LocalAccount(t *testing.T) {
+func TestPeer_Client_SendAnchoredDocument_Encrypted(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -600,49 +651,92 @@ func TestPeer_Client_SendAnchoredDocument_LocalAccount(t *testing.T) {
 	receiverID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	cd := &coredocumentpb.CoreDocument{}
+	cd := &coredocumentpb.CoreDocument{
+		DocumentIdentifier: utils.RandomSlice(32),
+	}
 
 	req := &p2ppb.AnchorDocumentRequest{
 		Document: cd,
 	}
 
-	p2pConnTimeout := 1 * time.Second
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
 
-	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
-		Return(p2pConnTimeout).Once()
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", receiverID).
+		Return(nil).Once()
 
-	receiverAccountMock := config.NewAccountMock(t)
+	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
-	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
-		Return(receiverAccountMock, nil).Once()
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(true).Once()
 
-	anchorDocRes := &p2ppb.AnchorDocumentResponse{}
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(defaultChunkingThreshold).Once()
 
-	genericUtils.GetMock[*receiver.HandlerMock](mocks).
+	encryptionPubKey, encryptionPrivKey := mockEncryptionKeyRetrievalCalls(t, receiverID, mocks)
+
+	anchorDocRes := &p2ppb.AnchorDocumentResponse{Accepted: true}
+
+	anchorsDocResBytes, err := proto.Marshal(anchorDocRes)
+	assert.NoError(t, err)
+
+	envelopeRes := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       "test-version",
+			SenderId:          utils.RandomSlice(32),
+			Type:              p2pcommon.MessageTypeSendAnchoredDocRep.String(),
+		},
+		Body: anchorsDocResBytes,
+	}
+
+	envelopeResBytes, err := proto.Marshal(envelopeRes)
+	assert.NoError(t, err)
+
+	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
+		Body: envelopeResBytes,
+	}
+
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
-			"SendAnchoredDocument",
+			"SendMessage",
 			mock.Anything,
-			req,
-			identity,
+			peerID,
+			mock.IsType(&protocolpb.P2PEnvelope{}),
+			p2pcommon.ProtocolForIdentity(receiverID),
 		).
 		Run(func(args mock.Arguments) {
-			handlerCtx, ok := args.Get(0).(context.Context)
+			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
 			assert.True(t, ok)
 
-			handlerCtxAccount, err := contextutil.Account(handlerCtx)
+			var env p2ppb.Envelope
+
+			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
 			assert.NoError(t, err)
 
-			assert.Equal(t, receiverAccountMock, handlerCtxAccount)
+			reqBytes, err := proto.Marshal(req)
+			assert.NoError(t, err)
+
+			assert.NotEqual(t, reqBytes, env.GetBody())
+
+			decryptedBody, err := crypto.DecryptMessage(encryptionPubKey, encryptionPrivKey, env.GetBody(), crypto.CurveX25519)
+			assert.NoError(t, err)
+
+			assert.Equal(t, reqBytes, decryptedBody)
+			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
+			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
+			assert.Equal(t, p2pcommon.MessageTypeSendEncryptedAnchoredDoc.String(), env.GetHeader().GetType())
 		}).
-		Return(anchorDocRes, nil).
-		Once()
+		Return(protocolEnvelopeRes, nil).Once()
 
 	res, err := peer.SendAnchoredDocument(ctx, receiverID, req)
 	assert.NoError(t, err)
-	assert.Equal(t, anchorDocRes, res)
+	assert.True(t, proto.Equal(anchorDocRes, res))
 }
 
-func TestPeer_Client_SendAnchoredDocument_LocalAccount_HandlerError(t *testing.T) {
+func TestPeer_Client_SendAnchoredDocument_Encrypted_MessageTypeNotSupported(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -657,49 +751,38 @@ func TestPeer_Client_SendAnchoredDocument_LocalAccount_HandlerError(t *testing.T
 	receiverID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	cd := &coredocumentpb.CoreDocument{}
-
 	req := &p2ppb.AnchorDocumentRequest{
-		Document: cd,
+		Document: &coredocumentpb.CoreDocument{},
 	}
 
-	p2pConnTimeout := 1 * time.Second
-
-	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
-		Return(p2pConnTimeout).Once()
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
 
-	receiverAccountMock := config.NewAccountMock(t)
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", receiverID).
+		Return(nil).Once()
 
-	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
-		Return(receiverAccountMock, nil).Once()
+	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
-	handlerErr := errors.New("error")
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(true).Once()
 
-	genericUtils.GetMock[*receiver.HandlerMock](mocks).
-		On(
-			"SendAnchoredDocument",
-			mock.Anything,
-			req,
-			identity,
-		).
-		Run(func(args mock.Arguments) {
-			handlerCtx, ok := args.Get(0).(context.Context)
-			assert.True(t, ok)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(defaultChunkingThreshold).Once()
 
-			handlerCtxAccount, err := contextutil.Account(handlerCtx)
-			assert.NoError(t, err)
+	mockEncryptionKeyRetrievalCalls(t, receiverID, mocks)
 
-			assert.Equal(t, receiverAccountMock, handlerCtxAccount)
-		}).
-		Return(nil, handlerErr).
+	// The peer doesn't support encrypted documents.
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("NegotiateProtocol", append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(receiverID))...)...).
+		Return(p2pcommon.ProtocolForIdentityVersion(receiverID, p2pcommon.ProtocolVersion002), nil).
 		Once()
 
 	res, err := peer.SendAnchoredDocument(ctx, receiverID, req)
-	assert.ErrorIs(t, err, handlerErr)
+	assert.ErrorIs(t, err, ErrEncryptionNotSupported)
 	assert.Nil(t, res)
 }
 
-func TestPeer_Client_GetDocumentRequest(t *testing.T) {
+func TestPeer_Client_SendAnchoredDocument_Chunked(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -711,96 +794,93 @@ func TestPeer_Client_GetDocumentRequest(t *testing.T) {
 
 	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
 
-	requesterID, err := testingcommons.GetRandomAccountID()
+	receiverID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: &coredocumentpb.CoreDocument{
+			DocumentIdentifier: utils.RandomSlice(32),
+		},
 	}
 
-	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+	reqBytes, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
 		Return(nil, errors.New("error")).Once()
 
-	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", receiverID).
 		Return(nil).Once()
 
-	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
-	getDocRes := &p2ppb.GetDocumentResponse{}
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
 
-	getDocResBytes, err := proto.Marshal(getDocRes)
-	assert.NoError(t, err)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(16).Once()
 
-	envelopeRes := &p2ppb.Envelope{
-		Header: &p2ppb.Header{
-			NetworkIdentifier: networkID,
-			NodeVersion:       "test-version",
-			SenderId:          utils.RandomSlice(32),
-			Type:              p2pcommon.MessageTypeGetDocRep.String(),
-		},
-		Body: getDocResBytes,
-	}
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkSize").
+		Return(16).Once()
 
-	envelopeResBytes, err := proto.Marshal(envelopeRes)
-	assert.NoError(t, err)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetNetworkID").
+		Return(networkID).Once()
 
-	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
-		Body: envelopeResBytes,
-	}
+	mockProtocolNegotiation(mocks, peerID, receiverID)
 
-	genericUtils.GetMock[*ms.MessengerMock](mocks).
-		On(
-			"SendMessage",
-			mock.Anything,
-			peerID,
-			mock.IsType(&protocolpb.P2PEnvelope{}),
-			p2pcommon.ProtocolForIdentity(requesterID),
-		).
-		Run(func(args mock.Arguments) {
-			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
-			assert.True(t, ok)
+	var sentChunks []*p2pcommon.Chunk
 
-			var env p2ppb.Envelope
+	captureChunk := func(args mock.Arguments) {
+		protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
+		assert.True(t, ok)
 
-			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
-			assert.NoError(t, err)
+		var env p2ppb.Envelope
 
-			reqBytes, err := proto.Marshal(req)
-			assert.NoError(t, err)
+		err := proto.Unmarshal(protocolEnv.GetBody(), &env)
+		assert.NoError(t, err)
 
-			assert.Equal(t, reqBytes, env.GetBody())
-			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
-			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
-			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
-			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
-		}).
-		Return(protocolEnvelopeRes, nil).Once()
+		assert.Equal(t, p2pcommon.MessageTypeSendAnchoredDocChunk.String(), env.GetHeader().GetType())
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+		chunk, err := p2pcommon.DecodeChunk(env.GetBody())
+		assert.NoError(t, err)
+
+		sentChunks = append(sentChunks, chunk)
+	}
+
+	anchorDocRes := &p2ppb.AnchorDocumentResponse{Accepted: true}
+
+	anchorDocResBytes, err := proto.Marshal(anchorDocRes)
 	assert.NoError(t, err)
-	assert.Equal(t, getDocRes, res)
-}
 
-func TestPeer_Client_GetDocumentRequest_SenderIdentityError(t *testing.T) {
-	peer, _ := getPeerMocks(t)
+	// The receiver already has the second chunk, from a previous attempt.
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("SendMessage", mock.Anything, peerID, mock.IsType(&protocolpb.P2PEnvelope{}), p2pcommon.ProtocolForIdentity(receiverID)).
+		Run(captureChunk).
+		Return(getTestProtocolEnvelope(t, p2pcommon.MessageTypeSendAnchoredDocChunkRep, p2pcommon.EncodeChunkAck(2)), nil).
+		Once()
 
-	ctx := context.Background()
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("SendMessage", mock.Anything, peerID, mock.IsType(&protocolpb.P2PEnvelope{}), p2pcommon.ProtocolForIdentity(receiverID)).
+		Run(captureChunk).
+		Return(getTestProtocolEnvelope(t, p2pcommon.MessageTypeSendAnchoredDocRep, anchorDocResBytes), nil).
+		Once()
 
-	requesterID, err := testingcommons.GetRandomAccountID()
+	res, err := peer.SendAnchoredDocument(ctx, receiverID, req)
 	assert.NoError(t, err)
+	assert.True(t, proto.Equal(anchorDocRes, res))
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
-	}
+	assert.Len(t, sentChunks, 2)
+	assert.Equal(t, uint32(0), sentChunks[0].Index)
+	assert.Equal(t, uint32(2), sentChunks[1].Index)
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
-	assert.Nil(t, res)
+	for _, chunk := range sentChunks {
+		assert.Equal(t, p2pcommon.MessageTypeSendAnchoredDoc, chunk.MessageType)
+		assert.Equal(t, uint64(len(reqBytes)), chunk.PayloadSize)
+		assert.Equal(t, uint32(3), chunk.Total)
+	}
 }
 
-func TestPeer_Client_GetDocumentRequest_ValidateAccountError(t *testing.T) {
+func TestPeer_Client_SendAnchoredDocument_Chunked_InvalidAck(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -812,26 +892,45 @@ func TestPeer_Client_GetDocumentRequest_ValidateAccountError(t *testing.T) {
 
 	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
 
-	requesterID, err := testingcommons.GetRandomAccountID()
+	receiverID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: &coredocumentpb.CoreDocument{
+			DocumentIdentifier: utils.RandomSlice(32),
+		},
 	}
 
-	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
 		Return(nil, errors.New("error")).Once()
 
-	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
-		Return(errors.New("error")).Once()
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", receiverID).
+		Return(nil).Once()
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.ErrorIs(t, err, ErrInvalidRequesterAccount)
+	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(16).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkSize").
+		Return(16).Once()
+
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("SendMessage", mock.Anything, peerID, mock.IsType(&protocolpb.P2PEnvelope{}), p2pcommon.ProtocolForIdentity(receiverID)).
+		Return(getTestProtocolEnvelope(t, p2pcommon.MessageTypeSendAnchoredDocChunkRep, p2pcommon.EncodeChunkAck(5)), nil).
+		Once()
+
+	res, err := peer.SendAnchoredDocument(ctx, receiverID, req)
+	assert.ErrorIs(t, err, ErrInvalidChunkAck)
 	assert.Nil(t, res)
 }
 
-func TestPeer_Client_GetDocumentRequest_PeerIDRetrievalError(t *testing.T) {
+func TestPeer_Client_SendAnchoredDocument_Chunked_Incomplete(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -843,40 +942,815 @@ func TestPeer_Client_GetDocumentRequest_PeerIDRetrievalError(t *testing.T) {
 
 	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
 
-	requesterID, err := testingcommons.GetRandomAccountID()
+	receiverID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: &coredocumentpb.CoreDocument{
+			DocumentIdentifier: utils.RandomSlice(32),
+		},
 	}
 
-	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
 		Return(nil, errors.New("error")).Once()
 
-	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", receiverID).
 		Return(nil).Once()
 
-	genericUtils.GetMock[*keystore.APIMock](mocks).
-		On(
-			"GetLastKeyByPurpose",
-			requesterID,
-			keystoreType.KeyPurposeP2PDiscovery,
-		).
-		Return(nil, errors.New("error")).Once()
+	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.ErrorIs(t, err, ErrPeerIDRetrieval)
-	assert.Nil(t, res)
-}
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
 
-func TestPeer_Client_GetDocumentRequest_MessengerError(t *testing.T) {
-	peer, mocks := getPeerMocks(t)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(16).Once()
 
-	identity, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkSize").
+		Return(16).Once()
 
-	senderAccountMock := config.NewAccountMock(t)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetNetworkID").
+		Return(networkID).Times(5)
+
+	mockProtocolNegotiation(mocks, peerID, receiverID)
+
+	// The receiver keeps asking for the first chunk.
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("SendMessage", mock.Anything, peerID, mock.IsType(&protocolpb.P2PEnvelope{}), p2pcommon.ProtocolForIdentity(receiverID)).
+		Return(getTestProtocolEnvelope(t, p2pcommon.MessageTypeSendAnchoredDocChunkRep, p2pcommon.EncodeChunkAck(0)), nil).
+		Times(6)
+
+	res, err := peer.SendAnchoredDocument(ctx, receiverID, req)
+	assert.ErrorIs(t, err, ErrChunkedTransferIncomplete)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_SendAnchoredDocument_Chunked_MessageTypeNotSupported(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	receiverID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: &coredocumentpb.CoreDocument{
+			DocumentIdentifier: utils.RandomSlice(32),
+		},
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", receiverID).
+		Return(nil).Once()
+
+	peerID := mockPeerIDRetrievalCalls(t, receiverID, mocks)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PEncryptionEnabled").
+		Return(false).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkingThreshold").
+		Return(16).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PChunkSize").
+		Return(16).Once()
+
+	// The peer doesn't support chunked documents.
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("NegotiateProtocol", append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(receiverID))...)...).
+		Return(p2pcommon.ProtocolForIdentityVersion(receiverID, p2pcommon.ProtocolVersion003), nil).
+		Once()
+
+	res, err := peer.SendAnchoredDocument(ctx, receiverID, req)
+	assert.ErrorIs(t, err, ErrMessageTypeNotSupported)
+	assert.Nil(t, res)
+}
+
+func TestPeer_encryptAnchorDocumentRequest(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	receiverID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: &coredocumentpb.CoreDocument{
+			DocumentIdentifier: utils.RandomSlice(32),
+		},
+	}
+
+	encryptionPubKey, encryptionPrivKey := mockEncryptionKeyRetrievalCalls(t, receiverID, mocks)
+
+	res, err := peer.encryptAnchorDocumentRequest(receiverID, req)
+	assert.NoError(t, err)
+
+	decryptedBody, err := crypto.DecryptMessage(encryptionPubKey, encryptionPrivKey, res, crypto.CurveX25519)
+	assert.NoError(t, err)
+
+	var decryptedReq p2ppb.AnchorDocumentRequest
+
+	err = proto.Unmarshal(decryptedBody, &decryptedReq)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(req, &decryptedReq))
+}
+
+func TestPeer_encryptAnchorDocumentRequest_EncryptionKeyRetrievalError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	receiverID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).
+		On("GetLastKeyByPurpose", receiverID, keystore.KeyPurposeP2PDocumentEncryption).
+		Return(nil, errors.New("error")).Once()
+
+	res, err := peer.encryptAnchorDocumentRequest(receiverID, &p2ppb.AnchorDocumentRequest{})
+	assert.ErrorIs(t, err, ErrEncryptionKeyRetrieval)
+	assert.Nil(t, res)
+}
+
+func TestPeer_encryptAnchorDocumentRequest_InvalidEncryptionKey(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	receiverID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	encryptionKey := types.NewHash(utils.RandomSlice(32))
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).
+		On("GetLastKeyByPurpose", receiverID, keystore.KeyPurposeP2PDocumentEncryption).
+		Return(&encryptionKey, nil).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).
+		On("ValidateKey", receiverID, encryptionKey[:], keystore.KeyPurposeP2PDocumentEncryption, mock.Anything).
+		Return(errors.New("error")).Once()
+
+	res, err := peer.encryptAnchorDocumentRequest(receiverID, &p2ppb.AnchorDocumentRequest{})
+	assert.ErrorIs(t, err, ErrInvalidEncryptionKey)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_SendAnchoredDocument_LocalAccount(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	receiverID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	cd := &coredocumentpb.CoreDocument{}
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
+	}
+
+	p2pConnTimeout := 1 * time.Second
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(p2pConnTimeout).Once()
+
+	receiverAccountMock := config.NewAccountMock(t)
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
+		Return(receiverAccountMock, nil).Once()
+
+	anchorDocRes := &p2ppb.AnchorDocumentResponse{}
+
+	genericUtils.GetMock[*receiver.HandlerMock](mocks).
+		On(
+			"SendAnchoredDocument",
+			mock.Anything,
+			req,
+			identity,
+		).
+		Run(func(args mock.Arguments) {
+			handlerCtx, ok := args.Get(0).(context.Context)
+			assert.True(t, ok)
+
+			handlerCtxAccount, err := contextutil.Account(handlerCtx)
+			assert.NoError(t, err)
+
+			assert.Equal(t, receiverAccountMock, handlerCtxAccount)
+		}).
+		Return(anchorDocRes, nil).
+		Once()
+
+	res, err := peer.SendAnchoredDocument(ctx, receiverID, req)
+	assert.NoError(t, err)
+	assert.Equal(t, anchorDocRes, res)
+}
+
+func TestPeer_Client_SendAnchoredDocument_LocalAccount_HandlerError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	receiverID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	cd := &coredocumentpb.CoreDocument{}
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
+	}
+
+	p2pConnTimeout := 1 * time.Second
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(p2pConnTimeout).Once()
+
+	receiverAccountMock := config.NewAccountMock(t)
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", receiverID.ToBytes()).
+		Return(receiverAccountMock, nil).Once()
+
+	handlerErr := errors.New("error")
+
+	genericUtils.GetMock[*receiver.HandlerMock](mocks).
+		On(
+			"SendAnchoredDocument",
+			mock.Anything,
+			req,
+			identity,
+		).
+		Run(func(args mock.Arguments) {
+			handlerCtx, ok := args.Get(0).(context.Context)
+			assert.True(t, ok)
+
+			handlerCtxAccount, err := contextutil.Account(handlerCtx)
+			assert.NoError(t, err)
+
+			assert.Equal(t, receiverAccountMock, handlerCtxAccount)
+		}).
+		Return(nil, handlerErr).
+		Once()
+
+	res, err := peer.SendAnchoredDocument(ctx, receiverID, req)
+	assert.ErrorIs(t, err, handlerErr)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(nil).Once()
+
+	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+
+	getDocRes := &p2ppb.GetDocumentResponse{}
+
+	getDocResBytes, err := proto.Marshal(getDocRes)
+	assert.NoError(t, err)
+
+	envelopeRes := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: networkID,
+			NodeVersion:       "test-version",
+			SenderId:          utils.RandomSlice(32),
+			Type:              p2pcommon.MessageTypeGetDocRep.String(),
+		},
+		Body: getDocResBytes,
+	}
+
+	envelopeResBytes, err := proto.Marshal(envelopeRes)
+	assert.NoError(t, err)
+
+	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
+		Body: envelopeResBytes,
+	}
+
+	mockProtocolNegotiation(mocks, peerID, requesterID)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On(
+			"SendMessage",
+			mock.Anything,
+			peerID,
+			mock.IsType(&protocolpb.P2PEnvelope{}),
+			p2pcommon.ProtocolForIdentity(requesterID),
+		).
+		Run(func(args mock.Arguments) {
+			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
+			assert.True(t, ok)
+
+			var env p2ppb.Envelope
+
+			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
+			assert.NoError(t, err)
+
+			reqBytes, err := proto.Marshal(req)
+			assert.NoError(t, err)
+
+			assert.Equal(t, reqBytes, env.GetBody())
+			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
+			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
+			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
+			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
+		}).
+		Return(protocolEnvelopeRes, nil).Once()
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.NoError(t, err)
+	assert.Equal(t, getDocRes, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_SenderIdentityError(t *testing.T) {
+	peer, _ := getPeerMocks(t)
+
+	ctx := context.Background()
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_ValidateAccountError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(errors.New("error")).Once()
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, ErrInvalidRequesterAccount)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_PeerIDRetrievalError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(nil).Once()
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).
+		On(
+			"GetLastKeyByPurpose",
+			requesterID,
+			keystoreType.KeyPurposeP2PDiscovery,
+		).
+		Return(nil, errors.New("error")).Once()
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, ErrPeerIDRetrieval)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_MessengerError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(nil).Once()
+
+	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+
+	mockProtocolNegotiation(mocks, peerID, requesterID)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On(
+			"SendMessage",
+			mock.Anything,
+			peerID,
+			mock.IsType(&protocolpb.P2PEnvelope{}),
+			p2pcommon.ProtocolForIdentity(requesterID),
+		).
+		Return(nil, errors.New("error")).Once()
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, ErrP2PMessageSending)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_ResolveEnvelopeError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(nil).Once()
+
+	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+
+	// Set invalid body to ensure an error when resolving the envelope.
+	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
+		Body: utils.RandomSlice(32),
+	}
+
+	mockProtocolNegotiation(mocks, peerID, requesterID)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On(
+			"SendMessage",
+			mock.Anything,
+			peerID,
+			mock.IsType(&protocolpb.P2PEnvelope{}),
+			p2pcommon.ProtocolForIdentity(requesterID),
+		).
+		Run(func(args mock.Arguments) {
+			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
+			assert.True(t, ok)
+
+			var env p2ppb.Envelope
+
+			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
+			assert.NoError(t, err)
+
+			reqBytes, err := proto.Marshal(req)
+			assert.NoError(t, err)
+
+			assert.Equal(t, reqBytes, env.GetBody())
+			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
+			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
+			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
+			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
+		}).
+		Return(protocolEnvelopeRes, nil).Once()
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, ErrP2PDataEnvelopeResolving)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(nil).Once()
+
+	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+
+	envelopeRes := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: networkID,
+			NodeVersion:       "test-version",
+			SenderId:          utils.RandomSlice(32),
+			// Error type.
+			Type: p2pcommon.MessageTypeError.String(),
+		},
+		Body: nil,
+	}
+
+	envelopeResBytes, err := proto.Marshal(envelopeRes)
+	assert.NoError(t, err)
+
+	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
+		Body: envelopeResBytes,
+	}
+
+	mockProtocolNegotiation(mocks, peerID, requesterID)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On(
+			"SendMessage",
+			mock.Anything,
+			peerID,
+			mock.IsType(&protocolpb.P2PEnvelope{}),
+			p2pcommon.ProtocolForIdentity(requesterID),
+		).
+		Run(func(args mock.Arguments) {
+			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
+			assert.True(t, ok)
+
+			var env p2ppb.Envelope
+
+			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
+			assert.NoError(t, err)
+
+			reqBytes, err := proto.Marshal(req)
+			assert.NoError(t, err)
+
+			assert.Equal(t, reqBytes, env.GetBody())
+			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
+			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
+			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
+			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
+		}).
+		Return(protocolEnvelopeRes, nil).Once()
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.True(t, errors.IsOfType(ErrP2PClient, err))
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeInvalidType(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(nil).Once()
+
+	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+
+	getDocRes := &p2ppb.GetDocumentResponse{}
+
+	getDocResBytes, err := proto.Marshal(getDocRes)
+	assert.NoError(t, err)
+
+	envelopeRes := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: networkID,
+			NodeVersion:       "test-version",
+			SenderId:          utils.RandomSlice(32),
+			// Invalid type.
+			Type: p2pcommon.MessageTypeSendAnchoredDoc.String(),
+		},
+		Body: getDocResBytes,
+	}
+
+	envelopeResBytes, err := proto.Marshal(envelopeRes)
+	assert.NoError(t, err)
+
+	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
+		Body: envelopeResBytes,
+	}
+
+	mockProtocolNegotiation(mocks, peerID, requesterID)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On(
+			"SendMessage",
+			mock.Anything,
+			peerID,
+			mock.IsType(&protocolpb.P2PEnvelope{}),
+			p2pcommon.ProtocolForIdentity(requesterID),
+		).
+		Run(func(args mock.Arguments) {
+			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
+			assert.True(t, ok)
+
+			var env p2ppb.Envelope
+
+			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
+			assert.NoError(t, err)
+
+			reqBytes, err := proto.Marshal(req)
+			assert.NoError(t, err)
+
+			assert.Equal(t, reqBytes, env.GetBody())
+			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
+			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
+			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
+			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
+		}).
+		Return(protocolEnvelopeRes, nil).Once()
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, ErrIncorrectResponseMessageType)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_ResponseDecodeError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(nil).Once()
+
+	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+
+	envelopeRes := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: networkID,
+			NodeVersion:       "test-version",
+			SenderId:          utils.RandomSlice(32),
+			Type:              p2pcommon.MessageTypeGetDocRep.String(),
+		},
+		// Not a valid a p2ppb.GetDocumentResponse
+		Body: utils.RandomSlice(32),
+	}
+
+	envelopeResBytes, err := proto.Marshal(envelopeRes)
+	assert.NoError(t, err)
+
+	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
+		Body: envelopeResBytes,
+	}
+
+	mockProtocolNegotiation(mocks, peerID, requesterID)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On(
+			"SendMessage",
+			mock.Anything,
+			peerID,
+			mock.IsType(&protocolpb.P2PEnvelope{}),
+			p2pcommon.ProtocolForIdentity(requesterID),
+		).
+		Run(func(args mock.Arguments) {
+			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
+			assert.True(t, ok)
+
+			var env p2ppb.Envelope
+
+			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
+			assert.NoError(t, err)
+
+			reqBytes, err := proto.Marshal(req)
+			assert.NoError(t, err)
+
+			assert.Equal(t, reqBytes, env.GetBody())
+			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
+			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
+			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
+			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
+		}).
+		Return(protocolEnvelopeRes, nil).Once()
+
+	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, ErrResponseDecodeError)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetDocumentRequest_LocalAccount(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
 	senderAccountMock.On("GetIdentity").
 		Return(identity)
 
@@ -890,30 +1764,43 @@ func TestPeer_Client_GetDocumentRequest_MessengerError(t *testing.T) {
 		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
 	}
 
+	requesterAccountMock := config.NewAccountMock(t)
+
 	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
-		Return(nil, errors.New("error")).Once()
+		Return(requesterAccountMock, nil).Once()
 
-	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
-		Return(nil).Once()
+	p2pConnTimeout := 1 * time.Second
 
-	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(p2pConnTimeout).Once()
 
-	genericUtils.GetMock[*ms.MessengerMock](mocks).
+	getDocRes := &p2ppb.GetDocumentResponse{}
+
+	genericUtils.GetMock[*receiver.HandlerMock](mocks).
 		On(
-			"SendMessage",
+			"GetDocument",
 			mock.Anything,
-			peerID,
-			mock.IsType(&protocolpb.P2PEnvelope{}),
-			p2pcommon.ProtocolForIdentity(requesterID),
+			req,
+			identity,
 		).
-		Return(nil, errors.New("error")).Once()
+		Run(func(args mock.Arguments) {
+			handlerCtx, ok := args.Get(0).(context.Context)
+			assert.True(t, ok)
+
+			handlerCtxAccount, err := contextutil.Account(handlerCtx)
+			assert.NoError(t, err)
+
+			assert.Equal(t, requesterAccountMock, handlerCtxAccount)
+		}).
+		Return(getDocRes, nil).
+		Once()
 
 	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.ErrorIs(t, err, ErrP2PMessageSending)
-	assert.Nil(t, res)
+	assert.NoError(t, err)
+	assert.Equal(t, getDocRes, res)
 }
 
-func TestPeer_Client_GetDocumentRequest_ResolveEnvelopeError(t *testing.T) {
+func TestPeer_Client_GetDocumentRequest_LocalAccount_HandlerError(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -933,53 +1820,43 @@ func TestPeer_Client_GetDocumentRequest_ResolveEnvelopeError(t *testing.T) {
 		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
 	}
 
+	requesterAccountMock := config.NewAccountMock(t)
+
 	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
-		Return(nil, errors.New("error")).Once()
+		Return(requesterAccountMock, nil).Once()
 
-	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
-		Return(nil).Once()
+	p2pConnTimeout := 1 * time.Second
 
-	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(p2pConnTimeout).Once()
 
-	// Set invalid body to ensure an error when resolving the envelope.
-	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
-		Body: utils.RandomSlice(32),
-	}
+	handlerErr := errors.New("error")
 
-	genericUtils.GetMock[*ms.MessengerMock](mocks).
+	genericUtils.GetMock[*receiver.HandlerMock](mocks).
 		On(
-			"SendMessage",
+			"GetDocument",
 			mock.Anything,
-			peerID,
-			mock.IsType(&protocolpb.P2PEnvelope{}),
-			p2pcommon.ProtocolForIdentity(requesterID),
+			req,
+			identity,
 		).
 		Run(func(args mock.Arguments) {
-			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
+			handlerCtx, ok := args.Get(0).(context.Context)
 			assert.True(t, ok)
 
-			var env p2ppb.Envelope
-
-			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
-			assert.NoError(t, err)
-
-			reqBytes, err := proto.Marshal(req)
+			handlerCtxAccount, err := contextutil.Account(handlerCtx)
 			assert.NoError(t, err)
 
-			assert.Equal(t, reqBytes, env.GetBody())
-			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
-			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
-			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
-			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
+			assert.Equal(t, requesterAccountMock, handlerCtxAccount)
 		}).
-		Return(protocolEnvelopeRes, nil).Once()
+		Return(nil, handlerErr).
+		Once()
 
 	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.ErrorIs(t, err, ErrP2PDataEnvelopeResolving)
+	assert.Equal(t, err, handlerErr)
 	assert.Nil(t, res)
 }
 
-func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeError(t *testing.T) {
+func TestPeer_Client_GetLatestVersionRequest(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -996,7 +1873,6 @@ func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeError(t *testing.T) {
 
 	req := &p2ppb.GetDocumentRequest{
 		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
 	}
 
 	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
@@ -1007,15 +1883,19 @@ func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeError(t *testing.T) {
 
 	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
 
+	getDocRes := &p2ppb.GetDocumentResponse{}
+
+	getDocResBytes, err := proto.Marshal(getDocRes)
+	assert.NoError(t, err)
+
 	envelopeRes := &p2ppb.Envelope{
 		Header: &p2ppb.Header{
 			NetworkIdentifier: networkID,
 			NodeVersion:       "test-version",
 			SenderId:          utils.RandomSlice(32),
-			// Error type.
-			Type: p2pcommon.MessageTypeError.String(),
+			Type:              p2pcommon.MessageTypeGetLatestVersionRep.String(),
 		},
-		Body: nil,
+		Body: getDocResBytes,
 	}
 
 	envelopeResBytes, err := proto.Marshal(envelopeRes)
@@ -1025,6 +1905,8 @@ func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeError(t *testing.T) {
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, requesterID)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -1048,17 +1930,17 @@ func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeError(t *testing.T) {
 			assert.Equal(t, reqBytes, env.GetBody())
 			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
 			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
-			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
+			assert.Equal(t, p2pcommon.MessageTypeGetLatestVersion.String(), env.GetHeader().GetType())
 			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
 		}).
 		Return(protocolEnvelopeRes, nil).Once()
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.True(t, errors.IsOfType(ErrP2PClient, err))
-	assert.Nil(t, res)
+	res, err := peer.GetLatestVersionRequest(ctx, requesterID, req)
+	assert.NoError(t, err)
+	assert.Equal(t, getDocRes, res)
 }
 
-func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeInvalidType(t *testing.T) {
+func TestPeer_Client_GetLatestVersionRequest_MessageTypeNotSupported(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -1075,7 +1957,6 @@ func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeInvalidType(t *testing.T)
 
 	req := &p2ppb.GetDocumentRequest{
 		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
 	}
 
 	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
@@ -1086,63 +1967,58 @@ func TestPeer_Client_GetDocumentRequest_ReceiveEnvelopeInvalidType(t *testing.T)
 
 	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
 
-	getDocRes := &p2ppb.GetDocumentResponse{}
+	// The peer only speaks the initial protocol version.
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("NegotiateProtocol", append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(requesterID))...)...).
+		Return(p2pcommon.ProtocolForIdentityVersion(requesterID, p2pcommon.ProtocolVersion001), nil).
+		Once()
 
-	getDocResBytes, err := proto.Marshal(getDocRes)
+	res, err := peer.GetLatestVersionRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, ErrMessageTypeNotSupported)
+	assert.Nil(t, res)
+}
+
+func TestPeer_Client_GetLatestVersionRequest_ProtocolNegotiationError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	envelopeRes := &p2ppb.Envelope{
-		Header: &p2ppb.Header{
-			NetworkIdentifier: networkID,
-			NodeVersion:       "test-version",
-			SenderId:          utils.RandomSlice(32),
-			// Invalid type.
-			Type: p2pcommon.MessageTypeSendAnchoredDoc.String(),
-		},
-		Body: getDocResBytes,
-	}
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
 
-	envelopeResBytes, err := proto.Marshal(envelopeRes)
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	requesterID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	protocolEnvelopeRes := &protocolpb.P2PEnvelope{
-		Body: envelopeResBytes,
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
 	}
 
-	genericUtils.GetMock[*ms.MessengerMock](mocks).
-		On(
-			"SendMessage",
-			mock.Anything,
-			peerID,
-			mock.IsType(&protocolpb.P2PEnvelope{}),
-			p2pcommon.ProtocolForIdentity(requesterID),
-		).
-		Run(func(args mock.Arguments) {
-			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
-			assert.True(t, ok)
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
 
-			var env p2ppb.Envelope
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+		Return(nil).Once()
 
-			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
-			assert.NoError(t, err)
+	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
 
-			reqBytes, err := proto.Marshal(req)
-			assert.NoError(t, err)
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("NegotiateProtocol", append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(requesterID))...)...).
+		Return(protocol.ID(""), errors.New("error")).
+		Once()
 
-			assert.Equal(t, reqBytes, env.GetBody())
-			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
-			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
-			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
-			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
-		}).
-		Return(protocolEnvelopeRes, nil).Once()
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PRelayEnabled").
+		Return(false).Once()
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.ErrorIs(t, err, ErrIncorrectResponseMessageType)
+	res, err := peer.GetLatestVersionRequest(ctx, requesterID, req)
+	assert.ErrorIs(t, err, ErrProtocolNegotiation)
 	assert.Nil(t, res)
 }
 
-func TestPeer_Client_GetDocumentRequest_ResponseDecodeError(t *testing.T) {
+func TestPeer_Client_GetLatestVersionRequest_LocalAccount(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -1159,26 +2035,80 @@ func TestPeer_Client_GetDocumentRequest_ResponseDecodeError(t *testing.T) {
 
 	req := &p2ppb.GetDocumentRequest{
 		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
 	}
 
+	requesterAccountMock := config.NewAccountMock(t)
+
 	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
+		Return(requesterAccountMock, nil).Once()
+
+	p2pConnTimeout := 1 * time.Second
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(p2pConnTimeout).Once()
+
+	getDocRes := &p2ppb.GetDocumentResponse{}
+
+	genericUtils.GetMock[*receiver.HandlerMock](mocks).
+		On(
+			"GetLatestVersion",
+			mock.Anything,
+			req,
+			identity,
+		).
+		Run(func(args mock.Arguments) {
+			handlerCtx, ok := args.Get(0).(context.Context)
+			assert.True(t, ok)
+
+			handlerCtxAccount, err := contextutil.Account(handlerCtx)
+			assert.NoError(t, err)
+
+			assert.Equal(t, requesterAccountMock, handlerCtxAccount)
+		}).
+		Return(getDocRes, nil).
+		Once()
+
+	res, err := peer.GetLatestVersionRequest(ctx, requesterID, req)
+	assert.NoError(t, err)
+	assert.Equal(t, getDocRes, res)
+}
+
+func TestPeer_Client_GetAttachmentRequest(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	collaboratorID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	hash := utils.RandomSlice(32)
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", collaboratorID.ToBytes()).
 		Return(nil, errors.New("error")).Once()
 
-	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", requesterID).
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", collaboratorID).
 		Return(nil).Once()
 
-	peerID := mockPeerIDRetrievalCalls(t, requesterID, mocks)
+	peerID := mockPeerIDRetrievalCalls(t, collaboratorID, mocks)
+
+	data := utils.RandomSlice(128)
 
 	envelopeRes := &p2ppb.Envelope{
 		Header: &p2ppb.Header{
 			NetworkIdentifier: networkID,
 			NodeVersion:       "test-version",
 			SenderId:          utils.RandomSlice(32),
-			Type:              p2pcommon.MessageTypeGetDocRep.String(),
+			Type:              p2pcommon.MessageTypeGetAttachmentRep.String(),
 		},
-		// Not a valid a p2ppb.GetDocumentResponse
-		Body: utils.RandomSlice(32),
+		Body: data,
 	}
 
 	envelopeResBytes, err := proto.Marshal(envelopeRes)
@@ -1188,13 +2118,15 @@ func TestPeer_Client_GetDocumentRequest_ResponseDecodeError(t *testing.T) {
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, collaboratorID)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
 			mock.Anything,
 			peerID,
 			mock.IsType(&protocolpb.P2PEnvelope{}),
-			p2pcommon.ProtocolForIdentity(requesterID),
+			p2pcommon.ProtocolForIdentity(collaboratorID),
 		).
 		Run(func(args mock.Arguments) {
 			protocolEnv, ok := args.Get(2).(*protocolpb.P2PEnvelope)
@@ -1205,23 +2137,56 @@ func TestPeer_Client_GetDocumentRequest_ResponseDecodeError(t *testing.T) {
 			err = proto.Unmarshal(protocolEnv.GetBody(), &env)
 			assert.NoError(t, err)
 
-			reqBytes, err := proto.Marshal(req)
+			req, err := p2pcommon.DecodeAttachmentRequest(env.GetBody())
 			assert.NoError(t, err)
 
-			assert.Equal(t, reqBytes, env.GetBody())
+			assert.Equal(t, documentID, req.DocumentID)
+			assert.Equal(t, hash, req.Hash)
 			assert.Equal(t, identity.ToBytes(), env.GetHeader().GetSenderId())
-			assert.Equal(t, networkID, env.GetHeader().GetNetworkIdentifier())
-			assert.Equal(t, p2pcommon.MessageTypeGetDoc.String(), env.GetHeader().GetType())
-			assert.Equal(t, version.GetVersion().String(), env.GetHeader().GetNodeVersion())
+			assert.Equal(t, p2pcommon.MessageTypeGetAttachment.String(), env.GetHeader().GetType())
 		}).
 		Return(protocolEnvelopeRes, nil).Once()
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.ErrorIs(t, err, ErrResponseDecodeError)
+	res, err := peer.GetAttachmentRequest(ctx, collaboratorID, documentID, hash)
+	assert.NoError(t, err)
+	assert.Equal(t, data, res)
+}
+
+func TestPeer_Client_GetAttachmentRequest_MessageTypeNotSupported(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountMock := config.NewAccountMock(t)
+	senderAccountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
+
+	collaboratorID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", collaboratorID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", collaboratorID).
+		Return(nil).Once()
+
+	peerID := mockPeerIDRetrievalCalls(t, collaboratorID, mocks)
+
+	// The peer doesn't speak the protocol version that introduced attachments.
+	genericUtils.GetMock[*ms.MessengerMock](mocks).
+		On("NegotiateProtocol", append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(collaboratorID))...)...).
+		Return(p2pcommon.ProtocolForIdentityVersion(collaboratorID, p2pcommon.ProtocolVersion004), nil).
+		Once()
+
+	res, err := peer.GetAttachmentRequest(ctx, collaboratorID, utils.RandomSlice(32), utils.RandomSlice(32))
+	assert.ErrorIs(t, err, ErrMessageTypeNotSupported)
 	assert.Nil(t, res)
 }
 
-func TestPeer_Client_GetDocumentRequest_LocalAccount(t *testing.T) {
+func TestPeer_Client_GetAttachmentRequest_InvalidRequest(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -1233,51 +2198,21 @@ func TestPeer_Client_GetDocumentRequest_LocalAccount(t *testing.T) {
 
 	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
 
-	requesterID, err := testingcommons.GetRandomAccountID()
+	collaboratorID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
-	}
-
-	requesterAccountMock := config.NewAccountMock(t)
-
-	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
-		Return(requesterAccountMock, nil).Once()
-
-	p2pConnTimeout := 1 * time.Second
-
-	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
-		Return(p2pConnTimeout).Once()
-
-	getDocRes := &p2ppb.GetDocumentResponse{}
-
-	genericUtils.GetMock[*receiver.HandlerMock](mocks).
-		On(
-			"GetDocument",
-			mock.Anything,
-			req,
-			identity,
-		).
-		Run(func(args mock.Arguments) {
-			handlerCtx, ok := args.Get(0).(context.Context)
-			assert.True(t, ok)
-
-			handlerCtxAccount, err := contextutil.Account(handlerCtx)
-			assert.NoError(t, err)
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", collaboratorID.ToBytes()).
+		Return(nil, errors.New("error")).Once()
 
-			assert.Equal(t, requesterAccountMock, handlerCtxAccount)
-		}).
-		Return(getDocRes, nil).
-		Once()
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", collaboratorID).
+		Return(nil).Once()
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.NoError(t, err)
-	assert.Equal(t, getDocRes, res)
+	res, err := peer.GetAttachmentRequest(ctx, collaboratorID, utils.RandomSlice(32), utils.RandomSlice(31))
+	assert.ErrorIs(t, err, ErrP2PEnvelopePreparation)
+	assert.Nil(t, res)
 }
 
-func TestPeer_Client_GetDocumentRequest_LocalAccount_HandlerError(t *testing.T) {
+func TestPeer_Client_GetAttachmentRequest_LocalAccount(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -1289,31 +2224,27 @@ func TestPeer_Client_GetDocumentRequest_LocalAccount_HandlerError(t *testing.T)
 
 	ctx := contextutil.WithAccount(context.Background(), senderAccountMock)
 
-	requesterID, err := testingcommons.GetRandomAccountID()
+	collaboratorID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
-	}
-
-	requesterAccountMock := config.NewAccountMock(t)
+	documentID := utils.RandomSlice(32)
+	hash := utils.RandomSlice(32)
 
-	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", requesterID.ToBytes()).
-		Return(requesterAccountMock, nil).Once()
+	collaboratorAccountMock := config.NewAccountMock(t)
 
-	p2pConnTimeout := 1 * time.Second
+	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccount", collaboratorID.ToBytes()).
+		Return(collaboratorAccountMock, nil).Once()
 
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
-		Return(p2pConnTimeout).Once()
+		Return(1 * time.Second).Once()
 
-	handlerErr := errors.New("error")
+	data := utils.RandomSlice(128)
 
 	genericUtils.GetMock[*receiver.HandlerMock](mocks).
 		On(
-			"GetDocument",
+			"GetAttachment",
 			mock.Anything,
-			req,
+			&p2pcommon.AttachmentRequest{DocumentID: documentID, Hash: hash},
 			identity,
 		).
 		Run(func(args mock.Arguments) {
@@ -1323,14 +2254,14 @@ func TestPeer_Client_GetDocumentRequest_LocalAccount_HandlerError(t *testing.T)
 			handlerCtxAccount, err := contextutil.Account(handlerCtx)
 			assert.NoError(t, err)
 
-			assert.Equal(t, requesterAccountMock, handlerCtxAccount)
+			assert.Equal(t, collaboratorAccountMock, handlerCtxAccount)
 		}).
-		Return(nil, handlerErr).
+		Return(data, nil).
 		Once()
 
-	res, err := peer.GetDocumentRequest(ctx, requesterID, req)
-	assert.Equal(t, err, handlerErr)
-	assert.Nil(t, res)
+	res, err := peer.GetAttachmentRequest(ctx, collaboratorID, documentID, hash)
+	assert.NoError(t, err)
+	assert.Equal(t, data, res)
 }
 
 func TestPeer_Client_GetSignaturesForDocument(t *testing.T) {
@@ -1466,6 +2397,8 @@ func TestPeer_Client_GetSignaturesForDocument(t *testing.T) {
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, externalCollaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -1646,6 +2579,8 @@ func TestPeer_Client_GetSignaturesForDocument_LocalCollaboratorError(t *testing.
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, externalCollaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -1795,15 +2730,211 @@ func TestPeer_Client_GetSignaturesForDocument_ExternalCollaboratorError(t *testi
 	genericUtils.GetMock[*v2.ServiceMock](mocks).On("ValidateAccount", externalCollaborator).
 		Return(errors.New("error")).Once()
 
-	signatures, signatureErrors, err := peer.GetSignaturesForDocument(ctx, documentMock)
-	assert.NoError(t, err)
-	assert.Contains(t, signatureErrors, ErrInvalidCollaboratorAccount)
-	assert.Len(t, signatures, 1)
+	signatures, signatureErrors, err := peer.GetSignaturesForDocument(ctx, documentMock)
+	assert.NoError(t, err)
+	assert.Contains(t, signatureErrors, ErrInvalidCollaboratorAccount)
+	assert.Len(t, signatures, 1)
+}
+
+func TestPeer_Client_getPeerID(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+	peer.disablePeerStore = true
+
+	ctx := context.Background()
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	pubKey, _, err := ed25519.GenerateSigningKeyPair()
+	assert.NoError(t, err)
+
+	p2pKey := types.NewHash(pubKey)
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).On(
+		"GetLastKeyByPurpose",
+		accountID,
+		keystoreType.KeyPurposeP2PDiscovery,
+	).Return(&p2pKey, nil).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On(
+		"ValidateKey",
+		accountID,
+		p2pKey[:],
+		keystoreType.KeyPurposeP2PDiscovery,
+		mock.IsType(time.Now()),
+	).Return(nil).Once()
+
+	peerID, err := p2pcommon.ParsePeerID(p2pKey)
+	assert.NoError(t, err)
+
+	res, err := peer.getPeerID(ctx, accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, peerID, res)
+}
+
+func TestPeer_Client_getPeerID_KeyRetrievalError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+	peer.disablePeerStore = true
+
+	ctx := context.Background()
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).On(
+		"GetLastKeyByPurpose",
+		accountID,
+		keystoreType.KeyPurposeP2PDiscovery,
+	).Return(nil, errors.New("error")).Once()
+
+	res, err := peer.getPeerID(ctx, accountID)
+	assert.ErrorIs(t, err, ErrP2PKeyRetrievalError)
+	assert.Empty(t, res)
+}
+
+func TestPeer_Client_getPeerID_KeyValidationError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+	peer.disablePeerStore = true
+
+	ctx := context.Background()
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	pubKey, _, err := ed25519.GenerateSigningKeyPair()
+	assert.NoError(t, err)
+
+	p2pKey := types.NewHash(pubKey)
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).On(
+		"GetLastKeyByPurpose",
+		accountID,
+		keystoreType.KeyPurposeP2PDiscovery,
+	).Return(&p2pKey, nil).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On(
+		"ValidateKey",
+		accountID,
+		p2pKey[:],
+		keystoreType.KeyPurposeP2PDiscovery,
+		mock.IsType(time.Now()),
+	).Return(errors.New("error")).Once()
+
+	res, err := peer.getPeerID(ctx, accountID)
+	assert.ErrorIs(t, err, ErrInvalidP2PKey)
+	assert.Empty(t, res)
+}
+
+func TestPeer_Client_getPeerID_WithPeerstore(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	ctx := context.Background()
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	pubKey, _, err := ed25519.GenerateSigningKeyPair()
+	assert.NoError(t, err)
+
+	p2pKey := types.NewHash(pubKey)
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).On(
+		"GetLastKeyByPurpose",
+		accountID,
+		keystoreType.KeyPurposeP2PDiscovery,
+	).Return(&p2pKey, nil).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On(
+		"ValidateKey",
+		accountID,
+		p2pKey[:],
+		keystoreType.KeyPurposeP2PDiscovery,
+		mock.IsType(time.Now()),
+	).Return(nil).Once()
+
+	p2pConnTimeout := 1 * time.Second
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(p2pConnTimeout).Once()
+
+	peerID, err := p2pcommon.ParsePeerID(p2pKey)
+	assert.NoError(t, err)
+
+	addrInfo := libp2ppeer.AddrInfo{}
+
+	genericUtils.GetMock[*p2pMocks.IpfsDHTMock](mocks).
+		On(
+			"FindPeer",
+			mock.Anything,
+			peerID,
+		).
+		Return(addrInfo, nil).Once()
+
+	peerstoreMock := p2pMocks.NewPeerstoreMock(t)
+
+	genericUtils.GetMock[*p2pMocks.HostMock](mocks).On("Peerstore").
+		Return(peerstoreMock, nil).Once()
+
+	peerstoreMock.On("AddAddrs", peerID, addrInfo.Addrs, time.Duration(pstore.PermanentAddrTTL)).
+		Once()
+
+	res, err := peer.getPeerID(ctx, accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, peerID, res)
+}
+
+func TestPeer_Client_getPeerID_WithPeerstore_DHTError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	ctx := context.Background()
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	pubKey, _, err := ed25519.GenerateSigningKeyPair()
+	assert.NoError(t, err)
+
+	p2pKey := types.NewHash(pubKey)
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).On(
+		"GetLastKeyByPurpose",
+		accountID,
+		keystoreType.KeyPurposeP2PDiscovery,
+	).Return(&p2pKey, nil).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On(
+		"ValidateKey",
+		accountID,
+		p2pKey[:],
+		keystoreType.KeyPurposeP2PDiscovery,
+		mock.IsType(time.Now()),
+	).Return(nil).Once()
+
+	p2pConnTimeout := 1 * time.Second
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(p2pConnTimeout).Once()
+
+	peerID, err := p2pcommon.ParsePeerID(p2pKey)
+	assert.NoError(t, err)
+
+	addrInfo := libp2ppeer.AddrInfo{}
+
+	genericUtils.GetMock[*p2pMocks.IpfsDHTMock](mocks).
+		On(
+			"FindPeer",
+			mock.Anything,
+			peerID,
+		).
+		Return(addrInfo, errors.New("error")).Once()
+
+	res, err := peer.getPeerID(ctx, accountID)
+	assert.ErrorIs(t, err, ErrPeerNotFound)
+	assert.Empty(t, res)
 }
 
-func TestPeer_Client_getPeerID(t *testing.T) {
+func TestPeer_Client_getPeerID_StaticPeer(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
-	peer.disablePeerStore = true
 
 	ctx := context.Background()
 
@@ -1832,34 +2963,83 @@ func TestPeer_Client_getPeerID(t *testing.T) {
 	peerID, err := p2pcommon.ParsePeerID(p2pKey)
 	assert.NoError(t, err)
 
+	peer.staticPeers = map[string]*libp2ppeer.AddrInfo{
+		accountID.ToHexString(): {
+			ID: peerID,
+		},
+	}
+
 	res, err := peer.getPeerID(ctx, accountID)
 	assert.NoError(t, err)
 	assert.Equal(t, peerID, res)
 }
 
-func TestPeer_Client_getPeerID_KeyRetrievalError(t *testing.T) {
+func TestPeer_Client_getPeerID_StaticPeerMismatch(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
-	peer.disablePeerStore = true
 
 	ctx := context.Background()
 
 	accountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
+	pubKey, _, err := ed25519.GenerateSigningKeyPair()
+	assert.NoError(t, err)
+
+	p2pKey := types.NewHash(pubKey)
+
 	genericUtils.GetMock[*keystore.APIMock](mocks).On(
 		"GetLastKeyByPurpose",
 		accountID,
 		keystoreType.KeyPurposeP2PDiscovery,
-	).Return(nil, errors.New("error")).Once()
+	).Return(&p2pKey, nil).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).On(
+		"ValidateKey",
+		accountID,
+		p2pKey[:],
+		keystoreType.KeyPurposeP2PDiscovery,
+		mock.IsType(time.Now()),
+	).Return(nil).Once()
+
+	peerID, err := p2pcommon.ParsePeerID(p2pKey)
+	assert.NoError(t, err)
+
+	peer.staticPeers = map[string]*libp2ppeer.AddrInfo{
+		accountID.ToHexString(): {
+			ID: getTestPeerID(t),
+		},
+	}
+
+	p2pConnTimeout := 1 * time.Second
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(p2pConnTimeout).Once()
+
+	addrInfo := libp2ppeer.AddrInfo{}
+
+	genericUtils.GetMock[*p2pMocks.IpfsDHTMock](mocks).
+		On(
+			"FindPeer",
+			mock.Anything,
+			peerID,
+		).
+		Return(addrInfo, nil).Once()
+
+	peerstoreMock := p2pMocks.NewPeerstoreMock(t)
+
+	genericUtils.GetMock[*p2pMocks.HostMock](mocks).On("Peerstore").
+		Return(peerstoreMock, nil).Once()
+
+	peerstoreMock.On("AddAddrs", peerID, addrInfo.Addrs, time.Duration(pstore.PermanentAddrTTL)).
+		Once()
 
 	res, err := peer.getPeerID(ctx, accountID)
-	assert.ErrorIs(t, err, ErrP2PKeyRetrievalError)
-	assert.Empty(t, res)
+	assert.NoError(t, err)
+	assert.Equal(t, peerID, res)
 }
 
-func TestPeer_Client_getPeerID_KeyValidationError(t *testing.T) {
+func TestPeer_Client_getPeerID_AddressBook(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
-	peer.disablePeerStore = true
 
 	ctx := context.Background()
 
@@ -1883,14 +3063,34 @@ func TestPeer_Client_getPeerID_KeyValidationError(t *testing.T) {
 		p2pKey[:],
 		keystoreType.KeyPurposeP2PDiscovery,
 		mock.IsType(time.Now()),
-	).Return(errors.New("error")).Once()
+	).Return(nil).Once()
+
+	peerID, err := p2pcommon.ParsePeerID(p2pKey)
+	assert.NoError(t, err)
+
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/38202")
+	assert.NoError(t, err)
+
+	err = peer.addressBook.put(accountID, peerID, []ma.Multiaddr{addr})
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PAddressBookTTL").
+		Return(time.Hour).Once()
+
+	peerstoreMock := p2pMocks.NewPeerstoreMock(t)
+
+	genericUtils.GetMock[*p2pMocks.HostMock](mocks).On("Peerstore").
+		Return(peerstoreMock, nil).Once()
+
+	peerstoreMock.On("AddAddrs", peerID, []ma.Multiaddr{addr}, time.Duration(pstore.AddressTTL)).
+		Once()
 
 	res, err := peer.getPeerID(ctx, accountID)
-	assert.ErrorIs(t, err, ErrInvalidP2PKey)
-	assert.Empty(t, res)
+	assert.NoError(t, err)
+	assert.Equal(t, peerID, res)
 }
 
-func TestPeer_Client_getPeerID_WithPeerstore(t *testing.T) {
+func TestPeer_Client_getPeerID_AddressBookExpired(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
 	ctx := context.Background()
@@ -1917,15 +3117,30 @@ func TestPeer_Client_getPeerID_WithPeerstore(t *testing.T) {
 		mock.IsType(time.Now()),
 	).Return(nil).Once()
 
+	peerID, err := p2pcommon.ParsePeerID(p2pKey)
+	assert.NoError(t, err)
+
+	oldAddr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/38202")
+	assert.NoError(t, err)
+
+	err = peer.addressBook.put(accountID, peerID, []ma.Multiaddr{oldAddr})
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PAddressBookTTL").
+		Return(time.Duration(0)).Once()
+
 	p2pConnTimeout := 1 * time.Second
 
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
 		Return(p2pConnTimeout).Once()
 
-	peerID, err := p2pcommon.ParsePeerID(p2pKey)
+	newAddr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/38203")
 	assert.NoError(t, err)
 
-	addrInfo := libp2ppeer.AddrInfo{}
+	addrInfo := libp2ppeer.AddrInfo{
+		ID:    peerID,
+		Addrs: []ma.Multiaddr{newAddr},
+	}
 
 	genericUtils.GetMock[*p2pMocks.IpfsDHTMock](mocks).
 		On(
@@ -1946,58 +3161,192 @@ func TestPeer_Client_getPeerID_WithPeerstore(t *testing.T) {
 	res, err := peer.getPeerID(ctx, accountID)
 	assert.NoError(t, err)
 	assert.Equal(t, peerID, res)
+
+	entry, err := peer.addressBook.get(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{newAddr.String()}, entry.Addrs)
 }
 
-func TestPeer_Client_getPeerID_WithPeerstore_DHTError(t *testing.T) {
+func TestPeer_Client_negotiateProtocol_RelayFallback(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
-	ctx := context.Background()
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	peerID := getTestPeerID(t)
+	relayPeerID := getTestPeerID(t)
+
+	relayAddr, err := ma.NewMultiaddr("/ip4/1.2.3.4/tcp/38202")
+	assert.NoError(t, err)
+
+	peer.relayPeers = []libp2ppeer.AddrInfo{
+		{
+			ID:    relayPeerID,
+			Addrs: []ma.Multiaddr{relayAddr},
+		},
+	}
+
+	args := append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(accountID))...)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("NegotiateProtocol", args...).
+		Return(protocol.ID(""), errors.New("error")).
+		Once()
+
+	mockRelayedAddrsRetrieval(t, mocks, peerID, nil)
+
+	relayedAddr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/1.2.3.4/tcp/38202/p2p/%s/p2p-circuit", relayPeerID.Pretty()))
+	assert.NoError(t, err)
+
+	peerstoreMock := p2pMocks.NewPeerstoreMock(t)
+
+	genericUtils.GetMock[*p2pMocks.HostMock](mocks).On("Peerstore").
+		Return(peerstoreMock, nil).Once()
+
+	peerstoreMock.On("AddAddrs", peerID, []ma.Multiaddr{relayedAddr}, time.Duration(pstore.AddressTTL)).
+		Once()
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("NegotiateProtocol", args...).
+		Return(p2pcommon.ProtocolForIdentity(accountID), nil).
+		Once()
+
+	res, err := peer.negotiateProtocol(peerID, accountID, p2pcommon.MessageTypeGetDoc)
+	assert.NoError(t, err)
+	assert.Equal(t, p2pcommon.ProtocolForIdentity(accountID), res)
+}
+
+func TestPeer_Client_negotiateProtocol_RelayFallbackError(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
 
 	accountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	pubKey, _, err := ed25519.GenerateSigningKeyPair()
+	peerID := getTestPeerID(t)
+
+	relayAddr, err := ma.NewMultiaddr("/ip4/1.2.3.4/tcp/38202")
 	assert.NoError(t, err)
 
-	p2pKey := types.NewHash(pubKey)
+	peer.relayPeers = []libp2ppeer.AddrInfo{
+		{
+			ID:    getTestPeerID(t),
+			Addrs: []ma.Multiaddr{relayAddr},
+		},
+	}
 
-	genericUtils.GetMock[*keystore.APIMock](mocks).On(
-		"GetLastKeyByPurpose",
-		accountID,
-		keystoreType.KeyPurposeP2PDiscovery,
-	).Return(&p2pKey, nil).Once()
+	args := append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(accountID))...)
 
-	genericUtils.GetMock[*v2.ServiceMock](mocks).On(
-		"ValidateKey",
-		accountID,
-		p2pKey[:],
-		keystoreType.KeyPurposeP2PDiscovery,
-		mock.IsType(time.Now()),
-	).Return(nil).Once()
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("NegotiateProtocol", args...).
+		Return(protocol.ID(""), errors.New("error")).
+		Twice()
 
-	p2pConnTimeout := 1 * time.Second
+	mockRelayedAddrsRetrieval(t, mocks, peerID, nil)
 
-	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
-		Return(p2pConnTimeout).Once()
+	peerstoreMock := p2pMocks.NewPeerstoreMock(t)
 
-	peerID, err := p2pcommon.ParsePeerID(p2pKey)
+	genericUtils.GetMock[*p2pMocks.HostMock](mocks).On("Peerstore").
+		Return(peerstoreMock, nil).Once()
+
+	peerstoreMock.On("AddAddrs", peerID, mock.Anything, time.Duration(pstore.AddressTTL)).
+		Once()
+
+	res, err := peer.negotiateProtocol(peerID, accountID, p2pcommon.MessageTypeGetDoc)
+	assert.ErrorIs(t, err, ErrProtocolNegotiation)
+	assert.Empty(t, res)
+}
+
+func TestPeer_Client_negotiateProtocol_AdvertisedRelays(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	addrInfo := libp2ppeer.AddrInfo{}
+	peerID := getTestPeerID(t)
 
-	genericUtils.GetMock[*p2pMocks.IpfsDHTMock](mocks).
-		On(
-			"FindPeer",
-			mock.Anything,
-			peerID,
-		).
-		Return(addrInfo, errors.New("error")).Once()
+	relayAddr, err := ma.NewMultiaddr("/ip4/1.2.3.4/tcp/38202")
+	assert.NoError(t, err)
 
-	res, err := peer.getPeerID(ctx, accountID)
-	assert.ErrorIs(t, err, ErrPeerNotFound)
+	peer.relayPeers = []libp2ppeer.AddrInfo{
+		{
+			ID:    getTestPeerID(t),
+			Addrs: []ma.Multiaddr{relayAddr},
+		},
+	}
+
+	args := append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(accountID))...)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("NegotiateProtocol", args...).
+		Return(protocol.ID(""), errors.New("error")).
+		Once()
+
+	directAddr, err := ma.NewMultiaddr("/ip4/1.2.3.5/tcp/38202")
+	assert.NoError(t, err)
+
+	advertisedAddr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/1.2.3.6/tcp/38202/p2p/%s/p2p-circuit", getTestPeerID(t).Pretty()))
+	assert.NoError(t, err)
+
+	mockRelayedAddrsRetrieval(t, mocks, peerID, []ma.Multiaddr{directAddr, advertisedAddr})
+
+	peerstoreMock := p2pMocks.NewPeerstoreMock(t)
+
+	genericUtils.GetMock[*p2pMocks.HostMock](mocks).On("Peerstore").
+		Return(peerstoreMock, nil).Once()
+
+	// The relays advertised by the peer are used instead of the configured ones.
+	peerstoreMock.On("AddAddrs", peerID, []ma.Multiaddr{advertisedAddr}, time.Duration(pstore.AddressTTL)).
+		Once()
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("NegotiateProtocol", args...).
+		Return(p2pcommon.ProtocolForIdentity(accountID), nil).
+		Once()
+
+	res, err := peer.negotiateProtocol(peerID, accountID, p2pcommon.MessageTypeGetDoc)
+	assert.NoError(t, err)
+	assert.Equal(t, p2pcommon.ProtocolForIdentity(accountID), res)
+}
+
+func TestPeer_Client_negotiateProtocol_RelayDisabled(t *testing.T) {
+	peer, mocks := getPeerMocks(t)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	peerID := getTestPeerID(t)
+
+	relayAddr, err := ma.NewMultiaddr("/ip4/1.2.3.4/tcp/38202")
+	assert.NoError(t, err)
+
+	peer.relayPeers = []libp2ppeer.AddrInfo{
+		{
+			ID:    getTestPeerID(t),
+			Addrs: []ma.Multiaddr{relayAddr},
+		},
+	}
+
+	args := append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(accountID))...)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("NegotiateProtocol", args...).
+		Return(protocol.ID(""), errors.New("error")).
+		Once()
+
+	// The negotiation is not retried through relays.
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PRelayEnabled").
+		Return(false).Once()
+
+	res, err := peer.negotiateProtocol(peerID, accountID, p2pcommon.MessageTypeGetDoc)
+	assert.ErrorIs(t, err, ErrProtocolNegotiation)
 	assert.Empty(t, res)
 }
 
+func mockRelayedAddrsRetrieval(t *testing.T, mocks []any, peerID libp2ppeer.ID, advertisedAddrs []ma.Multiaddr) {
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PRelayEnabled").
+		Return(true).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
+		Return(time.Minute).Once()
+
+	genericUtils.GetMock[*p2pMocks.IpfsDHTMock](mocks).On("FindPeer", mock.Anything, peerID).
+		Return(libp2ppeer.AddrInfo{ID: peerID, Addrs: advertisedAddrs}, nil).Once()
+}
+
 func TestPeer_Client_getSignatureForDocument_LocalAccount(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
@@ -2145,6 +3494,8 @@ func TestPeer_Client_getSignatureForDocument_ExternalAccount(t *testing.T) {
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, collaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -2462,6 +3813,8 @@ func TestPeer_Client_getSignatureForDocument_ExternalAccount_MessengerError(t *t
 
 	peerID := mockPeerIDRetrievalCalls(t, collaborator, mocks)
 
+	mockProtocolNegotiation(mocks, peerID, collaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -2533,6 +3886,8 @@ func TestPeer_Client_getSignatureForDocument_ExternalAccount_ResolveEnvelopeErro
 		Body: utils.RandomSlice(32),
 	}
 
+	mockProtocolNegotiation(mocks, peerID, collaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -2617,6 +3972,8 @@ func TestPeer_Client_getSignatureForDocument_ExternalAccount_ReceiveEnvelopeErro
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, collaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -2700,6 +4057,8 @@ func TestPeer_Client_getSignatureForDocument_ExternalAccount_ReceiveEnvelopeInva
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, collaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -2784,6 +4143,8 @@ func TestPeer_Client_getSignatureForDocument_ExternalAccount_ResponseDecodeError
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, collaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -2882,6 +4243,8 @@ func TestPeer_Client_getSignatureForDocument_ExternalAccount_InvalidSignatureRes
 		Body: envelopeResBytes,
 	}
 
+	mockProtocolNegotiation(mocks, peerID, collaborator)
+
 	genericUtils.GetMock[*ms.MessengerMock](mocks).
 		On(
 			"SendMessage",
@@ -3210,6 +4573,8 @@ func TestPeer_Client_validateSignatureResp_SignatureValidationError(t *testing.T
 
 const (
 	networkID = uint32(36)
+
+	defaultChunkingThreshold = 8 * 1024 * 1024
 )
 
 func mockPeerIDRetrievalCalls(t *testing.T, accountID *types.AccountID, mocks []any) libp2ppeer.ID {
@@ -3268,6 +4633,53 @@ func mockPeerIDRetrievalCalls(t *testing.T, accountID *types.AccountID, mocks []
 	return peerID
 }
 
+// mockEncryptionKeyRetrievalCalls mocks the retrieval of the document encryption key of the account, and returns
+// the key pair.
+func mockEncryptionKeyRetrievalCalls(t *testing.T, accountID *types.AccountID, mocks []any) ([]byte, []byte) {
+	pubKey, privKey, err := crypto.GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	encryptionKey := types.NewHash(pubKey)
+
+	genericUtils.GetMock[*keystore.APIMock](mocks).
+		On("GetLastKeyByPurpose", accountID, keystore.KeyPurposeP2PDocumentEncryption).
+		Return(&encryptionKey, nil).Once()
+
+	genericUtils.GetMock[*v2.ServiceMock](mocks).
+		On("ValidateKey", accountID, encryptionKey[:], keystore.KeyPurposeP2PDocumentEncryption, mock.Anything).
+		Return(nil).Once()
+
+	return pubKey, privKey
+}
+
+// mockProtocolNegotiation mocks the negotiation of the latest protocol version with the peer.
+func mockProtocolNegotiation(mocks []any, peerID libp2ppeer.ID, accountID *types.AccountID) {
+	args := append([]any{peerID}, toAnySlice(p2pcommon.ProtocolsForIdentity(accountID))...)
+
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("NegotiateProtocol", args...).
+		Return(p2pcommon.ProtocolForIdentity(accountID), nil)
+}
+
+// getTestProtocolEnvelope returns the protocol envelope of a response with the provided type and body.
+func getTestProtocolEnvelope(t *testing.T, messageType p2pcommon.MessageType, body []byte) *protocolpb.P2PEnvelope {
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: networkID,
+			NodeVersion:       "test-version",
+			SenderId:          utils.RandomSlice(32),
+			Type:              messageType.String(),
+		},
+		Body: body,
+	}
+
+	envBytes, err := proto.Marshal(env)
+	assert.NoError(t, err)
+
+	return &protocolpb.P2PEnvelope{
+		Body: envBytes,
+	}
+}
+
 func getPeerMocks(t *testing.T) (*p2pPeer, []any) {
 	cfgServiceMock := config.NewServiceMock(t)
 	identityServiceMock := v2.NewServiceMock(t)
@@ -3291,6 +4703,7 @@ func getPeerMocks(t *testing.T) (*p2pPeer, []any) {
 	peer.host = p2pHostMock
 	peer.dht = ipfsDHTMock
 	peer.mes = messengerMock
+	peer.addressBook = getTestAddressBook(t)
 
 	return peer, []any{
 		configMock,
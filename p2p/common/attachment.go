@@ -0,0 +1,97 @@
+package p2pcommon
+
+import (
+	"crypto/sha256"
+
+	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/pod/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ErrInvalidAttachmentRequest must be used when an attachment request cannot be decoded
+	ErrInvalidAttachmentRequest = errors.Error("invalid attachment request")
+
+	// attachmentRequestSize is the size of the document ID and attachment hash of an encoded attachment request.
+	attachmentRequestSize = 2 * sha256.Size
+)
+
+// AttachmentRequest is the request for the content of an attachment of a document.
+// The response holds the content of the attachment.
+//
+// The requests are encoded as:
+//
+//	document ID (32 bytes) | attachment hash (32 bytes) | access (protobuf encoded GetDocumentRequest, optional)
+type AttachmentRequest struct {
+	// DocumentID is the ID of the document that holds the attachment.
+	DocumentID []byte
+	// Hash is the sha256 hash of the content of the attachment.
+	Hash []byte
+	// Access holds the access type, and its NFT or access token details, used to validate the access to
+	// the document. The requester verification is used if it is not set.
+	Access *p2ppb.GetDocumentRequest
+}
+
+// Encode encodes the request so that it can be sent as the body of an envelope.
+func (r *AttachmentRequest) Encode() ([]byte, error) {
+	if len(r.DocumentID) != sha256.Size || len(r.Hash) != sha256.Size {
+		return nil, ErrInvalidAttachmentRequest
+	}
+
+	var access []byte
+
+	if r.Access != nil {
+		var err error
+
+		access, err = proto.Marshal(r.Access)
+		if err != nil {
+			return nil, errors.NewTypedError(ErrInvalidAttachmentRequest, err)
+		}
+	}
+
+	b := make([]byte, 0, attachmentRequestSize+len(access))
+	b = append(b, r.DocumentID...)
+	b = append(b, r.Hash...)
+
+	return append(b, access...), nil
+}
+
+// DecodeAttachmentRequest decodes a request encoded with AttachmentRequest.Encode.
+func DecodeAttachmentRequest(b []byte) (*AttachmentRequest, error) {
+	if len(b) < attachmentRequestSize {
+		return nil, ErrInvalidAttachmentRequest
+	}
+
+	req := &AttachmentRequest{
+		DocumentID: append([]byte(nil), b[:sha256.Size]...),
+		Hash:       append([]byte(nil), b[sha256.Size:attachmentRequestSize]...),
+	}
+
+	if len(b) == attachmentRequestSize {
+		return req, nil
+	}
+
+	req.Access = &p2ppb.GetDocumentRequest{}
+
+	if err := proto.Unmarshal(b[attachmentRequestSize:], req.Access); err != nil {
+		return nil, errors.NewTypedError(ErrInvalidAttachmentRequest, err)
+	}
+
+	return req, nil
+}
+
+// AccessRequest returns the GetDocumentRequest used to validate the access to the document of the attachment.
+func (r *AttachmentRequest) AccessRequest() *p2ppb.GetDocumentRequest {
+	req := &p2ppb.GetDocumentRequest{
+		AccessType: p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	if r.Access != nil {
+		req = proto.Clone(r.Access).(*p2ppb.GetDocumentRequest)
+	}
+
+	// The access is always validated for the document that holds the attachment.
+	req.DocumentIdentifier = r.DocumentID
+
+	return req
+}
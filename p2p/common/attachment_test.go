@@ -0,0 +1,106 @@
+//go:build unit
+
+package p2pcommon
+
+import (
+	"testing"
+
+	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAttachmentRequest_EncodeDecode(t *testing.T) {
+	req := &AttachmentRequest{
+		DocumentID: utils.RandomSlice(32),
+		Hash:       utils.RandomSlice(32),
+	}
+
+	b, err := req.Encode()
+	assert.NoError(t, err)
+	assert.Len(t, b, 64)
+
+	res, err := DecodeAttachmentRequest(b)
+	assert.NoError(t, err)
+	assert.Equal(t, req, res)
+}
+
+func TestAttachmentRequest_EncodeDecode_WithAccess(t *testing.T) {
+	req := &AttachmentRequest{
+		DocumentID: utils.RandomSlice(32),
+		Hash:       utils.RandomSlice(32),
+		Access: &p2ppb.GetDocumentRequest{
+			AccessType:      p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+			NftCollectionId: utils.RandomSlice(8),
+			NftItemId:       utils.RandomSlice(16),
+		},
+	}
+
+	b, err := req.Encode()
+	assert.NoError(t, err)
+	assert.Greater(t, len(b), 64)
+
+	res, err := DecodeAttachmentRequest(b)
+	assert.NoError(t, err)
+	assert.Equal(t, req.DocumentID, res.DocumentID)
+	assert.Equal(t, req.Hash, res.Hash)
+	assert.True(t, proto.Equal(req.Access, res.Access))
+}
+
+func TestAttachmentRequest_AccessRequest(t *testing.T) {
+	req := &AttachmentRequest{
+		DocumentID: utils.RandomSlice(32),
+		Hash:       utils.RandomSlice(32),
+	}
+
+	accessReq := req.AccessRequest()
+	assert.Equal(t, p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION, accessReq.GetAccessType())
+	assert.Equal(t, req.DocumentID, accessReq.GetDocumentIdentifier())
+
+	// The access is validated for the document of the attachment.
+	req.Access = &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
+		AccessTokenRequest: &p2ppb.AccessTokenRequest{
+			DelegatingDocumentIdentifier: utils.RandomSlice(32),
+			AccessTokenId:                utils.RandomSlice(32),
+		},
+	}
+
+	accessReq = req.AccessRequest()
+	assert.Equal(t, p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION, accessReq.GetAccessType())
+	assert.Equal(t, req.DocumentID, accessReq.GetDocumentIdentifier())
+	assert.Equal(t, req.Access.GetAccessTokenRequest().GetAccessTokenId(), accessReq.GetAccessTokenRequest().GetAccessTokenId())
+	assert.NotEqual(t, req.DocumentID, req.Access.GetDocumentIdentifier())
+}
+
+func TestAttachmentRequest_Encode_InvalidRequest(t *testing.T) {
+	req := &AttachmentRequest{
+		DocumentID: utils.RandomSlice(31),
+		Hash:       utils.RandomSlice(32),
+	}
+
+	_, err := req.Encode()
+	assert.ErrorIs(t, err, ErrInvalidAttachmentRequest)
+
+	req = &AttachmentRequest{
+		DocumentID: utils.RandomSlice(32),
+	}
+
+	_, err = req.Encode()
+	assert.ErrorIs(t, err, ErrInvalidAttachmentRequest)
+}
+
+func TestDecodeAttachmentRequest_InvalidRequest(t *testing.T) {
+	_, err := DecodeAttachmentRequest(utils.RandomSlice(63))
+	assert.ErrorIs(t, err, ErrInvalidAttachmentRequest)
+
+	_, err = DecodeAttachmentRequest(nil)
+	assert.ErrorIs(t, err, ErrInvalidAttachmentRequest)
+
+	// The access cannot be decoded.
+	_, err = DecodeAttachmentRequest(append(utils.RandomSlice(64), 0xff))
+	assert.True(t, errors.IsOfType(ErrInvalidAttachmentRequest, err))
+}
@@ -0,0 +1,191 @@
+package p2pcommon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/centrifuge/pod/errors"
+)
+
+const (
+	// ErrInvalidChunkSize must be used when a payload is split using an invalid chunk size
+	ErrInvalidChunkSize = errors.Error("invalid chunk size")
+
+	// ErrInvalidChunk must be used when a chunk cannot be decoded or its fields are inconsistent
+	ErrInvalidChunk = errors.Error("invalid chunk")
+
+	// ErrChunkIntegrity must be used when the data of a chunk, or of the assembled chunks, doesn't match its hash
+	ErrChunkIntegrity = errors.Error("chunk integrity check failed")
+
+	// chunkHeaderSize is the size of the fixed length fields of an encoded chunk, without the message type.
+	chunkHeaderSize = sha256.Size + 8 + 4 + 4 + sha256.Size
+)
+
+// Chunk is a piece of a message whose payload is too large to be sent in a single envelope.
+//
+// The chunks are encoded as:
+//
+//	message type length (2 bytes) | message type | payload hash (32 bytes) | payload size (8 bytes) |
+//	index (4 bytes) | total (4 bytes) | data hash (32 bytes) | data
+type Chunk struct {
+	// MessageType is the type of the message that is chunked.
+	MessageType MessageType
+	// PayloadHash is the sha256 hash of the whole payload, it also identifies the transfer.
+	PayloadHash [sha256.Size]byte
+	// PayloadSize is the size of the whole payload.
+	PayloadSize uint64
+	// Index is the position of the chunk in the payload.
+	Index uint32
+	// Total is the number of chunks of the payload.
+	Total uint32
+	// DataHash is the sha256 hash of the data of the chunk.
+	DataHash [sha256.Size]byte
+	// Data is the part of the payload held by the chunk.
+	Data []byte
+}
+
+// SplitIntoChunks splits the payload of a message of the provided type into chunks of at most chunkSize bytes.
+func SplitIntoChunks(messageType MessageType, payload []byte, chunkSize int) ([]*Chunk, error) {
+	if chunkSize <= 0 {
+		return nil, ErrInvalidChunkSize
+	}
+
+	total := (len(payload) + chunkSize - 1) / chunkSize
+
+	// An empty payload is sent in a single empty chunk.
+	if total == 0 {
+		total = 1
+	}
+
+	payloadHash := sha256.Sum256(payload)
+
+	chunks := make([]*Chunk, 0, total)
+
+	for i := 0; i < total; i++ {
+		start := i * chunkSize
+		end := start + chunkSize
+
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		data := payload[start:end]
+
+		chunks = append(chunks, &Chunk{
+			MessageType: messageType,
+			PayloadHash: payloadHash,
+			PayloadSize: uint64(len(payload)),
+			Index:       uint32(i),
+			Total:       uint32(total),
+			DataHash:    sha256.Sum256(data),
+			Data:        data,
+		})
+	}
+
+	return chunks, nil
+}
+
+// Encode encodes the chunk so that it can be sent as the body of an envelope.
+func (c *Chunk) Encode() []byte {
+	messageType := []byte(c.MessageType)
+
+	b := make([]byte, 2+len(messageType)+chunkHeaderSize+len(c.Data))
+
+	binary.BigEndian.PutUint16(b, uint16(len(messageType)))
+	n := 2
+
+	n += copy(b[n:], messageType)
+	n += copy(b[n:], c.PayloadHash[:])
+
+	binary.BigEndian.PutUint64(b[n:], c.PayloadSize)
+	n += 8
+
+	binary.BigEndian.PutUint32(b[n:], c.Index)
+	n += 4
+
+	binary.BigEndian.PutUint32(b[n:], c.Total)
+	n += 4
+
+	n += copy(b[n:], c.DataHash[:])
+
+	copy(b[n:], c.Data)
+
+	return b
+}
+
+// DecodeChunk decodes and validates a chunk encoded with Chunk.Encode.
+func DecodeChunk(b []byte) (*Chunk, error) {
+	if len(b) < 2 {
+		return nil, ErrInvalidChunk
+	}
+
+	messageTypeLen := int(binary.BigEndian.Uint16(b))
+	b = b[2:]
+
+	if len(b) < messageTypeLen+chunkHeaderSize {
+		return nil, ErrInvalidChunk
+	}
+
+	var c Chunk
+
+	c.MessageType = MessageType(b[:messageTypeLen])
+	b = b[messageTypeLen:]
+
+	copy(c.PayloadHash[:], b)
+	b = b[sha256.Size:]
+
+	c.PayloadSize = binary.BigEndian.Uint64(b)
+	b = b[8:]
+
+	c.Index = binary.BigEndian.Uint32(b)
+	b = b[4:]
+
+	c.Total = binary.BigEndian.Uint32(b)
+	b = b[4:]
+
+	copy(c.DataHash[:], b)
+
+	c.Data = b[sha256.Size:]
+
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *Chunk) validate() error {
+	if c.Total == 0 || c.Index >= c.Total || uint64(len(c.Data)) > c.PayloadSize {
+		return ErrInvalidChunk
+	}
+
+	// Only the chunk of an empty payload can be empty.
+	if c.PayloadSize > 0 && (len(c.Data) == 0 || uint64(c.Total) > c.PayloadSize) {
+		return ErrInvalidChunk
+	}
+
+	if sha256.Sum256(c.Data) != c.DataHash {
+		return ErrChunkIntegrity
+	}
+
+	return nil
+}
+
+// EncodeChunkAck encodes the acknowledgement of a chunk, which holds the index of the next chunk expected
+// by the receiver. Chunks that were already received are skipped when a transfer is resumed.
+func EncodeChunkAck(nextIndex uint32) []byte {
+	b := make([]byte, 4)
+
+	binary.BigEndian.PutUint32(b, nextIndex)
+
+	return b
+}
+
+// DecodeChunkAck decodes the acknowledgement of a chunk encoded with EncodeChunkAck.
+func DecodeChunkAck(b []byte) (uint32, error) {
+	if len(b) != 4 {
+		return 0, ErrInvalidChunk
+	}
+
+	return binary.BigEndian.Uint32(b), nil
+}
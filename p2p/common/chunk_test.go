@@ -0,0 +1,164 @@
+//go:build unit
+
+package p2pcommon
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitIntoChunks(t *testing.T) {
+	payload := utils.RandomSlice(25)
+
+	chunks, err := SplitIntoChunks(MessageTypeSendAnchoredDoc, payload, 10)
+	assert.NoError(t, err)
+	assert.Len(t, chunks, 3)
+
+	var assembled []byte
+
+	for i, chunk := range chunks {
+		assert.Equal(t, MessageTypeSendAnchoredDoc, chunk.MessageType)
+		assert.Equal(t, sha256.Sum256(payload), chunk.PayloadHash)
+		assert.Equal(t, uint64(len(payload)), chunk.PayloadSize)
+		assert.Equal(t, uint32(i), chunk.Index)
+		assert.Equal(t, uint32(3), chunk.Total)
+		assert.Equal(t, sha256.Sum256(chunk.Data), chunk.DataHash)
+
+		assembled = append(assembled, chunk.Data...)
+	}
+
+	assert.Len(t, chunks[2].Data, 5)
+	assert.Equal(t, payload, assembled)
+}
+
+func TestSplitIntoChunks_ExactSize(t *testing.T) {
+	chunks, err := SplitIntoChunks(MessageTypeSendAnchoredDoc, utils.RandomSlice(20), 10)
+	assert.NoError(t, err)
+	assert.Len(t, chunks, 2)
+	assert.Len(t, chunks[1].Data, 10)
+}
+
+func TestSplitIntoChunks_EmptyPayload(t *testing.T) {
+	chunks, err := SplitIntoChunks(MessageTypeSendAnchoredDoc, nil, 10)
+	assert.NoError(t, err)
+	assert.Len(t, chunks, 1)
+	assert.Empty(t, chunks[0].Data)
+	assert.Equal(t, uint32(1), chunks[0].Total)
+}
+
+func TestSplitIntoChunks_InvalidChunkSize(t *testing.T) {
+	chunks, err := SplitIntoChunks(MessageTypeSendAnchoredDoc, utils.RandomSlice(20), 0)
+	assert.ErrorIs(t, err, ErrInvalidChunkSize)
+	assert.Nil(t, chunks)
+}
+
+func TestChunk_EncodeDecode(t *testing.T) {
+	chunks, err := SplitIntoChunks(MessageTypeSendEncryptedAnchoredDoc, utils.RandomSlice(25), 10)
+	assert.NoError(t, err)
+
+	for _, chunk := range chunks {
+		res, err := DecodeChunk(chunk.Encode())
+		assert.NoError(t, err)
+		assert.Equal(t, chunk, res)
+	}
+}
+
+func TestDecodeChunk_Errors(t *testing.T) {
+	chunks, err := SplitIntoChunks(MessageTypeSendAnchoredDoc, utils.RandomSlice(25), 10)
+	assert.NoError(t, err)
+
+	validChunk := chunks[1]
+
+	tamperedData := *validChunk
+	tamperedData.Data = bytes.Repeat([]byte{1}, len(validChunk.Data))
+
+	invalidIndex := *validChunk
+	invalidIndex.Index = validChunk.Total
+
+	noChunks := *validChunk
+	noChunks.Total = 0
+
+	tooMuchData := *validChunk
+	tooMuchData.PayloadSize = uint64(len(validChunk.Data) - 1)
+
+	emptyData := *validChunk
+	emptyData.Data = nil
+	emptyData.DataHash = sha256.Sum256(nil)
+
+	tooManyChunks := *validChunk
+	tooManyChunks.Total = uint32(validChunk.PayloadSize + 1)
+
+	tests := []struct {
+		name        string
+		encoded     []byte
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			encoded:     nil,
+			expectedErr: ErrInvalidChunk,
+		},
+		{
+			name:        "truncated message type",
+			encoded:     validChunk.Encode()[:10],
+			expectedErr: ErrInvalidChunk,
+		},
+		{
+			name:        "truncated header",
+			encoded:     validChunk.Encode()[:2+len(validChunk.MessageType)+chunkHeaderSize-1],
+			expectedErr: ErrInvalidChunk,
+		},
+		{
+			name:        "tampered data",
+			encoded:     tamperedData.Encode(),
+			expectedErr: ErrChunkIntegrity,
+		},
+		{
+			name:        "invalid index",
+			encoded:     invalidIndex.Encode(),
+			expectedErr: ErrInvalidChunk,
+		},
+		{
+			name:        "no chunks",
+			encoded:     noChunks.Encode(),
+			expectedErr: ErrInvalidChunk,
+		},
+		{
+			name:        "data larger than payload",
+			encoded:     tooMuchData.Encode(),
+			expectedErr: ErrInvalidChunk,
+		},
+		{
+			name:        "empty data",
+			encoded:     emptyData.Encode(),
+			expectedErr: ErrInvalidChunk,
+		},
+		{
+			name:        "more chunks than bytes",
+			encoded:     tooManyChunks.Encode(),
+			expectedErr: ErrInvalidChunk,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := DecodeChunk(test.encoded)
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestChunkAck_EncodeDecode(t *testing.T) {
+	res, err := DecodeChunkAck(EncodeChunkAck(42))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(42), res)
+
+	res, err = DecodeChunkAck(utils.RandomSlice(3))
+	assert.ErrorIs(t, err, ErrInvalidChunk)
+	assert.Zero(t, res)
+}
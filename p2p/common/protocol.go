@@ -24,9 +24,38 @@ import (
 // MessageType holds the protocol message type
 type MessageType string
 
+// ProtocolVersion holds the version of the centrifuge wire protocol
+type ProtocolVersion string
+
 const (
-	// CentrifugeProtocol is the centrifuge wire protocol
-	CentrifugeProtocol protocol.ID = "/centrifuge/0.0.1"
+	// ProtocolVersion001 is the initial version of the centrifuge wire protocol
+	ProtocolVersion001 ProtocolVersion = "0.0.1"
+	// ProtocolVersion002 adds the MessageTypeGetLatestVersion message
+	ProtocolVersion002 ProtocolVersion = "0.0.2"
+	// ProtocolVersion003 adds the MessageTypeSendEncryptedAnchoredDoc message
+	ProtocolVersion003 ProtocolVersion = "0.0.3"
+	// ProtocolVersion004 adds the MessageTypeSendAnchoredDocChunk message
+	ProtocolVersion004 ProtocolVersion = "0.0.4"
+	// ProtocolVersion005 adds the MessageTypeGetAttachment message
+	ProtocolVersion005 ProtocolVersion = "0.0.5"
+
+	// LatestProtocolVersion is the highest protocol version spoken by the node
+	LatestProtocolVersion = ProtocolVersion005
+
+	// CentrifugeProtocolPrefix is the prefix of all centrifuge wire protocol versions
+	CentrifugeProtocolPrefix = "/centrifuge"
+
+	// CentrifugeProtocol is the latest version of the centrifuge wire protocol
+	CentrifugeProtocol protocol.ID = CentrifugeProtocolPrefix + "/" + protocol.ID(LatestProtocolVersion)
+
+	// ErrInvalidProtocolID must be used when a protocol ID is not a centrifuge protocol ID
+	ErrInvalidProtocolID = errors.Error("invalid protocol ID")
+
+	// ErrUnsupportedProtocolVersion must be used when a protocol version is not supported by the node
+	ErrUnsupportedProtocolVersion = errors.Error("unsupported protocol version")
+
+	// ErrUnsupportedMessageType must be used when a message type is not supported by a protocol version
+	ErrUnsupportedMessageType = errors.Error("message type not supported by protocol version")
 
 	// MessageTypeError defines any protocol error
 	MessageTypeError MessageType = "MessageTypeError"
@@ -44,18 +73,148 @@ const (
 	MessageTypeGetDoc MessageType = "MessageTypeGetDoc"
 	//MessageTypeGetDocRep defines GetAnchoredDoc response type
 	MessageTypeGetDocRep MessageType = "MessageTypeGetDocRep"
+	// MessageTypeGetLatestVersion defines GetLatestVersion type
+	MessageTypeGetLatestVersion MessageType = "MessageTypeGetLatestVersion"
+	// MessageTypeGetLatestVersionRep defines GetLatestVersion response type
+	MessageTypeGetLatestVersionRep MessageType = "MessageTypeGetLatestVersionRep"
+	// MessageTypeSendEncryptedAnchoredDoc defines SendAnchored type with a payload encrypted for the receiver,
+	// the response is of MessageTypeSendAnchoredDocRep type
+	MessageTypeSendEncryptedAnchoredDoc MessageType = "MessageTypeSendEncryptedAnchoredDoc"
+	// MessageTypeSendAnchoredDocChunk defines the type of a chunk of a SendAnchored message that is too large
+	// to be sent at once, the response to the last chunk is of MessageTypeSendAnchoredDocRep type
+	MessageTypeSendAnchoredDocChunk MessageType = "MessageTypeSendAnchoredDocChunk"
+	// MessageTypeSendAnchoredDocChunkRep defines SendAnchoredDocChunk response type
+	MessageTypeSendAnchoredDocChunkRep MessageType = "MessageTypeSendAnchoredDocChunkRep"
+	// MessageTypeGetAttachment defines the type of the request for the content of an attachment of a document
+	MessageTypeGetAttachment MessageType = "MessageTypeGetAttachment"
+	// MessageTypeGetAttachmentRep defines GetAttachment response type
+	MessageTypeGetAttachmentRep MessageType = "MessageTypeGetAttachmentRep"
 )
 
-//MessageTypes map for MessageTypeFromString function
+// MessageTypes map for MessageTypeFromString function
 var messageTypes = map[string]MessageType{
-	"MessageTypeError":               "MessageTypeError",
-	"MessageTypeInvalid":             "MessageTypeInvalid",
-	"MessageTypeRequestSignature":    "MessageTypeRequestSignature",
-	"MessageTypeRequestSignatureRep": "MessageTypeRequestSignatureRep",
-	"MessageTypeSendAnchoredDoc":     "MessageTypeSendAnchoredDoc",
-	"MessageTypeSendAnchoredDocRep":  "MessageTypeSendAnchoredDocRep",
-	"MessageTypeGetDoc":              "MessageTypeGetDoc",
-	"MessageTypeGetDocRep":           "MessageTypeGetDocRep",
+	"MessageTypeError":                    "MessageTypeError",
+	"MessageTypeInvalid":                  "MessageTypeInvalid",
+	"MessageTypeRequestSignature":         "MessageTypeRequestSignature",
+	"MessageTypeRequestSignatureRep":      "MessageTypeRequestSignatureRep",
+	"MessageTypeSendAnchoredDoc":          "MessageTypeSendAnchoredDoc",
+	"MessageTypeSendAnchoredDocRep":       "MessageTypeSendAnchoredDocRep",
+	"MessageTypeGetDoc":                   "MessageTypeGetDoc",
+	"MessageTypeGetDocRep":                "MessageTypeGetDocRep",
+	"MessageTypeGetLatestVersion":         "MessageTypeGetLatestVersion",
+	"MessageTypeGetLatestVersionRep":      "MessageTypeGetLatestVersionRep",
+	"MessageTypeSendEncryptedAnchoredDoc": "MessageTypeSendEncryptedAnchoredDoc",
+	"MessageTypeSendAnchoredDocChunk":     "MessageTypeSendAnchoredDocChunk",
+	"MessageTypeSendAnchoredDocChunkRep":  "MessageTypeSendAnchoredDocChunkRep",
+	"MessageTypeGetAttachment":            "MessageTypeGetAttachment",
+	"MessageTypeGetAttachmentRep":         "MessageTypeGetAttachmentRep",
+}
+
+// SupportedProtocolVersions holds the protocol versions spoken by the node, in order of preference.
+var SupportedProtocolVersions = []ProtocolVersion{
+	ProtocolVersion005,
+	ProtocolVersion004,
+	ProtocolVersion003,
+	ProtocolVersion002,
+	ProtocolVersion001,
+}
+
+// protocolMessageTypes holds the message types that can be exchanged using each protocol version.
+var protocolMessageTypes = map[ProtocolVersion]map[MessageType]struct{}{
+	ProtocolVersion001: messageTypeSet(
+		MessageTypeError,
+		MessageTypeInvalid,
+		MessageTypeRequestSignature,
+		MessageTypeRequestSignatureRep,
+		MessageTypeSendAnchoredDoc,
+		MessageTypeSendAnchoredDocRep,
+		MessageTypeGetDoc,
+		MessageTypeGetDocRep,
+	),
+	ProtocolVersion002: messageTypeSet(
+		MessageTypeError,
+		MessageTypeInvalid,
+		MessageTypeRequestSignature,
+		MessageTypeRequestSignatureRep,
+		MessageTypeSendAnchoredDoc,
+		MessageTypeSendAnchoredDocRep,
+		MessageTypeGetDoc,
+		MessageTypeGetDocRep,
+		MessageTypeGetLatestVersion,
+		MessageTypeGetLatestVersionRep,
+	),
+	ProtocolVersion003: messageTypeSet(
+		MessageTypeError,
+		MessageTypeInvalid,
+		MessageTypeRequestSignature,
+		MessageTypeRequestSignatureRep,
+		MessageTypeSendAnchoredDoc,
+		MessageTypeSendAnchoredDocRep,
+		MessageTypeGetDoc,
+		MessageTypeGetDocRep,
+		MessageTypeGetLatestVersion,
+		MessageTypeGetLatestVersionRep,
+		MessageTypeSendEncryptedAnchoredDoc,
+	),
+	ProtocolVersion004: messageTypeSet(
+		MessageTypeError,
+		MessageTypeInvalid,
+		MessageTypeRequestSignature,
+		MessageTypeRequestSignatureRep,
+		MessageTypeSendAnchoredDoc,
+		MessageTypeSendAnchoredDocRep,
+		MessageTypeGetDoc,
+		MessageTypeGetDocRep,
+		MessageTypeGetLatestVersion,
+		MessageTypeGetLatestVersionRep,
+		MessageTypeSendEncryptedAnchoredDoc,
+		MessageTypeSendAnchoredDocChunk,
+		MessageTypeSendAnchoredDocChunkRep,
+	),
+	ProtocolVersion005: messageTypeSet(
+		MessageTypeError,
+		MessageTypeInvalid,
+		MessageTypeRequestSignature,
+		MessageTypeRequestSignatureRep,
+		MessageTypeSendAnchoredDoc,
+		MessageTypeSendAnchoredDocRep,
+		MessageTypeGetDoc,
+		MessageTypeGetDocRep,
+		MessageTypeGetLatestVersion,
+		MessageTypeGetLatestVersionRep,
+		MessageTypeSendEncryptedAnchoredDoc,
+		MessageTypeSendAnchoredDocChunk,
+		MessageTypeSendAnchoredDocChunkRep,
+		MessageTypeGetAttachment,
+		MessageTypeGetAttachmentRep,
+	),
+}
+
+func messageTypeSet(messageTypes ...MessageType) map[MessageType]struct{} {
+	set := make(map[MessageType]struct{}, len(messageTypes))
+
+	for _, messageType := range messageTypes {
+		set[messageType] = struct{}{}
+	}
+
+	return set
+}
+
+// String representation
+func (v ProtocolVersion) String() string {
+	return string(v)
+}
+
+// IsSupported checks if the protocol version is spoken by the node
+func (v ProtocolVersion) IsSupported() bool {
+	_, ok := protocolMessageTypes[v]
+	return ok
+}
+
+// SupportsMessageType checks if the message type can be exchanged using the protocol version
+func (v ProtocolVersion) SupportsMessageType(messageType MessageType) bool {
+	_, ok := protocolMessageTypes[v][messageType]
+	return ok
 }
 
 // Equals compares if string is of a particular MessageType
@@ -77,9 +236,44 @@ func MessageTypeFromString(ht string) MessageType {
 	return messageType
 }
 
-// ProtocolForIdentity creates the protocol string for the given identity
+// ProtocolForIdentity creates the protocol string of the latest protocol version for the given identity
 func ProtocolForIdentity(identity *types.AccountID) protocol.ID {
-	return protocol.ID(fmt.Sprintf("%s/%s", CentrifugeProtocol, identity.ToHexString()))
+	return ProtocolForIdentityVersion(identity, LatestProtocolVersion)
+}
+
+// ProtocolForIdentityVersion creates the protocol string of the given protocol version for the given identity
+func ProtocolForIdentityVersion(identity *types.AccountID, version ProtocolVersion) protocol.ID {
+	return protocol.ID(fmt.Sprintf("%s/%s/%s", CentrifugeProtocolPrefix, version, identity.ToHexString()))
+}
+
+// ProtocolsForIdentity creates the protocol strings of all supported protocol versions for the given identity,
+// in order of preference.
+func ProtocolsForIdentity(identity *types.AccountID) []protocol.ID {
+	protocols := make([]protocol.ID, 0, len(SupportedProtocolVersions))
+
+	for _, version := range SupportedProtocolVersions {
+		protocols = append(protocols, ProtocolForIdentityVersion(identity, version))
+	}
+
+	return protocols
+}
+
+// ExtractProtocolVersion extracts the protocol version from a protocol string
+func ExtractProtocolVersion(id protocol.ID) (ProtocolVersion, error) {
+	parts := strings.Split(string(id), "/")
+
+	// Expected format - /centrifuge/<version>/<identity>
+	if len(parts) != 4 || "/"+parts[1] != CentrifugeProtocolPrefix {
+		return "", ErrInvalidProtocolID
+	}
+
+	version := ProtocolVersion(parts[2])
+
+	if !version.IsSupported() {
+		return "", ErrUnsupportedProtocolVersion
+	}
+
+	return version, nil
 }
 
 // ExtractIdentity extracts the identity from a protocol string
@@ -119,6 +313,17 @@ func ResolveDataEnvelope(mes proto.Message) (*p2ppb.Envelope, error) {
 
 // PrepareP2PEnvelope wraps content message into p2p envelope
 func PrepareP2PEnvelope(ctx context.Context, networkID uint32, messageType MessageType, mes proto.Message) (*protocolpb.P2PEnvelope, error) {
+	body, err := proto.Marshal(mes)
+	if err != nil {
+		return nil, err
+	}
+
+	return PrepareP2PEnvelopeWithBody(ctx, networkID, messageType, body)
+}
+
+// PrepareP2PEnvelopeWithBody wraps an already encoded content message, such as an encrypted one,
+// into p2p envelope
+func PrepareP2PEnvelopeWithBody(ctx context.Context, networkID uint32, messageType MessageType, body []byte) (*protocolpb.P2PEnvelope, error) {
 	sender, err := contextutil.Identity(ctx)
 
 	if err != nil {
@@ -135,11 +340,6 @@ func PrepareP2PEnvelope(ctx context.Context, networkID uint32, messageType Messa
 		Timestamp:         tm,
 	}
 
-	body, err := proto.Marshal(mes)
-	if err != nil {
-		return nil, err
-	}
-
 	envelope := &p2ppb.Envelope{
 		Header: p2pheader,
 		Body:   body,
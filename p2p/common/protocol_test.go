@@ -32,6 +32,83 @@ func TestProtocolForIdentity(t *testing.T) {
 	assert.Equal(t, expectedProtocolID, protocolID)
 }
 
+func TestProtocolsForIdentity(t *testing.T) {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	res := ProtocolsForIdentity(accountID)
+	assert.Len(t, res, len(SupportedProtocolVersions))
+
+	// The latest version is the preferred one.
+	assert.Equal(t, ProtocolForIdentity(accountID), res[0])
+
+	for i, version := range SupportedProtocolVersions {
+		expectedProtocolID := protocol.ID(fmt.Sprintf("/centrifuge/%s/%s", version, accountID.ToHexString()))
+
+		assert.Equal(t, expectedProtocolID, res[i])
+
+		identity, err := ExtractIdentity(res[i])
+		assert.NoError(t, err)
+		assert.Equal(t, accountID, identity)
+	}
+}
+
+func TestExtractProtocolVersion(t *testing.T) {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	for _, version := range SupportedProtocolVersions {
+		res, err := ExtractProtocolVersion(ProtocolForIdentityVersion(accountID, version))
+		assert.NoError(t, err)
+		assert.Equal(t, version, res)
+	}
+
+	res, err := ExtractProtocolVersion(ProtocolForIdentityVersion(accountID, "0.1.0"))
+	assert.ErrorIs(t, err, ErrUnsupportedProtocolVersion)
+	assert.Empty(t, res)
+
+	res, err = ExtractProtocolVersion(protocol.ID(fmt.Sprintf("/other/0.0.1/%s", accountID.ToHexString())))
+	assert.ErrorIs(t, err, ErrInvalidProtocolID)
+	assert.Empty(t, res)
+
+	res, err = ExtractProtocolVersion("protocol-id")
+	assert.ErrorIs(t, err, ErrInvalidProtocolID)
+	assert.Empty(t, res)
+}
+
+func TestProtocolVersion_SupportsMessageType(t *testing.T) {
+	for _, version := range SupportedProtocolVersions {
+		assert.True(t, version.IsSupported())
+		assert.True(t, version.SupportsMessageType(MessageTypeError))
+		assert.True(t, version.SupportsMessageType(MessageTypeRequestSignature))
+		assert.True(t, version.SupportsMessageType(MessageTypeSendAnchoredDoc))
+		assert.True(t, version.SupportsMessageType(MessageTypeGetDoc))
+		assert.False(t, version.SupportsMessageType(MessageType("unknown")))
+	}
+
+	assert.False(t, ProtocolVersion001.SupportsMessageType(MessageTypeGetLatestVersion))
+	assert.True(t, ProtocolVersion002.SupportsMessageType(MessageTypeGetLatestVersion))
+	assert.True(t, ProtocolVersion003.SupportsMessageType(MessageTypeGetLatestVersion))
+
+	assert.False(t, ProtocolVersion001.SupportsMessageType(MessageTypeSendEncryptedAnchoredDoc))
+	assert.False(t, ProtocolVersion002.SupportsMessageType(MessageTypeSendEncryptedAnchoredDoc))
+	assert.True(t, ProtocolVersion003.SupportsMessageType(MessageTypeSendEncryptedAnchoredDoc))
+	assert.True(t, ProtocolVersion004.SupportsMessageType(MessageTypeSendEncryptedAnchoredDoc))
+
+	assert.False(t, ProtocolVersion003.SupportsMessageType(MessageTypeSendAnchoredDocChunk))
+	assert.True(t, ProtocolVersion004.SupportsMessageType(MessageTypeSendAnchoredDocChunk))
+	assert.True(t, ProtocolVersion004.SupportsMessageType(MessageTypeSendAnchoredDocChunkRep))
+	assert.True(t, ProtocolVersion005.SupportsMessageType(MessageTypeSendAnchoredDocChunk))
+
+	assert.False(t, ProtocolVersion004.SupportsMessageType(MessageTypeGetAttachment))
+	assert.True(t, ProtocolVersion005.SupportsMessageType(MessageTypeGetAttachment))
+	assert.True(t, ProtocolVersion005.SupportsMessageType(MessageTypeGetAttachmentRep))
+
+	unknownVersion := ProtocolVersion("0.1.0")
+	assert.False(t, unknownVersion.IsSupported())
+	assert.False(t, unknownVersion.SupportsMessageType(MessageTypeError))
+}
+
 func TestExtractIdentity(t *testing.T) {
 	accountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
@@ -183,6 +260,21 @@ func TestPrepareP2PEnvelope_NoSenderIdentity(t *testing.T) {
 }
 
 func TestPrepareP2PEnvelope_NilMessage(t *testing.T) {
+	// The message is encoded before the sender identity is retrieved.
+	accountMock := config.NewAccountMock(t)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	messageType := MessageTypeGetDoc
+
+	networkID := uint32(36)
+
+	res, err := PrepareP2PEnvelope(ctx, networkID, messageType, nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+}
+
+func TestPrepareP2PEnvelopeWithBody(t *testing.T) {
 	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
@@ -193,11 +285,29 @@ func TestPrepareP2PEnvelope_NilMessage(t *testing.T) {
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	messageType := MessageTypeGetDoc
+	body := utils.RandomSlice(64)
+
+	messageType := MessageTypeSendEncryptedAnchoredDoc
 
 	networkID := uint32(36)
 
-	res, err := PrepareP2PEnvelope(ctx, networkID, messageType, nil)
+	res, err := PrepareP2PEnvelopeWithBody(ctx, networkID, messageType, body)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	var p2pEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &p2pEnvelope)
+	assert.NoError(t, err)
+
+	assert.Equal(t, p2pEnvelope.GetHeader().GetSenderId(), identity.ToBytes())
+	assert.Equal(t, p2pEnvelope.GetHeader().GetNetworkIdentifier(), networkID)
+	assert.Equal(t, p2pEnvelope.GetHeader().GetType(), messageType.String())
+	assert.Equal(t, body, p2pEnvelope.GetBody())
+}
+
+func TestPrepareP2PEnvelopeWithBody_NoSenderIdentity(t *testing.T) {
+	res, err := PrepareP2PEnvelopeWithBody(context.Background(), 36, MessageTypeSendEncryptedAnchoredDoc, utils.RandomSlice(64))
 	assert.NotNil(t, err)
 	assert.Nil(t, res)
 }
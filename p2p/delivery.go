@@ -0,0 +1,358 @@
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// deliveryPrefix is the DB prefix of the anchored document deliveries.
+	deliveryPrefix = "p2p_delivery_"
+
+	// deliveryProcessInterval is the interval at which the pending deliveries are retried.
+	deliveryProcessInterval = 30 * time.Second
+
+	// deliveryInitialBackoff is the delay before the first retry of a failed delivery, it doubles after each attempt.
+	deliveryInitialBackoff = 30 * time.Second
+)
+
+// deliveryEntry is the persisted delivery of an anchored document version to a recipient.
+type deliveryEntry struct {
+	Sender      *types.AccountID        `json:"sender"`
+	Recipient   *types.AccountID        `json:"recipient"`
+	DocumentID  hexutil.Bytes           `json:"document_id"`
+	VersionID   hexutil.Bytes           `json:"version_id"`
+	Request     []byte                  `json:"request"`
+	State       documents.DeliveryState `json:"state"`
+	Attempts    int                     `json:"attempts"`
+	LastAttempt time.Time               `json:"last_attempt"`
+	NextAttempt time.Time               `json:"next_attempt"`
+	LastError   string                  `json:"last_error,omitempty"`
+	CreatedAt   time.Time               `json:"created_at"`
+}
+
+// JSON marshals deliveryEntry to json bytes.
+func (e *deliveryEntry) JSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// FromJSON loads json bytes to deliveryEntry.
+func (e *deliveryEntry) FromJSON(data []byte) error {
+	return json.Unmarshal(data, e)
+}
+
+// Type returns the type of deliveryEntry.
+func (e *deliveryEntry) Type() reflect.Type {
+	return reflect.TypeOf(e)
+}
+
+func (e *deliveryEntry) toDeliveryStatus() *documents.DeliveryStatus {
+	return &documents.DeliveryStatus{
+		Recipient:   e.Recipient,
+		State:       e.State,
+		Attempts:    e.Attempts,
+		LastAttempt: e.LastAttempt,
+		NextAttempt: e.NextAttempt,
+		LastError:   e.LastError,
+	}
+}
+
+// deliveryManager stores the anchored documents that are sent to the collaborators and retries the
+// deliveries that failed, with a backoff per recipient, until they succeed or expire.
+type deliveryManager struct {
+	db         storage.Repository
+	config     config.Configuration
+	cfgService config.Service
+	client     documents.Client
+
+	processInterval time.Duration
+
+	mu sync.Mutex
+}
+
+func newDeliveryManager(
+	db storage.Repository,
+	config config.Configuration,
+	cfgService config.Service,
+	client documents.Client,
+) *deliveryManager {
+	db.Register(new(deliveryEntry))
+
+	return &deliveryManager{
+		db:              db,
+		config:          config,
+		cfgService:      cfgService,
+		client:          client,
+		processInterval: deliveryProcessInterval,
+	}
+}
+
+// Name returns the name of the delivery manager server.
+func (*deliveryManager) Name() string {
+	return "P2PDeliveryManager"
+}
+
+// Start retries the pending deliveries until the context is done.
+func (d *deliveryManager) Start(ctx context.Context, wg *sync.WaitGroup, _ chan<- error) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(d.processInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Stopping delivery manager: %s", ctx.Err())
+			return
+		case <-ticker.C:
+			d.processDeliveries(ctx)
+		}
+	}
+}
+
+// Queue stores the delivery of the anchored document and attempts to send it to the receiver.
+// The delivery is retried later if the attempt fails, in which case the error of the attempt is returned.
+func (d *deliveryManager) Queue(ctx context.Context, receiverID *types.AccountID, req *p2ppb.AnchorDocumentRequest) error {
+	sender, err := contextutil.Identity(ctx)
+	if err != nil {
+		log.Errorf("Couldn't get sender identity: %s", err)
+
+		return errors.ErrContextIdentityRetrieval
+	}
+
+	data, err := proto.Marshal(req)
+	if err != nil {
+		log.Errorf("Couldn't marshal anchor document request: %s", err)
+
+		return ErrDeliveryStorage
+	}
+
+	entry := &deliveryEntry{
+		Sender:     sender,
+		Recipient:  receiverID,
+		DocumentID: req.GetDocument().GetDocumentIdentifier(),
+		VersionID:  req.GetDocument().GetCurrentVersion(),
+		Request:    data,
+		State:      documents.DeliveryStatePending,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	deliveryErr := d.deliver(ctx, entry)
+
+	if err := d.saveEntry(entry); err != nil {
+		log.Errorf("Couldn't store delivery: %s", err)
+
+		return ErrDeliveryStorage
+	}
+
+	return deliveryErr
+}
+
+// GetDeliveryStatus returns the status of the deliveries of the document version sent by the account.
+func (d *deliveryManager) GetDeliveryStatus(ctx context.Context, documentID, versionID []byte) ([]*documents.DeliveryStatus, error) {
+	sender, err := contextutil.Identity(ctx)
+	if err != nil {
+		log.Errorf("Couldn't get sender identity: %s", err)
+
+		return nil, errors.ErrContextIdentityRetrieval
+	}
+
+	models, err := d.db.GetAllByPrefix(getDeliveryPrefix(sender, documentID, versionID))
+	if err != nil {
+		log.Errorf("Couldn't retrieve deliveries: %s", err)
+
+		return nil, ErrDeliveryRetrieval
+	}
+
+	var res []*documents.DeliveryStatus
+
+	for _, model := range models {
+		entry, ok := model.(*deliveryEntry)
+		if !ok {
+			continue
+		}
+
+		res = append(res, entry.toDeliveryStatus())
+	}
+
+	return res, nil
+}
+
+// processDeliveries retries the pending deliveries that are due and removes the finished ones that expired.
+func (d *deliveryManager) processDeliveries(ctx context.Context) {
+	models, err := d.db.GetAllByPrefix(deliveryPrefix)
+	if err != nil {
+		log.Errorf("Couldn't retrieve deliveries: %s", err)
+
+		return
+	}
+
+	now := time.Now().UTC()
+
+	// the backoff of the failed attempt is applied to the other pending deliveries of an unreachable recipient
+	unreachable := make(map[types.AccountID]time.Time)
+
+	for _, model := range models {
+		if ctx.Err() != nil {
+			return
+		}
+
+		entry, ok := model.(*deliveryEntry)
+		if !ok {
+			continue
+		}
+
+		if entry.State != documents.DeliveryStatePending {
+			if now.Sub(entry.CreatedAt) >= d.config.GetP2PDeliveryExpiry() {
+				if err := d.db.Delete(getDeliveryKey(entry)); err != nil {
+					log.Errorf("Couldn't delete delivery: %s", err)
+				}
+			}
+
+			continue
+		}
+
+		if now.Before(entry.NextAttempt) {
+			continue
+		}
+
+		if nextAttempt, ok := unreachable[*entry.Recipient]; ok {
+			entry.NextAttempt = nextAttempt
+
+			if err := d.saveEntry(entry); err != nil {
+				log.Errorf("Couldn't store delivery: %s", err)
+			}
+
+			continue
+		}
+
+		acc, err := d.cfgService.GetAccount(entry.Sender.ToBytes())
+		if err != nil {
+			log.Errorf("Couldn't retrieve sender account: %s", err)
+
+			continue
+		}
+
+		if err := d.deliver(contextutil.WithAccount(ctx, acc), entry); err != nil {
+			log.Warnf("Couldn't deliver document to %s: %s", entry.Recipient.ToHexString(), err)
+
+			if entry.State == documents.DeliveryStatePending {
+				unreachable[*entry.Recipient] = entry.NextAttempt
+			}
+		}
+
+		if err := d.saveEntry(entry); err != nil {
+			log.Errorf("Couldn't store delivery: %s", err)
+		}
+	}
+}
+
+// deliver sends the anchored document to the recipient and updates the state of the delivery.
+func (d *deliveryManager) deliver(ctx context.Context, entry *deliveryEntry) error {
+	req := new(p2ppb.AnchorDocumentRequest)
+
+	if err := proto.Unmarshal(entry.Request, req); err != nil {
+		entry.State = documents.DeliveryStateFailed
+		entry.LastError = err.Error()
+
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.config.GetP2PConnectionTimeout())
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	entry.Attempts++
+	entry.LastAttempt = now
+
+	res, err := d.client.SendAnchoredDocument(ctx, entry.Recipient, req)
+
+	if err == nil && !res.GetAccepted() {
+		err = ErrDocumentNotAccepted
+	}
+
+	if err == nil {
+		entry.State = documents.DeliveryStateDelivered
+		entry.NextAttempt = time.Time{}
+		entry.LastError = ""
+
+		return nil
+	}
+
+	entry.LastError = err.Error()
+
+	if now.Sub(entry.CreatedAt) >= d.config.GetP2PDeliveryExpiry() {
+		entry.State = documents.DeliveryStateFailed
+		entry.NextAttempt = time.Time{}
+
+		return err
+	}
+
+	entry.NextAttempt = now.Add(d.getBackoff(entry.Attempts))
+
+	return err
+}
+
+// getBackoff returns the delay before the next attempt, doubling with each attempt up to the configured maximum.
+func (d *deliveryManager) getBackoff(attempts int) time.Duration {
+	maxBackoff := d.config.GetP2PDeliveryMaxBackoff()
+
+	backoff := deliveryInitialBackoff
+
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+
+	return backoff
+}
+
+func (d *deliveryManager) saveEntry(entry *deliveryEntry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := getDeliveryKey(entry)
+
+	if d.db.Exists(key) {
+		return d.db.Update(key, entry)
+	}
+
+	return d.db.Create(key, entry)
+}
+
+// getDeliveryPrefix returns the prefix of the deliveries of the document version sent by the account.
+func getDeliveryPrefix(sender *types.AccountID, documentID, versionID []byte) string {
+	var key []byte
+
+	key = append(key, sender.ToBytes()...)
+	key = append(key, documentID...)
+	key = append(key, versionID...)
+
+	return deliveryPrefix + hexutil.Encode(key)
+}
+
+// getDeliveryKey returns the key of the delivery, the hex encoding of the recipient is appended to the
+// delivery prefix so that all the deliveries of a document version can be retrieved by prefix.
+func getDeliveryKey(entry *deliveryEntry) []byte {
+	return []byte(
+		getDeliveryPrefix(entry.Sender, entry.DocumentID, entry.VersionID) +
+			hexutil.Encode(entry.Recipient.ToBytes())[2:],
+	)
+}
@@ -0,0 +1,280 @@
+//go:build unit
+
+package p2p
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/storage/leveldb"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testDeliveryStoragePattern = "p2p-delivery-*"
+)
+
+type deliveryTestMocks struct {
+	configMock     *config.ConfigurationMock
+	cfgServiceMock *config.ServiceMock
+	clientMock     *documents.ClientMock
+}
+
+func getTestDeliveryManager(t *testing.T) (*deliveryManager, *deliveryTestMocks) {
+	randomStoragePath, err := testingcommons.GetRandomTestStoragePath(testDeliveryStoragePattern)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = os.RemoveAll(randomStoragePath)
+	})
+
+	db, err := leveldb.NewLevelDBStorage(randomStoragePath)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	mocks := &deliveryTestMocks{
+		configMock:     config.NewConfigurationMock(t),
+		cfgServiceMock: config.NewServiceMock(t),
+		clientMock:     documents.NewClientMock(t),
+	}
+
+	d := newDeliveryManager(
+		leveldb.NewLevelDBRepository(db),
+		mocks.configMock,
+		mocks.cfgServiceMock,
+		mocks.clientMock,
+	)
+
+	return d, mocks
+}
+
+func getTestAnchorDocumentRequest() *p2ppb.AnchorDocumentRequest {
+	return &p2ppb.AnchorDocumentRequest{
+		Document: &coredocumentpb.CoreDocument{
+			DocumentIdentifier: utils.RandomSlice(32),
+			CurrentVersion:     utils.RandomSlice(32),
+		},
+	}
+}
+
+func getTestSenderContext(t *testing.T) (context.Context, *types.AccountID, config.Account) {
+	sender, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(sender).
+		Maybe()
+
+	return contextutil.WithAccount(context.Background(), accountMock), sender, accountMock
+}
+
+func TestDeliveryManager_Queue(t *testing.T) {
+	d, mocks := getTestDeliveryManager(t)
+
+	ctx, _, _ := getTestSenderContext(t)
+
+	recipient, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := getTestAnchorDocumentRequest()
+
+	mocks.configMock.On("GetP2PConnectionTimeout").
+		Return(time.Second)
+
+	mocks.clientMock.On("SendAnchoredDocument", mock.Anything, recipient, mock.IsType(req)).
+		Return(&p2ppb.AnchorDocumentResponse{Accepted: true}, nil).
+		Once()
+
+	err = d.Queue(ctx, recipient, req)
+	assert.NoError(t, err)
+
+	res, err := d.GetDeliveryStatus(ctx, req.GetDocument().GetDocumentIdentifier(), req.GetDocument().GetCurrentVersion())
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, recipient, res[0].Recipient)
+	assert.Equal(t, documents.DeliveryStateDelivered, res[0].State)
+	assert.Equal(t, 1, res[0].Attempts)
+	assert.Empty(t, res[0].LastError)
+}
+
+func TestDeliveryManager_Queue_NoIdentity(t *testing.T) {
+	d, _ := getTestDeliveryManager(t)
+
+	recipient, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	err = d.Queue(context.Background(), recipient, getTestAnchorDocumentRequest())
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+}
+
+func TestDeliveryManager_ProcessDeliveries(t *testing.T) {
+	d, mocks := getTestDeliveryManager(t)
+
+	ctx, sender, accountMock := getTestSenderContext(t)
+
+	recipient, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req1 := getTestAnchorDocumentRequest()
+	req2 := getTestAnchorDocumentRequest()
+
+	mocks.configMock.On("GetP2PConnectionTimeout").
+		Return(time.Second)
+	mocks.configMock.On("GetP2PDeliveryExpiry").
+		Return(time.Hour)
+	mocks.configMock.On("GetP2PDeliveryMaxBackoff").
+		Return(time.Hour)
+
+	sendErr := errors.New("error")
+
+	// The recipient is offline when the documents are sent.
+	mocks.clientMock.On("SendAnchoredDocument", mock.Anything, recipient, mock.IsType(req1)).
+		Return(nil, sendErr).
+		Twice()
+
+	err = d.Queue(ctx, recipient, req1)
+	assert.ErrorIs(t, err, sendErr)
+
+	err = d.Queue(ctx, recipient, req2)
+	assert.ErrorIs(t, err, sendErr)
+
+	res, err := d.GetDeliveryStatus(ctx, req1.GetDocument().GetDocumentIdentifier(), req1.GetDocument().GetCurrentVersion())
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, documents.DeliveryStatePending, res[0].State)
+	assert.Equal(t, sendErr.Error(), res[0].LastError)
+	assert.True(t, res[0].NextAttempt.After(res[0].LastAttempt))
+
+	// Deliveries are not retried before the backoff ends.
+	d.processDeliveries(context.Background())
+
+	setDeliveriesDue(t, d)
+
+	mocks.cfgServiceMock.On("GetAccount", sender.ToBytes()).
+		Return(accountMock, nil)
+
+	// Only one attempt is made for an unreachable recipient, the backoff is applied to all its deliveries.
+	mocks.clientMock.On("SendAnchoredDocument", mock.Anything, recipient, mock.IsType(req1)).
+		Return(nil, sendErr).
+		Once()
+
+	d.processDeliveries(context.Background())
+
+	res1, err := d.GetDeliveryStatus(ctx, req1.GetDocument().GetDocumentIdentifier(), req1.GetDocument().GetCurrentVersion())
+	assert.NoError(t, err)
+
+	res2, err := d.GetDeliveryStatus(ctx, req2.GetDocument().GetDocumentIdentifier(), req2.GetDocument().GetCurrentVersion())
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, res1[0].Attempts+res2[0].Attempts)
+	assert.Equal(t, res1[0].NextAttempt.Unix(), res2[0].NextAttempt.Unix())
+
+	setDeliveriesDue(t, d)
+
+	// The recipient is back online.
+	mocks.clientMock.On("SendAnchoredDocument", mock.Anything, recipient, mock.IsType(req1)).
+		Return(&p2ppb.AnchorDocumentResponse{Accepted: true}, nil).
+		Twice()
+
+	d.processDeliveries(context.Background())
+
+	for _, req := range []*p2ppb.AnchorDocumentRequest{req1, req2} {
+		res, err := d.GetDeliveryStatus(ctx, req.GetDocument().GetDocumentIdentifier(), req.GetDocument().GetCurrentVersion())
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, documents.DeliveryStateDelivered, res[0].State)
+	}
+}
+
+func TestDeliveryManager_ProcessDeliveries_Expired(t *testing.T) {
+	d, mocks := getTestDeliveryManager(t)
+
+	ctx, sender, accountMock := getTestSenderContext(t)
+
+	recipient, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := getTestAnchorDocumentRequest()
+
+	mocks.configMock.On("GetP2PConnectionTimeout").
+		Return(time.Second)
+	mocks.configMock.On("GetP2PDeliveryMaxBackoff").
+		Return(time.Hour)
+
+	sendErr := errors.New("error")
+
+	mocks.clientMock.On("SendAnchoredDocument", mock.Anything, recipient, mock.IsType(req)).
+		Return(nil, sendErr)
+
+	mocks.configMock.On("GetP2PDeliveryExpiry").
+		Return(time.Hour).
+		Once()
+
+	err = d.Queue(ctx, recipient, req)
+	assert.ErrorIs(t, err, sendErr)
+
+	setDeliveriesDue(t, d)
+
+	mocks.cfgServiceMock.On("GetAccount", sender.ToBytes()).
+		Return(accountMock, nil)
+
+	mocks.configMock.On("GetP2PDeliveryExpiry").
+		Return(time.Duration(0))
+
+	// The delivery is abandoned once it expires.
+	d.processDeliveries(context.Background())
+
+	res, err := d.GetDeliveryStatus(ctx, req.GetDocument().GetDocumentIdentifier(), req.GetDocument().GetCurrentVersion())
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, documents.DeliveryStateFailed, res[0].State)
+
+	// Expired deliveries are removed.
+	d.processDeliveries(context.Background())
+
+	res, err = d.GetDeliveryStatus(ctx, req.GetDocument().GetDocumentIdentifier(), req.GetDocument().GetCurrentVersion())
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestDeliveryManager_GetBackoff(t *testing.T) {
+	d, mocks := getTestDeliveryManager(t)
+
+	mocks.configMock.On("GetP2PDeliveryMaxBackoff").
+		Return(3 * time.Minute)
+
+	assert.Equal(t, deliveryInitialBackoff, d.getBackoff(1))
+	assert.Equal(t, 2*deliveryInitialBackoff, d.getBackoff(2))
+	assert.Equal(t, 4*deliveryInitialBackoff, d.getBackoff(3))
+	assert.Equal(t, 3*time.Minute, d.getBackoff(4))
+	assert.Equal(t, 3*time.Minute, d.getBackoff(100))
+}
+
+// setDeliveriesDue sets the next attempt of all pending deliveries in the past.
+func setDeliveriesDue(t *testing.T, d *deliveryManager) {
+	models, err := d.db.GetAllByPrefix(deliveryPrefix)
+	assert.NoError(t, err)
+
+	for _, model := range models {
+		entry := model.(*deliveryEntry)
+		entry.NextAttempt = time.Now().Add(-time.Minute)
+
+		assert.NoError(t, d.saveEntry(entry))
+	}
+}
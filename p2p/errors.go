@@ -21,4 +21,17 @@ const (
 	ErrCoreDocumentPacking          = errors.Error("couldn't pack core document")
 	ErrDocumentSignatureRequest     = errors.Error("couldn't request document signature")
 	ErrInvalidSignatureResponse     = errors.Error("invalid signature response")
+	ErrDocumentNotAccepted          = errors.Error("document not accepted by the receiver")
+	ErrDeliveryStorage              = errors.Error("couldn't store document delivery")
+	ErrDeliveryRetrieval            = errors.Error("couldn't retrieve document deliveries")
+	ErrProtocolNegotiation          = errors.Error("couldn't negotiate P2P protocol")
+	ErrMessageTypeNotSupported      = errors.Error("message type not supported by the peer")
+	ErrPeerHostNotStarted           = errors.Error("P2P host not started")
+	ErrAddressBookRetrieval         = errors.Error("couldn't retrieve address book")
+	ErrEncryptionKeyRetrieval       = errors.Error("couldn't retrieve document encryption key")
+	ErrInvalidEncryptionKey         = errors.Error("invalid document encryption key")
+	ErrEncryptionNotSupported       = errors.Error("receiver doesn't support encrypted documents")
+	ErrDocumentEncryption           = errors.Error("couldn't encrypt document")
+	ErrInvalidChunkAck              = errors.Error("invalid chunk acknowledgement")
+	ErrChunkedTransferIncomplete    = errors.Error("chunked transfer incomplete")
 )
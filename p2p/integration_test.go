@@ -27,6 +27,7 @@ import (
 	"github.com/centrifuge/pod/crypto/ed25519"
 	protocolIDDispatcher "github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/documents/entityrelationship"
 	"github.com/centrifuge/pod/documents/generic"
 	v2 "github.com/centrifuge/pod/identity/v2"
@@ -951,6 +952,7 @@ func getIntegrationTestBootstrappers() []bootstrap.TestBootstrapper {
 		documents.Bootstrapper{},
 		pending.Bootstrapper{},
 		&ipfs.TestBootstrapper{},
+		attachments.Bootstrapper{},
 		&nftv3.Bootstrapper{},
 		&Bootstrapper{},
 		documents.PostBootstrapper{},
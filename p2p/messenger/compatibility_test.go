@@ -0,0 +1,220 @@
+//go:build unit
+
+package messenger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	p2pcommon "github.com/centrifuge/pod/p2p/common"
+	p2pMocks "github.com/centrifuge/pod/p2p/mocks"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/libp2p/go-libp2p-core/network"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errProtocolNotSupported = errors.New("protocol not supported")
+
+func TestP2PMessenger_NegotiateProtocol_Compatibility(t *testing.T) {
+	tests := []struct {
+		name                     string
+		localVersions            []p2pcommon.ProtocolVersion
+		remoteVersions           []p2pcommon.ProtocolVersion
+		expectedVersion          p2pcommon.ProtocolVersion
+		expectedSupportedTypes   []p2pcommon.MessageType
+		expectedUnsupportedTypes []p2pcommon.MessageType
+		expectedError            bool
+	}{
+		{
+			name:            "same versions",
+			localVersions:   []p2pcommon.ProtocolVersion{p2pcommon.ProtocolVersion002, p2pcommon.ProtocolVersion001},
+			remoteVersions:  []p2pcommon.ProtocolVersion{p2pcommon.ProtocolVersion002, p2pcommon.ProtocolVersion001},
+			expectedVersion: p2pcommon.ProtocolVersion002,
+			expectedSupportedTypes: []p2pcommon.MessageType{
+				p2pcommon.MessageTypeRequestSignature,
+				p2pcommon.MessageTypeSendAnchoredDoc,
+				p2pcommon.MessageTypeGetDoc,
+				p2pcommon.MessageTypeGetLatestVersion,
+			},
+		},
+		{
+			name:            "older remote",
+			localVersions:   []p2pcommon.ProtocolVersion{p2pcommon.ProtocolVersion002, p2pcommon.ProtocolVersion001},
+			remoteVersions:  []p2pcommon.ProtocolVersion{p2pcommon.ProtocolVersion001},
+			expectedVersion: p2pcommon.ProtocolVersion001,
+			expectedSupportedTypes: []p2pcommon.MessageType{
+				p2pcommon.MessageTypeRequestSignature,
+				p2pcommon.MessageTypeSendAnchoredDoc,
+				p2pcommon.MessageTypeGetDoc,
+			},
+			expectedUnsupportedTypes: []p2pcommon.MessageType{
+				p2pcommon.MessageTypeGetLatestVersion,
+			},
+		},
+		{
+			name:            "older local",
+			localVersions:   []p2pcommon.ProtocolVersion{p2pcommon.ProtocolVersion001},
+			remoteVersions:  []p2pcommon.ProtocolVersion{p2pcommon.ProtocolVersion002, p2pcommon.ProtocolVersion001},
+			expectedVersion: p2pcommon.ProtocolVersion001,
+			expectedSupportedTypes: []p2pcommon.MessageType{
+				p2pcommon.MessageTypeRequestSignature,
+				p2pcommon.MessageTypeSendAnchoredDoc,
+				p2pcommon.MessageTypeGetDoc,
+			},
+			expectedUnsupportedTypes: []p2pcommon.MessageType{
+				p2pcommon.MessageTypeGetLatestVersion,
+			},
+		},
+		{
+			name:           "newer remote",
+			localVersions:  []p2pcommon.ProtocolVersion{p2pcommon.ProtocolVersion002, p2pcommon.ProtocolVersion001},
+			remoteVersions: []p2pcommon.ProtocolVersion{"0.1.0", p2pcommon.ProtocolVersion002},
+			// The highest common version is used.
+			expectedVersion: p2pcommon.ProtocolVersion002,
+			expectedSupportedTypes: []p2pcommon.MessageType{
+				p2pcommon.MessageTypeGetLatestVersion,
+			},
+		},
+		{
+			name:           "no common version",
+			localVersions:  []p2pcommon.ProtocolVersion{p2pcommon.ProtocolVersion002, p2pcommon.ProtocolVersion001},
+			remoteVersions: []p2pcommon.ProtocolVersion{"0.1.0"},
+			expectedError:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mes, hostMock, peerID, identity := getCompatibilityTestMessenger(t)
+
+			localProtocols := protocolsForVersions(identity, test.localVersions)
+			remoteProtocols := protocolsForVersions(identity, test.remoteVersions)
+
+			mockProtocolSelection(t, hostMock, peerID, localProtocols, remoteProtocols)
+
+			res, err := mes.NegotiateProtocol(peerID, localProtocols...)
+
+			if test.expectedError {
+				assert.ErrorIs(t, err, errProtocolNotSupported)
+				assert.Empty(t, res)
+				assert.Empty(t, mes.strmap[peerID])
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, p2pcommon.ProtocolForIdentityVersion(identity, test.expectedVersion), res)
+
+			version, err := p2pcommon.ExtractProtocolVersion(res)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedVersion, version)
+
+			for _, messageType := range test.expectedSupportedTypes {
+				assert.True(t, version.SupportsMessageType(messageType), "expected %s to be supported", messageType)
+			}
+
+			for _, messageType := range test.expectedUnsupportedTypes {
+				assert.False(t, version.SupportsMessageType(messageType), "expected %s to be unsupported", messageType)
+			}
+
+			// The negotiated sender is stored and pinned to the negotiated protocol.
+			ms, ok := mes.strmap[peerID][res]
+			assert.True(t, ok)
+			assert.Equal(t, res, ms.Protocol())
+
+			// Subsequent negotiations use the stored sender.
+			res2, err := mes.NegotiateProtocol(peerID, localProtocols...)
+			assert.NoError(t, err)
+			assert.Equal(t, res, res2)
+		})
+	}
+}
+
+func TestP2PMessenger_NegotiateProtocol_NoProtocols(t *testing.T) {
+	mes, _ := getMessengerWithMocks(t)
+
+	res, err := mes.NegotiateProtocol(libp2ppeer.ID("peer-id"))
+	assert.ErrorIs(t, err, ErrNoProtocols)
+	assert.Empty(t, res)
+}
+
+func getCompatibilityTestMessenger(t *testing.T) (*P2PMessenger, *p2pMocks.HostMock, libp2ppeer.ID, *types.AccountID) {
+	hostMock := p2pMocks.NewHostMock(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	mes := NewP2PMessenger(
+		context.Background(),
+		hostMock,
+		time.Second,
+		MessageSizeMax,
+		NewMessageSenderFactory(),
+		nil,
+	)
+
+	return mes.(*P2PMessenger), hostMock, libp2ppeer.ID("peer-id"), identity
+}
+
+func protocolsForVersions(identity *types.AccountID, versions []p2pcommon.ProtocolVersion) []protocol.ID {
+	var protocols []protocol.ID
+
+	for _, version := range versions {
+		protocols = append(protocols, p2pcommon.ProtocolForIdentityVersion(identity, version))
+	}
+
+	return protocols
+}
+
+// mockProtocolSelection mocks the multistream protocol selection performed by the host, the first of the
+// local protocols that is supported by the remote peer is selected.
+func mockProtocolSelection(
+	t *testing.T,
+	hostMock *p2pMocks.HostMock,
+	peerID libp2ppeer.ID,
+	localProtocols []protocol.ID,
+	remoteProtocols []protocol.ID,
+) {
+	var selected protocol.ID
+
+	for _, localProtocol := range localProtocols {
+		for _, remoteProtocol := range remoteProtocols {
+			if localProtocol == remoteProtocol {
+				selected = localProtocol
+				break
+			}
+		}
+
+		if selected != "" {
+			break
+		}
+	}
+
+	args := []any{mock.Anything, peerID}
+
+	for _, localProtocol := range localProtocols {
+		args = append(args, localProtocol)
+	}
+
+	if selected == "" {
+		hostMock.On("NewStream", args...).
+			Return(nil, errProtocolNotSupported).
+			Once()
+
+		return
+	}
+
+	streamMock := p2pMocks.NewStreamMock(t)
+	streamMock.On("Protocol").
+		Return(selected).
+		Maybe()
+
+	hostMock.On("NewStream", args...).
+		Return(network.Stream(streamMock), nil).
+		Once()
+}
@@ -28,6 +28,12 @@ const (
 
 	// ErrInvalidatedMessageSender must be used when the message sender object created is no longer valid (connection has dropped)
 	ErrInvalidatedMessageSender = errors.Error("message sender has been invalidated")
+
+	// ErrNoProtocols must be used when no protocols are provided for negotiation
+	ErrNoProtocols = errors.Error("no protocols provided")
+
+	// ErrMessageTooLarge must be used when the length of a message exceeds the maximum message size
+	ErrMessageTooLarge = errors.Error("message too large")
 )
 
 var log = logging.Logger("p2p-messenger")
@@ -36,6 +42,7 @@ var log = logging.Logger("p2p-messenger")
 
 type Messenger interface {
 	Init(protocols ...protocol.ID)
+	NegotiateProtocol(peerID libp2pPeer.ID, protocolIDs ...protocol.ID) (protocol.ID, error)
 	SendMessage(ctx context.Context, peerID libp2pPeer.ID, mes *pb.P2PEnvelope, protocolID protocol.ID) (*pb.P2PEnvelope, error)
 }
 
@@ -46,6 +53,9 @@ type P2PMessenger struct {
 	timeout time.Duration
 	ctx     context.Context
 
+	// maxMessageSize is the maximum size of the received requests, it is checked before reading the message.
+	maxMessageSize uint64
+
 	strmap map[libp2pPeer.ID]map[protocol.ID]MessageSender
 	smlk   sync.Mutex
 
@@ -59,6 +69,7 @@ func NewP2PMessenger(
 	ctx context.Context,
 	host host.Host,
 	p2pTimeout time.Duration,
+	maxMessageSize int,
 	messageSenderFactory MessageSenderFactory,
 	handler func(ctx context.Context, peer libp2pPeer.ID, protoc protocol.ID, msg *pb.P2PEnvelope) (*pb.P2PEnvelope, error),
 ) Messenger {
@@ -66,6 +77,7 @@ func NewP2PMessenger(
 		ctx:                  ctx,
 		host:                 host,
 		timeout:              p2pTimeout,
+		maxMessageSize:       uint64(maxMessageSize),
 		strmap:               make(map[libp2pPeer.ID]map[protocol.ID]MessageSender),
 		messageSenderFactory: messageSenderFactory,
 		handler:              handler,
@@ -94,7 +106,7 @@ func (mes *P2PMessenger) handleNewMessage(s inet.Stream) {
 
 		var pmes pb.P2PEnvelope
 
-		if err := readMsg(r, &pmes); err != nil {
+		if err := readMsg(r, &pmes, mes.maxMessageSize); err != nil {
 			log.Errorf("Couldn't read message: %s", err)
 			_ = s.Reset()
 			return
@@ -145,6 +157,55 @@ func (mes *P2PMessenger) SendMessage(ctx context.Context, peerID libp2pPeer.ID,
 	return rpmes, nil
 }
 
+// NegotiateProtocol returns the first protocol, from the provided ones in order of preference, that is
+// supported by the peer. The message sender used for the negotiation is kept for subsequent messages.
+func (mes *P2PMessenger) NegotiateProtocol(peerID libp2pPeer.ID, protocolIDs ...protocol.ID) (protocol.ID, error) {
+	if len(protocolIDs) == 0 {
+		return "", ErrNoProtocols
+	}
+
+	mes.smlk.Lock()
+	for _, protocolID := range protocolIDs {
+		if _, ok := mes.strmap[peerID][protocolID]; ok {
+			mes.smlk.Unlock()
+			return protocolID, nil
+		}
+	}
+	mes.smlk.Unlock()
+
+	args := &MessageSenderArgs{
+		Ctx:                 mes.ctx,
+		Host:                mes.host,
+		Timeout:             mes.timeout,
+		PeerID:              peerID,
+		ProtocolID:          protocolIDs[0],
+		FallbackProtocolIDs: protocolIDs[1:],
+	}
+
+	ms := mes.messageSenderFactory.NewMessageSender(args)
+
+	if err := ms.Prepare(); err != nil {
+		log.Errorf("Couldn't negotiate protocol: %s", err)
+
+		return "", err
+	}
+
+	protocolID := ms.Protocol()
+
+	mes.smlk.Lock()
+	defer mes.smlk.Unlock()
+
+	if mes.strmap[peerID] == nil {
+		mes.strmap[peerID] = make(map[protocol.ID]MessageSender)
+	}
+
+	if _, ok := mes.strmap[peerID][protocolID]; !ok {
+		mes.strmap[peerID][protocolID] = ms
+	}
+
+	return protocolID, nil
+}
+
 func (mes *P2PMessenger) getMessageSender(peerID libp2pPeer.ID, protocolID protocol.ID) (MessageSender, error) {
 	mes.smlk.Lock()
 	ms, ok := mes.strmap[peerID][protocolID]
@@ -216,15 +277,17 @@ func writeMsg(w *bufio.Writer, msg proto.Message) error {
 	return nil
 }
 
-func readMsg(r *bufio.Reader, msg proto.Message) error {
+// readMsg reads a length delimited message, the message is rejected before being read if its length
+// exceeds the provided maximum size.
+func readMsg(r *bufio.Reader, msg proto.Message, maxSize uint64) error {
 	length, err := binary.ReadUvarint(r)
 
 	if err != nil {
 		return fmt.Errorf("couldn't read message length: %w", err)
 	}
 
-	if length > MessageSizeMax {
-		return fmt.Errorf("message too big - %d", length)
+	if length > maxSize {
+		return fmt.Errorf("%w - %d", ErrMessageTooLarge, length)
 	}
 
 	b := make([]byte, length)
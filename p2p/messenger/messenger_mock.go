@@ -29,6 +29,34 @@ func (_m *MessengerMock) Init(protocols ...protocol.ID) {
 	_m.Called(_ca...)
 }
 
+// NegotiateProtocol provides a mock function with given fields: peerID, protocolIDs
+func (_m *MessengerMock) NegotiateProtocol(peerID peer.ID, protocolIDs ...protocol.ID) (protocol.ID, error) {
+	_va := make([]interface{}, len(protocolIDs))
+	for _i := range protocolIDs {
+		_va[_i] = protocolIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, peerID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 protocol.ID
+	if rf, ok := ret.Get(0).(func(peer.ID, ...protocol.ID) protocol.ID); ok {
+		r0 = rf(peerID, protocolIDs...)
+	} else {
+		r0 = ret.Get(0).(protocol.ID)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(peer.ID, ...protocol.ID) error); ok {
+		r1 = rf(peerID, protocolIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendMessage provides a mock function with given fields: ctx, peerID, mes, protocolID
 func (_m *MessengerMock) SendMessage(ctx context.Context, peerID peer.ID, mes *protocolpb.P2PEnvelope, protocolID protocol.ID) (*protocolpb.P2PEnvelope, error) {
 	ret := _m.Called(ctx, peerID, mes, protocolID)
@@ -605,12 +605,32 @@ func Test_writeAndReadMsg(t *testing.T) {
 
 	var res protocolpb.P2PEnvelope
 
-	err = readMsg(reader, &res)
+	err = readMsg(reader, &res, MessageSizeMax)
 	assert.NoError(t, err)
 
 	assert.Equal(t, envelope.GetBody(), res.GetBody())
 }
 
+func Test_readMsg_TooLarge(t *testing.T) {
+	b := make([]byte, 0, 4096)
+
+	buf := bytes.NewBuffer(b)
+
+	writer := bufio.NewWriter(buf)
+
+	envelope := &protocolpb.P2PEnvelope{Body: utils.RandomSlice(32)}
+
+	err := writeMsg(writer, envelope)
+	assert.NoError(t, err)
+
+	reader := bufio.NewReader(bytes.NewReader(buf.Bytes()))
+
+	var res protocolpb.P2PEnvelope
+
+	err = readMsg(reader, &res, uint64(proto.Size(envelope)-1))
+	assert.ErrorIs(t, err, ErrMessageTooLarge)
+}
+
 func assertReaderContainsEnvelopeWithBody(t *testing.T, reader io.Reader, body []byte) {
 	buf := bufio.NewReader(reader)
 
@@ -647,7 +667,7 @@ func getMessengerWithMocks(t *testing.T) (*P2PMessenger, []any) {
 	handlerMock := receiver.NewHandlerMock(t)
 	factoryMock := NewMessageSenderFactoryMock(t)
 
-	p2pMessenger := NewP2PMessenger(ctx, hostMock, time.Second, factoryMock, handlerMock.HandleInterceptor)
+	p2pMessenger := NewP2PMessenger(ctx, hostMock, time.Second, MessageSizeMax, factoryMock, handlerMock.HandleInterceptor)
 
 	return p2pMessenger.(*P2PMessenger), []any{
 		hostMock,
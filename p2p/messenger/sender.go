@@ -19,6 +19,10 @@ type MessageSenderArgs struct {
 	Timeout    time.Duration
 	PeerID     libp2pPeer.ID
 	ProtocolID protocol.ID
+
+	// FallbackProtocolIDs are the protocols, in order of preference, that are used
+	// if ProtocolID is not supported by the peer.
+	FallbackProtocolIDs []protocol.ID
 }
 
 //go:generate mockery --name MessageSenderFactory --structname MessageSenderFactoryMock --filename factory_mock.go --inpackage
@@ -36,6 +40,8 @@ func (f *messageSenderFactory) NewMessageSender(args *MessageSenderArgs) Message
 		timeout:    args.Timeout,
 		peerID:     args.PeerID,
 		protocolID: args.ProtocolID,
+
+		fallbackProtocolIDs: args.FallbackProtocolIDs,
 	}
 }
 
@@ -47,6 +53,7 @@ func NewMessageSenderFactory() MessageSenderFactory {
 
 type MessageSender interface {
 	Prepare() error
+	Protocol() protocol.ID
 	SendMessage(ctx context.Context, pmes *pb.P2PEnvelope) (*pb.P2PEnvelope, error)
 }
 type messageSender struct {
@@ -60,6 +67,9 @@ type messageSender struct {
 	timeout    time.Duration
 	host       host.Host
 
+	// fallbackProtocolIDs are cleared once a protocol was negotiated with the peer.
+	fallbackProtocolIDs []protocol.ID
+
 	invalid           bool
 	currentStreamUses int
 }
@@ -74,6 +84,14 @@ func (ms *messageSender) Prepare() error {
 	return nil
 }
 
+// Protocol returns the protocol used by the message sender.
+func (ms *messageSender) Protocol() protocol.ID {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	return ms.protocolID
+}
+
 // maxStreamReuseTries is the number of times we will try to reuse a stream to a
 // given peer before giving up and reverting to the old one-message-per-stream
 // behaviour.
@@ -151,11 +169,19 @@ func (ms *messageSender) prep() error {
 	timeoutCtx, canc := context.WithTimeout(ms.ctx, ms.timeout)
 	defer canc()
 
-	nstr, err := ms.host.NewStream(timeoutCtx, ms.peerID, ms.protocolID)
+	protocolIDs := append([]protocol.ID{ms.protocolID}, ms.fallbackProtocolIDs...)
+
+	nstr, err := ms.host.NewStream(timeoutCtx, ms.peerID, protocolIDs...)
 	if err != nil {
 		return err
 	}
 
+	if len(ms.fallbackProtocolIDs) > 0 {
+		// Stick to the protocol negotiated with the peer for any subsequent streams.
+		ms.protocolID = nstr.Protocol()
+		ms.fallbackProtocolIDs = nil
+	}
+
 	ms.reader = bufio.NewReader(nstr)
 	ms.writer = bufio.NewWriter(nstr)
 	ms.stream = nstr
@@ -165,7 +191,7 @@ func (ms *messageSender) prep() error {
 func (ms *messageSender) ctxReadMsg(ctx context.Context, mes *pb.P2PEnvelope) error {
 	errc := make(chan error, 1)
 	go func(r *bufio.Reader) {
-		errc <- readMsg(r, mes)
+		errc <- readMsg(r, mes, MessageSizeMax)
 	}(ms.reader)
 
 	t := time.NewTimer(ms.timeout)
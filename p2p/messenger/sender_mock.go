@@ -5,6 +5,8 @@ package messenger
 import (
 	context "context"
 
+	protocol "github.com/libp2p/go-libp2p-core/protocol"
+
 	protocolpb "github.com/centrifuge/centrifuge-protobufs/gen/go/protocol"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -28,6 +30,20 @@ func (_m *MessageSenderMock) Prepare() error {
 	return r0
 }
 
+// Protocol provides a mock function with given fields:
+func (_m *MessageSenderMock) Protocol() protocol.ID {
+	ret := _m.Called()
+
+	var r0 protocol.ID
+	if rf, ok := ret.Get(0).(func() protocol.ID); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(protocol.ID)
+	}
+
+	return r0
+}
+
 // SendMessage provides a mock function with given fields: ctx, pmes
 func (_m *MessageSenderMock) SendMessage(ctx context.Context, pmes *protocolpb.P2PEnvelope) (*protocolpb.P2PEnvelope, error) {
 	ret := _m.Called(ctx, pmes)
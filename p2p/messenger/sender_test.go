@@ -42,6 +42,38 @@ func TestMessageSender_Prepare(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMessageSender_Prepare_FallbackProtocols(t *testing.T) {
+	ms, mocks := getMessageSenderWithMocks(t)
+	ms.stream = nil
+
+	fallbackProtocolID := protocol.ID("fallback-protocol-id")
+	ms.fallbackProtocolIDs = []protocol.ID{fallbackProtocolID}
+
+	expectedCtx, cancel := context.WithTimeout(ms.ctx, ms.timeout)
+	defer cancel()
+
+	streamMock := p2pMocks.NewStreamMock(t)
+	streamMock.On("Protocol").
+		Return(fallbackProtocolID).
+		Once()
+
+	genericUtils.GetMock[*p2pMocks.HostMock](mocks).
+		On(
+			"NewStream",
+			mock.IsType(expectedCtx),
+			ms.peerID,
+			ms.protocolID,
+			fallbackProtocolID,
+		).Return(streamMock, nil).Once()
+
+	err := ms.Prepare()
+	assert.NoError(t, err)
+
+	// The negotiated protocol is used for subsequent streams.
+	assert.Equal(t, fallbackProtocolID, ms.Protocol())
+	assert.Nil(t, ms.fallbackProtocolIDs)
+}
+
 func TestMessageSender_Prepare_InvalidMessageSender(t *testing.T) {
 	ms, _ := getMessageSenderWithMocks(t)
 	ms.stream = nil
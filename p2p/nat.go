@@ -0,0 +1,147 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/errors"
+	"github.com/libp2p/go-libp2p"
+	libp2pPeer "github.com/libp2p/go-libp2p-core/peer"
+	pstore "github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/host/autorelay"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// relayPeerTag is the connection manager tag used to protect the connections to the relays.
+	relayPeerTag = "centrifuge-relay-peer"
+)
+
+// natOptions returns the libp2p options used to reach and be reached by nodes behind NAT.
+func natOptions(cfg config.Configuration, relayPeers []libp2pPeer.AddrInfo) []libp2p.Option {
+	var opts []libp2p.Option
+
+	if cfg.IsP2PRelayEnabled() {
+		var relayOpts []autorelay.StaticRelayOption
+
+		// The relays are discovered in the DHT if none are configured.
+		if len(relayPeers) > 0 {
+			relayOpts = append(relayOpts, autorelay.WithStaticRelays(relayPeers))
+		}
+
+		opts = append(opts, libp2p.EnableRelay(), libp2p.EnableAutoRelay(relayOpts...))
+	}
+
+	if cfg.IsP2PRelayServiceEnabled() {
+		opts = append(opts, libp2p.EnableRelay(), libp2p.EnableRelayService())
+	}
+
+	if cfg.IsP2PHolePunchingEnabled() {
+		opts = append(opts, libp2p.EnableHolePunching())
+	}
+
+	if cfg.IsP2PAutoNATServiceEnabled() {
+		opts = append(opts, libp2p.EnableNATService())
+	}
+
+	return opts
+}
+
+// parseRelayPeers parses the multiaddresses of the configured relays, the addresses must include the peer ID.
+func parseRelayPeers(relayPeers []string) ([]libp2pPeer.AddrInfo, error) {
+	var res []libp2pPeer.AddrInfo
+
+	for _, addr := range relayPeers {
+		maddr, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			return nil, errors.New("invalid address of relay %s: %s", addr, err)
+		}
+
+		info, err := libp2pPeer.AddrInfoFromP2pAddr(maddr)
+		if err != nil {
+			return nil, errors.New("invalid address of relay %s: %s", addr, err)
+		}
+
+		res = append(res, *info)
+	}
+
+	return res, nil
+}
+
+// getRelayedAddrs returns the addresses of the peer through the provided relays.
+func getRelayedAddrs(relayPeers []libp2pPeer.AddrInfo, peerID libp2pPeer.ID) ([]ma.Multiaddr, error) {
+	var addrs []ma.Multiaddr
+
+	for _, relayPeer := range relayPeers {
+		if relayPeer.ID == peerID {
+			continue
+		}
+
+		circuit, err := ma.NewMultiaddr(fmt.Sprintf("/p2p/%s/p2p-circuit", relayPeer.ID.Pretty()))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addr := range relayPeer.Addrs {
+			addrs = append(addrs, addr.Encapsulate(circuit))
+		}
+	}
+
+	return addrs, nil
+}
+
+// getAdvertisedRelayedAddrs returns the relayed addresses that the peer advertises in the DHT.
+func (s *p2pPeer) getAdvertisedRelayedAddrs(peerID libp2pPeer.ID) []ma.Multiaddr {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.GetP2PConnectionTimeout())
+	defer cancel()
+
+	pinfo, err := s.dht.FindPeer(ctx, peerID)
+	if err != nil {
+		log.Warnf("Couldn't find advertised addresses of peer %s: %s", peerID, err)
+
+		return nil
+	}
+
+	var addrs []ma.Multiaddr
+
+	for _, addr := range pinfo.Addrs {
+		if _, err := addr.ValueForProtocol(ma.P_CIRCUIT); err == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
+// addRelayedAddrs adds the relayed addresses of the peer to the peer store, it returns false if relaying is
+// disabled or if there are no relayed addresses for the peer.
+//
+// The relays advertised by the peer are used first, the configured relays are only used when the peer
+// doesn't advertise any.
+func (s *p2pPeer) addRelayedAddrs(peerID libp2pPeer.ID) bool {
+	if !s.config.IsP2PRelayEnabled() {
+		return false
+	}
+
+	addrs := s.getAdvertisedRelayedAddrs(peerID)
+
+	if len(addrs) == 0 {
+		var err error
+
+		addrs, err = getRelayedAddrs(s.relayPeers, peerID)
+		if err != nil {
+			log.Errorf("Couldn't get relayed addresses: %s", err)
+
+			return false
+		}
+	}
+
+	if len(addrs) == 0 {
+		return false
+	}
+
+	s.host.Peerstore().AddAddrs(peerID, addrs, pstore.AddressTTL)
+
+	return true
+}
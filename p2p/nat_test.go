@@ -0,0 +1,143 @@
+//go:build unit
+
+package p2p
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/centrifuge/pod/config"
+	"github.com/libp2p/go-libp2p"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNatOptions(t *testing.T) {
+	relayPeers := []libp2ppeer.AddrInfo{
+		{
+			ID: getTestPeerID(t),
+		},
+	}
+
+	tests := []struct {
+		name                       string
+		relayEnabled               bool
+		relayServiceEnabled        bool
+		holePunchingEnabled        bool
+		autoNATServiceEnabled      bool
+		relayPeers                 []libp2ppeer.AddrInfo
+		expectedStaticRelays       bool
+		expectedRelayCustomEnabled bool
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:                       "relay with discovered relays",
+			relayEnabled:               true,
+			expectedRelayCustomEnabled: true,
+		},
+		{
+			name:                       "relay with static relays",
+			relayEnabled:               true,
+			relayPeers:                 relayPeers,
+			expectedStaticRelays:       true,
+			expectedRelayCustomEnabled: true,
+		},
+		{
+			name:                       "relay service",
+			relayServiceEnabled:        true,
+			expectedRelayCustomEnabled: true,
+		},
+		{
+			name:                "hole punching",
+			holePunchingEnabled: true,
+		},
+		{
+			name:                  "AutoNAT service",
+			autoNATServiceEnabled: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configMock := config.NewConfigurationMock(t)
+
+			configMock.On("IsP2PRelayEnabled").Return(test.relayEnabled).Once()
+			configMock.On("IsP2PRelayServiceEnabled").Return(test.relayServiceEnabled).Once()
+			configMock.On("IsP2PHolePunchingEnabled").Return(test.holePunchingEnabled).Once()
+			configMock.On("IsP2PAutoNATServiceEnabled").Return(test.autoNATServiceEnabled).Once()
+
+			var cfg libp2p.Config
+
+			err := cfg.Apply(natOptions(configMock, test.relayPeers)...)
+			assert.NoError(t, err)
+
+			assert.Equal(t, test.relayEnabled, cfg.EnableAutoRelay)
+			assert.Equal(t, test.expectedStaticRelays, cfg.StaticRelayOpt != nil)
+			assert.Equal(t, test.expectedRelayCustomEnabled, cfg.RelayCustom && cfg.Relay)
+			assert.Equal(t, test.relayServiceEnabled, cfg.EnableRelayService)
+			assert.Equal(t, test.holePunchingEnabled, cfg.EnableHolePunching)
+			assert.Equal(t, test.autoNATServiceEnabled, cfg.AutoNATConfig.EnableService)
+		})
+	}
+}
+
+func TestParseRelayPeers(t *testing.T) {
+	peerID := getTestPeerID(t)
+
+	res, err := parseRelayPeers([]string{
+		fmt.Sprintf("/ip4/127.0.0.1/tcp/38202/p2p/%s", peerID.Pretty()),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, peerID, res[0].ID)
+	assert.Len(t, res[0].Addrs, 1)
+	assert.Equal(t, "/ip4/127.0.0.1/tcp/38202", res[0].Addrs[0].String())
+
+	res, err = parseRelayPeers(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	res, err = parseRelayPeers([]string{"invalid-address"})
+	assert.Error(t, err)
+	assert.Nil(t, res)
+
+	res, err = parseRelayPeers([]string{"/ip4/127.0.0.1/tcp/38202"})
+	assert.Error(t, err)
+	assert.Nil(t, res)
+}
+
+func TestGetRelayedAddrs(t *testing.T) {
+	peerID := getTestPeerID(t)
+	relayPeerID := getTestPeerID(t)
+
+	relayAddr1, err := ma.NewMultiaddr("/ip4/1.2.3.4/tcp/38202")
+	assert.NoError(t, err)
+
+	relayAddr2, err := ma.NewMultiaddr("/ip4/1.2.3.5/tcp/38202")
+	assert.NoError(t, err)
+
+	relayPeers := []libp2ppeer.AddrInfo{
+		{
+			ID:    relayPeerID,
+			Addrs: []ma.Multiaddr{relayAddr1, relayAddr2},
+		},
+		// The peer is not relayed through itself.
+		{
+			ID:    peerID,
+			Addrs: []ma.Multiaddr{relayAddr1},
+		},
+	}
+
+	res, err := getRelayedAddrs(relayPeers, peerID)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, fmt.Sprintf("/ip4/1.2.3.4/tcp/38202/p2p/%s/p2p-circuit", relayPeerID.Pretty()), res[0].String())
+	assert.Equal(t, fmt.Sprintf("/ip4/1.2.3.5/tcp/38202/p2p/%s/p2p-circuit", relayPeerID.Pretty()), res[1].String())
+
+	res, err = getRelayedAddrs(nil, peerID)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
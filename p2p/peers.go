@@ -0,0 +1,163 @@
+package p2p
+
+import (
+	"sort"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+	inet "github.com/libp2p/go-libp2p-core/network"
+	libp2pPeer "github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// staticPeerTag is the connection manager tag used to protect the connections to the static peers.
+	staticPeerTag = "centrifuge-static-peer"
+)
+
+// PeerInfo holds the details of a peer known by the node.
+type PeerInfo struct {
+	PeerID    libp2pPeer.ID
+	AccountID *types.AccountID
+	Addrs     []string
+	Connected bool
+	Static    bool
+	Latency   time.Duration
+	LastSeen  time.Time
+}
+
+//go:generate mockery --name PeerLister --structname PeerListerMock --filename peer_lister_mock.go --inpackage
+
+// PeerLister lists the peers known by the node.
+type PeerLister interface {
+	// GetPeers returns the connected peers and the peers of the collaborators stored in the address book.
+	GetPeers() ([]*PeerInfo, error)
+}
+
+// GetPeers returns the connected peers and the peers of the collaborators stored in the address book.
+func (s *p2pPeer) GetPeers() ([]*PeerInfo, error) {
+	if s.host == nil {
+		return nil, ErrPeerHostNotStarted
+	}
+
+	peers := make(map[libp2pPeer.ID]*PeerInfo)
+
+	for _, peerID := range s.host.Network().Peers() {
+		info := &PeerInfo{
+			PeerID:    peerID,
+			Connected: true,
+			Latency:   s.host.Peerstore().LatencyEWMA(peerID),
+			LastSeen:  time.Now(),
+		}
+
+		for _, conn := range s.host.Network().ConnsToPeer(peerID) {
+			info.Addrs = append(info.Addrs, conn.RemoteMultiaddr().String())
+		}
+
+		peers[peerID] = info
+	}
+
+	entries, err := s.addressBook.getAll()
+	if err != nil {
+		log.Errorf("Couldn't retrieve address book entries: %s", err)
+
+		return nil, ErrAddressBookRetrieval
+	}
+
+	for _, entry := range entries {
+		info, ok := peers[entry.PeerID]
+		if !ok {
+			info = &PeerInfo{
+				PeerID:   entry.PeerID,
+				Addrs:    entry.Addrs,
+				LastSeen: entry.LastSeen,
+			}
+
+			peers[entry.PeerID] = info
+		}
+
+		info.AccountID = entry.AccountID
+	}
+
+	for accountID, staticPeer := range s.staticPeers {
+		info, ok := peers[staticPeer.ID]
+		if !ok {
+			info = &PeerInfo{
+				PeerID: staticPeer.ID,
+			}
+
+			for _, addr := range staticPeer.Addrs {
+				info.Addrs = append(info.Addrs, addr.String())
+			}
+
+			peers[staticPeer.ID] = info
+		}
+
+		if info.AccountID == nil {
+			// The keys of the static peers are validated when they are parsed.
+			info.AccountID, _ = types.NewAccountIDFromHexString(accountID)
+		}
+
+		info.Static = true
+	}
+
+	res := make([]*PeerInfo, 0, len(peers))
+
+	for _, info := range peers {
+		res = append(res, info)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].PeerID < res[j].PeerID
+	})
+
+	return res, nil
+}
+
+// peerNotifiee updates the last seen time of the peers in the address book when they connect or disconnect.
+func (s *p2pPeer) peerNotifiee() inet.Notifiee {
+	markSeen := func(_ inet.Network, conn inet.Conn) {
+		peerID := conn.RemotePeer()
+		seen := time.Now()
+
+		// Notifications must not block the network.
+		go func() {
+			if err := s.addressBook.markSeen(peerID, seen); err != nil {
+				log.Errorf("Couldn't update last seen time of peer %s: %s", peerID, err)
+			}
+		}()
+	}
+
+	return &inet.NotifyBundle{
+		ConnectedF:    markSeen,
+		DisconnectedF: markSeen,
+	}
+}
+
+// parseStaticPeers parses the statically configured peers, mapping account IDs to multiaddresses that include
+// the peer ID.
+func parseStaticPeers(staticPeers map[string]string) (map[string]*libp2pPeer.AddrInfo, error) {
+	res := make(map[string]*libp2pPeer.AddrInfo)
+
+	for accountIDHex, addr := range staticPeers {
+		accountID, err := types.NewAccountIDFromHexString(accountIDHex)
+		if err != nil {
+			return nil, errors.New("invalid account ID of static peer %s: %s", accountIDHex, err)
+		}
+
+		maddr, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			return nil, errors.New("invalid address of static peer %s: %s", accountIDHex, err)
+		}
+
+		info, err := libp2pPeer.AddrInfoFromP2pAddr(maddr)
+		if err != nil {
+			return nil, errors.New("invalid address of static peer %s: %s", accountIDHex, err)
+		}
+
+		res[accountID.ToHexString()] = info
+	}
+
+	return res, nil
+}
@@ -0,0 +1,167 @@
+//go:build unit
+
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStaticPeers(t *testing.T) {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	peerID := getTestPeerID(t)
+
+	addr := fmt.Sprintf("/ip4/127.0.0.1/tcp/38202/p2p/%s", peerID.Pretty())
+
+	res, err := parseStaticPeers(map[string]string{
+		accountID.ToHexString(): addr,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+
+	info, ok := res[accountID.ToHexString()]
+	assert.True(t, ok)
+	assert.Equal(t, peerID, info.ID)
+	assert.Len(t, info.Addrs, 1)
+	assert.Equal(t, "/ip4/127.0.0.1/tcp/38202", info.Addrs[0].String())
+
+	res, err = parseStaticPeers(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestParseStaticPeers_Errors(t *testing.T) {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	tests := map[string]map[string]string{
+		"invalid account ID": {
+			"invalid-account-id": fmt.Sprintf("/ip4/127.0.0.1/tcp/38202/p2p/%s", getTestPeerID(t).Pretty()),
+		},
+		"invalid address": {
+			accountID.ToHexString(): "invalid-address",
+		},
+		"missing peer ID": {
+			accountID.ToHexString(): "/ip4/127.0.0.1/tcp/38202",
+		},
+	}
+
+	for name, staticPeers := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := parseStaticPeers(staticPeers)
+			assert.Error(t, err)
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestPeer_GetPeers_HostNotStarted(t *testing.T) {
+	peer, _ := getPeerMocks(t)
+
+	peer.host = nil
+
+	res, err := peer.GetPeers()
+	assert.ErrorIs(t, err, ErrPeerHostNotStarted)
+	assert.Nil(t, res)
+}
+
+func TestPeer_GetPeers(t *testing.T) {
+	peer, _ := getPeerMocks(t)
+
+	localHost := getTestHost(t)
+	remoteHost := getTestHost(t)
+
+	err := localHost.Connect(context.Background(), libp2ppeer.AddrInfo{
+		ID:    remoteHost.ID(),
+		Addrs: remoteHost.Addrs(),
+	})
+	assert.NoError(t, err)
+
+	peer.host = localHost
+
+	connectedAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	err = peer.addressBook.put(connectedAccountID, remoteHost.ID(), remoteHost.Addrs())
+	assert.NoError(t, err)
+
+	disconnectedAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	disconnectedPeerID := getTestPeerID(t)
+	disconnectedAddr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/38202")
+	assert.NoError(t, err)
+
+	err = peer.addressBook.put(disconnectedAccountID, disconnectedPeerID, []ma.Multiaddr{disconnectedAddr})
+	assert.NoError(t, err)
+
+	staticAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	staticPeerID := getTestPeerID(t)
+	staticAddr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/38203")
+	assert.NoError(t, err)
+
+	peer.staticPeers = map[string]*libp2ppeer.AddrInfo{
+		staticAccountID.ToHexString(): {
+			ID:    staticPeerID,
+			Addrs: []ma.Multiaddr{staticAddr},
+		},
+	}
+
+	res, err := peer.GetPeers()
+	assert.NoError(t, err)
+	assert.Len(t, res, 3)
+
+	peers := make(map[libp2ppeer.ID]*PeerInfo)
+
+	for i, info := range res {
+		if i > 0 {
+			assert.True(t, res[i-1].PeerID < info.PeerID)
+		}
+
+		peers[info.PeerID] = info
+	}
+
+	connected := peers[remoteHost.ID()]
+	assert.NotNil(t, connected)
+	assert.Equal(t, connectedAccountID, connected.AccountID)
+	assert.True(t, connected.Connected)
+	assert.False(t, connected.Static)
+	assert.NotEmpty(t, connected.Addrs)
+
+	disconnected := peers[disconnectedPeerID]
+	assert.NotNil(t, disconnected)
+	assert.Equal(t, disconnectedAccountID, disconnected.AccountID)
+	assert.False(t, disconnected.Connected)
+	assert.False(t, disconnected.Static)
+	assert.Equal(t, []string{disconnectedAddr.String()}, disconnected.Addrs)
+
+	static := peers[staticPeerID]
+	assert.NotNil(t, static)
+	assert.Equal(t, staticAccountID, static.AccountID)
+	assert.False(t, static.Connected)
+	assert.True(t, static.Static)
+	assert.Equal(t, []string{staticAddr.String()}, static.Addrs)
+}
+
+func getTestHost(t *testing.T) host.Host {
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = h.Close()
+	})
+
+	return h
+}
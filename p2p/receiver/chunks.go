@@ -0,0 +1,197 @@
+package receiver
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/pod/config"
+	p2pcommon "github.com/centrifuge/pod/p2p/common"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// chunkedTransfer holds the chunks received for a payload that is sent in chunks.
+type chunkedTransfer struct {
+	peerID      peer.ID
+	messageType p2pcommon.MessageType
+	payloadSize uint64
+	total       uint32
+	chunks      map[uint32][]byte
+	size        uint64
+	// nextIndex is the lowest index of the chunks that were not received yet.
+	nextIndex uint32
+	updatedAt time.Time
+}
+
+// chunkUsage holds the number of transfers in progress and the payload bytes reserved by them.
+type chunkUsage struct {
+	transfers int
+	bytes     uint64
+}
+
+// chunkStore keeps the chunks of the transfers that are in progress until all the chunks of a transfer are received.
+//
+// The number of transfers in progress and the payload bytes reserved by them are limited per peer and globally,
+// new transfers are rejected when a limit is reached.
+type chunkStore struct {
+	mu               sync.Mutex
+	maxDocumentSize  uint64
+	maxPeerTransfers int
+	maxPeerBytes     uint64
+	maxTransfers     int
+	maxBytes         uint64
+	timeout          time.Duration
+	transfers        map[string]*chunkedTransfer
+	peerUsage        map[peer.ID]*chunkUsage
+	usage            chunkUsage
+}
+
+func newChunkStore(cfg config.Configuration) *chunkStore {
+	return &chunkStore{
+		maxDocumentSize:  uint64(cfg.GetP2PMaxChunkedDocumentSize()),
+		maxPeerTransfers: cfg.GetP2PMaxChunkedTransfersPerPeer(),
+		maxPeerBytes:     uint64(cfg.GetP2PMaxChunkedBytesPerPeer()),
+		maxTransfers:     cfg.GetP2PMaxChunkedTransfers(),
+		maxBytes:         uint64(cfg.GetP2PMaxChunkedBytes()),
+		timeout:          cfg.GetP2PChunkedTransferTimeout(),
+		transfers:        make(map[string]*chunkedTransfer),
+		peerUsage:        make(map[peer.ID]*chunkUsage),
+	}
+}
+
+// add stores the chunk of the transfer with the provided key. It returns the assembled payload once all the
+// chunks of the transfer are received, otherwise the index of the next chunk expected.
+func (s *chunkStore) add(peerID peer.ID, key string, chunk *p2pcommon.Chunk, now time.Time) ([]byte, uint32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	if chunk.PayloadSize > s.maxDocumentSize {
+		return nil, 0, ErrChunkedDocumentTooLarge
+	}
+
+	transfer, ok := s.transfers[key]
+	if !ok {
+		if err := s.reserve(peerID, chunk.PayloadSize); err != nil {
+			return nil, 0, err
+		}
+
+		transfer = &chunkedTransfer{
+			peerID:      peerID,
+			messageType: chunk.MessageType,
+			payloadSize: chunk.PayloadSize,
+			total:       chunk.Total,
+			chunks:      make(map[uint32][]byte),
+		}
+
+		s.transfers[key] = transfer
+	}
+
+	if transfer.messageType != chunk.MessageType ||
+		transfer.payloadSize != chunk.PayloadSize ||
+		transfer.total != chunk.Total {
+		return nil, 0, p2pcommon.ErrInvalidChunk
+	}
+
+	transfer.updatedAt = now
+
+	// Chunks that were already received are acknowledged again, which allows a sender to resume a transfer.
+	if _, ok := transfer.chunks[chunk.Index]; !ok {
+		if transfer.size+uint64(len(chunk.Data)) > transfer.payloadSize {
+			s.remove(key)
+
+			return nil, 0, p2pcommon.ErrInvalidChunk
+		}
+
+		transfer.chunks[chunk.Index] = chunk.Data
+		transfer.size += uint64(len(chunk.Data))
+	}
+
+	for {
+		if _, ok := transfer.chunks[transfer.nextIndex]; !ok {
+			break
+		}
+
+		transfer.nextIndex++
+	}
+
+	if transfer.nextIndex < transfer.total {
+		return nil, transfer.nextIndex, nil
+	}
+
+	s.remove(key)
+
+	payload := make([]byte, 0, transfer.payloadSize)
+
+	for i := uint32(0); i < transfer.total; i++ {
+		payload = append(payload, transfer.chunks[i]...)
+	}
+
+	if uint64(len(payload)) != transfer.payloadSize || sha256.Sum256(payload) != chunk.PayloadHash {
+		return nil, 0, p2pcommon.ErrChunkIntegrity
+	}
+
+	return payload, transfer.total, nil
+}
+
+// sweep removes the transfers that were not updated within the timeout.
+func (s *chunkStore) sweep(now time.Time) {
+	for key, transfer := range s.transfers {
+		if now.Sub(transfer.updatedAt) >= s.timeout {
+			s.remove(key)
+		}
+	}
+}
+
+// reserve accounts for a new transfer of the peer, if it doesn't exceed the limits.
+func (s *chunkStore) reserve(peerID peer.ID, payloadSize uint64) error {
+	peerUsage, ok := s.peerUsage[peerID]
+	if !ok {
+		peerUsage = &chunkUsage{}
+	}
+
+	if peerUsage.transfers >= s.maxPeerTransfers || peerUsage.bytes+payloadSize > s.maxPeerBytes {
+		log.Warnf("Peer %s reached the limits of chunked transfers", peerID)
+
+		return ErrChunkedTransferLimitExceeded
+	}
+
+	if s.usage.transfers >= s.maxTransfers || s.usage.bytes+payloadSize > s.maxBytes {
+		log.Warn("Node reached the limits of chunked transfers")
+
+		return ErrChunkedTransferLimitExceeded
+	}
+
+	peerUsage.transfers++
+	peerUsage.bytes += payloadSize
+
+	s.peerUsage[peerID] = peerUsage
+
+	s.usage.transfers++
+	s.usage.bytes += payloadSize
+
+	return nil
+}
+
+// remove discards the transfer and releases its reservation.
+func (s *chunkStore) remove(key string) {
+	transfer, ok := s.transfers[key]
+	if !ok {
+		return
+	}
+
+	delete(s.transfers, key)
+
+	s.usage.transfers--
+	s.usage.bytes -= transfer.payloadSize
+
+	peerUsage := s.peerUsage[transfer.peerID]
+
+	peerUsage.transfers--
+	peerUsage.bytes -= transfer.payloadSize
+
+	if peerUsage.transfers == 0 {
+		delete(s.peerUsage, transfer.peerID)
+	}
+}
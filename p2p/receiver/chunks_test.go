@@ -0,0 +1,253 @@
+//go:build unit
+
+package receiver
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	p2pcommon "github.com/centrifuge/pod/p2p/common"
+	"github.com/centrifuge/pod/utils"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testChunkPeerID = libp2ppeer.ID("peer-id")
+)
+
+func TestChunkStore_Add(t *testing.T) {
+	store := newTestChunkStore(math.MaxInt, time.Hour)
+
+	payload := utils.RandomSlice(25)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, payload, 10)
+	assert.NoError(t, err)
+
+	now := time.Now()
+
+	res, nextIndex, err := store.add(testChunkPeerID, "key", chunks[0], now)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+	assert.Equal(t, uint32(1), nextIndex)
+
+	res, nextIndex, err = store.add(testChunkPeerID, "key", chunks[2], now)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+	assert.Equal(t, uint32(1), nextIndex)
+
+	// Duplicate chunks are acknowledged again.
+	res, nextIndex, err = store.add(testChunkPeerID, "key", chunks[0], now)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+	assert.Equal(t, uint32(1), nextIndex)
+
+	res, nextIndex, err = store.add(testChunkPeerID, "key", chunks[1], now)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, res)
+	assert.Equal(t, uint32(3), nextIndex)
+
+	assert.Empty(t, store.transfers)
+}
+
+func TestChunkStore_Add_EmptyPayload(t *testing.T) {
+	store := newTestChunkStore(math.MaxInt, time.Hour)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, nil, 10)
+	assert.NoError(t, err)
+
+	res, nextIndex, err := store.add(testChunkPeerID, "key", chunks[0], time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+	assert.Equal(t, uint32(1), nextIndex)
+}
+
+func TestChunkStore_Add_DocumentTooLarge(t *testing.T) {
+	store := newTestChunkStore(20, time.Hour)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(25), 10)
+	assert.NoError(t, err)
+
+	res, nextIndex, err := store.add(testChunkPeerID, "key", chunks[0], time.Now())
+	assert.ErrorIs(t, err, ErrChunkedDocumentTooLarge)
+	assert.Nil(t, res)
+	assert.Zero(t, nextIndex)
+	assert.Empty(t, store.transfers)
+}
+
+func TestChunkStore_Add_InconsistentChunk(t *testing.T) {
+	store := newTestChunkStore(math.MaxInt, time.Hour)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(25), 10)
+	assert.NoError(t, err)
+
+	now := time.Now()
+
+	_, _, err = store.add(testChunkPeerID, "key", chunks[0], now)
+	assert.NoError(t, err)
+
+	differentMessageType := *chunks[1]
+	differentMessageType.MessageType = p2pcommon.MessageTypeSendEncryptedAnchoredDoc
+
+	differentTotal := *chunks[1]
+	differentTotal.Total = 4
+
+	tooMuchData := *chunks[1]
+	tooMuchData.Data = utils.RandomSlice(20)
+
+	for _, chunk := range []*p2pcommon.Chunk{&differentMessageType, &differentTotal} {
+		res, nextIndex, err := store.add(testChunkPeerID, "key", chunk, now)
+		assert.ErrorIs(t, err, p2pcommon.ErrInvalidChunk)
+		assert.Nil(t, res)
+		assert.Zero(t, nextIndex)
+	}
+
+	res, nextIndex, err := store.add(testChunkPeerID, "key", &tooMuchData, now)
+	assert.ErrorIs(t, err, p2pcommon.ErrInvalidChunk)
+	assert.Nil(t, res)
+	assert.Zero(t, nextIndex)
+
+	// The transfer is discarded when it holds more data than the payload.
+	assert.Empty(t, store.transfers)
+}
+
+func TestChunkStore_Add_IntegrityError(t *testing.T) {
+	store := newTestChunkStore(math.MaxInt, time.Hour)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(25), 10)
+	assert.NoError(t, err)
+
+	otherChunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(25), 10)
+	assert.NoError(t, err)
+
+	now := time.Now()
+
+	_, _, err = store.add(testChunkPeerID, "key", chunks[0], now)
+	assert.NoError(t, err)
+
+	_, _, err = store.add(testChunkPeerID, "key", chunks[1], now)
+	assert.NoError(t, err)
+
+	// A valid chunk of a different payload, sent with the key of the transfer.
+	res, nextIndex, err := store.add(testChunkPeerID, "key", otherChunks[2], now)
+	assert.ErrorIs(t, err, p2pcommon.ErrChunkIntegrity)
+	assert.Nil(t, res)
+	assert.Zero(t, nextIndex)
+	assert.Empty(t, store.transfers)
+}
+
+func TestChunkStore_Add_ExpiredTransfer(t *testing.T) {
+	store := newTestChunkStore(math.MaxInt, time.Minute)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(25), 10)
+	assert.NoError(t, err)
+
+	now := time.Now()
+
+	_, _, err = store.add(testChunkPeerID, "key", chunks[0], now)
+	assert.NoError(t, err)
+
+	_, nextIndex, err := store.add(testChunkPeerID, "key", chunks[1], now.Add(30*time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), nextIndex)
+
+	// The transfer expired, the chunks received before are dropped.
+	res, nextIndex, err := store.add(testChunkPeerID, "key", chunks[2], now.Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+	assert.Equal(t, uint32(0), nextIndex)
+	assert.Len(t, store.transfers, 1)
+}
+
+func TestChunkStore_Add_PeerLimits(t *testing.T) {
+	store := newTestChunkStore(math.MaxInt, time.Hour)
+	store.maxPeerTransfers = 2
+	store.maxPeerBytes = 50
+
+	now := time.Now()
+
+	firstChunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(20), 10)
+	assert.NoError(t, err)
+
+	secondChunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(20), 10)
+	assert.NoError(t, err)
+
+	_, _, err = store.add(testChunkPeerID, "first", firstChunks[0], now)
+	assert.NoError(t, err)
+
+	_, _, err = store.add(testChunkPeerID, "second", secondChunks[0], now)
+	assert.NoError(t, err)
+
+	// The peer has too many transfers in progress.
+	smallChunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(5), 10)
+	assert.NoError(t, err)
+
+	res, nextIndex, err := store.add(testChunkPeerID, "third", smallChunks[0], now)
+	assert.ErrorIs(t, err, ErrChunkedTransferLimitExceeded)
+	assert.Nil(t, res)
+	assert.Zero(t, nextIndex)
+
+	// Chunks of the transfers in progress are still accepted.
+	res, _, err = store.add(testChunkPeerID, "first", firstChunks[1], now)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	// The peer would buffer too many bytes.
+	largeChunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(31), 10)
+	assert.NoError(t, err)
+
+	_, _, err = store.add(testChunkPeerID, "large", largeChunks[0], now)
+	assert.ErrorIs(t, err, ErrChunkedTransferLimitExceeded)
+
+	// Other peers have their own limits.
+	_, _, err = store.add(libp2ppeer.ID("other-peer-id"), "large", largeChunks[0], now)
+	assert.NoError(t, err)
+
+	_, _, err = store.add(testChunkPeerID, "third", smallChunks[0], now)
+	assert.NoError(t, err)
+}
+
+func TestChunkStore_Add_GlobalLimits(t *testing.T) {
+	store := newTestChunkStore(math.MaxInt, time.Minute)
+	store.maxTransfers = 1
+	store.maxBytes = 20
+
+	now := time.Now()
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(20), 10)
+	assert.NoError(t, err)
+
+	_, _, err = store.add(testChunkPeerID, "key", chunks[0], now)
+	assert.NoError(t, err)
+
+	_, _, err = store.add(libp2ppeer.ID("other-peer-id"), "other-key", chunks[0], now)
+	assert.ErrorIs(t, err, ErrChunkedTransferLimitExceeded)
+
+	// The reservation is released when the transfer expires.
+	_, _, err = store.add(libp2ppeer.ID("other-peer-id"), "other-key", chunks[0], now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, store.transfers, 1)
+	assert.Equal(t, chunkUsage{transfers: 1, bytes: 20}, store.usage)
+	assert.NotContains(t, store.peerUsage, testChunkPeerID)
+
+	store.maxTransfers = 2
+
+	// The transfer would exceed the bytes buffered by the node.
+	_, _, err = store.add(testChunkPeerID, "key", chunks[0], now.Add(time.Minute))
+	assert.ErrorIs(t, err, ErrChunkedTransferLimitExceeded)
+}
+
+// newTestChunkStore returns a chunk store without limits on the transfers in progress.
+func newTestChunkStore(maxDocumentSize int, timeout time.Duration) *chunkStore {
+	return &chunkStore{
+		maxDocumentSize:  uint64(maxDocumentSize),
+		maxPeerTransfers: math.MaxInt,
+		maxPeerBytes:     math.MaxUint64,
+		maxTransfers:     math.MaxInt,
+		maxBytes:         math.MaxUint64,
+		timeout:          timeout,
+		transfers:        make(map[string]*chunkedTransfer),
+		peerUsage:        make(map[libp2ppeer.ID]*chunkUsage),
+	}
+}
@@ -10,4 +10,26 @@ const (
 
 	// ErrInvalidAccessType must be used when the access type found in the request is invalid
 	ErrInvalidAccessType = errors.Error("invalid access type")
+
+	// ErrPeerBanned must be used when the request is sent by a banned peer
+	ErrPeerBanned = errors.Error("peer is banned")
+
+	// ErrPeerRateLimitExceeded must be used when the peer sent too many requests
+	ErrPeerRateLimitExceeded = errors.Error("peer rate limit exceeded")
+
+	// ErrIdentityRateLimitExceeded must be used when the identity sent too many requests
+	ErrIdentityRateLimitExceeded = errors.Error("identity rate limit exceeded")
+
+	// ErrTooManyConcurrentRequests must be used when the node is handling too many requests
+	ErrTooManyConcurrentRequests = errors.Error("too many concurrent requests")
+
+	// ErrDocumentDecryption must be used when the encrypted document cannot be decrypted by the receiving account
+	ErrDocumentDecryption = errors.Error("couldn't decrypt document")
+
+	// ErrChunkedDocumentTooLarge must be used when the size of a document sent in chunks exceeds the maximum size
+	ErrChunkedDocumentTooLarge = errors.Error("chunked document too large")
+
+	// ErrChunkedTransferLimitExceeded must be used when a new chunked transfer exceeds the limits of the transfers
+	// in progress for the peer or the node
+	ErrChunkedTransferLimitExceeded = errors.Error("chunked transfer limit exceeded")
 )
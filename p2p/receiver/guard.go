@@ -0,0 +1,260 @@
+package receiver
+
+import (
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/config"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	rateLimitInterval = time.Minute
+)
+
+// requestGuard protects the node against peers that flood it with requests or that repeatedly send
+// documents with invalid signatures.
+type requestGuard struct {
+	peerLimiter     *rateLimiter
+	identityLimiter *rateLimiter
+	banList         *banList
+	sem             chan struct{}
+}
+
+func newRequestGuard(cfg config.Configuration) *requestGuard {
+	return &requestGuard{
+		peerLimiter:     newRateLimiter(cfg.GetP2PPeerRateLimit(), rateLimitInterval),
+		identityLimiter: newRateLimiter(cfg.GetP2PIdentityRateLimit(), rateLimitInterval),
+		banList:         newBanList(cfg.GetP2PBanThreshold(), cfg.GetP2PBanDuration()),
+		sem:             make(chan struct{}, cfg.GetP2PMaxConcurrentRequests()),
+	}
+}
+
+// admitPeer checks that a request can be accepted from the peer.
+//
+// The size of the request is checked by the messenger before the message is read.
+func (g *requestGuard) admitPeer(peerID peer.ID) error {
+	now := time.Now()
+
+	if g.banList.isBanned(peerID, now) {
+		return g.reject(rejectReasonBanned, ErrPeerBanned)
+	}
+
+	if !g.peerLimiter.allow(peerID.String(), now) {
+		return g.reject(rejectReasonPeerRateLimit, ErrPeerRateLimitExceeded)
+	}
+
+	return nil
+}
+
+// admitIdentity checks that a request can be accepted from the identity.
+func (g *requestGuard) admitIdentity(identity *types.AccountID) error {
+	if !g.identityLimiter.allow(identity.ToHexString(), time.Now()) {
+		return g.reject(rejectReasonIdentityRateLimit, ErrIdentityRateLimitExceeded)
+	}
+
+	return nil
+}
+
+// acquire reserves a slot for handling a request, the returned function releases the slot.
+func (g *requestGuard) acquire() (func(), error) {
+	select {
+	case g.sem <- struct{}{}:
+		return func() { <-g.sem }, nil
+	default:
+		return nil, g.reject(rejectReasonConcurrency, ErrTooManyConcurrentRequests)
+	}
+}
+
+// strike records a document with invalid signatures sent by the peer.
+func (g *requestGuard) strike(peerID peer.ID) {
+	if g.banList.strike(peerID, time.Now()) {
+		log.Warnf("Peer %s is banned after sending documents with invalid signatures", peerID)
+
+		bannedPeers.Inc()
+	}
+}
+
+func (g *requestGuard) reject(reason string, err error) error {
+	rejectedMessages.WithLabelValues(reason).Inc()
+
+	return err
+}
+
+// rateLimiter is a token bucket rate limiter that allows a number of events per interval for each key.
+type rateLimiter struct {
+	mu        sync.Mutex
+	limit     float64
+	interval  time.Duration
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newRateLimiter(limit int, interval time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:    float64(limit),
+		interval: interval,
+		buckets:  make(map[string]*tokenBucket),
+	}
+}
+
+// allow consumes a token of the key, it returns false if there are no tokens left.
+func (l *rateLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{
+			tokens:     l.limit,
+			lastRefill: now,
+		}
+
+		l.buckets[key] = bucket
+	}
+
+	elapsed := now.Sub(bucket.lastRefill)
+
+	bucket.tokens += l.limit * float64(elapsed) / float64(l.interval)
+
+	if bucket.tokens > l.limit {
+		bucket.tokens = l.limit
+	}
+
+	bucket.lastRefill = now
+
+	if bucket.tokens < 1 {
+		return false
+	}
+
+	bucket.tokens--
+
+	return true
+}
+
+// sweep removes the buckets that are full again, so that the limiter doesn't grow with every key it sees.
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.interval {
+		return
+	}
+
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.lastRefill) >= l.interval {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastSweep = now
+}
+
+// banList keeps track of the peers that sent documents with invalid signatures, peers that reach the
+// threshold within the ban duration are banned for the ban duration.
+type banList struct {
+	mu        sync.Mutex
+	threshold int
+	duration  time.Duration
+	strikes   map[peer.ID][]time.Time
+	bans      map[peer.ID]time.Time
+	lastSweep time.Time
+}
+
+func newBanList(threshold int, duration time.Duration) *banList {
+	return &banList{
+		threshold: threshold,
+		duration:  duration,
+		strikes:   make(map[peer.ID][]time.Time),
+		bans:      make(map[peer.ID]time.Time),
+	}
+}
+
+// isBanned returns true if the peer is banned.
+func (b *banList) isBanned(peerID peer.ID, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.sweep(now)
+
+	bannedUntil, ok := b.bans[peerID]
+	if !ok {
+		return false
+	}
+
+	if now.Before(bannedUntil) {
+		return true
+	}
+
+	delete(b.bans, peerID)
+
+	return false
+}
+
+// strike records a strike for the peer, it returns true if the peer got banned.
+func (b *banList) strike(peerID peer.ID, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.sweep(now)
+
+	strikes := append(b.activeStrikes(peerID, now), now)
+
+	if len(strikes) < b.threshold {
+		b.strikes[peerID] = strikes
+
+		return false
+	}
+
+	delete(b.strikes, peerID)
+
+	b.bans[peerID] = now.Add(b.duration)
+
+	return true
+}
+
+// activeStrikes returns the strikes of the peer that are within the ban duration.
+func (b *banList) activeStrikes(peerID peer.ID, now time.Time) []time.Time {
+	var strikes []time.Time
+
+	for _, strike := range b.strikes[peerID] {
+		if now.Sub(strike) < b.duration {
+			strikes = append(strikes, strike)
+		}
+	}
+
+	return strikes
+}
+
+// sweep removes the expired strikes and bans, so that the ban list doesn't grow with every peer
+// that got a strike.
+func (b *banList) sweep(now time.Time) {
+	if now.Sub(b.lastSweep) < b.duration {
+		return
+	}
+
+	for peerID := range b.strikes {
+		strikes := b.activeStrikes(peerID, now)
+
+		if len(strikes) == 0 {
+			delete(b.strikes, peerID)
+
+			continue
+		}
+
+		b.strikes[peerID] = strikes
+	}
+
+	for peerID, bannedUntil := range b.bans {
+		if !now.Before(bannedUntil) {
+			delete(b.bans, peerID)
+		}
+	}
+
+	b.lastSweep = now
+}
@@ -0,0 +1,183 @@
+//go:build unit
+
+package receiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/pod/config"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestGuard(t *testing.T) {
+	configMock := config.NewConfigurationMock(t)
+
+	configMock.On("GetP2PPeerRateLimit").Return(10).Once()
+	configMock.On("GetP2PIdentityRateLimit").Return(5).Once()
+	configMock.On("GetP2PBanThreshold").Return(3).Once()
+	configMock.On("GetP2PBanDuration").Return(time.Hour).Once()
+	configMock.On("GetP2PMaxConcurrentRequests").Return(2).Once()
+
+	guard := newRequestGuard(configMock)
+	assert.Equal(t, float64(10), guard.peerLimiter.limit)
+	assert.Equal(t, float64(5), guard.identityLimiter.limit)
+	assert.Equal(t, 3, guard.banList.threshold)
+	assert.Equal(t, time.Hour, guard.banList.duration)
+	assert.Equal(t, 2, cap(guard.sem))
+}
+
+func TestRequestGuard_AdmitPeer(t *testing.T) {
+	guard := &requestGuard{
+		peerLimiter: newRateLimiter(2, time.Minute),
+		banList:     newBanList(1, time.Hour),
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+
+	rejectedRateLimit := testutil.ToFloat64(rejectedMessages.WithLabelValues(rejectReasonPeerRateLimit))
+
+	assert.NoError(t, guard.admitPeer(peerID))
+	assert.NoError(t, guard.admitPeer(peerID))
+	assert.ErrorIs(t, guard.admitPeer(peerID), ErrPeerRateLimitExceeded)
+	assert.Equal(t, rejectedRateLimit+1, testutil.ToFloat64(rejectedMessages.WithLabelValues(rejectReasonPeerRateLimit)))
+
+	// Other peers are not affected.
+	assert.NoError(t, guard.admitPeer(libp2ppeer.ID("other-peer-id")))
+
+	banned := testutil.ToFloat64(bannedPeers)
+	rejectedBanned := testutil.ToFloat64(rejectedMessages.WithLabelValues(rejectReasonBanned))
+
+	guard.strike(peerID)
+	assert.Equal(t, banned+1, testutil.ToFloat64(bannedPeers))
+
+	assert.ErrorIs(t, guard.admitPeer(peerID), ErrPeerBanned)
+	assert.Equal(t, rejectedBanned+1, testutil.ToFloat64(rejectedMessages.WithLabelValues(rejectReasonBanned)))
+}
+
+func TestRequestGuard_AdmitIdentity(t *testing.T) {
+	guard := &requestGuard{
+		identityLimiter: newRateLimiter(1, time.Minute),
+	}
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	otherIdentity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	rejected := testutil.ToFloat64(rejectedMessages.WithLabelValues(rejectReasonIdentityRateLimit))
+
+	assert.NoError(t, guard.admitIdentity(identity))
+	assert.ErrorIs(t, guard.admitIdentity(identity), ErrIdentityRateLimitExceeded)
+	assert.NoError(t, guard.admitIdentity(otherIdentity))
+	assert.Equal(t, rejected+1, testutil.ToFloat64(rejectedMessages.WithLabelValues(rejectReasonIdentityRateLimit)))
+}
+
+func TestRequestGuard_Acquire(t *testing.T) {
+	guard := &requestGuard{
+		sem: make(chan struct{}, 2),
+	}
+
+	release1, err := guard.acquire()
+	assert.NoError(t, err)
+
+	release2, err := guard.acquire()
+	assert.NoError(t, err)
+
+	rejected := testutil.ToFloat64(rejectedMessages.WithLabelValues(rejectReasonConcurrency))
+
+	release3, err := guard.acquire()
+	assert.ErrorIs(t, err, ErrTooManyConcurrentRequests)
+	assert.Nil(t, release3)
+	assert.Equal(t, rejected+1, testutil.ToFloat64(rejectedMessages.WithLabelValues(rejectReasonConcurrency)))
+
+	release1()
+
+	release3, err = guard.acquire()
+	assert.NoError(t, err)
+
+	release2()
+	release3()
+
+	assert.Len(t, guard.sem, 0)
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(2, time.Minute)
+
+	now := time.Now()
+
+	assert.True(t, limiter.allow("key", now))
+	assert.True(t, limiter.allow("key", now))
+	assert.False(t, limiter.allow("key", now))
+	assert.True(t, limiter.allow("other-key", now))
+
+	// A token is added every 30 seconds.
+	assert.False(t, limiter.allow("key", now.Add(29*time.Second)))
+	assert.True(t, limiter.allow("key", now.Add(30*time.Second)))
+	assert.False(t, limiter.allow("key", now.Add(30*time.Second)))
+
+	// The tokens don't exceed the limit.
+	later := now.Add(time.Hour)
+
+	assert.True(t, limiter.allow("key", later))
+	assert.True(t, limiter.allow("key", later))
+	assert.False(t, limiter.allow("key", later))
+
+	// The buckets that are full are removed.
+	assert.Len(t, limiter.buckets, 1)
+}
+
+func TestBanList(t *testing.T) {
+	banList := newBanList(3, time.Hour)
+
+	peerID := libp2ppeer.ID("peer-id")
+
+	now := time.Now()
+
+	assert.False(t, banList.strike(peerID, now))
+	assert.False(t, banList.strike(peerID, now.Add(time.Minute)))
+	assert.False(t, banList.isBanned(peerID, now.Add(time.Minute)))
+
+	// Strikes older than the ban duration are not counted.
+	assert.False(t, banList.strike(peerID, now.Add(time.Hour)))
+	assert.False(t, banList.isBanned(peerID, now.Add(time.Hour)))
+
+	bannedAt := now.Add(time.Hour + 30*time.Second)
+
+	assert.True(t, banList.strike(peerID, bannedAt))
+	assert.True(t, banList.isBanned(peerID, bannedAt))
+	assert.False(t, banList.isBanned(libp2ppeer.ID("other-peer-id"), bannedAt))
+
+	// The ban expires after the ban duration.
+	assert.True(t, banList.isBanned(peerID, bannedAt.Add(time.Hour-time.Second)))
+	assert.False(t, banList.isBanned(peerID, bannedAt.Add(time.Hour)))
+
+	assert.Empty(t, banList.strikes)
+	assert.Empty(t, banList.bans)
+}
+
+func TestBanList_Sweep(t *testing.T) {
+	banList := newBanList(3, time.Hour)
+
+	peerID := libp2ppeer.ID("peer-id")
+	otherPeerID := libp2ppeer.ID("other-peer-id")
+
+	now := time.Now()
+
+	assert.False(t, banList.strike(peerID, now))
+	assert.False(t, banList.strike(otherPeerID, now.Add(30*time.Minute)))
+	assert.Len(t, banList.strikes, 2)
+
+	// The expired strikes are removed, and so are the peers without strikes left.
+	assert.False(t, banList.isBanned(libp2ppeer.ID("third-peer-id"), now.Add(time.Hour)))
+	assert.Len(t, banList.strikes, 1)
+	assert.Len(t, banList.strikes[otherPeerID], 1)
+
+	assert.False(t, banList.isBanned(libp2ppeer.ID("third-peer-id"), now.Add(2*time.Hour)))
+	assert.Empty(t, banList.strikes)
+}
@@ -13,10 +13,12 @@ import (
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/contextutil"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/errors"
 	v2 "github.com/centrifuge/pod/identity/v2"
 	nftv3 "github.com/centrifuge/pod/nft/v3"
 	p2pcommon "github.com/centrifuge/pod/p2p/common"
+	"github.com/centrifuge/pod/utils"
 	"github.com/centrifuge/pod/utils/timeutils"
 	"github.com/golang/protobuf/proto"
 	logging "github.com/ipfs/go-log"
@@ -34,8 +36,14 @@ type Handler interface {
 	RequestDocumentSignature(ctx context.Context, sigReq *p2ppb.SignatureRequest, collaborator *types.AccountID) (*p2ppb.SignatureResponse, error)
 	HandleSendAnchoredDocument(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error)
 	SendAnchoredDocument(ctx context.Context, docReq *p2ppb.AnchorDocumentRequest, collaborator *types.AccountID) (*p2ppb.AnchorDocumentResponse, error)
+	HandleSendEncryptedAnchoredDocument(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error)
+	HandleSendAnchoredDocumentChunk(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error)
 	HandleGetDocument(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error)
 	GetDocument(ctx context.Context, docReq *p2ppb.GetDocumentRequest, requester *types.AccountID) (*p2ppb.GetDocumentResponse, error)
+	HandleGetLatestVersion(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error)
+	GetLatestVersion(ctx context.Context, docReq *p2ppb.GetDocumentRequest, requester *types.AccountID) (*p2ppb.GetDocumentResponse, error)
+	HandleGetAttachment(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error)
+	GetAttachment(ctx context.Context, req *p2pcommon.AttachmentRequest, requester *types.AccountID) ([]byte, error)
 }
 
 // handler implements protocol message handlers
@@ -46,6 +54,9 @@ type handler struct {
 	docSrv             documents.Service
 	identityService    v2.Service
 	nftService         nftv3.Service
+	attachmentStore    attachments.Store
+	guard              *requestGuard
+	chunks             *chunkStore
 }
 
 // NewHandler returns an implementation of P2PServiceServer
@@ -56,6 +67,7 @@ func NewHandler(
 	docSrv documents.Service,
 	identityService v2.Service,
 	nftService nftv3.Service,
+	attachmentStore attachments.Store,
 ) Handler {
 	return &handler{
 		cfg:                cfg,
@@ -64,11 +76,26 @@ func NewHandler(
 		docSrv:             docSrv,
 		identityService:    identityService,
 		nftService:         nftService,
+		attachmentStore:    attachmentStore,
+		guard:              newRequestGuard(cfg),
+		chunks:             newChunkStore(cfg),
 	}
 }
 
 // HandleInterceptor acts as main entry point for all message types, routes the request to the correct handler
 func (h *handler) HandleInterceptor(ctx context.Context, peerID peer.ID, protocolID protocol.ID, msg *pb.P2PEnvelope) (*pb.P2PEnvelope, error) {
+	// Rejected requests are answered immediately, so that flooding peers don't hold resources.
+	if err := h.guard.admitPeer(peerID); err != nil {
+		return h.newRejectionEnvelope(peerID, err)
+	}
+
+	release, err := h.guard.acquire()
+	if err != nil {
+		return h.newRejectionEnvelope(peerID, err)
+	}
+
+	defer release()
+
 	defer timeutils.EnsureDelayOperation(time.Now(), h.cfg.GetP2PResponseDelay())
 
 	if msg == nil {
@@ -102,20 +129,40 @@ func (h *handler) HandleInterceptor(ctx context.Context, peerID peer.ID, protoco
 		return h.convertToErrorEnvelop(err)
 	}
 
+	// The identity is only known to be used by the peer after the handshake validation.
+	if err := h.guard.admitIdentity(collaborator); err != nil {
+		return h.newRejectionEnvelope(peerID, err)
+	}
+
+	if err := validateProtocolMessageType(protocolID, envelope.GetHeader().GetType()); err != nil {
+		log.Error(err)
+
+		// Protocol errors are not masked so that the peer knows why the message was rejected.
+		return h.newErrorEnvelope(err.Error())
+	}
+
 	switch p2pcommon.MessageTypeFromString(envelope.GetHeader().GetType()) {
 	case p2pcommon.MessageTypeRequestSignature:
 		return h.HandleRequestDocumentSignature(ctx, peerID, protocolID, envelope)
 	case p2pcommon.MessageTypeSendAnchoredDoc:
 		return h.HandleSendAnchoredDocument(ctx, peerID, protocolID, envelope)
+	case p2pcommon.MessageTypeSendEncryptedAnchoredDoc:
+		return h.HandleSendEncryptedAnchoredDocument(ctx, peerID, protocolID, envelope)
+	case p2pcommon.MessageTypeSendAnchoredDocChunk:
+		return h.HandleSendAnchoredDocumentChunk(ctx, peerID, protocolID, envelope)
 	case p2pcommon.MessageTypeGetDoc:
 		return h.HandleGetDocument(ctx, peerID, protocolID, envelope)
+	case p2pcommon.MessageTypeGetLatestVersion:
+		return h.HandleGetLatestVersion(ctx, peerID, protocolID, envelope)
+	case p2pcommon.MessageTypeGetAttachment:
+		return h.HandleGetAttachment(ctx, peerID, protocolID, envelope)
 	default:
 		return h.convertToErrorEnvelop(errors.New("MessageType [%s] not found", envelope.GetHeader().GetType()))
 	}
 }
 
 // HandleRequestDocumentSignature handles the RequestDocumentSignature message
-func (h *handler) HandleRequestDocumentSignature(ctx context.Context, _ peer.ID, _ protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+func (h *handler) HandleRequestDocumentSignature(ctx context.Context, peerID peer.ID, _ protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
 	req := new(p2ppb.SignatureRequest)
 	err := proto.Unmarshal(msg.GetBody(), req)
 	if err != nil {
@@ -128,6 +175,8 @@ func (h *handler) HandleRequestDocumentSignature(ctx context.Context, _ peer.ID,
 	}
 	res, err := h.RequestDocumentSignature(ctx, req, collaborator)
 	if err != nil {
+		h.recordInvalidDocument(peerID, err)
+
 		return h.convertToErrorEnvelop(err)
 	}
 
@@ -162,7 +211,7 @@ func (h *handler) RequestDocumentSignature(ctx context.Context, sigReq *p2ppb.Si
 }
 
 // HandleSendAnchoredDocument handles the SendAnchoredDocument message
-func (h *handler) HandleSendAnchoredDocument(ctx context.Context, _ peer.ID, _ protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+func (h *handler) HandleSendAnchoredDocument(ctx context.Context, peerID peer.ID, _ protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
 	m := new(p2ppb.AnchorDocumentRequest)
 	err := proto.Unmarshal(msg.GetBody(), m)
 	if err != nil {
@@ -175,6 +224,8 @@ func (h *handler) HandleSendAnchoredDocument(ctx context.Context, _ peer.ID, _ p
 	}
 	res, err := h.SendAnchoredDocument(ctx, m, collaborator)
 	if err != nil {
+		h.recordInvalidDocument(peerID, err)
+
 		return h.convertToErrorEnvelop(err)
 	}
 
@@ -205,6 +256,86 @@ func (h *handler) SendAnchoredDocument(ctx context.Context, docReq *p2ppb.Anchor
 	return &p2ppb.AnchorDocumentResponse{Accepted: true}, nil
 }
 
+// HandleSendEncryptedAnchoredDocument handles the SendAnchoredDocument message encrypted for the receiving account,
+// the document is decrypted before it is validated
+func (h *handler) HandleSendEncryptedAnchoredDocument(ctx context.Context, peerID peer.ID, protocolID protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	body, err := acc.DecryptMsg(msg.GetBody())
+	if err != nil {
+		return h.convertToErrorEnvelop(errors.NewTypedError(ErrDocumentDecryption, err))
+	}
+
+	decryptedMsg := &p2ppb.Envelope{
+		Header: msg.GetHeader(),
+		Body:   body,
+	}
+
+	return h.HandleSendAnchoredDocument(ctx, peerID, protocolID, decryptedMsg)
+}
+
+// HandleSendAnchoredDocumentChunk handles a chunk of a SendAnchoredDocument message that is too large to be sent
+// at once, the message is handled when all its chunks are received
+func (h *handler) HandleSendAnchoredDocumentChunk(ctx context.Context, peerID peer.ID, protocolID protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	chunk, err := p2pcommon.DecodeChunk(msg.GetBody())
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	var handleMessage func(ctx context.Context, peer peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error)
+
+	switch chunk.MessageType {
+	case p2pcommon.MessageTypeSendAnchoredDoc:
+		handleMessage = h.HandleSendAnchoredDocument
+	case p2pcommon.MessageTypeSendEncryptedAnchoredDoc:
+		handleMessage = h.HandleSendEncryptedAnchoredDocument
+	default:
+		return h.convertToErrorEnvelop(errors.New("MessageType [%s] cannot be chunked", chunk.MessageType))
+	}
+
+	acc, err := contextutil.Account(ctx)
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	collaborator, err := types.NewAccountID(msg.GetHeader().GetSenderId())
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	// The transfers are kept per receiver and sender, so that peers can't add chunks to the transfers of others.
+	key := fmt.Sprintf("%s/%s/%x", acc.GetIdentity().ToHexString(), collaborator.ToHexString(), chunk.PayloadHash)
+
+	payload, nextIndex, err := h.chunks.add(peerID, key, chunk, time.Now())
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	if nextIndex < chunk.Total {
+		p2pEnv, err := p2pcommon.PrepareP2PEnvelopeWithBody(
+			ctx,
+			h.cfg.GetNetworkID(),
+			p2pcommon.MessageTypeSendAnchoredDocChunkRep,
+			p2pcommon.EncodeChunkAck(nextIndex),
+		)
+		if err != nil {
+			return h.convertToErrorEnvelop(err)
+		}
+
+		return p2pEnv, nil
+	}
+
+	assembledMsg := &p2ppb.Envelope{
+		Header: msg.GetHeader(),
+		Body:   payload,
+	}
+
+	return handleMessage(ctx, peerID, protocolID, assembledMsg)
+}
+
 // HandleGetDocument handles HandleGetDocument message
 func (h *handler) HandleGetDocument(ctx context.Context, _ peer.ID, _ protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
 	m := new(p2ppb.GetDocumentRequest)
@@ -250,6 +381,131 @@ func (h *handler) GetDocument(ctx context.Context, docReq *p2ppb.GetDocumentRequ
 	return &p2ppb.GetDocumentResponse{Document: cd}, nil
 }
 
+// HandleGetLatestVersion handles the GetLatestVersion message
+func (h *handler) HandleGetLatestVersion(ctx context.Context, _ peer.ID, _ protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	m := new(p2ppb.GetDocumentRequest)
+	err := proto.Unmarshal(msg.GetBody(), m)
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	requester, err := types.NewAccountID(msg.GetHeader().GetSenderId())
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	res, err := h.GetLatestVersion(ctx, m, requester)
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelope(ctx, h.cfg.GetNetworkID(), p2pcommon.MessageTypeGetLatestVersionRep, res)
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	return p2pEnv, nil
+}
+
+// GetLatestVersion returns the latest anchored version of the document to a collaborator that is catching up
+// on the versions it missed. Only the collaborators of the document are allowed to request it.
+func (h *handler) GetLatestVersion(ctx context.Context, docReq *p2ppb.GetDocumentRequest, requester *types.AccountID) (*p2ppb.GetDocumentResponse, error) {
+	model, err := h.getLatestCommittedVersion(ctx, docReq.GetDocumentIdentifier())
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.AccountCanRead(requester) {
+		return nil, ErrAccessDenied
+	}
+
+	cd, err := model.PackCoreDocument()
+	if err != nil {
+		return nil, err
+	}
+
+	return &p2ppb.GetDocumentResponse{Document: cd}, nil
+}
+
+// getLatestCommittedVersion returns the latest version of the document that was anchored, the current version
+// might still be pending or in the process of being anchored.
+func (h *handler) getLatestCommittedVersion(ctx context.Context, documentID []byte) (documents.Document, error) {
+	model, err := h.docSrv.GetCurrentVersion(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	for model.GetStatus() != documents.Committed {
+		prevVersion := model.PreviousVersion()
+
+		if utils.IsEmptyByteSlice(prevVersion) {
+			return nil, documents.ErrDocumentVersionNotFound
+		}
+
+		model, err = h.docSrv.GetVersion(ctx, documentID, prevVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return model, nil
+}
+
+// HandleGetAttachment handles the GetAttachment message
+func (h *handler) HandleGetAttachment(ctx context.Context, _ peer.ID, _ protocol.ID, msg *p2ppb.Envelope) (*pb.P2PEnvelope, error) {
+	req, err := p2pcommon.DecodeAttachmentRequest(msg.GetBody())
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	requester, err := types.NewAccountID(msg.GetHeader().GetSenderId())
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	data, err := h.GetAttachment(ctx, req, requester)
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelopeWithBody(ctx, h.cfg.GetNetworkID(), p2pcommon.MessageTypeGetAttachmentRep, data)
+	if err != nil {
+		return h.convertToErrorEnvelop(err)
+	}
+
+	return p2pEnv, nil
+}
+
+// GetAttachment returns the content of an attachment of the latest version of the document. The access to the
+// document is validated against the access type of the request, and only the attachments held by the document
+// can be requested.
+func (h *handler) GetAttachment(ctx context.Context, req *p2pcommon.AttachmentRequest, requester *types.AccountID) ([]byte, error) {
+	identity, err := contextutil.Identity(ctx)
+	if err != nil {
+		return nil, errors.ErrContextIdentityRetrieval
+	}
+
+	model, err := h.docSrv.GetCurrentVersion(ctx, req.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.validateDocumentAccess(ctx, req.AccessRequest(), model, requester); err != nil {
+		return nil, err
+	}
+
+	if !documents.HasAttachment(model, req.Hash) {
+		return nil, ErrAccessDenied
+	}
+
+	blob, err := h.attachmentStore.Get(identity, req.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return blob.Data, nil
+}
+
 // validateDocumentAccess validates the GetDocument request against the AccessType indicated in the request
 func (h *handler) validateDocumentAccess(
 	ctx context.Context,
@@ -333,7 +589,48 @@ func (h *handler) convertToErrorEnvelop(ierr error) (*pb.P2PEnvelope, error) {
 	log.Error(ierr)
 
 	ierr = errors.Mask(ierr)
-	errPb := &errorspb.Error{Message: ierr.Error()}
+
+	return h.newErrorEnvelope(ierr.Error())
+}
+
+// recordInvalidDocument records a strike for the peer if the document it sent holds invalid signatures,
+// other validation errors might not be caused by the peer.
+func (h *handler) recordInvalidDocument(peerID peer.ID, err error) {
+	if errors.IsOfType(documents.ErrDocumentSignatureInvalid, err) {
+		h.guard.strike(peerID)
+	}
+}
+
+// newRejectionEnvelope returns an error envelope for a request rejected by the abuse protection.
+func (h *handler) newRejectionEnvelope(peerID peer.ID, err error) (*pb.P2PEnvelope, error) {
+	log.Warnf("Rejected request from peer %s: %s", peerID, err)
+
+	// Rejection errors are not masked so that the peer knows why the message was rejected.
+	return h.newErrorEnvelope(err.Error())
+}
+
+// validateProtocolMessageType checks that the protocol version is spoken by the node and that
+// the message type can be exchanged using it.
+func validateProtocolMessageType(protocolID protocol.ID, messageType string) error {
+	protocolVersion, err := p2pcommon.ExtractProtocolVersion(protocolID)
+	if err != nil {
+		return fmt.Errorf("%w: protocol [%s]", err, protocolID)
+	}
+
+	if !protocolVersion.SupportsMessageType(p2pcommon.MessageTypeFromString(messageType)) {
+		return fmt.Errorf(
+			"%w: message type [%s], protocol version [%s]",
+			p2pcommon.ErrUnsupportedMessageType,
+			messageType,
+			protocolVersion,
+		)
+	}
+
+	return nil
+}
+
+func (h *handler) newErrorEnvelope(message string) (*pb.P2PEnvelope, error) {
+	errPb := &errorspb.Error{Message: message}
 	errBytes, errx := proto.Marshal(errPb)
 	if errx != nil {
 		return nil, errx
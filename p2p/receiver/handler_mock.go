@@ -8,6 +8,8 @@ import (
 	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
 	mock "github.com/stretchr/testify/mock"
 
+	p2pcommon "github.com/centrifuge/pod/p2p/common"
+
 	peer "github.com/libp2p/go-libp2p-core/peer"
 
 	protocol "github.com/libp2p/go-libp2p-core/protocol"
@@ -22,6 +24,29 @@ type HandlerMock struct {
 	mock.Mock
 }
 
+// GetAttachment provides a mock function with given fields: ctx, req, requester
+func (_m *HandlerMock) GetAttachment(ctx context.Context, req *p2pcommon.AttachmentRequest, requester *types.AccountID) ([]byte, error) {
+	ret := _m.Called(ctx, req, requester)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, *p2pcommon.AttachmentRequest, *types.AccountID) []byte); ok {
+		r0 = rf(ctx, req, requester)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *p2pcommon.AttachmentRequest, *types.AccountID) error); ok {
+		r1 = rf(ctx, req, requester)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDocument provides a mock function with given fields: ctx, docReq, requester
 func (_m *HandlerMock) GetDocument(ctx context.Context, docReq *p2ppb.GetDocumentRequest, requester *types.AccountID) (*p2ppb.GetDocumentResponse, error) {
 	ret := _m.Called(ctx, docReq, requester)
@@ -45,6 +70,52 @@ func (_m *HandlerMock) GetDocument(ctx context.Context, docReq *p2ppb.GetDocumen
 	return r0, r1
 }
 
+// GetLatestVersion provides a mock function with given fields: ctx, docReq, requester
+func (_m *HandlerMock) GetLatestVersion(ctx context.Context, docReq *p2ppb.GetDocumentRequest, requester *types.AccountID) (*p2ppb.GetDocumentResponse, error) {
+	ret := _m.Called(ctx, docReq, requester)
+
+	var r0 *p2ppb.GetDocumentResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *p2ppb.GetDocumentRequest, *types.AccountID) *p2ppb.GetDocumentResponse); ok {
+		r0 = rf(ctx, docReq, requester)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*p2ppb.GetDocumentResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *p2ppb.GetDocumentRequest, *types.AccountID) error); ok {
+		r1 = rf(ctx, docReq, requester)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleGetAttachment provides a mock function with given fields: ctx, _a1, protoc, msg
+func (_m *HandlerMock) HandleGetAttachment(ctx context.Context, _a1 peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*protocolpb.P2PEnvelope, error) {
+	ret := _m.Called(ctx, _a1, protoc, msg)
+
+	var r0 *protocolpb.P2PEnvelope
+	if rf, ok := ret.Get(0).(func(context.Context, peer.ID, protocol.ID, *p2ppb.Envelope) *protocolpb.P2PEnvelope); ok {
+		r0 = rf(ctx, _a1, protoc, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*protocolpb.P2PEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, peer.ID, protocol.ID, *p2ppb.Envelope) error); ok {
+		r1 = rf(ctx, _a1, protoc, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HandleGetDocument provides a mock function with given fields: ctx, _a1, protoc, msg
 func (_m *HandlerMock) HandleGetDocument(ctx context.Context, _a1 peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*protocolpb.P2PEnvelope, error) {
 	ret := _m.Called(ctx, _a1, protoc, msg)
@@ -68,6 +139,29 @@ func (_m *HandlerMock) HandleGetDocument(ctx context.Context, _a1 peer.ID, proto
 	return r0, r1
 }
 
+// HandleGetLatestVersion provides a mock function with given fields: ctx, _a1, protoc, msg
+func (_m *HandlerMock) HandleGetLatestVersion(ctx context.Context, _a1 peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*protocolpb.P2PEnvelope, error) {
+	ret := _m.Called(ctx, _a1, protoc, msg)
+
+	var r0 *protocolpb.P2PEnvelope
+	if rf, ok := ret.Get(0).(func(context.Context, peer.ID, protocol.ID, *p2ppb.Envelope) *protocolpb.P2PEnvelope); ok {
+		r0 = rf(ctx, _a1, protoc, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*protocolpb.P2PEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, peer.ID, protocol.ID, *p2ppb.Envelope) error); ok {
+		r1 = rf(ctx, _a1, protoc, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HandleInterceptor provides a mock function with given fields: ctx, _a1, protoc, msg
 func (_m *HandlerMock) HandleInterceptor(ctx context.Context, _a1 peer.ID, protoc protocol.ID, msg *protocolpb.P2PEnvelope) (*protocolpb.P2PEnvelope, error) {
 	ret := _m.Called(ctx, _a1, protoc, msg)
@@ -137,6 +231,52 @@ func (_m *HandlerMock) HandleSendAnchoredDocument(ctx context.Context, _a1 peer.
 	return r0, r1
 }
 
+// HandleSendAnchoredDocumentChunk provides a mock function with given fields: ctx, _a1, protoc, msg
+func (_m *HandlerMock) HandleSendAnchoredDocumentChunk(ctx context.Context, _a1 peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*protocolpb.P2PEnvelope, error) {
+	ret := _m.Called(ctx, _a1, protoc, msg)
+
+	var r0 *protocolpb.P2PEnvelope
+	if rf, ok := ret.Get(0).(func(context.Context, peer.ID, protocol.ID, *p2ppb.Envelope) *protocolpb.P2PEnvelope); ok {
+		r0 = rf(ctx, _a1, protoc, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*protocolpb.P2PEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, peer.ID, protocol.ID, *p2ppb.Envelope) error); ok {
+		r1 = rf(ctx, _a1, protoc, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandleSendEncryptedAnchoredDocument provides a mock function with given fields: ctx, _a1, protoc, msg
+func (_m *HandlerMock) HandleSendEncryptedAnchoredDocument(ctx context.Context, _a1 peer.ID, protoc protocol.ID, msg *p2ppb.Envelope) (*protocolpb.P2PEnvelope, error) {
+	ret := _m.Called(ctx, _a1, protoc, msg)
+
+	var r0 *protocolpb.P2PEnvelope
+	if rf, ok := ret.Get(0).(func(context.Context, peer.ID, protocol.ID, *p2ppb.Envelope) *protocolpb.P2PEnvelope); ok {
+		r0 = rf(ctx, _a1, protoc, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*protocolpb.P2PEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, peer.ID, protocol.ID, *p2ppb.Envelope) error); ok {
+		r1 = rf(ctx, _a1, protoc, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RequestDocumentSignature provides a mock function with given fields: ctx, sigReq, collaborator
 func (_m *HandlerMock) RequestDocumentSignature(ctx context.Context, sigReq *p2ppb.SignatureRequest, collaborator *types.AccountID) (*p2ppb.SignatureResponse, error) {
 	ret := _m.Called(ctx, sigReq, collaborator)
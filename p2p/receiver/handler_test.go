@@ -4,6 +4,7 @@ package receiver
 
 import (
 	"context"
+	"math"
 	"math/big"
 	"testing"
 	"time"
@@ -15,12 +16,16 @@ import (
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/config/configstore"
 	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/crypto"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/errors"
 	v2 "github.com/centrifuge/pod/identity/v2"
 	nftv3 "github.com/centrifuge/pod/nft/v3"
 	p2pcommon "github.com/centrifuge/pod/p2p/common"
+	"github.com/centrifuge/pod/p2p/messenger"
 	testingcommons "github.com/centrifuge/pod/testingutils/common"
 	genericUtils "github.com/centrifuge/pod/testingutils/generic"
 	"github.com/centrifuge/pod/utils"
@@ -249,6 +254,113 @@ func TestHandler_HandleInterceptor_SendAnchoredDocument(t *testing.T) {
 	assert.True(t, anchorDoc.GetAccepted())
 }
 
+func TestHandler_HandleInterceptor_SendEncryptedAnchoredDocument(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	encryptionPubKey, encryptionPrivKey, err := crypto.GenerateEncryptionKeyPair()
+	assert.NoError(t, err)
+
+	acc := &configstore.Account{
+		Identity:             identity,
+		EncryptionPublicKey:  encryptionPubKey,
+		EncryptionPrivateKey: encryptionPrivKey,
+	}
+
+	cd := &coredocumentpb.CoreDocument{
+		DocumentIdentifier: utils.RandomSlice(32),
+	}
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	encryptedReq, err := crypto.EncryptMessage(encryptionPubKey, encodedReq, crypto.CurveX25519)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeSendEncryptedAnchoredDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encryptedReq,
+	}
+
+	encodedEnv, err := proto.Marshal(env)
+	assert.NoError(t, err)
+
+	msg := &protocolpb.P2PEnvelope{
+		Body: encodedEnv,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetP2PResponseDelay").
+		Return(0 * time.Second).Once()
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).
+		On("GetAccount", identity.ToBytes()).
+		Return(acc, nil).Once()
+
+	genericUtils.GetMock[*ValidatorMock](mocks).
+		On("Validate", mock.IsType(env.GetHeader()), senderAccountID, &peerID).
+		Return(nil).Once()
+
+	// SendAnchoredDocument with the decrypted document
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On("DeriveFromCoreDocument", mock.MatchedBy(func(doc *coredocumentpb.CoreDocument) bool {
+			return proto.Equal(cd, doc)
+		})).
+		Return(documentMock, nil).Once()
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"ReceiveAnchoredDocument",
+			mock.Anything,
+			documentMock,
+			senderAccountID,
+		).
+		Return(nil).Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetNetworkID").
+		Return(uint32(36)).Once()
+
+	res, err := handler.HandleInterceptor(ctx, peerID, protocolID, msg)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	var responseEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
+
+	var anchorDoc p2ppb.AnchorDocumentResponse
+
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &anchorDoc)
+	assert.NoError(t, err)
+
+	assert.True(t, anchorDoc.GetAccepted())
+}
+
 func TestHandler_HandleInterceptor_GetDocument(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
@@ -414,7 +526,7 @@ func TestHandler_HandleInterceptor_InvalidMessageType(t *testing.T) {
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleRequestDocumentSignature(t *testing.T) {
+func TestHandler_HandleInterceptor_MessageTypeNotSupportedByProtocolVersion(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -422,91 +534,61 @@ func TestHandler_HandleRequestDocumentSignature(t *testing.T) {
 	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	accountMock := config.NewAccountMock(t)
-	accountMock.On("GetIdentity").
-		Return(identity)
-
-	ctx = contextutil.WithAccount(ctx, accountMock)
-
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	cd := &coredocumentpb.CoreDocument{}
-
-	req := &p2ppb.SignatureRequest{
-		Document: cd,
-	}
-
-	encodedReq, err := proto.Marshal(req)
-	assert.NoError(t, err)
-
 	env := &p2ppb.Envelope{
 		Header: &p2ppb.Header{
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeRequestSignature.String(),
+			Type:              p2pcommon.MessageTypeGetLatestVersion.String(),
 			Timestamp:         timestamppb.Now(),
 		},
-		Body: encodedReq,
+		Body: nil,
 	}
 
-	peerID := libp2ppeer.ID("peer-id")
-	protocolID := p2pcommon.ProtocolForIdentity(identity)
-
-	documentMock := documents.NewDocumentMock(t)
-
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On("DeriveFromCoreDocument", req.GetDocument()).
-		Return(documentMock, nil).Once()
+	encodedEnv, err := proto.Marshal(env)
+	assert.NoError(t, err)
 
-	signatures := []*coredocumentpb.Signature{
-		{
-			SignatureId:         utils.RandomSlice(32),
-			SignerId:            utils.RandomSlice(32),
-			PublicKey:           utils.RandomSlice(32),
-			Signature:           utils.RandomSlice(32),
-			TransitionValidated: true,
-		},
+	msg := &protocolpb.P2PEnvelope{
+		Body: encodedEnv,
 	}
 
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On(
-			"RequestDocumentSignature",
-			mock.Anything,
-			documentMock,
-			senderAccountID,
-		).
-		Return(signatures, nil).Once()
+	peerID := libp2ppeer.ID("peer-id")
+	// The latest version message was added in a later protocol version.
+	protocolID := p2pcommon.ProtocolForIdentityVersion(identity, p2pcommon.ProtocolVersion001)
 
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).
-		On("GetNetworkID").
-		Return(uint32(36)).Once()
+		On("GetP2PResponseDelay").
+		Return(0 * time.Second).Once()
 
-	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
-	assert.NoError(t, err)
-	assert.NotNil(t, res)
+	accountMock := config.NewAccountMock(t)
 
-	var responseEnvelope p2ppb.Envelope
+	genericUtils.GetMock[*config.ServiceMock](mocks).
+		On("GetAccount", identity.ToBytes()).
+		Return(accountMock, nil).Once()
 
-	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
-	assert.NoError(t, err)
+	genericUtils.GetMock[*ValidatorMock](mocks).
+		On("Validate", mock.IsType(env.GetHeader()), senderAccountID, &peerID).
+		Run(func(args mock.Arguments) {
+			header, ok := args.Get(0).(*p2ppb.Header)
+			assert.True(t, ok)
 
-	var signatureRes p2ppb.SignatureResponse
+			assertExpectedHeaderMatchesActual(t, env.GetHeader(), header)
 
-	err = proto.Unmarshal(responseEnvelope.GetBody(), &signatureRes)
-	assert.NoError(t, err)
+		}).
+		Return(nil).Once()
 
-	assert.Len(t, signatureRes.GetSignatures(), 1)
-	assert.Equal(t, signatures[0].GetSignatureId(), signatureRes.GetSignatures()[0].GetSignatureId())
-	assert.Equal(t, signatures[0].GetSignerId(), signatureRes.GetSignatures()[0].GetSignerId())
-	assert.Equal(t, signatures[0].GetPublicKey(), signatureRes.GetSignatures()[0].GetPublicKey())
-	assert.Equal(t, signatures[0].GetSignature(), signatureRes.GetSignatures()[0].GetSignature())
-	assert.Equal(t, signatures[0].GetTransitionValidated(), signatureRes.GetSignatures()[0].GetTransitionValidated())
+	res, err := handler.HandleInterceptor(ctx, peerID, protocolID, msg)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+
+	assertProtocolErrorEnvelope(t, res, p2pcommon.ErrUnsupportedMessageType)
 }
 
-func TestHandler_HandleRequestDocumentSignature_RequestDecodeError(t *testing.T) {
-	handler, _ := getHandlerWithMocks(t)
+func TestHandler_HandleInterceptor_UnsupportedProtocolVersion(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
 
@@ -521,63 +603,111 @@ func TestHandler_HandleRequestDocumentSignature_RequestDecodeError(t *testing.T)
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeRequestSignature.String(),
+			Type:              p2pcommon.MessageTypeGetLatestVersion.String(),
 			Timestamp:         timestamppb.Now(),
 		},
-		Body: utils.RandomSlice(32),
+		Body: nil,
+	}
+
+	encodedEnv, err := proto.Marshal(env)
+	assert.NoError(t, err)
+
+	msg := &protocolpb.P2PEnvelope{
+		Body: encodedEnv,
 	}
 
 	peerID := libp2ppeer.ID("peer-id")
-	protocolID := p2pcommon.ProtocolForIdentity(identity)
+	protocolID := p2pcommon.ProtocolForIdentityVersion(identity, "0.1.0")
 
-	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
-	assert.NoError(t, err)
-	assert.NotNil(t, res)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetP2PResponseDelay").
+		Return(0 * time.Second).Once()
 
-	assertErrorEnvelope(t, res)
-}
+	accountMock := config.NewAccountMock(t)
 
-func TestHandler_HandleRequestDocumentSignature_InvalidSenderID(t *testing.T) {
-	handler, _ := getHandlerWithMocks(t)
+	genericUtils.GetMock[*config.ServiceMock](mocks).
+		On("GetAccount", identity.ToBytes()).
+		Return(accountMock, nil).Once()
 
-	ctx := context.Background()
+	genericUtils.GetMock[*ValidatorMock](mocks).
+		On("Validate", mock.IsType(env.GetHeader()), senderAccountID, &peerID).
+		Run(func(args mock.Arguments) {
+			header, ok := args.Get(0).(*p2ppb.Header)
+			assert.True(t, ok)
 
-	identity, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
+			assertExpectedHeaderMatchesActual(t, env.GetHeader(), header)
 
-	cd := &coredocumentpb.CoreDocument{}
+		}).
+		Return(nil).Once()
 
-	req := &p2ppb.SignatureRequest{
-		Document: cd,
-	}
+	res, err := handler.HandleInterceptor(ctx, peerID, protocolID, msg)
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
 
-	encodedReq, err := proto.Marshal(req)
-	assert.NoError(t, err)
+	assertProtocolErrorEnvelope(t, res, p2pcommon.ErrUnsupportedProtocolVersion)
+}
 
-	env := &p2ppb.Envelope{
-		Header: &p2ppb.Header{
-			NetworkIdentifier: 36,
-			NodeVersion:       version.GetVersion().String(),
-			// Invalid sender ID account bytes.
-			SenderId:  utils.RandomSlice(31),
-			Type:      p2pcommon.MessageTypeRequestSignature.String(),
-			Timestamp: timestamppb.Now(),
+func TestHandler_HandleInterceptor_PeerRejected(t *testing.T) {
+	peerID := libp2ppeer.ID("peer-id")
+
+	tests := []struct {
+		name        string
+		guard       func(guard *requestGuard)
+		expectedErr error
+	}{
+		{
+			name: "banned peer",
+			guard: func(guard *requestGuard) {
+				guard.banList = newBanList(1, time.Hour)
+				guard.banList.strike(peerID, time.Now())
+			},
+			expectedErr: ErrPeerBanned,
+		},
+		{
+			name: "peer rate limit",
+			guard: func(guard *requestGuard) {
+				guard.peerLimiter = newRateLimiter(0, time.Minute)
+			},
+			expectedErr: ErrPeerRateLimitExceeded,
+		},
+		{
+			name: "too many concurrent requests",
+			guard: func(guard *requestGuard) {
+				guard.sem = make(chan struct{})
+			},
+			expectedErr: ErrTooManyConcurrentRequests,
 		},
-		Body: encodedReq,
 	}
 
-	peerID := libp2ppeer.ID("peer-id")
-	protocolID := p2pcommon.ProtocolForIdentity(identity)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, _ := getHandlerWithMocks(t)
 
-	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
-	assert.NoError(t, err)
-	assert.NotNil(t, res)
+			test.guard(handler.guard)
 
-	assertErrorEnvelope(t, res)
+			identity, err := testingcommons.GetRandomAccountID()
+			assert.NoError(t, err)
+
+			msg := &protocolpb.P2PEnvelope{
+				Body: utils.RandomSlice(32),
+			}
+
+			protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+			// The request is rejected before any of the mocks is called.
+			res, err := handler.HandleInterceptor(context.Background(), peerID, protocolID, msg)
+			assert.NoError(t, err)
+			assert.NotNil(t, res)
+
+			assertProtocolErrorEnvelope(t, res, test.expectedErr)
+		})
+	}
 }
 
-func TestHandler_HandleRequestDocumentSignature_NilDocument(t *testing.T) {
-	handler, _ := getHandlerWithMocks(t)
+func TestHandler_HandleInterceptor_IdentityRateLimit(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	handler.guard.identityLimiter = newRateLimiter(0, time.Minute)
 
 	ctx := context.Background()
 
@@ -587,80 +717,55 @@ func TestHandler_HandleRequestDocumentSignature_NilDocument(t *testing.T) {
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.SignatureRequest{}
-
-	encodedReq, err := proto.Marshal(req)
-	assert.NoError(t, err)
-
 	env := &p2ppb.Envelope{
 		Header: &p2ppb.Header{
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeRequestSignature.String(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
 			Timestamp:         timestamppb.Now(),
 		},
-		Body: encodedReq,
-	}
-
-	peerID := libp2ppeer.ID("peer-id")
-	protocolID := p2pcommon.ProtocolForIdentity(identity)
-
-	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
-	assert.NoError(t, err)
-	assert.NotNil(t, res)
-
-	assertErrorEnvelope(t, res)
-}
-
-func TestHandler_HandleRequestDocumentSignature_DocDeriveError(t *testing.T) {
-	handler, mocks := getHandlerWithMocks(t)
-
-	ctx := context.Background()
-
-	identity, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
-
-	senderAccountID, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
-
-	cd := &coredocumentpb.CoreDocument{}
-
-	req := &p2ppb.SignatureRequest{
-		Document: cd,
+		Body: nil,
 	}
 
-	encodedReq, err := proto.Marshal(req)
+	encodedEnv, err := proto.Marshal(env)
 	assert.NoError(t, err)
 
-	env := &p2ppb.Envelope{
-		Header: &p2ppb.Header{
-			NetworkIdentifier: 36,
-			NodeVersion:       version.GetVersion().String(),
-			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeRequestSignature.String(),
-			Timestamp:         timestamppb.Now(),
-		},
-		Body: encodedReq,
+	msg := &protocolpb.P2PEnvelope{
+		Body: encodedEnv,
 	}
 
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On("DeriveFromCoreDocument", req.GetDocument()).
-		Return(nil, errors.New("error")).Once()
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetP2PResponseDelay").
+		Return(0 * time.Second).Once()
 
-	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
+	accountMock := config.NewAccountMock(t)
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).
+		On("GetAccount", identity.ToBytes()).
+		Return(accountMock, nil).Once()
+
+	genericUtils.GetMock[*ValidatorMock](mocks).
+		On("Validate", mock.IsType(env.GetHeader()), senderAccountID, &peerID).
+		Return(nil).Once()
+
+	res, err := handler.HandleInterceptor(ctx, peerID, protocolID, msg)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
-	assertErrorEnvelope(t, res)
+	assertProtocolErrorEnvelope(t, res, ErrIdentityRateLimitExceeded)
 }
 
-func TestHandler_HandleRequestDocumentSignature_RequestDocumentSignatureError(t *testing.T) {
+func TestHandler_HandleRequestDocumentSignature_InvalidDocumentBan(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
+	banThreshold := 2
+
+	handler.guard.banList = newBanList(banThreshold, time.Hour)
+
 	ctx := context.Background()
 
 	identity, err := testingcommons.GetRandomAccountID()
@@ -669,10 +774,8 @@ func TestHandler_HandleRequestDocumentSignature_RequestDocumentSignatureError(t
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	cd := &coredocumentpb.CoreDocument{}
-
 	req := &p2ppb.SignatureRequest{
-		Document: cd,
+		Document: &coredocumentpb.CoreDocument{},
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -696,8 +799,9 @@ func TestHandler_HandleRequestDocumentSignature_RequestDocumentSignatureError(t
 
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On("DeriveFromCoreDocument", req.GetDocument()).
-		Return(documentMock, nil).Once()
+		Return(documentMock, nil).Times(2*banThreshold + 1)
 
+	// Errors that are not caused by an invalid document don't count towards the ban.
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
 			"RequestDocumentSignature",
@@ -709,12 +813,61 @@ func TestHandler_HandleRequestDocumentSignature_RequestDocumentSignatureError(t
 
 	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
-	assert.NotNil(t, res)
-
 	assertErrorEnvelope(t, res)
+	assert.False(t, handler.guard.banList.isBanned(peerID, time.Now()))
+
+	// Neither do validation errors other than invalid signatures.
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"RequestDocumentSignature",
+			mock.Anything,
+			documentMock,
+			senderAccountID,
+		).
+		Return(nil, errors.NewTypedError(documents.ErrDocumentInvalid, errors.New("invalid document"))).
+		Times(banThreshold)
+
+	for i := 0; i < banThreshold; i++ {
+		res, err = handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
+		assert.NoError(t, err)
+		assertErrorEnvelope(t, res)
+	}
+
+	assert.False(t, handler.guard.banList.isBanned(peerID, time.Now()))
+
+	signatureErr := errors.AppendError(
+		errors.New("invalid document"),
+		errors.NewTypedError(documents.ErrDocumentSignatureInvalid, errors.New("invalid signature")),
+	)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"RequestDocumentSignature",
+			mock.Anything,
+			documentMock,
+			senderAccountID,
+		).
+		Return(nil, errors.NewTypedError(documents.ErrDocumentInvalid, signatureErr)).
+		Times(banThreshold)
+
+	for i := 0; i < banThreshold; i++ {
+		res, err = handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
+		assert.NoError(t, err)
+		assertErrorEnvelope(t, res)
+	}
+
+	assert.True(t, handler.guard.banList.isBanned(peerID, time.Now()))
+
+	msg := &protocolpb.P2PEnvelope{
+		Body: encodedReq,
+	}
+
+	res, err = handler.HandleInterceptor(ctx, peerID, protocolID, msg)
+	assert.NoError(t, err)
+	assertProtocolErrorEnvelope(t, res, ErrPeerBanned)
 }
 
-func TestHandler_HandleSendAnchoredDocument(t *testing.T) {
+func TestHandler_HandleRequestDocumentSignature(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -733,7 +886,7 @@ func TestHandler_HandleSendAnchoredDocument(t *testing.T) {
 
 	cd := &coredocumentpb.CoreDocument{}
 
-	req := &p2ppb.AnchorDocumentRequest{
+	req := &p2ppb.SignatureRequest{
 		Document: cd,
 	}
 
@@ -745,7 +898,7 @@ func TestHandler_HandleSendAnchoredDocument(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
+			Type:              p2pcommon.MessageTypeRequestSignature.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -760,20 +913,30 @@ func TestHandler_HandleSendAnchoredDocument(t *testing.T) {
 		On("DeriveFromCoreDocument", req.GetDocument()).
 		Return(documentMock, nil).Once()
 
+	signatures := []*coredocumentpb.Signature{
+		{
+			SignatureId:         utils.RandomSlice(32),
+			SignerId:            utils.RandomSlice(32),
+			PublicKey:           utils.RandomSlice(32),
+			Signature:           utils.RandomSlice(32),
+			TransitionValidated: true,
+		},
+	}
+
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
-			"ReceiveAnchoredDocument",
+			"RequestDocumentSignature",
 			mock.Anything,
 			documentMock,
 			senderAccountID,
 		).
-		Return(nil).Once()
+		Return(signatures, nil).Once()
 
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).
 		On("GetNetworkID").
 		Return(uint32(36)).Once()
 
-	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
@@ -782,15 +945,20 @@ func TestHandler_HandleSendAnchoredDocument(t *testing.T) {
 	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
 	assert.NoError(t, err)
 
-	var anchorDoc p2ppb.AnchorDocumentResponse
+	var signatureRes p2ppb.SignatureResponse
 
-	err = proto.Unmarshal(responseEnvelope.GetBody(), &anchorDoc)
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &signatureRes)
 	assert.NoError(t, err)
 
-	assert.True(t, anchorDoc.GetAccepted())
+	assert.Len(t, signatureRes.GetSignatures(), 1)
+	assert.Equal(t, signatures[0].GetSignatureId(), signatureRes.GetSignatures()[0].GetSignatureId())
+	assert.Equal(t, signatures[0].GetSignerId(), signatureRes.GetSignatures()[0].GetSignerId())
+	assert.Equal(t, signatures[0].GetPublicKey(), signatureRes.GetSignatures()[0].GetPublicKey())
+	assert.Equal(t, signatures[0].GetSignature(), signatureRes.GetSignatures()[0].GetSignature())
+	assert.Equal(t, signatures[0].GetTransitionValidated(), signatureRes.GetSignatures()[0].GetTransitionValidated())
 }
 
-func TestHandler_HandleSendAnchoredDocument_RequestDecodeError(t *testing.T) {
+func TestHandler_HandleRequestDocumentSignature_RequestDecodeError(t *testing.T) {
 	handler, _ := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -806,7 +974,7 @@ func TestHandler_HandleSendAnchoredDocument_RequestDecodeError(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
+			Type:              p2pcommon.MessageTypeRequestSignature.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: utils.RandomSlice(32),
@@ -815,14 +983,14 @@ func TestHandler_HandleSendAnchoredDocument_RequestDecodeError(t *testing.T) {
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
-	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleSendAnchoredDocument_InvalidSenderID(t *testing.T) {
+func TestHandler_HandleRequestDocumentSignature_InvalidSenderID(t *testing.T) {
 	handler, _ := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -832,7 +1000,7 @@ func TestHandler_HandleSendAnchoredDocument_InvalidSenderID(t *testing.T) {
 
 	cd := &coredocumentpb.CoreDocument{}
 
-	req := &p2ppb.AnchorDocumentRequest{
+	req := &p2ppb.SignatureRequest{
 		Document: cd,
 	}
 
@@ -844,8 +1012,8 @@ func TestHandler_HandleSendAnchoredDocument_InvalidSenderID(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			// Invalid sender ID account bytes.
-			SenderId:  utils.RandomSlice(11),
-			Type:      p2pcommon.MessageTypeSendAnchoredDoc.String(),
+			SenderId:  utils.RandomSlice(31),
+			Type:      p2pcommon.MessageTypeRequestSignature.String(),
 			Timestamp: timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -854,14 +1022,14 @@ func TestHandler_HandleSendAnchoredDocument_InvalidSenderID(t *testing.T) {
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
-	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleSendAnchoredDocument_NilDocument(t *testing.T) {
+func TestHandler_HandleRequestDocumentSignature_NilDocument(t *testing.T) {
 	handler, _ := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -872,7 +1040,7 @@ func TestHandler_HandleSendAnchoredDocument_NilDocument(t *testing.T) {
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.AnchorDocumentRequest{}
+	req := &p2ppb.SignatureRequest{}
 
 	encodedReq, err := proto.Marshal(req)
 	assert.NoError(t, err)
@@ -882,7 +1050,7 @@ func TestHandler_HandleSendAnchoredDocument_NilDocument(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
+			Type:              p2pcommon.MessageTypeRequestSignature.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -891,14 +1059,14 @@ func TestHandler_HandleSendAnchoredDocument_NilDocument(t *testing.T) {
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
-	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleSendAnchoredDocument_DocDeriveError(t *testing.T) {
+func TestHandler_HandleRequestDocumentSignature_DocDeriveError(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -911,7 +1079,7 @@ func TestHandler_HandleSendAnchoredDocument_DocDeriveError(t *testing.T) {
 
 	cd := &coredocumentpb.CoreDocument{}
 
-	req := &p2ppb.AnchorDocumentRequest{
+	req := &p2ppb.SignatureRequest{
 		Document: cd,
 	}
 
@@ -923,7 +1091,7 @@ func TestHandler_HandleSendAnchoredDocument_DocDeriveError(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
+			Type:              p2pcommon.MessageTypeRequestSignature.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -936,14 +1104,14 @@ func TestHandler_HandleSendAnchoredDocument_DocDeriveError(t *testing.T) {
 		On("DeriveFromCoreDocument", req.GetDocument()).
 		Return(nil, errors.New("error")).Once()
 
-	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleSendAnchoredDocument_ReceiveDocumentError(t *testing.T) {
+func TestHandler_HandleRequestDocumentSignature_RequestDocumentSignatureError(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -956,7 +1124,7 @@ func TestHandler_HandleSendAnchoredDocument_ReceiveDocumentError(t *testing.T) {
 
 	cd := &coredocumentpb.CoreDocument{}
 
-	req := &p2ppb.AnchorDocumentRequest{
+	req := &p2ppb.SignatureRequest{
 		Document: cd,
 	}
 
@@ -968,7 +1136,7 @@ func TestHandler_HandleSendAnchoredDocument_ReceiveDocumentError(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
+			Type:              p2pcommon.MessageTypeRequestSignature.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -985,21 +1153,21 @@ func TestHandler_HandleSendAnchoredDocument_ReceiveDocumentError(t *testing.T) {
 
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
-			"ReceiveAnchoredDocument",
+			"RequestDocumentSignature",
 			mock.Anything,
 			documentMock,
 			senderAccountID,
 		).
-		Return(errors.New("error")).Once()
+		Return(nil, errors.New("error")).Once()
 
-	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleRequestDocumentSignature(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_RequesterVerification(t *testing.T) {
+func TestHandler_HandleSendAnchoredDocument(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1016,9 +1184,10 @@ func TestHandler_HandleGetDocument_RequesterVerification(t *testing.T) {
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	cd := &coredocumentpb.CoreDocument{}
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1029,7 +1198,7 @@ func TestHandler_HandleGetDocument_RequesterVerification(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -1040,29 +1209,24 @@ func TestHandler_HandleGetDocument_RequesterVerification(t *testing.T) {
 
 	documentMock := documents.NewDocumentMock(t)
 
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On("DeriveFromCoreDocument", req.GetDocument()).
+		Return(documentMock, nil).Once()
+
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
-			"GetCurrentVersion",
+			"ReceiveAnchoredDocument",
 			mock.Anything,
-			req.GetDocumentIdentifier(),
+			documentMock,
+			senderAccountID,
 		).
-		Return(documentMock, nil).Once()
-
-	cd := &coredocumentpb.CoreDocument{}
-
-	documentMock.On("AccountCanRead", senderAccountID).
-		Return(true).
-		Once()
-
-	documentMock.On("PackCoreDocument").
-		Return(cd, nil).
-		Once()
+		Return(nil).Once()
 
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).
 		On("GetNetworkID").
 		Return(uint32(36)).Once()
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
@@ -1071,136 +1235,58 @@ func TestHandler_HandleGetDocument_RequesterVerification(t *testing.T) {
 	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
 	assert.NoError(t, err)
 
-	var getDocumentRes p2ppb.GetDocumentResponse
+	var anchorDoc p2ppb.AnchorDocumentResponse
 
-	err = proto.Unmarshal(responseEnvelope.GetBody(), &getDocumentRes)
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &anchorDoc)
 	assert.NoError(t, err)
 
-	assert.NotNil(t, getDocumentRes.GetDocument())
+	assert.True(t, anchorDoc.GetAccepted())
 }
 
-func TestHandler_HandleGetDocument_NFTOwnerVerification(t *testing.T) {
-	handler, mocks := getHandlerWithMocks(t)
+func TestHandler_HandleSendAnchoredDocument_RequestDecodeError(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
 
 	ctx := context.Background()
 
 	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	accountMock := config.NewAccountMock(t)
-	accountMock.On("GetIdentity").
-		Return(identity)
-
-	ctx = contextutil.WithAccount(ctx, accountMock)
-
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	collectionID := types.U64(1111)
-	encodedCollectionID, err := codec.Encode(collectionID)
-	assert.NoError(t, err)
-
-	itemID := types.NewU128(*big.NewInt(2222))
-	encodedItemID, err := codec.Encode(itemID)
-	assert.NoError(t, err)
-
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
-		NftCollectionId:    encodedCollectionID,
-		NftItemId:          encodedItemID,
-	}
-
-	encodedReq, err := proto.Marshal(req)
-	assert.NoError(t, err)
-
 	env := &p2ppb.Envelope{
 		Header: &p2ppb.Header{
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
 			Timestamp:         timestamppb.Now(),
 		},
-		Body: encodedReq,
+		Body: utils.RandomSlice(32),
 	}
 
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
-	documentMock := documents.NewDocumentMock(t)
-
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On(
-			"GetCurrentVersion",
-			mock.Anything,
-			req.GetDocumentIdentifier(),
-		).
-		Return(documentMock, nil).Once()
-
-	cd := &coredocumentpb.CoreDocument{}
-
-	documentMock.On("AccountCanRead", senderAccountID).
-		Return(true).
-		Once()
-
-	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
-		Return(true).
-		Once()
-
-	genericUtils.GetMock[*nftv3.ServiceMock](mocks).
-		On("GetNFTOwner", collectionID, itemID).
-		Return(senderAccountID, nil).Once()
-
-	documentMock.On("PackCoreDocument").
-		Return(cd, nil).
-		Once()
-
-	genericUtils.GetMock[*config.ConfigurationMock](mocks).
-		On("GetNetworkID").
-		Return(uint32(36)).Once()
-
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
-	var responseEnvelope p2ppb.Envelope
-
-	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
-	assert.NoError(t, err)
-
-	var getDocumentRes p2ppb.GetDocumentResponse
-
-	err = proto.Unmarshal(responseEnvelope.GetBody(), &getDocumentRes)
-	assert.NoError(t, err)
-
-	assert.NotNil(t, getDocumentRes.GetDocument())
+	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_AccessTokenVerification(t *testing.T) {
-	handler, mocks := getHandlerWithMocks(t)
+func TestHandler_HandleSendAnchoredDocument_InvalidSenderID(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
 
 	ctx := context.Background()
 
 	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	accountMock := config.NewAccountMock(t)
-	accountMock.On("GetIdentity").
-		Return(identity)
-
-	ctx = contextutil.WithAccount(ctx, accountMock)
-
-	senderAccountID, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
+	cd := &coredocumentpb.CoreDocument{}
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
-		AccessTokenRequest: &p2ppb.AccessTokenRequest{
-			DelegatingDocumentIdentifier: utils.RandomSlice(32),
-			AccessTokenId:                utils.RandomSlice(32),
-		},
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1210,9 +1296,10 @@ func TestHandler_HandleGetDocument_AccessTokenVerification(t *testing.T) {
 		Header: &p2ppb.Header{
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
-			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
-			Timestamp:         timestamppb.Now(),
+			// Invalid sender ID account bytes.
+			SenderId:  utils.RandomSlice(11),
+			Type:      p2pcommon.MessageTypeSendAnchoredDoc.String(),
+			Timestamp: timestamppb.Now(),
 		},
 		Body: encodedReq,
 	}
@@ -1220,64 +1307,14 @@ func TestHandler_HandleGetDocument_AccessTokenVerification(t *testing.T) {
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
-	documentMock := documents.NewDocumentMock(t)
-
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On(
-			"GetCurrentVersion",
-			mock.Anything,
-			req.GetDocumentIdentifier(),
-		).
-		Return(documentMock, nil).Once()
-
-	entityRelationshipMock := documents.NewDocumentMock(t)
-
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On(
-			"GetCurrentVersion",
-			mock.Anything,
-			req.GetAccessTokenRequest().GetDelegatingDocumentIdentifier(),
-		).
-		Return(entityRelationshipMock, nil).Once()
-
-	entityRelationshipMock.On(
-		"ATGranteeCanRead",
-		mock.Anything,
-		handler.docSrv,
-		handler.identityService,
-		req.GetAccessTokenRequest().GetAccessTokenId(),
-		req.GetDocumentIdentifier(),
-		senderAccountID,
-	).Return(nil).Once()
-
-	cd := &coredocumentpb.CoreDocument{}
-
-	documentMock.On("PackCoreDocument").
-		Return(cd, nil).
-		Once()
-
-	genericUtils.GetMock[*config.ConfigurationMock](mocks).
-		On("GetNetworkID").
-		Return(uint32(36)).Once()
-
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
-	var responseEnvelope p2ppb.Envelope
-
-	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
-	assert.NoError(t, err)
-
-	var getDocumentRes p2ppb.GetDocumentResponse
-
-	err = proto.Unmarshal(responseEnvelope.GetBody(), &getDocumentRes)
-	assert.NoError(t, err)
-
-	assert.NotNil(t, getDocumentRes.GetDocument())
+	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_RequestDecodeError(t *testing.T) {
+func TestHandler_HandleSendAnchoredDocument_NilDocument(t *testing.T) {
 	handler, _ := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1288,39 +1325,7 @@ func TestHandler_HandleGetDocument_RequestDecodeError(t *testing.T) {
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	env := &p2ppb.Envelope{
-		Header: &p2ppb.Header{
-			NetworkIdentifier: 36,
-			NodeVersion:       version.GetVersion().String(),
-			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
-			Timestamp:         timestamppb.Now(),
-		},
-		Body: utils.RandomSlice(32),
-	}
-
-	peerID := libp2ppeer.ID("peer-id")
-	protocolID := p2pcommon.ProtocolForIdentity(identity)
-
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
-	assert.NoError(t, err)
-	assert.NotNil(t, res)
-
-	assertErrorEnvelope(t, res)
-}
-
-func TestHandler_HandleGetDocument_InvalidSenderID(t *testing.T) {
-	handler, _ := getHandlerWithMocks(t)
-
-	ctx := context.Background()
-
-	identity, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
-
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
-	}
+	req := &p2ppb.AnchorDocumentRequest{}
 
 	encodedReq, err := proto.Marshal(req)
 	assert.NoError(t, err)
@@ -1329,10 +1334,9 @@ func TestHandler_HandleGetDocument_InvalidSenderID(t *testing.T) {
 		Header: &p2ppb.Header{
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
-			// Invalid sender ID account bytes.
-			SenderId:  utils.RandomSlice(11),
-			Type:      p2pcommon.MessageTypeGetDoc.String(),
-			Timestamp: timestamppb.Now(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
+			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
 	}
@@ -1340,14 +1344,14 @@ func TestHandler_HandleGetDocument_InvalidSenderID(t *testing.T) {
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_GetCurrentVersionError(t *testing.T) {
+func TestHandler_HandleSendAnchoredDocument_DocDeriveError(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1358,9 +1362,10 @@ func TestHandler_HandleGetDocument_GetCurrentVersionError(t *testing.T) {
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	cd := &coredocumentpb.CoreDocument{}
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1371,7 +1376,7 @@ func TestHandler_HandleGetDocument_GetCurrentVersionError(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -1381,21 +1386,17 @@ func TestHandler_HandleGetDocument_GetCurrentVersionError(t *testing.T) {
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On(
-			"GetCurrentVersion",
-			mock.Anything,
-			req.GetDocumentIdentifier(),
-		).
+		On("DeriveFromCoreDocument", req.GetDocument()).
 		Return(nil, errors.New("error")).Once()
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_PackCoreDocumentError(t *testing.T) {
+func TestHandler_HandleSendAnchoredDocument_ReceiveDocumentError(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1406,9 +1407,10 @@ func TestHandler_HandleGetDocument_PackCoreDocumentError(t *testing.T) {
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	cd := &coredocumentpb.CoreDocument{}
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1419,7 +1421,7 @@ func TestHandler_HandleGetDocument_PackCoreDocumentError(t *testing.T) {
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeSendAnchoredDoc.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -1430,30 +1432,27 @@ func TestHandler_HandleGetDocument_PackCoreDocumentError(t *testing.T) {
 
 	documentMock := documents.NewDocumentMock(t)
 
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On("DeriveFromCoreDocument", req.GetDocument()).
+		Return(documentMock, nil).Once()
+
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
-			"GetCurrentVersion",
+			"ReceiveAnchoredDocument",
 			mock.Anything,
-			req.GetDocumentIdentifier(),
+			documentMock,
+			senderAccountID,
 		).
-		Return(documentMock, nil).Once()
-
-	documentMock.On("AccountCanRead", senderAccountID).
-		Return(true).
-		Once()
-
-	documentMock.On("PackCoreDocument").
-		Return(nil, errors.New("error")).
-		Once()
+		Return(errors.New("error")).Once()
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleSendAnchoredDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_RequesterVerification_AccountCannotRead(t *testing.T) {
+func TestHandler_HandleSendEncryptedAnchoredDocument(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1464,23 +1463,34 @@ func TestHandler_HandleGetDocument_RequesterVerification_AccountCannotRead(t *te
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	cd := &coredocumentpb.CoreDocument{}
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
 	}
 
 	encodedReq, err := proto.Marshal(req)
 	assert.NoError(t, err)
 
+	encryptedReq := utils.RandomSlice(64)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+	accountMock.On("DecryptMsg", encryptedReq).
+		Return(encodedReq, nil).Once()
+
+	ctx = contextutil.WithAccount(ctx, accountMock)
+
 	env := &p2ppb.Envelope{
 		Header: &p2ppb.Header{
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeSendEncryptedAnchoredDoc.String(),
 			Timestamp:         timestamppb.Now(),
 		},
-		Body: encodedReq,
+		Body: encryptedReq,
 	}
 
 	peerID := libp2ppeer.ID("peer-id")
@@ -1488,17 +1498,1225 @@ func TestHandler_HandleGetDocument_RequesterVerification_AccountCannotRead(t *te
 
 	documentMock := documents.NewDocumentMock(t)
 
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On("DeriveFromCoreDocument", mock.MatchedBy(func(doc *coredocumentpb.CoreDocument) bool {
+			return proto.Equal(cd, doc)
+		})).
+		Return(documentMock, nil).Once()
+
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
-			"GetCurrentVersion",
+			"ReceiveAnchoredDocument",
 			mock.Anything,
-			req.GetDocumentIdentifier(),
+			documentMock,
+			senderAccountID,
 		).
-		Return(documentMock, nil).Once()
+		Return(nil).Once()
 
-	documentMock.On("AccountCanRead", senderAccountID).
-		Return(false).
-		Once()
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetNetworkID").
+		Return(uint32(36)).Once()
+
+	res, err := handler.HandleSendEncryptedAnchoredDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	var responseEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
+
+	assert.Equal(t, p2pcommon.MessageTypeSendAnchoredDocRep.String(), responseEnvelope.GetHeader().GetType())
+
+	var anchorDoc p2ppb.AnchorDocumentResponse
+
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &anchorDoc)
+	assert.NoError(t, err)
+
+	assert.True(t, anchorDoc.GetAccepted())
+}
+
+func TestHandler_HandleSendEncryptedAnchoredDocument_NoAccount(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeSendEncryptedAnchoredDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: utils.RandomSlice(64),
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	res, err := handler.HandleSendEncryptedAnchoredDocument(context.Background(), peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleSendEncryptedAnchoredDocument_DecryptionError(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	encryptedReq := utils.RandomSlice(64)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("DecryptMsg", encryptedReq).
+		Return(nil, errors.New("error")).Once()
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeSendEncryptedAnchoredDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encryptedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	res, err := handler.HandleSendEncryptedAnchoredDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleSendAnchoredDocumentChunk(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	cd := &coredocumentpb.CoreDocument{
+		DocumentIdentifier: utils.RandomSlice(32),
+	}
+
+	req := &p2ppb.AnchorDocumentRequest{
+		Document: cd,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, encodedReq, 16)
+	assert.NoError(t, err)
+	assert.Len(t, chunks, 3)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	getChunkEnvelope := func(chunk *p2pcommon.Chunk) *p2ppb.Envelope {
+		return &p2ppb.Envelope{
+			Header: &p2ppb.Header{
+				NetworkIdentifier: 36,
+				NodeVersion:       version.GetVersion().String(),
+				SenderId:          senderAccountID.ToBytes(),
+				Type:              p2pcommon.MessageTypeSendAnchoredDocChunk.String(),
+				Timestamp:         timestamppb.Now(),
+			},
+			Body: chunk.Encode(),
+		}
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	assertChunkAck := func(res *protocolpb.P2PEnvelope, expectedNextIndex uint32) {
+		var responseEnvelope p2ppb.Envelope
+
+		err := proto.Unmarshal(res.GetBody(), &responseEnvelope)
+		assert.NoError(t, err)
+
+		assert.Equal(t, p2pcommon.MessageTypeSendAnchoredDocChunkRep.String(), responseEnvelope.GetHeader().GetType())
+
+		nextIndex, err := p2pcommon.DecodeChunkAck(responseEnvelope.GetBody())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedNextIndex, nextIndex)
+	}
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetNetworkID").
+		Return(uint32(36)).Times(3)
+
+	res, err := handler.HandleSendAnchoredDocumentChunk(ctx, peerID, protocolID, getChunkEnvelope(chunks[0]))
+	assert.NoError(t, err)
+	assertChunkAck(res, 1)
+
+	// The chunks received out of order are kept, the next index expected remains the same.
+	res, err = handler.HandleSendAnchoredDocumentChunk(ctx, peerID, protocolID, getChunkEnvelope(chunks[2]))
+	assert.NoError(t, err)
+	assertChunkAck(res, 1)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On("DeriveFromCoreDocument", mock.MatchedBy(func(doc *coredocumentpb.CoreDocument) bool {
+			return proto.Equal(cd, doc)
+		})).
+		Return(documentMock, nil).Once()
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"ReceiveAnchoredDocument",
+			mock.Anything,
+			documentMock,
+			senderAccountID,
+		).
+		Return(nil).Once()
+
+	res, err = handler.HandleSendAnchoredDocumentChunk(ctx, peerID, protocolID, getChunkEnvelope(chunks[1]))
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	var responseEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
+
+	assert.Equal(t, p2pcommon.MessageTypeSendAnchoredDocRep.String(), responseEnvelope.GetHeader().GetType())
+
+	var anchorDoc p2ppb.AnchorDocumentResponse
+
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &anchorDoc)
+	assert.NoError(t, err)
+
+	assert.True(t, anchorDoc.GetAccepted())
+}
+
+func TestHandler_HandleSendAnchoredDocumentChunk_InvalidChunk(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeSendAnchoredDocChunk.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: utils.RandomSlice(64),
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	res, err := handler.HandleSendAnchoredDocumentChunk(context.Background(), peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleSendAnchoredDocumentChunk_UnsupportedMessageType(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeGetDoc, utils.RandomSlice(32), 16)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeSendAnchoredDocChunk.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: chunks[0].Encode(),
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	res, err := handler.HandleSendAnchoredDocumentChunk(context.Background(), peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleSendAnchoredDocumentChunk_NoAccount(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	chunks, err := p2pcommon.SplitIntoChunks(p2pcommon.MessageTypeSendAnchoredDoc, utils.RandomSlice(32), 16)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeSendAnchoredDocChunk.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: chunks[0].Encode(),
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	res, err := handler.HandleSendAnchoredDocumentChunk(context.Background(), peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_RequesterVerification(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx = contextutil.WithAccount(ctx, accountMock)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	cd := &coredocumentpb.CoreDocument{}
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("PackCoreDocument").
+		Return(cd, nil).
+		Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetNetworkID").
+		Return(uint32(36)).Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	var responseEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
+
+	var getDocumentRes p2ppb.GetDocumentResponse
+
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &getDocumentRes)
+	assert.NoError(t, err)
+
+	assert.NotNil(t, getDocumentRes.GetDocument())
+}
+
+func TestHandler_HandleGetDocument_NFTOwnerVerification(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx = contextutil.WithAccount(ctx, accountMock)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collectionID := types.U64(1111)
+	encodedCollectionID, err := codec.Encode(collectionID)
+	assert.NoError(t, err)
+
+	itemID := types.NewU128(*big.NewInt(2222))
+	encodedItemID, err := codec.Encode(itemID)
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+		NftCollectionId:    encodedCollectionID,
+		NftItemId:          encodedItemID,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	cd := &coredocumentpb.CoreDocument{}
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
+		Return(true).
+		Once()
+
+	genericUtils.GetMock[*nftv3.ServiceMock](mocks).
+		On("GetNFTOwner", collectionID, itemID).
+		Return(senderAccountID, nil).Once()
+
+	documentMock.On("PackCoreDocument").
+		Return(cd, nil).
+		Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetNetworkID").
+		Return(uint32(36)).Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	var responseEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
+
+	var getDocumentRes p2ppb.GetDocumentResponse
+
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &getDocumentRes)
+	assert.NoError(t, err)
+
+	assert.NotNil(t, getDocumentRes.GetDocument())
+}
+
+func TestHandler_HandleGetDocument_AccessTokenVerification(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx = contextutil.WithAccount(ctx, accountMock)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
+		AccessTokenRequest: &p2ppb.AccessTokenRequest{
+			DelegatingDocumentIdentifier: utils.RandomSlice(32),
+			AccessTokenId:                utils.RandomSlice(32),
+		},
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	entityRelationshipMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetAccessTokenRequest().GetDelegatingDocumentIdentifier(),
+		).
+		Return(entityRelationshipMock, nil).Once()
+
+	entityRelationshipMock.On(
+		"ATGranteeCanRead",
+		mock.Anything,
+		handler.docSrv,
+		handler.identityService,
+		req.GetAccessTokenRequest().GetAccessTokenId(),
+		req.GetDocumentIdentifier(),
+		senderAccountID,
+	).Return(nil).Once()
+
+	cd := &coredocumentpb.CoreDocument{}
+
+	documentMock.On("PackCoreDocument").
+		Return(cd, nil).
+		Once()
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetNetworkID").
+		Return(uint32(36)).Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	var responseEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
+
+	var getDocumentRes p2ppb.GetDocumentResponse
+
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &getDocumentRes)
+	assert.NoError(t, err)
+
+	assert.NotNil(t, getDocumentRes.GetDocument())
+}
+
+func TestHandler_HandleGetDocument_RequestDecodeError(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: utils.RandomSlice(32),
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_InvalidSenderID(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			// Invalid sender ID account bytes.
+			SenderId:  utils.RandomSlice(11),
+			Type:      p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp: timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_GetCurrentVersionError(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(nil, errors.New("error")).Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_PackCoreDocumentError(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("PackCoreDocument").
+		Return(nil, errors.New("error")).
+		Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_RequesterVerification_AccountCannotRead(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(false).
+		Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_NFTOwnerVerification_AccountCannotRead(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collectionID := types.U64(1111)
+	encodedCollectionID, err := codec.Encode(collectionID)
+	assert.NoError(t, err)
+
+	itemID := types.NewU128(*big.NewInt(2222))
+	encodedItemID, err := codec.Encode(itemID)
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+		NftCollectionId:    encodedCollectionID,
+		NftItemId:          encodedItemID,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(false).
+		Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTCannotRead(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collectionID := types.U64(1111)
+	encodedCollectionID, err := codec.Encode(collectionID)
+	assert.NoError(t, err)
+
+	itemID := types.NewU128(*big.NewInt(2222))
+	encodedItemID, err := codec.Encode(itemID)
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+		NftCollectionId:    encodedCollectionID,
+		NftItemId:          encodedItemID,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
+		Return(false).
+		Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTCollectionID(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	itemID := types.NewU128(*big.NewInt(2222))
+	encodedItemID, err := codec.Encode(itemID)
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+		// Invalid collection ID bytes.
+		NftCollectionId: []byte{1},
+		NftItemId:       encodedItemID,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("NFTCanRead", req.GetNftCollectionId(), req.GetNftItemId()).
+		Return(true).
+		Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTItemID(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collectionID := types.U64(1111)
+	encodedCollectionID, err := codec.Encode(collectionID)
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+		NftCollectionId:    encodedCollectionID,
+		// Invalid item ID bytes.
+		NftItemId: []byte{1},
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("NFTCanRead", req.GetNftCollectionId(), req.GetNftItemId()).
+		Return(true).
+		Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerRetrievalError(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collectionID := types.U64(1111)
+	encodedCollectionID, err := codec.Encode(collectionID)
+	assert.NoError(t, err)
+
+	itemID := types.NewU128(*big.NewInt(2222))
+	encodedItemID, err := codec.Encode(itemID)
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+		NftCollectionId:    encodedCollectionID,
+		NftItemId:          encodedItemID,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
+		Return(true).
+		Once()
+
+	genericUtils.GetMock[*nftv3.ServiceMock](mocks).
+		On("GetNFTOwner", collectionID, itemID).
+		Return(nil, errors.New("error")).Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerDifference(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	collectionID := types.U64(1111)
+	encodedCollectionID, err := codec.Encode(collectionID)
+	assert.NoError(t, err)
+
+	itemID := types.NewU128(*big.NewInt(2222))
+	encodedItemID, err := codec.Encode(itemID)
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+		NftCollectionId:    encodedCollectionID,
+		NftItemId:          encodedItemID,
+	}
+
+	encodedReq, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
+		Return(true).
+		Once()
+
+	randomAccountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*nftv3.ServiceMock](mocks).
+		On("GetNFTOwner", collectionID, itemID).
+		Return(randomAccountID, nil).Once()
 
 	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
@@ -1507,7 +2725,7 @@ func TestHandler_HandleGetDocument_RequesterVerification_AccountCannotRead(t *te
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_NFTOwnerVerification_AccountCannotRead(t *testing.T) {
+func TestHandler_HandleGetDocument_AccessTokenVerification_NilAccessTokenRequest(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1518,19 +2736,63 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_AccountCannotRead(t *tes
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	collectionID := types.U64(1111)
-	encodedCollectionID, err := codec.Encode(collectionID)
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
+	}
+
+	encodedReq, err := proto.Marshal(req)
 	assert.NoError(t, err)
 
-	itemID := types.NewU128(*big.NewInt(2222))
-	encodedItemID, err := codec.Encode(itemID)
+	env := &p2ppb.Envelope{
+		Header: &p2ppb.Header{
+			NetworkIdentifier: 36,
+			NodeVersion:       version.GetVersion().String(),
+			SenderId:          senderAccountID.ToBytes(),
+			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Timestamp:         timestamppb.Now(),
+		},
+		Body: encodedReq,
+	}
+
+	peerID := libp2ppeer.ID("peer-id")
+	protocolID := p2pcommon.ProtocolForIdentity(identity)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetDocumentIdentifier(),
+		).
+		Return(documentMock, nil).Once()
+
+	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_HandleGetDocument_AccessTokenVerification_EntityRelationshipRetrievalError(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	req := &p2ppb.GetDocumentRequest{
 		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
-		NftCollectionId:    encodedCollectionID,
-		NftItemId:          encodedItemID,
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
+		AccessTokenRequest: &p2ppb.AccessTokenRequest{
+			DelegatingDocumentIdentifier: utils.RandomSlice(32),
+			AccessTokenId:                utils.RandomSlice(32),
+		},
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1560,9 +2822,13 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_AccountCannotRead(t *tes
 		).
 		Return(documentMock, nil).Once()
 
-	documentMock.On("AccountCanRead", senderAccountID).
-		Return(false).
-		Once()
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetAccessTokenRequest().GetDelegatingDocumentIdentifier(),
+		).
+		Return(nil, errors.New("error")).Once()
 
 	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
@@ -1571,7 +2837,7 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_AccountCannotRead(t *tes
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTCannotRead(t *testing.T) {
+func TestHandler_HandleGetDocument_AccessTokenVerification_AccessTokenCannotRead(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1582,19 +2848,13 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTCannotRead(t *testing
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	collectionID := types.U64(1111)
-	encodedCollectionID, err := codec.Encode(collectionID)
-	assert.NoError(t, err)
-
-	itemID := types.NewU128(*big.NewInt(2222))
-	encodedItemID, err := codec.Encode(itemID)
-	assert.NoError(t, err)
-
 	req := &p2ppb.GetDocumentRequest{
 		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
-		NftCollectionId:    encodedCollectionID,
-		NftItemId:          encodedItemID,
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
+		AccessTokenRequest: &p2ppb.AccessTokenRequest{
+			DelegatingDocumentIdentifier: utils.RandomSlice(32),
+			AccessTokenId:                utils.RandomSlice(32),
+		},
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1624,13 +2884,25 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTCannotRead(t *testing
 		).
 		Return(documentMock, nil).Once()
 
-	documentMock.On("AccountCanRead", senderAccountID).
-		Return(true).
-		Once()
+	entityRelationshipMock := documents.NewDocumentMock(t)
 
-	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
-		Return(false).
-		Once()
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On(
+			"GetCurrentVersion",
+			mock.Anything,
+			req.GetAccessTokenRequest().GetDelegatingDocumentIdentifier(),
+		).
+		Return(entityRelationshipMock, nil).Once()
+
+	entityRelationshipMock.On(
+		"ATGranteeCanRead",
+		mock.Anything,
+		handler.docSrv,
+		handler.identityService,
+		req.GetAccessTokenRequest().GetAccessTokenId(),
+		req.GetDocumentIdentifier(),
+		senderAccountID,
+	).Return(errors.New("error")).Once()
 
 	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
@@ -1639,7 +2911,7 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTCannotRead(t *testing
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTCollectionID(t *testing.T) {
+func TestHandler_HandleGetLatestVersion(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1647,19 +2919,17 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTCollectionID(t
 	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	senderAccountID, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
 
-	itemID := types.NewU128(*big.NewInt(2222))
-	encodedItemID, err := codec.Encode(itemID)
+	ctx = contextutil.WithAccount(ctx, accountMock)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	req := &p2ppb.GetDocumentRequest{
 		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
-		// Invalid collection ID bytes.
-		NftCollectionId: []byte{1},
-		NftItemId:       encodedItemID,
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1670,7 +2940,7 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTCollectionID(t
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeGetLatestVersion.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -1689,22 +2959,46 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTCollectionID(t
 		).
 		Return(documentMock, nil).Once()
 
+	documentMock.On("GetStatus").
+		Return(documents.Committed).
+		Once()
+
+	cd := &coredocumentpb.CoreDocument{
+		DocumentIdentifier: req.GetDocumentIdentifier(),
+		CurrentVersion:     utils.RandomSlice(32),
+	}
+
 	documentMock.On("AccountCanRead", senderAccountID).
 		Return(true).
 		Once()
 
-	documentMock.On("NFTCanRead", req.GetNftCollectionId(), req.GetNftItemId()).
-		Return(true).
+	documentMock.On("PackCoreDocument").
+		Return(cd, nil).
 		Once()
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetNetworkID").
+		Return(uint32(36)).Once()
+
+	res, err := handler.HandleGetLatestVersion(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
-	assertErrorEnvelope(t, res)
+	var responseEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
+	assert.Equal(t, p2pcommon.MessageTypeGetLatestVersionRep.String(), responseEnvelope.GetHeader().GetType())
+
+	var getDocumentRes p2ppb.GetDocumentResponse
+
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &getDocumentRes)
+	assert.NoError(t, err)
+
+	assert.Equal(t, cd.GetCurrentVersion(), getDocumentRes.GetDocument().GetCurrentVersion())
 }
 
-func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTItemID(t *testing.T) {
+func TestHandler_HandleGetLatestVersion_AccountCannotRead(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1715,16 +3009,8 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTItemID(t *test
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	collectionID := types.U64(1111)
-	encodedCollectionID, err := codec.Encode(collectionID)
-	assert.NoError(t, err)
-
 	req := &p2ppb.GetDocumentRequest{
 		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
-		NftCollectionId:    encodedCollectionID,
-		// Invalid item ID bytes.
-		NftItemId: []byte{1},
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1735,7 +3021,7 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTItemID(t *test
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeGetLatestVersion.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -1754,22 +3040,22 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_InvalidNFTItemID(t *test
 		).
 		Return(documentMock, nil).Once()
 
-	documentMock.On("AccountCanRead", senderAccountID).
-		Return(true).
+	documentMock.On("GetStatus").
+		Return(documents.Committed).
 		Once()
 
-	documentMock.On("NFTCanRead", req.GetNftCollectionId(), req.GetNftItemId()).
-		Return(true).
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(false).
 		Once()
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	res, err := handler.HandleGetLatestVersion(ctx, peerID, protocolID, env)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
 	assertErrorEnvelope(t, res)
 }
 
-func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerRetrievalError(t *testing.T) {
+func TestHandler_HandleGetLatestVersion_GetCurrentVersionError(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1780,19 +3066,8 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerRetrievalError(t
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	collectionID := types.U64(1111)
-	encodedCollectionID, err := codec.Encode(collectionID)
-	assert.NoError(t, err)
-
-	itemID := types.NewU128(*big.NewInt(2222))
-	encodedItemID, err := codec.Encode(itemID)
-	assert.NoError(t, err)
-
 	req := &p2ppb.GetDocumentRequest{
 		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
-		NftCollectionId:    encodedCollectionID,
-		NftItemId:          encodedItemID,
 	}
 
 	encodedReq, err := proto.Marshal(req)
@@ -1803,7 +3078,7 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerRetrievalError(t
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeGetLatestVersion.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
@@ -1812,36 +3087,109 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerRetrievalError(t
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
-	documentMock := documents.NewDocumentMock(t)
-
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
 			"GetCurrentVersion",
 			mock.Anything,
 			req.GetDocumentIdentifier(),
 		).
-		Return(documentMock, nil).Once()
+		Return(nil, errors.New("error")).Once()
 
-	documentMock.On("AccountCanRead", senderAccountID).
-		Return(true).
+	res, err := handler.HandleGetLatestVersion(ctx, peerID, protocolID, env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	assertErrorEnvelope(t, res)
+}
+
+func TestHandler_GetLatestVersion_PendingVersion(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	requester, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+	}
+
+	currentDocumentMock := documents.NewDocumentMock(t)
+	committedDocumentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On("GetCurrentVersion", ctx, req.GetDocumentIdentifier()).
+		Return(currentDocumentMock, nil).Once()
+
+	previousVersion := utils.RandomSlice(32)
+
+	// The current version is not anchored yet, the previous one is returned instead.
+	currentDocumentMock.On("GetStatus").
+		Return(documents.Pending).
 		Once()
 
-	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
+	currentDocumentMock.On("PreviousVersion").
+		Return(previousVersion).
+		Once()
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On("GetVersion", ctx, req.GetDocumentIdentifier(), previousVersion).
+		Return(committedDocumentMock, nil).Once()
+
+	committedDocumentMock.On("GetStatus").
+		Return(documents.Committed).
+		Once()
+
+	committedDocumentMock.On("AccountCanRead", requester).
 		Return(true).
 		Once()
 
-	genericUtils.GetMock[*nftv3.ServiceMock](mocks).
-		On("GetNFTOwner", collectionID, itemID).
-		Return(nil, errors.New("error")).Once()
+	cd := &coredocumentpb.CoreDocument{
+		DocumentIdentifier: req.GetDocumentIdentifier(),
+		CurrentVersion:     previousVersion,
+	}
+
+	committedDocumentMock.On("PackCoreDocument").
+		Return(cd, nil).
+		Once()
+
+	res, err := handler.GetLatestVersion(ctx, req, requester)
+	assert.NoError(t, err)
+	assert.Equal(t, cd, res.GetDocument())
+}
+
+func TestHandler_GetLatestVersion_NoCommittedVersion(t *testing.T) {
+	handler, mocks := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	requester, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: utils.RandomSlice(32),
+	}
+
+	documentMock := documents.NewDocumentMock(t)
+
+	genericUtils.GetMock[*documents.ServiceMock](mocks).
+		On("GetCurrentVersion", ctx, req.GetDocumentIdentifier()).
+		Return(documentMock, nil).Once()
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
-	assert.NoError(t, err)
-	assert.NotNil(t, res)
+	documentMock.On("GetStatus").
+		Return(documents.Pending).
+		Once()
 
-	assertErrorEnvelope(t, res)
+	documentMock.On("PreviousVersion").
+		Return(nil).
+		Once()
+
+	res, err := handler.GetLatestVersion(ctx, req, requester)
+	assert.ErrorIs(t, err, documents.ErrDocumentVersionNotFound)
+	assert.Nil(t, res)
 }
 
-func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerDifference(t *testing.T) {
+func TestHandler_HandleInterceptor_GetAttachment(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
 	ctx := context.Background()
@@ -1852,22 +3200,12 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerDifference(t *te
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	collectionID := types.U64(1111)
-	encodedCollectionID, err := codec.Encode(collectionID)
-	assert.NoError(t, err)
-
-	itemID := types.NewU128(*big.NewInt(2222))
-	encodedItemID, err := codec.Encode(itemID)
-	assert.NoError(t, err)
-
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
-		NftCollectionId:    encodedCollectionID,
-		NftItemId:          encodedItemID,
+	req := &p2pcommon.AttachmentRequest{
+		DocumentID: utils.RandomSlice(32),
+		Hash:       utils.RandomSlice(32),
 	}
 
-	encodedReq, err := proto.Marshal(req)
+	encodedReq, err := req.Encode()
 	assert.NoError(t, err)
 
 	env := &p2ppb.Envelope{
@@ -1875,22 +3213,51 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerDifference(t *te
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeGetAttachment.String(),
 			Timestamp:         timestamppb.Now(),
 		},
 		Body: encodedReq,
 	}
 
+	encodedEnv, err := proto.Marshal(env)
+	assert.NoError(t, err)
+
+	msg := &protocolpb.P2PEnvelope{
+		Body: encodedEnv,
+	}
+
 	peerID := libp2ppeer.ID("peer-id")
 	protocolID := p2pcommon.ProtocolForIdentity(identity)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetP2PResponseDelay").
+		Return(0 * time.Second).Once()
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	genericUtils.GetMock[*config.ServiceMock](mocks).
+		On("GetAccount", identity.ToBytes()).
+		Return(accountMock, nil).Once()
+
+	genericUtils.GetMock[*ValidatorMock](mocks).
+		On("Validate", mock.IsType(env.GetHeader()), senderAccountID, &peerID).
+		Return(nil).Once()
+
+	att, err := documents.NewAttachment(req.Hash, 128, "application/pdf")
+	assert.NoError(t, err)
+
+	attr, err := documents.NewAttachmentAttribute("invoice_pdf", att)
+	assert.NoError(t, err)
+
 	documentMock := documents.NewDocumentMock(t)
 
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
 			"GetCurrentVersion",
 			mock.Anything,
-			req.GetDocumentIdentifier(),
+			req.DocumentID,
 		).
 		Return(documentMock, nil).Once()
 
@@ -1898,157 +3265,210 @@ func TestHandler_HandleGetDocument_NFTOwnerVerification_NFTOwnerDifference(t *te
 		Return(true).
 		Once()
 
-	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
-		Return(true).
+	documentMock.On("GetAttributes").
+		Return([]documents.Attribute{attr}).
 		Once()
 
-	randomAccountID, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
+	data := utils.RandomSlice(128)
 
-	genericUtils.GetMock[*nftv3.ServiceMock](mocks).
-		On("GetNFTOwner", collectionID, itemID).
-		Return(randomAccountID, nil).Once()
+	genericUtils.GetMock[*attachments.StoreMock](mocks).
+		On("Get", identity, req.Hash).
+		Return(&attachments.Blob{Hash: req.Hash, MIMEType: "application/pdf", Data: data}, nil).
+		Once()
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).
+		On("GetNetworkID").
+		Return(uint32(36)).Once()
+
+	res, err := handler.HandleInterceptor(ctx, peerID, protocolID, msg)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
-	assertErrorEnvelope(t, res)
+	var responseEnvelope p2ppb.Envelope
+
+	err = proto.Unmarshal(res.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
+	assert.Equal(t, p2pcommon.MessageTypeGetAttachmentRep.String(), responseEnvelope.GetHeader().GetType())
+	assert.Equal(t, data, responseEnvelope.GetBody())
 }
 
-func TestHandler_HandleGetDocument_AccessTokenVerification_NilAccessTokenRequest(t *testing.T) {
+func TestHandler_HandleGetAttachment_AccessDenied(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
-	ctx := context.Background()
-
 	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	senderAccountID, err := testingcommons.GetRandomAccountID()
-	assert.NoError(t, err)
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
-	}
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
-	encodedReq, err := proto.Marshal(req)
+	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	env := &p2ppb.Envelope{
-		Header: &p2ppb.Header{
-			NetworkIdentifier: 36,
-			NodeVersion:       version.GetVersion().String(),
-			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
-			Timestamp:         timestamppb.Now(),
-		},
-		Body: encodedReq,
+	req := &p2pcommon.AttachmentRequest{
+		DocumentID: utils.RandomSlice(32),
+		Hash:       utils.RandomSlice(32),
 	}
 
-	peerID := libp2ppeer.ID("peer-id")
-	protocolID := p2pcommon.ProtocolForIdentity(identity)
-
 	documentMock := documents.NewDocumentMock(t)
 
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
 			"GetCurrentVersion",
 			mock.Anything,
-			req.GetDocumentIdentifier(),
+			req.DocumentID,
 		).
-		Return(documentMock, nil).Once()
+		Return(documentMock, nil).Times(2)
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	// The requester cannot read the document.
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(false).
+		Once()
+
+	res, err := handler.GetAttachment(ctx, req, senderAccountID)
+	assert.ErrorIs(t, err, ErrAccessDenied)
+	assert.Nil(t, res)
+
+	// The attachment is not part of the document.
+	att, err := documents.NewAttachment(utils.RandomSlice(32), 128, "application/pdf")
 	assert.NoError(t, err)
-	assert.NotNil(t, res)
 
-	assertErrorEnvelope(t, res)
+	attr, err := documents.NewAttachmentAttribute("invoice_pdf", att)
+	assert.NoError(t, err)
+
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Once()
+
+	documentMock.On("GetAttributes").
+		Return([]documents.Attribute{attr}).
+		Once()
+
+	res, err = handler.GetAttachment(ctx, req, senderAccountID)
+	assert.ErrorIs(t, err, ErrAccessDenied)
+	assert.Nil(t, res)
 }
 
-func TestHandler_HandleGetDocument_AccessTokenVerification_EntityRelationshipRetrievalError(t *testing.T) {
+func TestHandler_HandleGetAttachment_NFTOwnerVerification(t *testing.T) {
 	handler, mocks := getHandlerWithMocks(t)
 
-	ctx := context.Background()
-
 	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
 	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
-		AccessTokenRequest: &p2ppb.AccessTokenRequest{
-			DelegatingDocumentIdentifier: utils.RandomSlice(32),
-			AccessTokenId:                utils.RandomSlice(32),
-		},
-	}
+	collectionID := types.U64(1111)
+	encodedCollectionID, err := codec.Encode(collectionID)
+	assert.NoError(t, err)
 
-	encodedReq, err := proto.Marshal(req)
+	itemID := types.NewU128(*big.NewInt(2222))
+	encodedItemID, err := codec.Encode(itemID)
 	assert.NoError(t, err)
 
-	env := &p2ppb.Envelope{
-		Header: &p2ppb.Header{
-			NetworkIdentifier: 36,
-			NodeVersion:       version.GetVersion().String(),
-			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
-			Timestamp:         timestamppb.Now(),
+	req := &p2pcommon.AttachmentRequest{
+		DocumentID: utils.RandomSlice(32),
+		Hash:       utils.RandomSlice(32),
+		Access: &p2ppb.GetDocumentRequest{
+			AccessType:      p2ppb.AccessType_ACCESS_TYPE_NFT_OWNER_VERIFICATION,
+			NftCollectionId: encodedCollectionID,
+			NftItemId:       encodedItemID,
 		},
-		Body: encodedReq,
 	}
 
-	peerID := libp2ppeer.ID("peer-id")
-	protocolID := p2pcommon.ProtocolForIdentity(identity)
-
 	documentMock := documents.NewDocumentMock(t)
 
 	genericUtils.GetMock[*documents.ServiceMock](mocks).
 		On(
 			"GetCurrentVersion",
 			mock.Anything,
-			req.GetDocumentIdentifier(),
+			req.DocumentID,
 		).
-		Return(documentMock, nil).Once()
+		Return(documentMock, nil).Times(2)
 
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On(
-			"GetCurrentVersion",
-			mock.Anything,
-			req.GetAccessTokenRequest().GetDelegatingDocumentIdentifier(),
-		).
-		Return(nil, errors.New("error")).Once()
+	documentMock.On("AccountCanRead", senderAccountID).
+		Return(true).
+		Times(2)
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	documentMock.On("NFTCanRead", encodedCollectionID, encodedItemID).
+		Return(true).
+		Times(2)
+
+	// The requester does not own the NFT.
+	genericUtils.GetMock[*nftv3.ServiceMock](mocks).
+		On("GetNFTOwner", collectionID, itemID).
+		Return(identity, nil).Once()
+
+	res, err := handler.GetAttachment(ctx, req, senderAccountID)
+	assert.ErrorIs(t, err, ErrAccessDenied)
+	assert.Nil(t, res)
+
+	// The requester owns the NFT.
+	genericUtils.GetMock[*nftv3.ServiceMock](mocks).
+		On("GetNFTOwner", collectionID, itemID).
+		Return(senderAccountID, nil).Once()
+
+	att, err := documents.NewAttachment(req.Hash, 128, "application/pdf")
 	assert.NoError(t, err)
-	assert.NotNil(t, res)
 
-	assertErrorEnvelope(t, res)
-}
+	attr, err := documents.NewAttachmentAttribute("invoice_pdf", att)
+	assert.NoError(t, err)
 
-func TestHandler_HandleGetDocument_AccessTokenVerification_AccessTokenCannotRead(t *testing.T) {
-	handler, mocks := getHandlerWithMocks(t)
+	documentMock.On("GetAttributes").
+		Return([]documents.Attribute{attr}).
+		Once()
 
-	ctx := context.Background()
+	data := utils.RandomSlice(128)
+
+	genericUtils.GetMock[*attachments.StoreMock](mocks).
+		On("Get", identity, req.Hash).
+		Return(&attachments.Blob{Hash: req.Hash, MIMEType: "application/pdf", Data: data}, nil).
+		Once()
 
+	res, err = handler.GetAttachment(ctx, req, senderAccountID)
+	assert.NoError(t, err)
+	assert.Equal(t, data, res)
+}
+
+func TestHandler_HandleGetAttachment_MaxAttachmentSize(t *testing.T) {
 	identity, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
-	senderAccountID, err := testingcommons.GetRandomAccountID()
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(identity)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	p2pEnv, err := p2pcommon.PrepareP2PEnvelopeWithBody(
+		ctx,
+		36,
+		p2pcommon.MessageTypeGetAttachmentRep,
+		make([]byte, attachments.MaxAttachmentSize),
+	)
 	assert.NoError(t, err)
 
-	req := &p2ppb.GetDocumentRequest{
-		DocumentIdentifier: utils.RandomSlice(32),
-		AccessType:         p2ppb.AccessType_ACCESS_TYPE_ACCESS_TOKEN_VERIFICATION,
-		AccessTokenRequest: &p2ppb.AccessTokenRequest{
-			DelegatingDocumentIdentifier: utils.RandomSlice(32),
-			AccessTokenId:                utils.RandomSlice(32),
-		},
-	}
+	// The response holding the largest attachment fits in a message.
+	assert.Less(t, proto.Size(p2pEnv), messenger.MessageSizeMax)
+}
 
-	encodedReq, err := proto.Marshal(req)
+func TestHandler_HandleGetAttachment_InvalidRequest(t *testing.T) {
+	handler, _ := getHandlerWithMocks(t)
+
+	ctx := context.Background()
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	senderAccountID, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
 	env := &p2ppb.Envelope{
@@ -2056,64 +3476,47 @@ func TestHandler_HandleGetDocument_AccessTokenVerification_AccessTokenCannotRead
 			NetworkIdentifier: 36,
 			NodeVersion:       version.GetVersion().String(),
 			SenderId:          senderAccountID.ToBytes(),
-			Type:              p2pcommon.MessageTypeGetDoc.String(),
+			Type:              p2pcommon.MessageTypeGetAttachment.String(),
 			Timestamp:         timestamppb.Now(),
 		},
-		Body: encodedReq,
+		Body: utils.RandomSlice(10),
 	}
 
-	peerID := libp2ppeer.ID("peer-id")
-	protocolID := p2pcommon.ProtocolForIdentity(identity)
-
-	documentMock := documents.NewDocumentMock(t)
+	res, err := handler.HandleGetAttachment(ctx, libp2ppeer.ID("peer-id"), p2pcommon.ProtocolForIdentity(identity), env)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
 
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On(
-			"GetCurrentVersion",
-			mock.Anything,
-			req.GetDocumentIdentifier(),
-		).
-		Return(documentMock, nil).Once()
+	assertErrorEnvelope(t, res)
+}
 
-	entityRelationshipMock := documents.NewDocumentMock(t)
+func assertErrorEnvelope(t *testing.T, env *protocolpb.P2PEnvelope) {
+	var responseEnvelope p2ppb.Envelope
 
-	genericUtils.GetMock[*documents.ServiceMock](mocks).
-		On(
-			"GetCurrentVersion",
-			mock.Anything,
-			req.GetAccessTokenRequest().GetDelegatingDocumentIdentifier(),
-		).
-		Return(entityRelationshipMock, nil).Once()
+	err := proto.Unmarshal(env.GetBody(), &responseEnvelope)
+	assert.NoError(t, err)
 
-	entityRelationshipMock.On(
-		"ATGranteeCanRead",
-		mock.Anything,
-		handler.docSrv,
-		handler.identityService,
-		req.GetAccessTokenRequest().GetAccessTokenId(),
-		req.GetDocumentIdentifier(),
-		senderAccountID,
-	).Return(errors.New("error")).Once()
+	var errorEnv errorspb.Error
 
-	res, err := handler.HandleGetDocument(ctx, peerID, protocolID, env)
+	err = proto.Unmarshal(responseEnvelope.GetBody(), &errorEnv)
 	assert.NoError(t, err)
-	assert.NotNil(t, res)
 
-	assertErrorEnvelope(t, res)
+	assert.NotEmpty(t, errorEnv.GetMessage())
 }
 
-func assertErrorEnvelope(t *testing.T, env *protocolpb.P2PEnvelope) {
+func assertProtocolErrorEnvelope(t *testing.T, env *protocolpb.P2PEnvelope, expectedErr error) {
 	var responseEnvelope p2ppb.Envelope
 
 	err := proto.Unmarshal(env.GetBody(), &responseEnvelope)
 	assert.NoError(t, err)
+	assert.Equal(t, p2pcommon.MessageTypeError.String(), responseEnvelope.GetHeader().GetType())
 
 	var errorEnv errorspb.Error
 
 	err = proto.Unmarshal(responseEnvelope.GetBody(), &errorEnv)
 	assert.NoError(t, err)
 
-	assert.NotEmpty(t, errorEnv.GetMessage())
+	// Protocol errors are not masked.
+	assert.Contains(t, errorEnv.GetMessage(), expectedErr.Error())
 }
 
 func assertExpectedHeaderMatchesActual(t *testing.T, expected *p2ppb.Header, actual *p2ppb.Header) {
@@ -2131,6 +3534,7 @@ func getHandlerWithMocks(t *testing.T) (*handler, []any) {
 	documentServiceMock := documents.NewServiceMock(t)
 	identityServiceMock := v2.NewServiceMock(t)
 	nftServiceMock := nftv3.NewServiceMock(t)
+	attachmentStoreMock := attachments.NewStoreMock(t)
 
 	h := &handler{
 		cfgMock,
@@ -2139,6 +3543,9 @@ func getHandlerWithMocks(t *testing.T) (*handler, []any) {
 		documentServiceMock,
 		identityServiceMock,
 		nftServiceMock,
+		attachmentStoreMock,
+		getTestRequestGuard(),
+		newTestChunkStore(math.MaxInt, time.Hour),
 	}
 
 	return h, []any{
@@ -2148,5 +3555,15 @@ func getHandlerWithMocks(t *testing.T) (*handler, []any) {
 		documentServiceMock,
 		identityServiceMock,
 		nftServiceMock,
+		attachmentStoreMock,
+	}
+}
+
+func getTestRequestGuard() *requestGuard {
+	return &requestGuard{
+		peerLimiter:     newRateLimiter(math.MaxInt32, time.Minute),
+		identityLimiter: newRateLimiter(math.MaxInt32, time.Minute),
+		banList:         newBanList(math.MaxInt32, time.Hour),
+		sem:             make(chan struct{}, 100),
 	}
 }
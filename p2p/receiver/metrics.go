@@ -0,0 +1,42 @@
+package receiver
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "pod"
+	metricsSubsystem = "p2p_receiver"
+
+	reasonLabel = "reason"
+
+	rejectReasonBanned            = "banned"
+	rejectReasonPeerRateLimit     = "peer_rate_limit"
+	rejectReasonIdentityRateLimit = "identity_rate_limit"
+	rejectReasonConcurrency       = "concurrency"
+)
+
+var (
+	rejectedMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "rejected_messages_total",
+			Help:      "Number of p2p messages rejected by the abuse protection.",
+		},
+		[]string{reasonLabel},
+	)
+
+	bannedPeers = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "banned_peers_total",
+			Help:      "Number of peers banned for sending documents with invalid signatures.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(rejectedMessages, bannedPeers)
+}
@@ -2,7 +2,6 @@ package p2p
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"time"
 
@@ -25,7 +24,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/libp2p/go-libp2p-core/routing"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
-	"github.com/libp2p/go-tcp-transport"
+	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	ma "github.com/multiformats/go-multiaddr"
 )
 
@@ -80,6 +79,11 @@ type p2pPeer struct {
 	disablePeerStore bool
 	mes              ms.Messenger
 	dht              IpfsDHT
+
+	deliveryManager *deliveryManager
+	addressBook     *addressBook
+	staticPeers     map[string]*libp2ppeer.AddrInfo
+	relayPeers      []libp2ppeer.AddrInfo
 }
 
 func newPeer(
@@ -121,16 +125,70 @@ func (s *p2pPeer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr chan
 		startupErr <- err
 		return
 	}
-	s.host, s.dht, err = makeBasicHost(ctx, priv, s.config.GetP2PExternalIP(), s.config.GetP2PPort())
+	s.staticPeers, err = parseStaticPeers(s.config.GetP2PStaticPeers())
 	if err != nil {
 		startupErr <- err
 		return
 	}
 
-	s.mes = ms.NewP2PMessenger(
+	s.relayPeers, err = parseRelayPeers(s.config.GetP2PRelayPeers())
+	if err != nil {
+		startupErr <- err
+		return
+	}
+
+	connManager, err := connmgr.NewConnManager(
+		s.config.GetP2PConnMgrLowWater(),
+		s.config.GetP2PConnMgrHighWater(),
+		connmgr.WithGracePeriod(s.config.GetP2PConnMgrGracePeriod()),
+	)
+	if err != nil {
+		startupErr <- err
+		return
+	}
+
+	hostOpts := append(
+		[]libp2p.Option{libp2p.ConnectionManager(connManager)},
+		natOptions(s.config, s.relayPeers)...,
+	)
+
+	s.host, s.dht, err = makeBasicHost(
 		ctx,
+		priv,
+		s.config.GetP2PExternalIP(),
+		getHostTransports(s.config),
+		hostOpts...,
+	)
+	if err != nil {
+		startupErr <- err
+		return
+	}
+
+	s.host.Network().Notify(s.peerNotifiee())
+
+	for _, staticPeer := range s.staticPeers {
+		// The connections to the static peers are never trimmed by the connection manager.
+		connManager.Protect(staticPeer.ID, staticPeerTag)
+		s.host.Peerstore().AddAddrs(staticPeer.ID, staticPeer.Addrs, peerstore.PermanentAddrTTL)
+	}
+
+	for _, relayPeer := range s.relayPeers {
+		connManager.Protect(relayPeer.ID, relayPeerTag)
+		s.host.Peerstore().AddAddrs(relayPeer.ID, relayPeer.Addrs, peerstore.PermanentAddrTTL)
+	}
+
+	messengerCtx := ctx
+
+	if s.config.IsP2PRelayEnabled() {
+		// Allow streams over relayed connections when no direct connection can be established.
+		messengerCtx = inet.WithUseTransient(ctx, "relay")
+	}
+
+	s.mes = ms.NewP2PMessenger(
+		messengerCtx,
 		s.host,
 		s.config.GetP2PConnectionTimeout(),
+		s.config.GetP2PMaxMessageSize(),
 		ms.NewMessageSenderFactory(),
 		s.handler.HandleInterceptor,
 	)
@@ -152,6 +210,8 @@ func (s *p2pPeer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr chan
 	// Start DHT and properly ignore errors :)
 	_ = s.runDHT(ctx, s.config.GetBootstrapPeers())
 
+	go s.connectStaticPeers(ctx)
+
 	if s.config.IsDebugLogEnabled() {
 		go func() {
 			for {
@@ -169,6 +229,19 @@ func (s *p2pPeer) Start(ctx context.Context, wg *sync.WaitGroup, startupErr chan
 	}
 }
 
+// connectStaticPeers connects to the static peers, failed connections are retried when a message is sent.
+func (s *p2pPeer) connectStaticPeers(ctx context.Context) {
+	for _, staticPeer := range s.staticPeers {
+		connCtx, cancel := context.WithTimeout(ctx, s.config.GetP2PConnectionTimeout())
+
+		if err := s.host.Connect(connCtx, *staticPeer); err != nil {
+			log.Warnf("Couldn't connect to static peer %s: %s", staticPeer.ID, err)
+		}
+
+		cancel()
+	}
+}
+
 func (s *p2pPeer) initProtocols() error {
 	accounts, err := s.cfgService.GetAccounts()
 	if err != nil {
@@ -177,7 +250,7 @@ func (s *p2pPeer) initProtocols() error {
 
 	var protocols []protocol.ID
 	for _, account := range accounts {
-		protocols = append(protocols, p2pcommon.ProtocolForIdentity(account.GetIdentity()))
+		protocols = append(protocols, p2pcommon.ProtocolsForIdentity(account.GetIdentity())...)
 	}
 
 	s.mes.Init(protocols...)
@@ -191,7 +264,15 @@ func (s *p2pPeer) processProtocolIDs(ctx context.Context, c chan protocol.ID) {
 			log.Errorf("Context done while processing protocol IDs: %s", ctx.Err())
 			return
 		case protocolID := <-c:
-			s.mes.Init(protocolID)
+			identity, err := p2pcommon.ExtractIdentity(protocolID)
+			if err != nil {
+				log.Errorf("Couldn't extract identity from protocol ID: %s", err)
+
+				continue
+			}
+
+			// Listen to all supported protocol versions for the identity.
+			s.mes.Init(p2pcommon.ProtocolsForIdentity(identity)...)
 		}
 	}
 }
@@ -227,28 +308,27 @@ func (s *p2pPeer) runDHT(ctx context.Context, bootstrapPeers []string) error {
 	return nil
 }
 
-// makeBasicHost creates a LibP2P host with a peer ID listening on the given port
+// makeBasicHost creates a LibP2P host with a peer ID listening on the addresses of the given transports
 func makeBasicHost(
 	ctx context.Context,
 	priv libp2pcrypto.PrivKey,
 	externalIP string,
-	listenPort int,
+	transports []*hostTransport,
+	extraOpts ...libp2p.Option,
 ) (host.Host, *dht.IpfsDHT, error) {
-	var err error
-	var extMultiAddr ma.Multiaddr
 	if externalIP == "" {
 		log.Warn("External IP not defined, Peers might not be able to resolve this node if behind NAT\n")
-	} else {
-		extMultiAddr, err = ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", externalIP, listenPort))
-		if err != nil {
-			return nil, nil, errors.New("failed to create multiaddr: %v", err)
-		}
+	}
+
+	transportOpts, externalAddrs, err := transportOptions(transports, externalIP)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	addressFactory := func(addrs []ma.Multiaddr) []ma.Multiaddr {
-		if extMultiAddr != nil {
-			// We currently support a single protocol and transport, if we add more to support then we will need to adapt this code
-			addrs = []ma.Multiaddr{extMultiAddr}
+		if len(externalAddrs) > 0 {
+			// Advertise the external address of every transport instead of the listen addresses
+			addrs = externalAddrs
 		}
 		return addrs
 	}
@@ -256,10 +336,7 @@ func makeBasicHost(
 	var idht *dht.IpfsDHT
 	opts := []libp2p.Option{
 		libp2p.Identity(priv),
-		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", listenPort)),
 		libp2p.DefaultSecurity,
-		// support any other default transports (TCP)
-		libp2p.Transport(tcp.NewTCPTransport),
 		// Attempt to open ports using uPNP for NATed hosts.
 		libp2p.NATPortMap(),
 		// Let this host use the DHT to find other hosts
@@ -271,6 +348,9 @@ func makeBasicHost(
 		libp2p.AddrsFactory(addressFactory),
 	}
 
+	opts = append(opts, transportOpts...)
+	opts = append(opts, extraOpts...)
+
 	bhost, err := libp2p.New(opts...)
 	if err != nil {
 		return nil, nil, err
@@ -14,6 +14,7 @@ import (
 	"github.com/centrifuge/pod/crypto/ed25519"
 	protocolIDDispatcher "github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/errors"
+	p2pcommon "github.com/centrifuge/pod/p2p/common"
 	ms "github.com/centrifuge/pod/p2p/messenger"
 	p2pMocks "github.com/centrifuge/pod/p2p/mocks"
 	testingcommons "github.com/centrifuge/pod/testingutils/common"
@@ -57,6 +58,8 @@ func TestPeer_Server_Start(t *testing.T) {
 	err = config.GenerateAndWriteP2PKeys(genericUtils.GetMock[*config.ConfigurationMock](mocks))
 	assert.NoError(t, err)
 
+	mockConnectionConfig(mocks)
+
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PExternalIP").
 		Return("")
 
@@ -65,6 +68,9 @@ func TestPeer_Server_Start(t *testing.T) {
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
 		Return(connectionTimeout)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PMaxMessageSize").
+		Return(1024)
+
 	accountID1, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
@@ -248,6 +254,8 @@ func TestPeer_Server_Start_BasicHostError(t *testing.T) {
 	err = config.GenerateAndWriteP2PKeys(genericUtils.GetMock[*config.ConfigurationMock](mocks))
 	assert.NoError(t, err)
 
+	mockConnectionConfig(mocks)
+
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PExternalIP").
 		Return("invalid-ip")
 
@@ -310,6 +318,8 @@ func TestPeer_Server_Start_InitProtocolsError(t *testing.T) {
 	err = config.GenerateAndWriteP2PKeys(genericUtils.GetMock[*config.ConfigurationMock](mocks))
 	assert.NoError(t, err)
 
+	mockConnectionConfig(mocks)
+
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PExternalIP").
 		Return("")
 
@@ -318,6 +328,9 @@ func TestPeer_Server_Start_InitProtocolsError(t *testing.T) {
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
 		Return(connectionTimeout)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PMaxMessageSize").
+		Return(1024)
+
 	cfgServiceErr := errors.New("error")
 
 	genericUtils.GetMock[*config.ServiceMock](mocks).On("GetAccounts").
@@ -383,6 +396,8 @@ func TestPeer_Server_Start_ProtocolIDDispatcherError(t *testing.T) {
 	err = config.GenerateAndWriteP2PKeys(genericUtils.GetMock[*config.ConfigurationMock](mocks))
 	assert.NoError(t, err)
 
+	mockConnectionConfig(mocks)
+
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PExternalIP").
 		Return("")
 
@@ -391,6 +406,9 @@ func TestPeer_Server_Start_ProtocolIDDispatcherError(t *testing.T) {
 	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnectionTimeout").
 		Return(connectionTimeout)
 
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PMaxMessageSize").
+		Return(1024)
+
 	accountID1, err := testingcommons.GetRandomAccountID()
 	assert.NoError(t, err)
 
@@ -452,13 +470,20 @@ func TestPeer_Server_Start_ProtocolIDDispatcherError(t *testing.T) {
 func TestPeer_Server_processProtocolIDs(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
-	protocolID1 := protocol.ID("protocol-id-1")
-	protocolID2 := protocol.ID("protocol-id-2")
+	accountID1, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
 
-	genericUtils.GetMock[*ms.MessengerMock](mocks).On("Init", protocolID1).
+	accountID2, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	protocolID1 := p2pcommon.ProtocolForIdentity(accountID1)
+	protocolID2 := p2pcommon.ProtocolForIdentity(accountID2)
+
+	// All supported protocol versions are initialized for each identity.
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("Init", toAnySlice(p2pcommon.ProtocolsForIdentity(accountID1))...).
 		Once()
 
-	genericUtils.GetMock[*ms.MessengerMock](mocks).On("Init", protocolID2).
+	genericUtils.GetMock[*ms.MessengerMock](mocks).On("Init", toAnySlice(p2pcommon.ProtocolsForIdentity(accountID2))...).
 		Once()
 
 	c := make(chan protocol.ID)
@@ -472,6 +497,8 @@ func TestPeer_Server_processProtocolIDs(t *testing.T) {
 	go func() {
 		defer close(sendDone)
 
+		// Invalid protocol IDs are ignored.
+		c <- protocol.ID("protocol-id")
 		c <- protocolID1
 		c <- protocolID2
 	}()
@@ -496,6 +523,16 @@ func TestPeer_Server_processProtocolIDs(t *testing.T) {
 	}
 }
 
+func toAnySlice[T any](items []T) []any {
+	res := make([]any, 0, len(items))
+
+	for _, item := range items {
+		res = append(res, item)
+	}
+
+	return res
+}
+
 func TestPeer_Server_runDHT(t *testing.T) {
 	peer, mocks := getPeerMocks(t)
 
@@ -612,7 +649,7 @@ func Test_makeBasicHost(t *testing.T) {
 	externalIP := "127.0.0.1"
 	externalPort := 9080
 
-	host, dht, err := makeBasicHost(ctx, priv, externalIP, externalPort)
+	host, dht, err := makeBasicHost(ctx, priv, externalIP, []*hostTransport{newTCPTransport(externalPort)})
 	assert.NoError(t, err)
 	assert.NotNil(t, host)
 	assert.NotNil(t, dht)
@@ -630,7 +667,7 @@ func Test_makeBasicHost_NoExternalIP(t *testing.T) {
 	externalIP := ""
 	externalPort := 9080
 
-	host, dht, err := makeBasicHost(ctx, priv, externalIP, externalPort)
+	host, dht, err := makeBasicHost(ctx, priv, externalIP, []*hostTransport{newTCPTransport(externalPort)})
 	assert.NoError(t, err)
 	assert.NotNil(t, host)
 	assert.NotNil(t, dht)
@@ -648,7 +685,7 @@ func Test_makeBasicHost_InvalidIP(t *testing.T) {
 	externalIP := "invalid-ip"
 	externalPort := 9080
 
-	host, dht, err := makeBasicHost(ctx, priv, externalIP, externalPort)
+	host, dht, err := makeBasicHost(ctx, priv, externalIP, []*hostTransport{newTCPTransport(externalPort)})
 	assert.NotNil(t, err)
 	assert.Nil(t, host)
 	assert.Nil(t, dht)
@@ -666,8 +703,86 @@ func Test_makeBasicHost_InvalidPort(t *testing.T) {
 	externalIP := "127.0.0.1"
 	externalPort := 99999
 
-	host, dht, err := makeBasicHost(ctx, priv, externalIP, externalPort)
+	host, dht, err := makeBasicHost(ctx, priv, externalIP, []*hostTransport{newTCPTransport(externalPort)})
 	assert.NotNil(t, err)
 	assert.Nil(t, host)
 	assert.Nil(t, dht)
 }
+
+func Test_makeBasicHost_AdditionalTransports(t *testing.T) {
+	ctx := context.Background()
+
+	_, privateKey, err := ed25519.GenerateSigningKeyPair()
+	assert.NoError(t, err)
+
+	priv, err := crypto.UnmarshalEd25519PrivateKey(privateKey)
+	assert.NoError(t, err)
+
+	externalIP := "127.0.0.1"
+
+	transports := []*hostTransport{
+		newTCPTransport(9084),
+		newQUICTransport(9084),
+		newWebSocketTransport(9085),
+	}
+
+	host, dht, err := makeBasicHost(ctx, priv, externalIP, transports)
+	assert.NoError(t, err)
+	assert.NotNil(t, host)
+	assert.NotNil(t, dht)
+
+	defer func() {
+		_ = host.Close()
+	}()
+
+	var addrs []string
+
+	for _, addr := range host.Addrs() {
+		addrs = append(addrs, addr.String())
+	}
+
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"/ip4/127.0.0.1/tcp/9084",
+			"/ip4/127.0.0.1/udp/9084/quic",
+			"/ip4/127.0.0.1/tcp/9085/ws",
+		},
+		addrs,
+	)
+}
+
+func mockConnectionConfig(mocks []any) {
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PStaticPeers").
+		Return(map[string]string{})
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnMgrLowWater").
+		Return(100)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnMgrHighWater").
+		Return(400)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PConnMgrGracePeriod").
+		Return(time.Minute)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("GetP2PRelayPeers").
+		Return([]string{})
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PRelayEnabled").
+		Return(false)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PRelayServiceEnabled").
+		Return(false)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PHolePunchingEnabled").
+		Return(false)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PAutoNATServiceEnabled").
+		Return(false)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PQUICEnabled").
+		Return(false)
+
+	genericUtils.GetMock[*config.ConfigurationMock](mocks).On("IsP2PWebSocketEnabled").
+		Return(false)
+}
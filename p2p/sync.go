@@ -0,0 +1,192 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// syncStartDelay is the delay after startup before the first sync, it allows the p2p host
+	// to connect to the network first.
+	syncStartDelay = 30 * time.Second
+)
+
+// documentSyncer periodically requests the latest anchored version of the shared documents from the
+// collaborators and fast-forwards the local copy, so that a node catches up on the versions that were
+// anchored while it was offline.
+type documentSyncer struct {
+	config     config.Configuration
+	cfgService config.Service
+	docSrv     documents.Service
+	client     documents.Client
+
+	startDelay time.Duration
+}
+
+func newDocumentSyncer(
+	config config.Configuration,
+	cfgService config.Service,
+	docSrv documents.Service,
+	client documents.Client,
+) *documentSyncer {
+	return &documentSyncer{
+		config:     config,
+		cfgService: cfgService,
+		docSrv:     docSrv,
+		client:     client,
+		startDelay: syncStartDelay,
+	}
+}
+
+// Name returns the name of the document syncer server.
+func (*documentSyncer) Name() string {
+	return "P2PDocumentSyncer"
+}
+
+// Start syncs the documents at the configured interval until the context is done.
+func (s *documentSyncer) Start(ctx context.Context, wg *sync.WaitGroup, _ chan<- error) {
+	defer wg.Done()
+
+	timer := time.NewTimer(s.startDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Stopping document syncer: %s", ctx.Err())
+			return
+		case <-timer.C:
+			s.syncDocuments(ctx)
+
+			timer.Reset(s.config.GetP2PSyncInterval())
+		}
+	}
+}
+
+// syncDocuments syncs the latest version of the documents of all accounts with the collaborators.
+func (s *documentSyncer) syncDocuments(ctx context.Context) {
+	accounts, err := s.cfgService.GetAccounts()
+	if err != nil {
+		log.Errorf("Couldn't retrieve accounts: %s", err)
+
+		return
+	}
+
+	for _, acc := range accounts {
+		accCtx := contextutil.WithAccount(ctx, acc)
+
+		docs, err := s.docSrv.GetLatestDocuments(accCtx)
+		if err != nil {
+			log.Errorf("Couldn't retrieve documents: %s", err)
+
+			continue
+		}
+
+		for _, doc := range docs {
+			if ctx.Err() != nil {
+				return
+			}
+
+			s.syncDocument(accCtx, acc.GetIdentity(), doc)
+		}
+	}
+}
+
+// syncDocument requests the latest anchored version of the document from the collaborators and
+// fast-forwards the local copy if it's a version that is not present locally.
+func (s *documentSyncer) syncDocument(ctx context.Context, identity *types.AccountID, doc documents.Document) {
+	collaborators, err := doc.GetCollaborators(identity)
+	if err != nil {
+		log.Errorf("Couldn't get document collaborators: %s", err)
+
+		return
+	}
+
+	// the collaborators with write access are asked first since they are the ones that anchor new versions
+	for _, collaborator := range append(collaborators.ReadWriteCollaborators, collaborators.ReadCollaborators...) {
+		cd, err := s.getLatestVersion(ctx, collaborator, doc.ID())
+		if err != nil {
+			log.Debugf("Couldn't get latest document version from %s: %s", collaborator.ToHexString(), err)
+
+			continue
+		}
+
+		if !bytes.Equal(cd.GetDocumentIdentifier(), doc.ID()) {
+			log.Warnf("Collaborator %s returned a different document", collaborator.ToHexString())
+
+			continue
+		}
+
+		latestVersion := cd.GetCurrentVersion()
+
+		if bytes.Equal(latestVersion, doc.CurrentVersion()) {
+			return
+		}
+
+		if _, err := s.docSrv.GetVersion(ctx, doc.ID(), latestVersion); err == nil {
+			// the collaborator is behind
+			continue
+		}
+
+		model, err := s.docSrv.DeriveFromCoreDocument(cd)
+		if err != nil {
+			log.Errorf("Couldn't derive document: %s", err)
+
+			continue
+		}
+
+		if err := s.docSrv.FastForwardDocument(ctx, model, collaborator); err != nil {
+			log.Errorf("Couldn't fast-forward document: %s", err)
+
+			continue
+		}
+
+		log.Infof(
+			"Fast-forwarded document %s to version %s from %s",
+			hexutil.Encode(doc.ID()),
+			hexutil.Encode(latestVersion),
+			collaborator.ToHexString(),
+		)
+
+		return
+	}
+}
+
+func (s *documentSyncer) getLatestVersion(
+	ctx context.Context,
+	collaborator *types.AccountID,
+	documentID []byte,
+) (*coredocumentpb.CoreDocument, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.GetP2PConnectionTimeout())
+	defer cancel()
+
+	req := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: documentID,
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	res, err := s.client.GetLatestVersionRequest(ctx, collaborator, req)
+
+	if err != nil && errors.IsOfType(ErrMessageTypeNotSupported, err) {
+		// Peers that only speak older protocol versions also return the current version of the document
+		// when no version is specified.
+		res, err = s.client.GetDocumentRequest(ctx, collaborator, req)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetDocument(), nil
+}
@@ -0,0 +1,326 @@
+//go:build unit
+
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
+	p2ppb "github.com/centrifuge/centrifuge-protobufs/gen/go/p2p"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/errors"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type syncTestMocks struct {
+	configMock     *config.ConfigurationMock
+	cfgServiceMock *config.ServiceMock
+	docSrvMock     *documents.ServiceMock
+	clientMock     *documents.ClientMock
+}
+
+func getTestDocumentSyncer(t *testing.T) (*documentSyncer, *syncTestMocks) {
+	mocks := &syncTestMocks{
+		configMock:     config.NewConfigurationMock(t),
+		cfgServiceMock: config.NewServiceMock(t),
+		docSrvMock:     documents.NewServiceMock(t),
+		clientMock:     documents.NewClientMock(t),
+	}
+
+	mocks.configMock.On("GetP2PConnectionTimeout").
+		Return(time.Second).
+		Maybe()
+
+	s := newDocumentSyncer(
+		mocks.configMock,
+		mocks.cfgServiceMock,
+		mocks.docSrvMock,
+		mocks.clientMock,
+	)
+
+	return s, mocks
+}
+
+func TestDocumentSyncer_SyncDocuments(t *testing.T) {
+	s, mocks := getTestDocumentSyncer(t)
+
+	_, identity, accountMock := getTestSenderContext(t)
+
+	mocks.cfgServiceMock.On("GetAccounts").
+		Return([]config.Account{accountMock}, nil).
+		Once()
+
+	readCollaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	writeCollaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+	latestVersion := utils.RandomSlice(32)
+
+	documentMock := documents.NewDocumentMock(t)
+	documentMock.On("ID").Return(documentID)
+	documentMock.On("CurrentVersion").Return(currentVersion)
+	documentMock.On("GetCollaborators", identity).
+		Return(documents.CollaboratorsAccess{
+			ReadCollaborators:      []*types.AccountID{readCollaborator},
+			ReadWriteCollaborators: []*types.AccountID{writeCollaborator},
+		}, nil).
+		Once()
+
+	mocks.docSrvMock.On("GetLatestDocuments", mock.Anything).
+		Return([]documents.Document{documentMock}, nil).
+		Once()
+
+	getLatestVersionReq := &p2ppb.GetDocumentRequest{
+		DocumentIdentifier: documentID,
+		AccessType:         p2ppb.AccessType_ACCESS_TYPE_REQUESTER_VERIFICATION,
+	}
+
+	// The collaborator with write access is asked first.
+	mocks.clientMock.On("GetLatestVersionRequest", mock.Anything, writeCollaborator, getLatestVersionReq).
+		Return(nil, errors.New("error")).
+		Once()
+
+	latestCoreDocument := &coredocumentpb.CoreDocument{
+		DocumentIdentifier: documentID,
+		CurrentVersion:     latestVersion,
+	}
+
+	mocks.clientMock.On("GetLatestVersionRequest", mock.Anything, readCollaborator, getLatestVersionReq).
+		Return(&p2ppb.GetDocumentResponse{Document: latestCoreDocument}, nil).
+		Once()
+
+	mocks.docSrvMock.On("GetVersion", mock.Anything, documentID, latestVersion).
+		Return(nil, documents.ErrDocumentNotFound).
+		Once()
+
+	latestDocumentMock := documents.NewDocumentMock(t)
+
+	mocks.docSrvMock.On("DeriveFromCoreDocument", latestCoreDocument).
+		Return(latestDocumentMock, nil).
+		Once()
+
+	mocks.docSrvMock.On("FastForwardDocument", mock.Anything, latestDocumentMock, readCollaborator).
+		Return(nil).
+		Once()
+
+	s.syncDocuments(context.Background())
+}
+
+func TestDocumentSyncer_SyncDocuments_UpToDate(t *testing.T) {
+	s, mocks := getTestDocumentSyncer(t)
+
+	_, identity, accountMock := getTestSenderContext(t)
+
+	mocks.cfgServiceMock.On("GetAccounts").
+		Return([]config.Account{accountMock}, nil).
+		Once()
+
+	collaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+
+	documentMock := documents.NewDocumentMock(t)
+	documentMock.On("ID").Return(documentID)
+	documentMock.On("CurrentVersion").Return(currentVersion)
+	documentMock.On("GetCollaborators", identity).
+		Return(documents.CollaboratorsAccess{
+			ReadWriteCollaborators: []*types.AccountID{collaborator},
+		}, nil).
+		Once()
+
+	mocks.docSrvMock.On("GetLatestDocuments", mock.Anything).
+		Return([]documents.Document{documentMock}, nil).
+		Once()
+
+	mocks.clientMock.On("GetLatestVersionRequest", mock.Anything, collaborator, mock.IsType(&p2ppb.GetDocumentRequest{})).
+		Return(&p2ppb.GetDocumentResponse{
+			Document: &coredocumentpb.CoreDocument{
+				DocumentIdentifier: documentID,
+				CurrentVersion:     currentVersion,
+			},
+		}, nil).
+		Once()
+
+	s.syncDocuments(context.Background())
+}
+
+func TestDocumentSyncer_SyncDocuments_ProtocolFallback(t *testing.T) {
+	s, mocks := getTestDocumentSyncer(t)
+
+	_, identity, accountMock := getTestSenderContext(t)
+
+	mocks.cfgServiceMock.On("GetAccounts").
+		Return([]config.Account{accountMock}, nil).
+		Once()
+
+	collaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	currentVersion := utils.RandomSlice(32)
+
+	documentMock := documents.NewDocumentMock(t)
+	documentMock.On("ID").Return(documentID)
+	documentMock.On("CurrentVersion").Return(currentVersion)
+	documentMock.On("GetCollaborators", identity).
+		Return(documents.CollaboratorsAccess{
+			ReadWriteCollaborators: []*types.AccountID{collaborator},
+		}, nil).
+		Once()
+
+	mocks.docSrvMock.On("GetLatestDocuments", mock.Anything).
+		Return([]documents.Document{documentMock}, nil).
+		Once()
+
+	// The collaborator only speaks a protocol version without the latest version message.
+	mocks.clientMock.On("GetLatestVersionRequest", mock.Anything, collaborator, mock.IsType(&p2ppb.GetDocumentRequest{})).
+		Return(nil, ErrMessageTypeNotSupported).
+		Once()
+
+	mocks.clientMock.On("GetDocumentRequest", mock.Anything, collaborator, mock.IsType(&p2ppb.GetDocumentRequest{})).
+		Return(&p2ppb.GetDocumentResponse{
+			Document: &coredocumentpb.CoreDocument{
+				DocumentIdentifier: documentID,
+				CurrentVersion:     currentVersion,
+			},
+		}, nil).
+		Once()
+
+	s.syncDocuments(context.Background())
+}
+
+func TestDocumentSyncer_SyncDocuments_DifferentDocument(t *testing.T) {
+	s, mocks := getTestDocumentSyncer(t)
+
+	_, identity, accountMock := getTestSenderContext(t)
+
+	mocks.cfgServiceMock.On("GetAccounts").
+		Return([]config.Account{accountMock}, nil).
+		Once()
+
+	collaborator, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+
+	documentMock := documents.NewDocumentMock(t)
+	documentMock.On("ID").Return(documentID)
+	documentMock.On("GetCollaborators", identity).
+		Return(documents.CollaboratorsAccess{
+			ReadWriteCollaborators: []*types.AccountID{collaborator},
+		}, nil).
+		Once()
+
+	mocks.docSrvMock.On("GetLatestDocuments", mock.Anything).
+		Return([]documents.Document{documentMock}, nil).
+		Once()
+
+	// The collaborator returns a version of another document.
+	mocks.clientMock.On("GetLatestVersionRequest", mock.Anything, collaborator, mock.IsType(&p2ppb.GetDocumentRequest{})).
+		Return(&p2ppb.GetDocumentResponse{
+			Document: &coredocumentpb.CoreDocument{
+				DocumentIdentifier: utils.RandomSlice(32),
+				CurrentVersion:     utils.RandomSlice(32),
+			},
+		}, nil).
+		Once()
+
+	s.syncDocuments(context.Background())
+}
+
+func TestDocumentSyncer_SyncDocuments_FastForwardError(t *testing.T) {
+	s, mocks := getTestDocumentSyncer(t)
+
+	_, identity, accountMock := getTestSenderContext(t)
+
+	mocks.cfgServiceMock.On("GetAccounts").
+		Return([]config.Account{accountMock}, nil).
+		Once()
+
+	collaborators, err := getTestCollaborators(2)
+	assert.NoError(t, err)
+
+	documentID := utils.RandomSlice(32)
+	latestVersion := utils.RandomSlice(32)
+
+	documentMock := documents.NewDocumentMock(t)
+	documentMock.On("ID").Return(documentID)
+	documentMock.On("CurrentVersion").Return(utils.RandomSlice(32))
+	documentMock.On("GetCollaborators", identity).
+		Return(documents.CollaboratorsAccess{
+			ReadWriteCollaborators: collaborators,
+		}, nil).
+		Once()
+
+	mocks.docSrvMock.On("GetLatestDocuments", mock.Anything).
+		Return([]documents.Document{documentMock}, nil).
+		Once()
+
+	latestCoreDocument := &coredocumentpb.CoreDocument{
+		DocumentIdentifier: documentID,
+		CurrentVersion:     latestVersion,
+	}
+
+	mocks.clientMock.On("GetLatestVersionRequest", mock.Anything, mock.Anything, mock.IsType(&p2ppb.GetDocumentRequest{})).
+		Return(&p2ppb.GetDocumentResponse{Document: latestCoreDocument}, nil).
+		Twice()
+
+	mocks.docSrvMock.On("GetVersion", mock.Anything, documentID, latestVersion).
+		Return(nil, documents.ErrDocumentNotFound).
+		Twice()
+
+	latestDocumentMock := documents.NewDocumentMock(t)
+
+	mocks.docSrvMock.On("DeriveFromCoreDocument", latestCoreDocument).
+		Return(latestDocumentMock, nil).
+		Twice()
+
+	// The version is not valid, the next collaborator is asked.
+	mocks.docSrvMock.On("FastForwardDocument", mock.Anything, latestDocumentMock, collaborators[0]).
+		Return(errors.New("error")).
+		Once()
+
+	mocks.docSrvMock.On("FastForwardDocument", mock.Anything, latestDocumentMock, collaborators[1]).
+		Return(nil).
+		Once()
+
+	s.syncDocuments(context.Background())
+}
+
+func TestDocumentSyncer_SyncDocuments_AccountsError(t *testing.T) {
+	s, mocks := getTestDocumentSyncer(t)
+
+	mocks.cfgServiceMock.On("GetAccounts").
+		Return(nil, errors.New("error")).
+		Once()
+
+	s.syncDocuments(context.Background())
+}
+
+func getTestCollaborators(count int) ([]*types.AccountID, error) {
+	var collaborators []*types.AccountID
+
+	for i := 0; i < count; i++ {
+		collaborator, err := testingcommons.GetRandomAccountID()
+		if err != nil {
+			return nil, err
+		}
+
+		collaborators = append(collaborators, collaborator)
+	}
+
+	return collaborators, nil
+}
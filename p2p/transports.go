@@ -0,0 +1,116 @@
+package p2p
+
+import (
+	"fmt"
+
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/errors"
+	"github.com/libp2p/go-libp2p"
+	libp2pquic "github.com/libp2p/go-libp2p-quic-transport"
+	"github.com/libp2p/go-tcp-transport"
+	ws "github.com/libp2p/go-ws-transport"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	listenIP = "0.0.0.0"
+
+	tcpTransportAddrFormat       = "/ip4/%s/tcp/%d"
+	quicTransportAddrFormat      = "/ip4/%s/udp/%d/quic"
+	webSocketTransportAddrFormat = "/ip4/%s/tcp/%d/ws"
+)
+
+// hostTransport is a transport of the libp2p host together with the port on which it listens.
+type hostTransport struct {
+	// addrFormat is the format of the addresses of the transport, it expects an IP and a port.
+	addrFormat  string
+	port        int
+	constructor interface{}
+}
+
+func newTCPTransport(port int) *hostTransport {
+	return &hostTransport{
+		addrFormat:  tcpTransportAddrFormat,
+		port:        port,
+		constructor: tcp.NewTCPTransport,
+	}
+}
+
+func newQUICTransport(port int) *hostTransport {
+	return &hostTransport{
+		addrFormat:  quicTransportAddrFormat,
+		port:        port,
+		constructor: libp2pquic.NewTransport,
+	}
+}
+
+func newWebSocketTransport(port int) *hostTransport {
+	return &hostTransport{
+		addrFormat:  webSocketTransportAddrFormat,
+		port:        port,
+		constructor: ws.New,
+	}
+}
+
+// multiaddr returns the address of the transport for the provided IP.
+func (t *hostTransport) multiaddr(ip string) (ma.Multiaddr, error) {
+	addr, err := ma.NewMultiaddr(fmt.Sprintf(t.addrFormat, ip, t.port))
+	if err != nil {
+		return nil, errors.New("failed to create multiaddr: %v", err)
+	}
+
+	return addr, nil
+}
+
+// getHostTransports returns the configured transports, the TCP transport is always enabled.
+func getHostTransports(cfg config.Configuration) []*hostTransport {
+	transports := []*hostTransport{
+		newTCPTransport(cfg.GetP2PPort()),
+	}
+
+	if cfg.IsP2PQUICEnabled() {
+		transports = append(transports, newQUICTransport(cfg.GetP2PQUICPort()))
+	}
+
+	if cfg.IsP2PWebSocketEnabled() {
+		transports = append(transports, newWebSocketTransport(cfg.GetP2PWebSocketPort()))
+	}
+
+	return transports
+}
+
+// transportOptions returns the libp2p options that enable the transports and listen on their addresses, and
+// the addresses of the transports for the external IP, if provided.
+func transportOptions(transports []*hostTransport, externalIP string) ([]libp2p.Option, []ma.Multiaddr, error) {
+	var (
+		opts          []libp2p.Option
+		listenAddrs   []ma.Multiaddr
+		externalAddrs []ma.Multiaddr
+	)
+
+	for _, transport := range transports {
+		listenAddr, err := transport.multiaddr(listenIP)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		listenAddrs = append(listenAddrs, listenAddr)
+
+		opts = append(opts, libp2p.Transport(transport.constructor))
+
+		if externalIP == "" {
+			continue
+		}
+
+		externalAddr, err := transport.multiaddr(externalIP)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		externalAddrs = append(externalAddrs, externalAddr)
+	}
+
+	opts = append(opts, libp2p.ListenAddrs(listenAddrs...))
+
+	return opts, externalAddrs, nil
+}
@@ -0,0 +1,141 @@
+//go:build unit
+
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifuge/pod/config"
+	"github.com/libp2p/go-libp2p"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetHostTransports(t *testing.T) {
+	configMock := config.NewConfigurationMock(t)
+
+	configMock.On("GetP2PPort").Return(38202).Once()
+	configMock.On("IsP2PQUICEnabled").Return(false).Once()
+	configMock.On("IsP2PWebSocketEnabled").Return(false).Once()
+
+	res := getHostTransports(configMock)
+	assert.Len(t, res, 1)
+	assert.Equal(t, tcpTransportAddrFormat, res[0].addrFormat)
+	assert.Equal(t, 38202, res[0].port)
+
+	configMock.On("GetP2PPort").Return(38202).Once()
+	configMock.On("IsP2PQUICEnabled").Return(true).Once()
+	configMock.On("GetP2PQUICPort").Return(38204).Once()
+	configMock.On("IsP2PWebSocketEnabled").Return(true).Once()
+	configMock.On("GetP2PWebSocketPort").Return(38203).Once()
+
+	res = getHostTransports(configMock)
+	assert.Len(t, res, 3)
+	assert.Equal(t, tcpTransportAddrFormat, res[0].addrFormat)
+	assert.Equal(t, 38202, res[0].port)
+	assert.Equal(t, quicTransportAddrFormat, res[1].addrFormat)
+	assert.Equal(t, 38204, res[1].port)
+	assert.Equal(t, webSocketTransportAddrFormat, res[2].addrFormat)
+	assert.Equal(t, 38203, res[2].port)
+}
+
+func TestTransportOptions(t *testing.T) {
+	transports := []*hostTransport{
+		newTCPTransport(38202),
+		newQUICTransport(38202),
+		newWebSocketTransport(38203),
+	}
+
+	opts, externalAddrs, err := transportOptions(transports, "1.2.3.4")
+	assert.NoError(t, err)
+
+	var cfg libp2p.Config
+
+	err = cfg.Apply(opts...)
+	assert.NoError(t, err)
+	assert.Len(t, cfg.Transports, 3)
+
+	var listenAddrs []string
+
+	for _, addr := range cfg.ListenAddrs {
+		listenAddrs = append(listenAddrs, addr.String())
+	}
+
+	assert.Equal(
+		t,
+		[]string{
+			"/ip4/0.0.0.0/tcp/38202",
+			"/ip4/0.0.0.0/udp/38202/quic",
+			"/ip4/0.0.0.0/tcp/38203/ws",
+		},
+		listenAddrs,
+	)
+
+	var externalAddrStrs []string
+
+	for _, addr := range externalAddrs {
+		externalAddrStrs = append(externalAddrStrs, addr.String())
+	}
+
+	assert.Equal(
+		t,
+		[]string{
+			"/ip4/1.2.3.4/tcp/38202",
+			"/ip4/1.2.3.4/udp/38202/quic",
+			"/ip4/1.2.3.4/tcp/38203/ws",
+		},
+		externalAddrStrs,
+	)
+
+	// No external addresses are returned if the external IP is not provided.
+	opts, externalAddrs, err = transportOptions(transports, "")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, opts)
+	assert.Empty(t, externalAddrs)
+
+	opts, externalAddrs, err = transportOptions(transports, "invalid-ip")
+	assert.Error(t, err)
+	assert.Nil(t, opts)
+	assert.Nil(t, externalAddrs)
+
+	opts, externalAddrs, err = transportOptions([]*hostTransport{newQUICTransport(99999)}, "")
+	assert.Error(t, err)
+	assert.Nil(t, opts)
+	assert.Nil(t, externalAddrs)
+}
+
+func TestTransports_WebSocketCommunication(t *testing.T) {
+	// Port 0 lets the OS pick a free port.
+	opts, _, err := transportOptions([]*hostTransport{newWebSocketTransport(0)}, "")
+	assert.NoError(t, err)
+
+	opts = append(opts, libp2p.DefaultSecurity, libp2p.DefaultMuxers)
+
+	remoteHost, err := libp2p.New(opts...)
+	assert.NoError(t, err)
+
+	defer func() {
+		_ = remoteHost.Close()
+	}()
+
+	localHost, err := libp2p.New(opts...)
+	assert.NoError(t, err)
+
+	defer func() {
+		_ = localHost.Close()
+	}()
+
+	err = localHost.Connect(context.Background(), libp2ppeer.AddrInfo{
+		ID:    remoteHost.ID(),
+		Addrs: remoteHost.Addrs(),
+	})
+	assert.NoError(t, err)
+
+	conns := localHost.Network().ConnsToPeer(remoteHost.ID())
+	assert.NotEmpty(t, conns)
+
+	for _, conn := range conns {
+		assert.Equal(t, "ws", conn.RemoteMultiaddr().Protocols()[2].Name)
+	}
+}
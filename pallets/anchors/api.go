@@ -164,14 +164,12 @@ func (a *api) CommitAnchor(ctx context.Context, anchorID AnchorID, documentRoot
 		return errors.ErrMetadataRetrieval
 	}
 
-	call, err := types.NewCall(
-		meta,
-		commit,
-		types.NewHash(anchorID[:]),
-		types.NewHash(documentRoot[:]),
-		types.NewHash(proof[:]),
-		types.NewMoment(time.Now().UTC().Add(a.anchorLifeSpan)),
-	)
+	call, err := GetCommitCallProviderFn(
+		anchorID,
+		documentRoot,
+		proof,
+		time.Now().UTC().Add(a.anchorLifeSpan),
+	)(meta)
 
 	if err != nil {
 		log.Errorf("Couldn't create call: %s", err)
@@ -184,7 +182,7 @@ func (a *api) CommitAnchor(ctx context.Context, anchorID AnchorID, documentRoot
 		identity,
 		a.podOperator.ToKeyringPair(),
 		types.NewOption(proxyType.PodOperation),
-		call,
+		*call,
 	)
 
 	if err != nil {
@@ -195,3 +193,28 @@ func (a *api) CommitAnchor(ctx context.Context, anchorID AnchorID, documentRoot
 
 	return nil
 }
+
+// GetCommitCallProviderFn returns a call provider for the anchor commit call.
+func GetCommitCallProviderFn(
+	anchorID AnchorID,
+	documentRoot DocumentRoot,
+	proof [32]byte,
+	storedUntil time.Time,
+) centchain.CallProviderFn {
+	return func(meta *types.Metadata) (*types.Call, error) {
+		call, err := types.NewCall(
+			meta,
+			commit,
+			types.NewHash(anchorID[:]),
+			types.NewHash(documentRoot[:]),
+			types.NewHash(proof[:]),
+			types.NewMoment(storedUntil),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &call, nil
+	}
+}
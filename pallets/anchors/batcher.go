@@ -0,0 +1,203 @@
+package anchors
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	proxyType "github.com/centrifuge/chain-custom-types/pkg/proxy"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/pallets/proxy"
+	"github.com/centrifuge/pod/pallets/utility"
+)
+
+const (
+	// defaultMaxBatchSize is the number of commit requests after which a batch is submitted without
+	// waiting for the batch window to end.
+	defaultMaxBatchSize = 100
+
+	// batchSubmissionTimeout is the time allowed for submitting a batch, it does not depend on the
+	// contexts of the callers since a batch is shared by all of them.
+	batchSubmissionTimeout = 5 * time.Minute
+)
+
+type commitRequest struct {
+	ctx          context.Context
+	account      config.Account
+	anchorID     AnchorID
+	documentRoot DocumentRoot
+	proof        [32]byte
+	storedUntil  time.Time
+	result       chan error
+}
+
+type commitBatch struct {
+	requests []*commitRequest
+	timer    *time.Timer
+}
+
+// batcher is an API that collects the commit requests received during a batch window and
+// submits them in a single batch extrinsic.
+type batcher struct {
+	API
+
+	utilityAPI     utility.API
+	anchorLifeSpan time.Duration
+	batchWindow    time.Duration
+	maxBatchSize   int
+
+	mu      sync.Mutex
+	pending *commitBatch
+}
+
+// NewBatcher returns an API that batches the anchor commits.
+//
+// The commit requests of all accounts are collected for the duration of the batch window and
+// submitted by the pod operator using a single Utility.batch_all call, each commit being proxied on behalf
+// of its account. Since batch_all is atomic, the requests of a failed batch are retried individually so that
+// each caller receives the result of its own commit.
+func NewBatcher(api API, utilityAPI utility.API, anchorLifeSpan time.Duration, batchWindow time.Duration) API {
+	return &batcher{
+		API:            api,
+		utilityAPI:     utilityAPI,
+		anchorLifeSpan: anchorLifeSpan,
+		batchWindow:    batchWindow,
+		maxBatchSize:   defaultMaxBatchSize,
+	}
+}
+
+// CommitAnchor adds the commit to the pending batch and waits until the batch is submitted.
+func (b *batcher) CommitAnchor(ctx context.Context, anchorID AnchorID, documentRoot DocumentRoot, proof [32]byte) error {
+	account, err := contextutil.Account(ctx)
+
+	if err != nil {
+		log.Errorf("Couldn't retrieve account from context: %s", err)
+
+		return errors.ErrContextIdentityRetrieval
+	}
+
+	req := &commitRequest{
+		ctx:          ctx,
+		account:      account,
+		anchorID:     anchorID,
+		documentRoot: documentRoot,
+		proof:        proof,
+		storedUntil:  time.Now().UTC().Add(b.anchorLifeSpan),
+		result:       make(chan error, 1),
+	}
+
+	b.addRequest(req)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-req.result:
+		return err
+	}
+}
+
+func (b *batcher) addRequest(req *commitRequest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batch := b.pending
+
+	if batch == nil {
+		batch = &commitBatch{}
+
+		batch.timer = time.AfterFunc(b.batchWindow, func() {
+			b.flush(batch)
+		})
+
+		b.pending = batch
+	}
+
+	batch.requests = append(batch.requests, req)
+
+	if len(batch.requests) >= b.maxBatchSize {
+		batch.timer.Stop()
+
+		b.pending = nil
+
+		go b.submit(batch.requests)
+	}
+}
+
+// flush submits the batch if it is still pending.
+func (b *batcher) flush(batch *commitBatch) {
+	b.mu.Lock()
+
+	if b.pending != batch {
+		b.mu.Unlock()
+
+		return
+	}
+
+	b.pending = nil
+
+	b.mu.Unlock()
+
+	b.submit(batch.requests)
+}
+
+func (b *batcher) submit(requests []*commitRequest) {
+	if len(requests) == 1 {
+		req := requests[0]
+
+		req.result <- b.API.CommitAnchor(req.ctx, req.anchorID, req.documentRoot, req.proof)
+
+		return
+	}
+
+	callProviderFns := make([]centchain.CallProviderFn, 0, len(requests))
+
+	for _, req := range requests {
+		callProviderFns = append(
+			callProviderFns,
+			proxy.WrapWithProxyCall(
+				req.account.GetIdentity(),
+				types.NewOption(proxyType.PodOperation),
+				GetCommitCallProviderFn(req.anchorID, req.documentRoot, req.proof, req.storedUntil),
+			),
+		)
+	}
+
+	// The account is only required by the dispatcher that watches the extrinsic.
+	ctx, cancel := context.WithTimeout(
+		contextutil.WithAccount(context.Background(), requests[0].account),
+		batchSubmissionTimeout,
+	)
+	defer cancel()
+
+	log.Infof("Committing batch of %d anchors", len(requests))
+
+	if _, err := b.utilityAPI.BatchAllProxyCalls(ctx, callProviderFns...); err != nil {
+		log.Errorf("Couldn't commit batch of %d anchors, committing individually: %s", len(requests), err)
+
+		b.commitIndividually(requests)
+
+		return
+	}
+
+	for _, req := range requests {
+		req.result <- nil
+	}
+}
+
+// commitIndividually commits each request of a failed batch on its own, the anchors that are already
+// committed with the expected document root are not committed again.
+func (b *batcher) commitIndividually(requests []*commitRequest) {
+	for _, req := range requests {
+		if docRoot, _, err := b.API.GetAnchorData(req.anchorID); err == nil && docRoot == req.documentRoot {
+			req.result <- nil
+
+			continue
+		}
+
+		req.result <- b.API.CommitAnchor(req.ctx, req.anchorID, req.documentRoot, req.proof)
+	}
+}
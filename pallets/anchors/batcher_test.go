@@ -0,0 +1,270 @@
+//go:build unit
+
+package anchors
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/contextutil"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/pallets/utility"
+	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type testCommit struct {
+	anchorID     AnchorID
+	documentRoot DocumentRoot
+	proof        [32]byte
+}
+
+func getTestCommits(t *testing.T, count int) []testCommit {
+	var commits []testCommit
+
+	for i := 0; i < count; i++ {
+		anchorID, err := ToAnchorID(utils.RandomSlice(32))
+		assert.NoError(t, err)
+
+		documentRoot, err := ToDocumentRoot(utils.RandomSlice(32))
+		assert.NoError(t, err)
+
+		commits = append(commits, testCommit{
+			anchorID:     anchorID,
+			documentRoot: documentRoot,
+			proof:        utils.RandomByte32(),
+		})
+	}
+
+	return commits
+}
+
+func getTestAccountContext(t *testing.T) context.Context {
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").Return(accountID).Maybe()
+
+	return contextutil.WithAccount(context.Background(), accountMock)
+}
+
+func commitConcurrently(b API, ctx context.Context, commits []testCommit) []error {
+	var wg sync.WaitGroup
+
+	errs := make([]error, len(commits))
+
+	for i, commit := range commits {
+		wg.Add(1)
+
+		go func(i int, commit testCommit) {
+			defer wg.Done()
+
+			errs[i] = b.CommitAnchor(ctx, commit.anchorID, commit.documentRoot, commit.proof)
+		}(i, commit)
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+func TestBatcher_CommitAnchor(t *testing.T) {
+	apiMock := NewAPIMock(t)
+	utilityAPIMock := utility.NewAPIMock(t)
+
+	b := NewBatcher(apiMock, utilityAPIMock, time.Minute, 100*time.Millisecond)
+
+	ctx := getTestAccountContext(t)
+
+	commits := getTestCommits(t, 3)
+
+	utilityAPIMock.On("BatchAllProxyCalls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(&centchain.ExtrinsicInfo{}, nil).
+		Once()
+
+	errs := commitConcurrently(b, ctx, commits)
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestBatcher_CommitAnchor_BatchContext(t *testing.T) {
+	apiMock := NewAPIMock(t)
+	utilityAPIMock := utility.NewAPIMock(t)
+
+	b := NewBatcher(apiMock, utilityAPIMock, time.Minute, 100*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(getTestAccountContext(t))
+
+	commits := getTestCommits(t, 2)
+
+	batchSubmitted := make(chan struct{})
+
+	// Cancelling the context of a caller does not affect the batch.
+	utilityAPIMock.On("BatchAllProxyCalls", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			batchCtx := args.Get(0).(context.Context)
+
+			cancel()
+
+			assert.NoError(t, batchCtx.Err())
+
+			_, err := contextutil.Identity(batchCtx)
+			assert.NoError(t, err)
+
+			close(batchSubmitted)
+		}).
+		Return(&centchain.ExtrinsicInfo{}, nil).
+		Once()
+
+	commitConcurrently(b, ctx, commits)
+
+	<-batchSubmitted
+}
+
+func TestBatcher_CommitAnchor_MultipleAccounts(t *testing.T) {
+	apiMock := NewAPIMock(t)
+	utilityAPIMock := utility.NewAPIMock(t)
+
+	b := NewBatcher(apiMock, utilityAPIMock, time.Minute, 100*time.Millisecond)
+
+	ctx1 := getTestAccountContext(t)
+	ctx2 := getTestAccountContext(t)
+
+	commits1 := getTestCommits(t, 2)
+	commits2 := getTestCommits(t, 1)
+
+	// The commits of both accounts are submitted in the same batch.
+	utilityAPIMock.On("BatchAllProxyCalls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(&centchain.ExtrinsicInfo{}, nil).
+		Once()
+
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		for _, err := range commitConcurrently(b, ctx1, commits1) {
+			assert.NoError(t, err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		for _, err := range commitConcurrently(b, ctx2, commits2) {
+			assert.NoError(t, err)
+		}
+	}()
+
+	wg.Wait()
+}
+
+func TestBatcher_CommitAnchor_SingleCommit(t *testing.T) {
+	apiMock := NewAPIMock(t)
+	utilityAPIMock := utility.NewAPIMock(t)
+
+	b := NewBatcher(apiMock, utilityAPIMock, time.Minute, 100*time.Millisecond)
+
+	ctx := getTestAccountContext(t)
+
+	commit := getTestCommits(t, 1)[0]
+
+	// A single commit is submitted without a batch.
+	apiMock.On("CommitAnchor", ctx, commit.anchorID, commit.documentRoot, commit.proof).
+		Return(nil).
+		Once()
+
+	err := b.CommitAnchor(ctx, commit.anchorID, commit.documentRoot, commit.proof)
+	assert.NoError(t, err)
+}
+
+func TestBatcher_CommitAnchor_BatchError(t *testing.T) {
+	apiMock := NewAPIMock(t)
+	utilityAPIMock := utility.NewAPIMock(t)
+
+	b := NewBatcher(apiMock, utilityAPIMock, time.Minute, 100*time.Millisecond)
+
+	ctx := getTestAccountContext(t)
+
+	commits := getTestCommits(t, 3)
+
+	utilityAPIMock.On("BatchAllProxyCalls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("error")).
+		Once()
+
+	// The first anchor was committed before the batch failed.
+	apiMock.On("GetAnchorData", commits[0].anchorID).
+		Return(commits[0].documentRoot, time.Unix(0, 0), nil).
+		Once()
+
+	apiMock.On("GetAnchorData", commits[1].anchorID).
+		Return(DocumentRoot{}, time.Time{}, ErrAnchorRetrieval).
+		Once()
+
+	apiMock.On("GetAnchorData", commits[2].anchorID).
+		Return(DocumentRoot{}, time.Time{}, ErrAnchorRetrieval).
+		Once()
+
+	commitErr := errors.New("commit error")
+
+	// The remaining commits are retried individually, each one gets its own result.
+	apiMock.On("CommitAnchor", ctx, commits[1].anchorID, commits[1].documentRoot, commits[1].proof).
+		Return(nil).
+		Once()
+
+	apiMock.On("CommitAnchor", ctx, commits[2].anchorID, commits[2].documentRoot, commits[2].proof).
+		Return(commitErr).
+		Once()
+
+	errs := commitConcurrently(b, ctx, commits)
+
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.ErrorIs(t, errs[2], commitErr)
+}
+
+func TestBatcher_CommitAnchor_MaxBatchSize(t *testing.T) {
+	apiMock := NewAPIMock(t)
+	utilityAPIMock := utility.NewAPIMock(t)
+
+	b := NewBatcher(apiMock, utilityAPIMock, time.Minute, time.Hour)
+	b.(*batcher).maxBatchSize = 2
+
+	ctx := getTestAccountContext(t)
+
+	commits := getTestCommits(t, 2)
+
+	utilityAPIMock.On("BatchAllProxyCalls", mock.Anything, mock.Anything, mock.Anything).
+		Return(&centchain.ExtrinsicInfo{}, nil).
+		Once()
+
+	// The batch is submitted as soon as it is full, without waiting for the batch window.
+	errs := commitConcurrently(b, ctx, commits)
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestBatcher_CommitAnchor_NoIdentity(t *testing.T) {
+	apiMock := NewAPIMock(t)
+	utilityAPIMock := utility.NewAPIMock(t)
+
+	b := NewBatcher(apiMock, utilityAPIMock, time.Minute, 100*time.Millisecond)
+
+	commit := getTestCommits(t, 1)[0]
+
+	err := b.CommitAnchor(context.Background(), commit.anchorID, commit.documentRoot, commit.proof)
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+}
@@ -1,10 +1,15 @@
 package pallets
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/centrifuge/pod/centchain"
 	"github.com/centrifuge/pod/config"
+	"github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/errors"
 	"github.com/centrifuge/pod/pallets/anchors"
+	"github.com/centrifuge/pod/pallets/cache"
 	"github.com/centrifuge/pod/pallets/keystore"
 	"github.com/centrifuge/pod/pallets/loans"
 	"github.com/centrifuge/pod/pallets/permissions"
@@ -21,23 +26,25 @@ const (
 	BootstrappedUtilityAPI     = "BootstrappedUtilityAPI"
 	BootstrappedPermissionsAPI = "BootstrappedPermissionsAPI"
 	BootstrappedLoansAPI       = "BootstrappedLoansAPI"
+
+	BootstrappedCacheInvalidator = "BootstrappedCacheInvalidator"
 )
 
 type Bootstrapper struct{}
 
-func (b *Bootstrapper) Bootstrap(context map[string]interface{}) error {
-	cfg, err := config.RetrieveConfig(false, context)
+func (b *Bootstrapper) Bootstrap(ctx map[string]interface{}) error {
+	cfg, err := config.RetrieveConfig(false, ctx)
 	if err != nil {
 		return err
 	}
 
-	centAPI, ok := context[centchain.BootstrappedCentChainClient].(centchain.API)
+	centAPI, ok := ctx[centchain.BootstrappedCentChainClient].(centchain.API)
 
 	if !ok {
 		return errors.New("centchain API not initialised")
 	}
 
-	cfgService, ok := context[config.BootstrappedConfigStorage].(config.Service)
+	cfgService, ok := ctx[config.BootstrappedConfigStorage].(config.Service)
 
 	if !ok {
 		return errors.New("config service not initialised")
@@ -49,33 +56,86 @@ func (b *Bootstrapper) Bootstrap(context map[string]interface{}) error {
 		return errors.ErrPodOperatorRetrieval
 	}
 
-	proxyAPI := proxy.NewAPI(centAPI)
+	var invalidator *cache.Invalidator
+	var blockNumberProvider cache.BlockNumberProvider
 
-	context[BootstrappedProxyAPI] = proxyAPI
+	cacheTTL := cfg.GetCentChainCacheTTL()
 
-	keystoreAPI := keystore.NewAPI(centAPI, proxyAPI, podOperator)
+	if cacheTTL > 0 {
+		eventDispatcher, ok := ctx[centchain.BootstrappedEventDispatcher].(dispatcher.Dispatcher[*centchain.Event])
 
-	context[BootstrappedKeystoreAPI] = keystoreAPI
+		if !ok {
+			return errors.New("centchain event dispatcher not initialised")
+		}
 
-	uniquesAPI := uniques.NewAPI(centAPI, proxyAPI, podOperator)
+		blockNumberProvider, ok = ctx[centchain.BootstrappedEventListener].(cache.BlockNumberProvider)
 
-	context[BootstrappedUniquesAPI] = uniquesAPI
+		if !ok {
+			return errors.New("centchain event listener not initialised")
+		}
 
-	anchorsAPI := anchors.NewAPI(centAPI, proxyAPI, cfg.GetCentChainAnchorLifespan(), podOperator)
+		invalidator = cache.NewInvalidator(eventDispatcher)
+
+		ctx[BootstrappedCacheInvalidator] = invalidator
+	}
+
+	var proxyAPI proxy.API = proxy.NewAPI(centAPI)
+
+	if invalidator != nil {
+		proxyAPI = cache.NewProxyAPI(proxyAPI, cacheTTL, blockNumberProvider, invalidator)
+	}
+
+	ctx[BootstrappedProxyAPI] = proxyAPI
 
-	context[BootstrappedAnchorService] = anchorsAPI
+	var keystoreAPI keystore.API = keystore.NewAPI(centAPI, proxyAPI, podOperator)
+
+	if invalidator != nil {
+		keystoreAPI = cache.NewKeystoreAPI(keystoreAPI, cacheTTL, blockNumberProvider, invalidator)
+	}
+
+	ctx[BootstrappedKeystoreAPI] = keystoreAPI
+
+	var uniquesAPI uniques.API = uniques.NewAPI(centAPI, proxyAPI, podOperator)
+
+	if invalidator != nil {
+		uniquesAPI = cache.NewUniquesAPI(uniquesAPI, cacheTTL, blockNumberProvider, invalidator)
+	}
+
+	ctx[BootstrappedUniquesAPI] = uniquesAPI
 
 	utilityAPI := utility.NewAPI(centAPI, proxyAPI, podOperator)
 
-	context[BootstrappedUtilityAPI] = utilityAPI
+	ctx[BootstrappedUtilityAPI] = utilityAPI
 
-	permissionsAPI := permissions.NewAPI(centAPI)
+	anchorsAPI := anchors.NewAPI(centAPI, proxyAPI, cfg.GetCentChainAnchorLifespan(), podOperator)
 
-	context[BootstrappedPermissionsAPI] = permissionsAPI
+	if batchWindow := cfg.GetCentChainAnchorBatchWindow(); batchWindow > 0 {
+		anchorsAPI = anchors.NewBatcher(anchorsAPI, utilityAPI, cfg.GetCentChainAnchorLifespan(), batchWindow)
+	}
 
-	loansAPI := loans.NewAPI(centAPI)
+	ctx[BootstrappedAnchorService] = anchorsAPI
+
+	var permissionsAPI permissions.API = permissions.NewAPI(centAPI)
+
+	if invalidator != nil {
+		permissionsAPI = cache.NewPermissionsAPI(permissionsAPI, cacheTTL, blockNumberProvider, invalidator)
+	}
 
-	context[BootstrappedLoansAPI] = loansAPI
+	ctx[BootstrappedPermissionsAPI] = permissionsAPI
+
+	var loansAPI loans.API = loans.NewAPI(centAPI)
+
+	if invalidator != nil {
+		loansAPI = cache.NewLoansAPI(loansAPI, cacheTTL, blockNumberProvider, invalidator)
+	}
+
+	ctx[BootstrappedLoansAPI] = loansAPI
+
+	if invalidator != nil {
+		if err := invalidator.Start(context.Background()); err != nil {
+			return fmt.Errorf("couldn't start cache invalidator: %w", err)
+		}
+	}
 
 	return nil
 }
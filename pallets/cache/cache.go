@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	logging "github.com/ipfs/go-log"
+)
+
+var (
+	log = logging.Logger("pallets_cache")
+)
+
+//go:generate mockery --name BlockNumberProvider --structname BlockNumberProviderMock --filename block_number_provider_mock.go --inpackage
+
+// BlockNumberProvider provides the number of the latest finalized block whose events were processed.
+type BlockNumberProvider interface {
+	LastProcessedBlock() types.BlockNumber
+}
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// keyInvalidation holds the block at which the values of the matching keys were invalidated.
+type keyInvalidation[K comparable] struct {
+	blockNumber types.BlockNumber
+	matchFn     func(key K) bool
+	expiresAt   time.Time
+}
+
+// Cache is a TTL cache for chain reads that is aware of the block number at which an invalidation occurred.
+//
+// A value is only stored if the last processed block at the time the load started is not older than the
+// last invalidation, this ensures that a load that raced with an invalidating event does not get cached.
+type Cache[K comparable, V any] struct {
+	name                string
+	ttl                 time.Duration
+	blockNumberProvider BlockNumberProvider
+
+	mu               sync.RWMutex
+	entries          map[K]*entry[V]
+	invalidatedAt    types.BlockNumber
+	keyInvalidations []*keyInvalidation[K]
+	lastEviction     time.Time
+}
+
+// New returns a new Cache, the name is used as label for the cache metrics.
+func New[K comparable, V any](name string, ttl time.Duration, blockNumberProvider BlockNumberProvider) *Cache[K, V] {
+	return &Cache[K, V]{
+		name:                name,
+		ttl:                 ttl,
+		blockNumberProvider: blockNumberProvider,
+		entries:             make(map[K]*entry[V]),
+	}
+}
+
+// Get returns the value stored for the key, if any.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[key]
+
+	if !ok || time.Now().After(e.expiresAt) {
+		var zero V
+
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+// GetOrLoad returns the value stored for the key or loads it using the provided function.
+// Errors returned by the load function are not cached.
+func (c *Cache[K, V]) GetOrLoad(key K, loadFn func() (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		cacheHits.WithLabelValues(c.name).Inc()
+
+		return value, nil
+	}
+
+	cacheMisses.WithLabelValues(c.name).Inc()
+
+	readBlock := c.blockNumberProvider.LastProcessedBlock()
+
+	value, err := loadFn()
+
+	if err != nil {
+		return value, err
+	}
+
+	c.set(key, value, readBlock)
+
+	return value, nil
+}
+
+func (c *Cache[K, V]) set(key K, value V, readBlock types.BlockNumber) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if readBlock < c.invalidatedAt {
+		log.Debugf("Skipping %s cache entry read at block %d, invalidated at block %d", c.name, readBlock, c.invalidatedAt)
+
+		return
+	}
+
+	for _, keyInvalidation := range c.keyInvalidations {
+		if readBlock < keyInvalidation.blockNumber && keyInvalidation.matchFn(key) {
+			log.Debugf("Skipping %s cache entry read at block %d, key invalidated at block %d", c.name, readBlock, keyInvalidation.blockNumber)
+
+			return
+		}
+	}
+
+	c.entries[key] = &entry[V]{
+		value:     value,
+		expiresAt: time.Now().Add(c.ttl),
+	}
+
+	c.evictExpired()
+}
+
+// Invalidate removes the value stored for the key.
+func (c *Cache[K, V]) Invalidate(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		return
+	}
+
+	delete(c.entries, key)
+
+	cacheInvalidations.WithLabelValues(c.name).Inc()
+}
+
+// InvalidateMatching removes the values whose keys match the provided function due to a change that happened
+// at the provided block.
+func (c *Cache[K, V]) InvalidateMatching(blockNumber types.BlockNumber, matchFn func(key K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if blockNumber > c.invalidatedAt {
+		c.keyInvalidations = append(c.keyInvalidations, &keyInvalidation[K]{
+			blockNumber: blockNumber,
+			matchFn:     matchFn,
+			expiresAt:   time.Now().Add(c.ttl),
+		})
+	}
+
+	for key := range c.entries {
+		if matchFn(key) {
+			delete(c.entries, key)
+		}
+	}
+
+	cacheInvalidations.WithLabelValues(c.name).Inc()
+}
+
+// InvalidateAll removes all the values stored in the cache due to a change that happened at the provided block.
+func (c *Cache[K, V]) InvalidateAll(blockNumber types.BlockNumber) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if blockNumber > c.invalidatedAt {
+		c.invalidatedAt = blockNumber
+	}
+
+	c.entries = make(map[K]*entry[V])
+
+	// The key invalidations up to the block are covered by the invalidation of all the values.
+	keyInvalidations := c.keyInvalidations[:0]
+
+	for _, keyInvalidation := range c.keyInvalidations {
+		if keyInvalidation.blockNumber > c.invalidatedAt {
+			keyInvalidations = append(keyInvalidations, keyInvalidation)
+		}
+	}
+
+	c.keyInvalidations = keyInvalidations
+
+	cacheInvalidations.WithLabelValues(c.name).Inc()
+}
+
+// Purge removes all the values stored in the cache after a change that was submitted by this node.
+// Values loaded before the next finalized block is processed are not stored.
+func (c *Cache[K, V]) Purge() {
+	c.InvalidateAll(c.blockNumberProvider.LastProcessedBlock() + 1)
+}
+
+// Len returns the number of values stored in the cache.
+func (c *Cache[K, V]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.entries)
+}
+
+// evictExpired removes the expired entries and key invalidations at most once per TTL, it must be called while
+// holding the write lock.
+//
+// A key invalidation is kept for a TTL, which is enough for the loads that raced with it to complete.
+func (c *Cache[K, V]) evictExpired() {
+	now := time.Now()
+
+	if now.Sub(c.lastEviction) < c.ttl {
+		return
+	}
+
+	c.lastEviction = now
+
+	for key, e := range c.entries {
+		if now.After(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	keyInvalidations := c.keyInvalidations[:0]
+
+	for _, keyInvalidation := range c.keyInvalidations {
+		if !now.After(keyInvalidation.expiresAt) {
+			keyInvalidations = append(keyInvalidations, keyInvalidation)
+		}
+	}
+
+	c.keyInvalidations = keyInvalidations
+}
@@ -0,0 +1,258 @@
+//go:build unit
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_GetOrLoad(t *testing.T) {
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+
+	cache := New[string, int]("test", time.Minute, blockNumberProviderMock)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1)).
+		Once()
+
+	loadCount := 0
+
+	loadFn := func() (int, error) {
+		loadCount++
+
+		return 11, nil
+	}
+
+	res, err := cache.GetOrLoad("key", loadFn)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, res)
+	assert.Equal(t, 1, loadCount)
+
+	// Cached value should be returned.
+	res, err = cache.GetOrLoad("key", loadFn)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, res)
+	assert.Equal(t, 1, loadCount)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestCache_GetOrLoad_LoadError(t *testing.T) {
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+
+	cache := New[string, int]("test", time.Minute, blockNumberProviderMock)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1)).
+		Once()
+
+	loadErr := errors.New("error")
+
+	res, err := cache.GetOrLoad("key", func() (int, error) {
+		return 0, loadErr
+	})
+	assert.ErrorIs(t, err, loadErr)
+	assert.Equal(t, 0, res)
+
+	_, ok := cache.Get("key")
+	assert.False(t, ok)
+}
+
+func TestCache_Expiry(t *testing.T) {
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+
+	cache := New[string, int]("test", 10*time.Millisecond, blockNumberProviderMock)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	_, err := cache.GetOrLoad("key", func() (int, error) {
+		return 1, nil
+	})
+	assert.NoError(t, err)
+
+	res, ok := cache.Get("key")
+	assert.True(t, ok)
+	assert.Equal(t, 1, res)
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, ok = cache.Get("key")
+	assert.False(t, ok)
+
+	// Expired entries are evicted when a new value is stored.
+	_, err = cache.GetOrLoad("other_key", func() (int, error) {
+		return 2, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+
+	cache := New[string, int]("test", time.Minute, blockNumberProviderMock)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	for _, key := range []string{"key1", "key2"} {
+		_, err := cache.GetOrLoad(key, func() (int, error) {
+			return 1, nil
+		})
+		assert.NoError(t, err)
+	}
+
+	cache.Invalidate("key1")
+
+	_, ok := cache.Get("key1")
+	assert.False(t, ok)
+
+	_, ok = cache.Get("key2")
+	assert.True(t, ok)
+
+	cache.InvalidateAll(1)
+
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCache_InvalidateAll_StaleLoad(t *testing.T) {
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+
+	cache := New[string, int]("test", time.Minute, blockNumberProviderMock)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1)).
+		Once()
+
+	// An invalidation that happens while loading should prevent the loaded value from being stored.
+	_, err := cache.GetOrLoad("key", func() (int, error) {
+		cache.InvalidateAll(2)
+
+		return 1, nil
+	})
+	assert.NoError(t, err)
+
+	_, ok := cache.Get("key")
+	assert.False(t, ok)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(2)).
+		Once()
+
+	_, err = cache.GetOrLoad("key", func() (int, error) {
+		return 1, nil
+	})
+	assert.NoError(t, err)
+
+	_, ok = cache.Get("key")
+	assert.True(t, ok)
+}
+
+func TestCache_InvalidateMatching(t *testing.T) {
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+
+	cache := New[string, int]("test", time.Minute, blockNumberProviderMock)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	for _, key := range []string{"key1", "key2"} {
+		_, err := cache.GetOrLoad(key, func() (int, error) {
+			return 1, nil
+		})
+		assert.NoError(t, err)
+	}
+
+	matchFn := func(key string) bool {
+		return key == "key1"
+	}
+
+	cache.InvalidateMatching(2, matchFn)
+
+	_, ok := cache.Get("key1")
+	assert.False(t, ok)
+
+	_, ok = cache.Get("key2")
+	assert.True(t, ok)
+
+	// Matching keys read before the invalidation block are not stored.
+	_, err := cache.GetOrLoad("key1", func() (int, error) {
+		return 1, nil
+	})
+	assert.NoError(t, err)
+
+	_, ok = cache.Get("key1")
+	assert.False(t, ok)
+
+	_, err = cache.GetOrLoad("key3", func() (int, error) {
+		return 1, nil
+	})
+	assert.NoError(t, err)
+
+	_, ok = cache.Get("key3")
+	assert.True(t, ok)
+
+	// The key invalidation is dropped once all the values are invalidated after it.
+	cache.InvalidateAll(2)
+
+	assert.Equal(t, 0, cache.Len())
+	assert.Len(t, cache.keyInvalidations, 0)
+}
+
+func TestCache_InvalidateMatching_Expiry(t *testing.T) {
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+
+	cache := New[string, int]("test", 10*time.Millisecond, blockNumberProviderMock)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	cache.InvalidateMatching(2, func(key string) bool {
+		return key == "key1"
+	})
+
+	assert.Len(t, cache.keyInvalidations, 1)
+
+	time.Sleep(20 * time.Millisecond)
+
+	// Expired key invalidations are evicted when a new value is stored.
+	_, err := cache.GetOrLoad("key2", func() (int, error) {
+		return 1, nil
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, cache.keyInvalidations, 0)
+}
+
+func TestCache_Purge(t *testing.T) {
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+
+	cache := New[string, int]("test", time.Minute, blockNumberProviderMock)
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1)).
+		Times(3)
+
+	_, err := cache.GetOrLoad("key", func() (int, error) {
+		return 1, nil
+	})
+	assert.NoError(t, err)
+
+	cache.Purge()
+
+	assert.Equal(t, 0, cache.Len())
+
+	// Values read before the next block is processed are not stored.
+	_, err = cache.GetOrLoad("key", func() (int, error) {
+		return 1, nil
+	})
+	assert.NoError(t, err)
+
+	_, ok := cache.Get("key")
+	assert.False(t, ok)
+}
@@ -0,0 +1,6 @@
+// Package cache provides caches for the chain reads of the pallet APIs.
+//
+// The cached values are invalidated by the keys that the events of their pallet touch, if the keys can't
+// be determined from an event, all the values of the cache are invalidated. The values are also invalidated
+// after a change submitted by this node, and expire after the configured TTL.
+package cache
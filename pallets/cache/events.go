@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+)
+
+const (
+	// fieldSeparator separates the type path from the field name in the names of the decoded fields.
+	fieldSeparator = "."
+)
+
+// getEventField returns the value of the event field with the provided name.
+//
+// The names of the decoded fields are prefixed by the path of their type, if any, and composite values with
+// a single field, such as new types, are unwrapped.
+func getEventField(event *centchain.Event, name string) (any, bool) {
+	for _, field := range event.Fields {
+		if field.Name != name && !strings.HasSuffix(field.Name, fieldSeparator+name) {
+			continue
+		}
+
+		value := field.Value
+
+		for {
+			fields, ok := value.(registry.DecodedFields)
+
+			if !ok || len(fields) != 1 {
+				break
+			}
+
+			value = fields[0].Value
+		}
+
+		return value, true
+	}
+
+	return nil, false
+}
+
+// GetAccountIDEventField returns the account ID stored in the event field with the provided name.
+func GetAccountIDEventField(event *centchain.Event, name string) (types.AccountID, bool) {
+	value, ok := getEventField(event, name)
+
+	if !ok {
+		return types.AccountID{}, false
+	}
+
+	switch v := value.(type) {
+	case types.AccountID:
+		return v, true
+	case []any:
+		var accountID types.AccountID
+
+		if len(v) != len(accountID) {
+			return types.AccountID{}, false
+		}
+
+		for i, item := range v {
+			b, ok := item.(types.U8)
+
+			if !ok {
+				return types.AccountID{}, false
+			}
+
+			accountID[i] = byte(b)
+		}
+
+		return accountID, true
+	default:
+		return types.AccountID{}, false
+	}
+}
+
+// getU64EventField returns the U64 stored in the event field with the provided name.
+func getU64EventField(event *centchain.Event, name string) (types.U64, bool) {
+	value, ok := getEventField(event, name)
+
+	if !ok {
+		return 0, false
+	}
+
+	v, ok := value.(types.U64)
+
+	return v, ok
+}
+
+// getU128EventField returns the U128 stored in the event field with the provided name.
+func getU128EventField(event *centchain.Event, name string) (types.U128, bool) {
+	value, ok := getEventField(event, name)
+
+	if !ok {
+		return types.U128{}, false
+	}
+
+	v, ok := value.(types.U128)
+
+	return v, ok && v.Int != nil
+}
@@ -0,0 +1,101 @@
+//go:build unit
+
+package cache
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountIDEventField(t *testing.T) {
+	accountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	event := &centchain.Event{
+		Fields: registry.DecodedFields{
+			getDecodedAccountIDField("sp_core.crypto.AccountId32.owner", accountID),
+			{Name: "who", Value: *accountID},
+			{Name: "invalid", Value: registry.DecodedFields{{Value: []any{types.U8(1)}}}},
+		},
+	}
+
+	res, ok := GetAccountIDEventField(event, "owner")
+	assert.True(t, ok)
+	assert.Equal(t, *accountID, res)
+
+	res, ok = GetAccountIDEventField(event, "who")
+	assert.True(t, ok)
+	assert.Equal(t, *accountID, res)
+
+	_, ok = GetAccountIDEventField(event, "invalid")
+	assert.False(t, ok)
+
+	_, ok = GetAccountIDEventField(event, "missing")
+	assert.False(t, ok)
+
+	// Partial field names are not matched.
+	_, ok = GetAccountIDEventField(event, "ner")
+	assert.False(t, ok)
+}
+
+func TestGetU64EventField(t *testing.T) {
+	event := &centchain.Event{
+		Fields: registry.DecodedFields{
+			{Name: "pool_id", Value: types.U64(1)},
+			{Name: "cfg_primitives.LoanId.loan_id", Value: registry.DecodedFields{{Value: types.U64(2)}}},
+			{Name: "invalid", Value: types.U32(3)},
+		},
+	}
+
+	res, ok := getU64EventField(event, "pool_id")
+	assert.True(t, ok)
+	assert.Equal(t, types.U64(1), res)
+
+	res, ok = getU64EventField(event, "loan_id")
+	assert.True(t, ok)
+	assert.Equal(t, types.U64(2), res)
+
+	_, ok = getU64EventField(event, "invalid")
+	assert.False(t, ok)
+
+	_, ok = getU64EventField(event, "missing")
+	assert.False(t, ok)
+}
+
+func TestGetU128EventField(t *testing.T) {
+	event := &centchain.Event{
+		Fields: registry.DecodedFields{
+			{Name: "item", Value: types.NewU128(*big.NewInt(1))},
+			{Name: "nil", Value: types.U128{}},
+		},
+	}
+
+	res, ok := getU128EventField(event, "item")
+	assert.True(t, ok)
+	assert.Equal(t, types.NewU128(*big.NewInt(1)), res)
+
+	_, ok = getU128EventField(event, "nil")
+	assert.False(t, ok)
+
+	_, ok = getU128EventField(event, "missing")
+	assert.False(t, ok)
+}
+
+func getDecodedAccountIDField(name string, accountID *types.AccountID) *registry.DecodedField {
+	var value []any
+
+	for _, b := range accountID.ToBytes() {
+		value = append(value, types.U8(b))
+	}
+
+	return &registry.DecodedField{
+		Name:  name,
+		Value: registry.DecodedFields{{Name: "[u8; 32]", Value: value}},
+	}
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/dispatcher"
+)
+
+// InvalidationFn is called for each chain event of the pallet it was registered for.
+type InvalidationFn func(event *centchain.Event)
+
+// Invalidator invalidates cached chain reads when events of the pallets they depend on are dispatched.
+type Invalidator struct {
+	eventDispatcher dispatcher.Dispatcher[*centchain.Event]
+
+	mu              sync.RWMutex
+	invalidationFns map[string][]InvalidationFn
+}
+
+// NewInvalidator returns a new Invalidator that processes the events of the provided dispatcher.
+func NewInvalidator(eventDispatcher dispatcher.Dispatcher[*centchain.Event]) *Invalidator {
+	return &Invalidator{
+		eventDispatcher: eventDispatcher,
+		invalidationFns: make(map[string][]InvalidationFn),
+	}
+}
+
+// Register adds an InvalidationFn for the events of the provided pallet.
+func (i *Invalidator) Register(palletName string, fn InvalidationFn) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.invalidationFns[palletName] = append(i.invalidationFns[palletName], fn)
+}
+
+// KeyMatchFn returns the function that matches the cache keys touched by an event.
+//
+// A nil match function is returned for events that don't touch any key, false is returned if the keys
+// can't be determined from the event.
+type KeyMatchFn[K comparable] func(event *centchain.Event) (func(key K) bool, bool)
+
+// RegisterCache invalidates the values of the cache whose keys are touched by the events of the provided pallets.
+// All the values are invalidated if the touched keys can't be determined from the event.
+func RegisterCache[K comparable, V any](
+	invalidator *Invalidator,
+	cache *Cache[K, V],
+	keyMatchFn KeyMatchFn[K],
+	palletNames ...string,
+) {
+	for _, palletName := range palletNames {
+		invalidator.Register(palletName, func(event *centchain.Event) {
+			matchFn, ok := keyMatchFn(event)
+
+			switch {
+			case !ok:
+				log.Debugf("Invalidating %s cache due to event %s at block %d", cache.name, event.Name, event.BlockNumber)
+
+				cache.InvalidateAll(event.BlockNumber)
+			case matchFn != nil:
+				log.Debugf("Invalidating %s cache keys due to event %s at block %d", cache.name, event.Name, event.BlockNumber)
+
+				cache.InvalidateMatching(event.BlockNumber, matchFn)
+			}
+		})
+	}
+}
+
+// Start subscribes to the chain events and processes them until the context is done.
+func (i *Invalidator) Start(ctx context.Context) error {
+	events, err := i.eventDispatcher.Subscribe(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	go i.processEvents(ctx, events)
+
+	return nil
+}
+
+func (i *Invalidator) processEvents(ctx context.Context, events chan *centchain.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Stopping cache invalidator: %s", ctx.Err())
+
+			if err := i.eventDispatcher.Unsubscribe(events); err != nil {
+				log.Errorf("Couldn't unsubscribe from chain events: %s", err)
+			}
+
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			i.processEvent(event)
+		}
+	}
+}
+
+func (i *Invalidator) processEvent(event *centchain.Event) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for _, fn := range i.invalidationFns[event.PalletName()] {
+		fn(event)
+	}
+}
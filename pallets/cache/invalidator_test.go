@@ -0,0 +1,132 @@
+//go:build unit
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventDispatcher := dispatcher.NewDispatcher[*centchain.Event](ctx)
+
+	invalidator := NewInvalidator(eventDispatcher)
+
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	keystoreCache := New[string, int]("keystore", time.Minute, blockNumberProviderMock)
+	proxyCache := New[string, int]("proxy", time.Minute, blockNumberProviderMock)
+
+	invalidateAllFn := func(_ *centchain.Event) (func(key string) bool, bool) {
+		return nil, false
+	}
+
+	RegisterCache(invalidator, keystoreCache, invalidateAllFn, centchain.KeystorePalletName)
+	RegisterCache(invalidator, proxyCache, invalidateAllFn, centchain.ProxyPalletName)
+
+	processedEvents := make(chan *centchain.Event, 1)
+
+	invalidator.Register(centchain.KeystorePalletName, func(event *centchain.Event) {
+		select {
+		case processedEvents <- event:
+		default:
+		}
+	})
+
+	for _, cache := range []*Cache[string, int]{keystoreCache, proxyCache} {
+		_, err := cache.GetOrLoad("key", func() (int, error) {
+			return 1, nil
+		})
+		assert.NoError(t, err)
+	}
+
+	err := invalidator.Start(ctx)
+	assert.NoError(t, err)
+
+	event := &centchain.Event{
+		BlockNumber: 2,
+		Name:        "Keystore.KeyAdded",
+	}
+
+	// The subscription is registered asynchronously by the dispatcher, so the event
+	// is dispatched until it is processed.
+	timeout := time.After(5 * time.Second)
+
+	for processed := false; !processed; {
+		err = eventDispatcher.Dispatch(ctx, event)
+		assert.NoError(t, err)
+
+		select {
+		case <-timeout:
+			t.Fatal("event was not processed")
+		case processedEvent := <-processedEvents:
+			assert.Equal(t, event, processedEvent)
+
+			processed = true
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	assert.Equal(t, 0, keystoreCache.Len())
+	assert.Equal(t, 1, proxyCache.Len())
+}
+
+func TestRegisterCache_KeyMatch(t *testing.T) {
+	invalidator := NewInvalidator(dispatcher.NewDispatcherMock[*centchain.Event](t))
+
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	cache := New[string, int]("test", time.Minute, blockNumberProviderMock)
+
+	RegisterCache(invalidator, cache, func(event *centchain.Event) (func(key string) bool, bool) {
+		switch event.EventName() {
+		case "KeyAdded":
+			return func(key string) bool {
+				return key == "key1"
+			}, true
+		case "DepositSet":
+			return nil, true
+		default:
+			return nil, false
+		}
+	}, centchain.KeystorePalletName)
+
+	for _, key := range []string{"key1", "key2"} {
+		_, err := cache.GetOrLoad(key, func() (int, error) {
+			return 1, nil
+		})
+		assert.NoError(t, err)
+	}
+
+	// Only the touched keys are invalidated.
+	invalidator.processEvent(&centchain.Event{BlockNumber: 2, Name: "Keystore.KeyAdded"})
+
+	_, ok := cache.Get("key1")
+	assert.False(t, ok)
+
+	_, ok = cache.Get("key2")
+	assert.True(t, ok)
+
+	// Events that don't touch any key are ignored.
+	invalidator.processEvent(&centchain.Event{BlockNumber: 3, Name: "Keystore.DepositSet"})
+
+	assert.Equal(t, 1, cache.Len())
+
+	// All the values are invalidated if the keys are unknown.
+	invalidator.processEvent(&centchain.Event{BlockNumber: 4, Name: "Keystore.KeyRevoked"})
+
+	assert.Equal(t, 0, cache.Len())
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	keystoreType "github.com/centrifuge/chain-custom-types/pkg/keystore"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/pallets/keystore"
+)
+
+type keyCacheKey struct {
+	accountID types.AccountID
+	keyID     keystoreType.KeyID
+}
+
+const (
+	keystoreDepositSetEventName = "DepositSet"
+
+	keystoreOwnerFieldName = "owner"
+)
+
+// keystoreKeyMatchFn matches the keys of the account whose keys were added or revoked.
+func keystoreKeyMatchFn(event *centchain.Event) (func(key keyCacheKey) bool, bool) {
+	if event.EventName() == keystoreDepositSetEventName {
+		return nil, true
+	}
+
+	owner, ok := GetAccountIDEventField(event, keystoreOwnerFieldName)
+
+	if !ok {
+		return nil, false
+	}
+
+	return func(key keyCacheKey) bool {
+		return key.accountID == owner
+	}, true
+}
+
+type keystoreAPI struct {
+	keystore.API
+
+	keyCache *Cache[keyCacheKey, *keystoreType.Key]
+}
+
+// NewKeystoreAPI returns a keystore.API that caches the keys retrieved from the chain.
+func NewKeystoreAPI(
+	api keystore.API,
+	ttl time.Duration,
+	blockNumberProvider BlockNumberProvider,
+	invalidator *Invalidator,
+) keystore.API {
+	keyCache := New[keyCacheKey, *keystoreType.Key]("keystore_keys", ttl, blockNumberProvider)
+
+	RegisterCache(invalidator, keyCache, keystoreKeyMatchFn, centchain.KeystorePalletName)
+
+	return &keystoreAPI{
+		API:      api,
+		keyCache: keyCache,
+	}
+}
+
+func (a *keystoreAPI) AddKeys(ctx context.Context, keys []*keystoreType.AddKey) (*centchain.ExtrinsicInfo, error) {
+	extInfo, err := a.API.AddKeys(ctx, keys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	a.keyCache.Purge()
+
+	return extInfo, nil
+}
+
+func (a *keystoreAPI) RevokeKeys(
+	ctx context.Context,
+	keys []*types.Hash,
+	keyPurpose keystoreType.KeyPurpose,
+) (*centchain.ExtrinsicInfo, error) {
+	extInfo, err := a.API.RevokeKeys(ctx, keys, keyPurpose)
+
+	if err != nil {
+		return nil, err
+	}
+
+	a.keyCache.Purge()
+
+	return extInfo, nil
+}
+
+func (a *keystoreAPI) GetKey(accountID *types.AccountID, keyID *keystoreType.KeyID) (*keystoreType.Key, error) {
+	if accountID == nil || keyID == nil {
+		return a.API.GetKey(accountID, keyID)
+	}
+
+	return a.keyCache.GetOrLoad(keyCacheKey{*accountID, *keyID}, func() (*keystoreType.Key, error) {
+		return a.API.GetKey(accountID, keyID)
+	})
+}
@@ -0,0 +1,128 @@
+//go:build unit
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	keystoreType "github.com/centrifuge/chain-custom-types/pkg/keystore"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/centrifuge/pod/pallets/keystore"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeystoreAPI_GetKey(t *testing.T) {
+	keystoreAPIMock := keystore.NewAPIMock(t)
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+	invalidator := NewInvalidator(dispatcher.NewDispatcherMock[*centchain.Event](t))
+
+	api := NewKeystoreAPI(keystoreAPIMock, time.Minute, blockNumberProviderMock, invalidator)
+
+	accountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	keyID := &keystoreType.KeyID{
+		Hash:       types.NewHash(utils.RandomSlice(32)),
+		KeyPurpose: keystoreType.KeyPurposeP2PDocumentSigning,
+	}
+
+	key := &keystoreType.Key{
+		KeyPurpose: keystoreType.KeyPurposeP2PDocumentSigning,
+	}
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	keystoreAPIMock.On("GetKey", accountID, keyID).
+		Return(key, nil).
+		Once()
+
+	res, err := api.GetKey(accountID, keyID)
+	assert.NoError(t, err)
+	assert.Equal(t, key, res)
+
+	// Cached key should be returned.
+	res, err = api.GetKey(accountID, keyID)
+	assert.NoError(t, err)
+	assert.Equal(t, key, res)
+
+	// Cache should be invalidated after a keystore event.
+	invalidator.processEvent(&centchain.Event{BlockNumber: 2, Name: "Keystore.KeyRevoked"})
+
+	keystoreAPIMock.On("GetKey", accountID, keyID).
+		Return(key, nil).
+		Once()
+
+	res, err = api.GetKey(accountID, keyID)
+	assert.NoError(t, err)
+	assert.Equal(t, key, res)
+}
+
+func TestKeystoreAPI_AddKeys(t *testing.T) {
+	keystoreAPIMock := keystore.NewAPIMock(t)
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+	invalidator := NewInvalidator(dispatcher.NewDispatcherMock[*centchain.Event](t))
+
+	api := NewKeystoreAPI(keystoreAPIMock, time.Minute, blockNumberProviderMock, invalidator)
+
+	accountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	keyID := &keystoreType.KeyID{
+		Hash:       types.NewHash(utils.RandomSlice(32)),
+		KeyPurpose: keystoreType.KeyPurposeP2PDocumentSigning,
+	}
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	keystoreAPIMock.On("GetKey", accountID, keyID).
+		Return(&keystoreType.Key{}, nil).
+		Twice()
+
+	_, err = api.GetKey(accountID, keyID)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	keys := []*keystoreType.AddKey{{Key: keyID.Hash, Purpose: keyID.KeyPurpose}}
+
+	keystoreAPIMock.On("AddKeys", ctx, keys).
+		Return(&centchain.ExtrinsicInfo{}, nil).
+		Once()
+
+	_, err = api.AddKeys(ctx, keys)
+	assert.NoError(t, err)
+
+	// The key should be retrieved from the chain after the keys were added.
+	_, err = api.GetKey(accountID, keyID)
+	assert.NoError(t, err)
+}
+
+func Test_keystoreKeyMatchFn(t *testing.T) {
+	owner, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	otherAccountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	matchFn, ok := keystoreKeyMatchFn(&centchain.Event{
+		Name:   "Keystore.KeyAdded",
+		Fields: registry.DecodedFields{getDecodedAccountIDField("sp_core.crypto.AccountId32.owner", owner)},
+	})
+	assert.True(t, ok)
+	assert.True(t, matchFn(keyCacheKey{accountID: *owner}))
+	assert.False(t, matchFn(keyCacheKey{accountID: *otherAccountID}))
+
+	matchFn, ok = keystoreKeyMatchFn(&centchain.Event{Name: "Keystore.DepositSet"})
+	assert.True(t, ok)
+	assert.Nil(t, matchFn)
+
+	_, ok = keystoreKeyMatchFn(&centchain.Event{Name: "Keystore.KeyRevoked"})
+	assert.False(t, ok)
+}
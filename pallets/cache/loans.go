@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/pallets/loans"
+)
+
+type createdLoanCacheKey struct {
+	poolID types.U64
+	loanID types.U64
+}
+
+const (
+	loansPoolIDFieldName = "pool_id"
+	loansLoanIDFieldName = "loan_id"
+)
+
+// loansKeyMatchFn matches the loan of the event, or all the loans of the pool for pool events.
+func loansKeyMatchFn(event *centchain.Event) (func(key createdLoanCacheKey) bool, bool) {
+	poolID, ok := getU64EventField(event, loansPoolIDFieldName)
+
+	if !ok {
+		return nil, false
+	}
+
+	loanID, ok := getU64EventField(event, loansLoanIDFieldName)
+
+	if !ok {
+		return func(key createdLoanCacheKey) bool {
+			return key.poolID == poolID
+		}, true
+	}
+
+	return func(key createdLoanCacheKey) bool {
+		return key.poolID == poolID && key.loanID == loanID
+	}, true
+}
+
+type loansAPI struct {
+	loans.API
+
+	createdLoanCache *Cache[createdLoanCacheKey, *loans.CreatedLoanStorageEntry]
+}
+
+// NewLoansAPI returns a loans.API that caches the created loans retrieved from the chain.
+func NewLoansAPI(
+	api loans.API,
+	ttl time.Duration,
+	blockNumberProvider BlockNumberProvider,
+	invalidator *Invalidator,
+) loans.API {
+	createdLoanCache := New[createdLoanCacheKey, *loans.CreatedLoanStorageEntry]("loans_created_loans", ttl, blockNumberProvider)
+
+	RegisterCache(invalidator, createdLoanCache, loansKeyMatchFn, centchain.LoansPalletName)
+
+	return &loansAPI{
+		API:              api,
+		createdLoanCache: createdLoanCache,
+	}
+}
+
+func (a *loansAPI) GetCreatedLoan(poolID types.U64, loanID types.U64) (*loans.CreatedLoanStorageEntry, error) {
+	key := createdLoanCacheKey{
+		poolID: poolID,
+		loanID: loanID,
+	}
+
+	return a.createdLoanCache.GetOrLoad(key, func() (*loans.CreatedLoanStorageEntry, error) {
+		return a.API.GetCreatedLoan(poolID, loanID)
+	})
+}
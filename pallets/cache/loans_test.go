@@ -0,0 +1,78 @@
+//go:build unit
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/centrifuge/pod/pallets/loans"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoansAPI_GetCreatedLoan(t *testing.T) {
+	loansAPIMock := loans.NewAPIMock(t)
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+	invalidator := NewInvalidator(dispatcher.NewDispatcherMock[*centchain.Event](t))
+
+	api := NewLoansAPI(loansAPIMock, time.Minute, blockNumberProviderMock, invalidator)
+
+	poolID := types.U64(1)
+	loanID := types.U64(2)
+
+	loan := &loans.CreatedLoanStorageEntry{}
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	loansAPIMock.On("GetCreatedLoan", poolID, loanID).
+		Return(loan, nil).
+		Once()
+
+	res, err := api.GetCreatedLoan(poolID, loanID)
+	assert.NoError(t, err)
+	assert.Equal(t, loan, res)
+
+	res, err = api.GetCreatedLoan(poolID, loanID)
+	assert.NoError(t, err)
+	assert.Equal(t, loan, res)
+
+	// Cache should be invalidated after a loans event.
+	invalidator.processEvent(&centchain.Event{BlockNumber: 2, Name: "Loans.Closed"})
+
+	loansAPIMock.On("GetCreatedLoan", poolID, loanID).
+		Return(loan, nil).
+		Once()
+
+	res, err = api.GetCreatedLoan(poolID, loanID)
+	assert.NoError(t, err)
+	assert.Equal(t, loan, res)
+}
+
+func Test_loansKeyMatchFn(t *testing.T) {
+	matchFn, ok := loansKeyMatchFn(&centchain.Event{
+		Name: "Loans.Borrowed",
+		Fields: registry.DecodedFields{
+			{Name: "pool_id", Value: types.U64(1)},
+			{Name: "loan_id", Value: types.U64(2)},
+		},
+	})
+	assert.True(t, ok)
+	assert.True(t, matchFn(createdLoanCacheKey{poolID: 1, loanID: 2}))
+	assert.False(t, matchFn(createdLoanCacheKey{poolID: 1, loanID: 3}))
+
+	matchFn, ok = loansKeyMatchFn(&centchain.Event{
+		Name:   "Loans.PortfolioValuationUpdated",
+		Fields: registry.DecodedFields{{Name: "pool_id", Value: types.U64(1)}},
+	})
+	assert.True(t, ok)
+	assert.True(t, matchFn(createdLoanCacheKey{poolID: 1, loanID: 3}))
+	assert.False(t, matchFn(createdLoanCacheKey{poolID: 2, loanID: 3}))
+
+	_, ok = loansKeyMatchFn(&centchain.Event{Name: "Loans.Created"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "pod"
+	metricsSubsystem = "chain_cache"
+
+	cacheLabel = "cache"
+)
+
+var (
+	cacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "hits_total",
+			Help:      "Number of chain reads served from the cache.",
+		},
+		[]string{cacheLabel},
+	)
+
+	cacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "misses_total",
+			Help:      "Number of chain reads that were not found in the cache.",
+		},
+		[]string{cacheLabel},
+	)
+
+	cacheInvalidations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "invalidations_total",
+			Help:      "Number of cache invalidations.",
+		},
+		[]string{cacheLabel},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(cacheHits, cacheMisses, cacheInvalidations)
+}
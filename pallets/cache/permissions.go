@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/pallets/permissions"
+)
+
+type permissionRolesCacheKey struct {
+	accountID types.AccountID
+	poolID    types.U64
+}
+
+const (
+	permissionsToFieldName   = "to"
+	permissionsFromFieldName = "from"
+)
+
+// permissionsKeyMatchFn matches the roles of the account whose permissions were added or removed.
+func permissionsKeyMatchFn(event *centchain.Event) (func(key permissionRolesCacheKey) bool, bool) {
+	for _, fieldName := range []string{permissionsToFieldName, permissionsFromFieldName} {
+		if accountID, ok := GetAccountIDEventField(event, fieldName); ok {
+			return func(key permissionRolesCacheKey) bool {
+				return key.accountID == accountID
+			}, true
+		}
+	}
+
+	return nil, false
+}
+
+type permissionsAPI struct {
+	permissions.API
+
+	rolesCache *Cache[permissionRolesCacheKey, *permissions.PermissionRoles]
+}
+
+// NewPermissionsAPI returns a permissions.API that caches the permission roles retrieved from the chain.
+func NewPermissionsAPI(
+	api permissions.API,
+	ttl time.Duration,
+	blockNumberProvider BlockNumberProvider,
+	invalidator *Invalidator,
+) permissions.API {
+	rolesCache := New[permissionRolesCacheKey, *permissions.PermissionRoles]("permissions_roles", ttl, blockNumberProvider)
+
+	RegisterCache(invalidator, rolesCache, permissionsKeyMatchFn, centchain.PermissionsPalletName)
+
+	return &permissionsAPI{
+		API:        api,
+		rolesCache: rolesCache,
+	}
+}
+
+func (a *permissionsAPI) GetPermissionRoles(accountID *types.AccountID, poolID types.U64) (*permissions.PermissionRoles, error) {
+	if accountID == nil {
+		return a.API.GetPermissionRoles(accountID, poolID)
+	}
+
+	key := permissionRolesCacheKey{
+		accountID: *accountID,
+		poolID:    poolID,
+	}
+
+	return a.rolesCache.GetOrLoad(key, func() (*permissions.PermissionRoles, error) {
+		return a.API.GetPermissionRoles(accountID, poolID)
+	})
+}
@@ -0,0 +1,90 @@
+//go:build unit
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/centrifuge/pod/errors"
+	"github.com/centrifuge/pod/pallets/permissions"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionsAPI_GetPermissionRoles(t *testing.T) {
+	permissionsAPIMock := permissions.NewAPIMock(t)
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+	invalidator := NewInvalidator(dispatcher.NewDispatcherMock[*centchain.Event](t))
+
+	api := NewPermissionsAPI(permissionsAPIMock, time.Minute, blockNumberProviderMock, invalidator)
+
+	accountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	poolID := types.U64(3)
+
+	roles := &permissions.PermissionRoles{}
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	// Errors should not be cached.
+	permissionsAPIMock.On("GetPermissionRoles", accountID, poolID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	res, err := api.GetPermissionRoles(accountID, poolID)
+	assert.Error(t, err)
+	assert.Nil(t, res)
+
+	permissionsAPIMock.On("GetPermissionRoles", accountID, poolID).
+		Return(roles, nil).
+		Once()
+
+	res, err = api.GetPermissionRoles(accountID, poolID)
+	assert.NoError(t, err)
+	assert.Equal(t, roles, res)
+
+	res, err = api.GetPermissionRoles(accountID, poolID)
+	assert.NoError(t, err)
+	assert.Equal(t, roles, res)
+
+	// Events of other pallets should not invalidate the cache.
+	invalidator.processEvent(&centchain.Event{BlockNumber: 2, Name: "Loans.Created"})
+
+	res, err = api.GetPermissionRoles(accountID, poolID)
+	assert.NoError(t, err)
+	assert.Equal(t, roles, res)
+}
+
+func Test_permissionsKeyMatchFn(t *testing.T) {
+	accountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	otherAccountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	for _, event := range []*centchain.Event{
+		{
+			Name:   "Permissions.Added",
+			Fields: registry.DecodedFields{getDecodedAccountIDField("sp_core.crypto.AccountId32.to", accountID)},
+		},
+		{
+			Name:   "Permissions.Removed",
+			Fields: registry.DecodedFields{getDecodedAccountIDField("sp_core.crypto.AccountId32.from", accountID)},
+		},
+	} {
+		matchFn, ok := permissionsKeyMatchFn(event)
+		assert.True(t, ok)
+		assert.True(t, matchFn(permissionRolesCacheKey{accountID: *accountID, poolID: 1}))
+		assert.False(t, matchFn(permissionRolesCacheKey{accountID: *otherAccountID, poolID: 1}))
+	}
+
+	_, ok := permissionsKeyMatchFn(&centchain.Event{Name: "Permissions.Added"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	proxyType "github.com/centrifuge/chain-custom-types/pkg/proxy"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/pallets/proxy"
+)
+
+const (
+	proxyExecutedEventName             = "ProxyExecuted"
+	proxyAnnouncedEventName            = "Announced"
+	proxyAnnouncementRejectedEventName = "AnnouncementRejected"
+
+	proxyDelegatorFieldName = "delegator"
+	proxyPureFieldName      = "pure"
+)
+
+// ProxyKeyMatchFn matches the accounts whose proxies were changed, that is the delegator or the created pure proxy.
+func ProxyKeyMatchFn(event *centchain.Event) (func(key types.AccountID) bool, bool) {
+	switch event.EventName() {
+	case proxyExecutedEventName, proxyAnnouncedEventName, proxyAnnouncementRejectedEventName:
+		return nil, true
+	}
+
+	for _, fieldName := range []string{proxyDelegatorFieldName, proxyPureFieldName} {
+		if accountID, ok := GetAccountIDEventField(event, fieldName); ok {
+			return func(key types.AccountID) bool {
+				return key == accountID
+			}, true
+		}
+	}
+
+	return nil, false
+}
+
+type proxyAPI struct {
+	proxy.API
+
+	proxiesCache *Cache[types.AccountID, *types.ProxyStorageEntry]
+}
+
+// NewProxyAPI returns a proxy.API that caches the proxies retrieved from the chain.
+func NewProxyAPI(
+	api proxy.API,
+	ttl time.Duration,
+	blockNumberProvider BlockNumberProvider,
+	invalidator *Invalidator,
+) proxy.API {
+	proxiesCache := New[types.AccountID, *types.ProxyStorageEntry]("proxy_proxies", ttl, blockNumberProvider)
+
+	RegisterCache(invalidator, proxiesCache, ProxyKeyMatchFn, centchain.ProxyPalletName)
+
+	return &proxyAPI{
+		API:          api,
+		proxiesCache: proxiesCache,
+	}
+}
+
+func (a *proxyAPI) AddProxy(
+	ctx context.Context,
+	delegate *types.AccountID,
+	delegateProxyType proxyType.CentrifugeProxyType,
+	delay types.U32,
+	krp signature.KeyringPair,
+) error {
+	if err := a.API.AddProxy(ctx, delegate, delegateProxyType, delay, krp); err != nil {
+		return err
+	}
+
+	a.proxiesCache.Purge()
+
+	return nil
+}
+
+func (a *proxyAPI) GetProxies(accountID *types.AccountID) (*types.ProxyStorageEntry, error) {
+	if accountID == nil {
+		return a.API.GetProxies(accountID)
+	}
+
+	return a.proxiesCache.GetOrLoad(*accountID, func() (*types.ProxyStorageEntry, error) {
+		return a.API.GetProxies(accountID)
+	})
+}
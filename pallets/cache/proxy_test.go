@@ -0,0 +1,101 @@
+//go:build unit
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	proxyType "github.com/centrifuge/chain-custom-types/pkg/proxy"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/centrifuge/pod/pallets/proxy"
+	"github.com/centrifuge/pod/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxyAPI_GetProxies(t *testing.T) {
+	proxyAPIMock := proxy.NewAPIMock(t)
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+	invalidator := NewInvalidator(dispatcher.NewDispatcherMock[*centchain.Event](t))
+
+	api := NewProxyAPI(proxyAPIMock, time.Minute, blockNumberProviderMock, invalidator)
+
+	accountID, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	proxies := &types.ProxyStorageEntry{}
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	proxyAPIMock.On("GetProxies", accountID).
+		Return(proxies, nil).
+		Once()
+
+	res, err := api.GetProxies(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, proxies, res)
+
+	// Cached proxies should be returned.
+	res, err = api.GetProxies(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, proxies, res)
+
+	ctx := context.Background()
+	delay := types.U32(0)
+	krp := signature.KeyringPair{}
+
+	proxyAPIMock.On("AddProxy", ctx, accountID, proxyType.PodOperation, delay, krp).
+		Return(nil).
+		Once()
+
+	err = api.AddProxy(ctx, accountID, proxyType.PodOperation, delay, krp)
+	assert.NoError(t, err)
+
+	// The proxies should be retrieved from the chain after a proxy was added and a proxy event was processed.
+	invalidator.processEvent(&centchain.Event{BlockNumber: 2, Name: "Proxy.ProxyAdded"})
+
+	proxyAPIMock.On("GetProxies", accountID).
+		Return(proxies, nil).
+		Once()
+
+	res, err = api.GetProxies(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, proxies, res)
+}
+
+func TestProxyKeyMatchFn(t *testing.T) {
+	delegator, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	pure, err := types.NewAccountID(utils.RandomSlice(32))
+	assert.NoError(t, err)
+
+	matchFn, ok := ProxyKeyMatchFn(&centchain.Event{
+		Name:   "Proxy.ProxyAdded",
+		Fields: registry.DecodedFields{getDecodedAccountIDField("sp_core.crypto.AccountId32.delegator", delegator)},
+	})
+	assert.True(t, ok)
+	assert.True(t, matchFn(*delegator))
+	assert.False(t, matchFn(*pure))
+
+	matchFn, ok = ProxyKeyMatchFn(&centchain.Event{
+		Name:   "Proxy.PureCreated",
+		Fields: registry.DecodedFields{getDecodedAccountIDField("sp_core.crypto.AccountId32.pure", pure)},
+	})
+	assert.True(t, ok)
+	assert.True(t, matchFn(*pure))
+	assert.False(t, matchFn(*delegator))
+
+	matchFn, ok = ProxyKeyMatchFn(&centchain.Event{Name: "Proxy.ProxyExecuted"})
+	assert.True(t, ok)
+	assert.Nil(t, matchFn)
+
+	_, ok = ProxyKeyMatchFn(&centchain.Event{Name: "Proxy.ProxyRemoved"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/pallets/uniques"
+)
+
+type itemCacheKey struct {
+	collectionID types.U64
+	itemID       string
+}
+
+const (
+	uniquesCollectionFieldName = "collection"
+	uniquesItemFieldName       = "item"
+)
+
+// uniquesKeyMatchFn matches the item of the event, or all the items of the collection for collection events.
+func uniquesKeyMatchFn(event *centchain.Event) (func(key itemCacheKey) bool, bool) {
+	collectionID, ok := getU64EventField(event, uniquesCollectionFieldName)
+
+	if !ok {
+		return nil, false
+	}
+
+	itemID, ok := getU128EventField(event, uniquesItemFieldName)
+
+	if !ok {
+		return func(key itemCacheKey) bool {
+			return key.collectionID == collectionID
+		}, true
+	}
+
+	return func(key itemCacheKey) bool {
+		return key.collectionID == collectionID && key.itemID == itemID.String()
+	}, true
+}
+
+type uniquesAPI struct {
+	uniques.API
+
+	itemDetailsCache *Cache[itemCacheKey, *types.ItemDetails]
+}
+
+// NewUniquesAPI returns a uniques.API that caches the item details retrieved from the chain.
+func NewUniquesAPI(
+	api uniques.API,
+	ttl time.Duration,
+	blockNumberProvider BlockNumberProvider,
+	invalidator *Invalidator,
+) uniques.API {
+	itemDetailsCache := New[itemCacheKey, *types.ItemDetails]("uniques_item_details", ttl, blockNumberProvider)
+
+	RegisterCache(invalidator, itemDetailsCache, uniquesKeyMatchFn, centchain.UniquesPalletName)
+
+	return &uniquesAPI{
+		API:              api,
+		itemDetailsCache: itemDetailsCache,
+	}
+}
+
+func (a *uniquesAPI) GetItemDetails(collectionID types.U64, itemID types.U128) (*types.ItemDetails, error) {
+	if itemID.Int == nil {
+		return a.API.GetItemDetails(collectionID, itemID)
+	}
+
+	key := itemCacheKey{
+		collectionID: collectionID,
+		itemID:       itemID.String(),
+	}
+
+	return a.itemDetailsCache.GetOrLoad(key, func() (*types.ItemDetails, error) {
+		return a.API.GetItemDetails(collectionID, itemID)
+	})
+}
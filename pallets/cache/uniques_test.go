@@ -0,0 +1,83 @@
+//go:build unit
+
+package cache
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/registry"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/pod/centchain"
+	"github.com/centrifuge/pod/dispatcher"
+	"github.com/centrifuge/pod/pallets/uniques"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniquesAPI_GetItemDetails(t *testing.T) {
+	uniquesAPIMock := uniques.NewAPIMock(t)
+	blockNumberProviderMock := NewBlockNumberProviderMock(t)
+	invalidator := NewInvalidator(dispatcher.NewDispatcherMock[*centchain.Event](t))
+
+	api := NewUniquesAPI(uniquesAPIMock, time.Minute, blockNumberProviderMock, invalidator)
+
+	collectionID := types.U64(1)
+	itemID := types.NewU128(*big.NewInt(2))
+
+	itemDetails := &types.ItemDetails{}
+
+	blockNumberProviderMock.On("LastProcessedBlock").
+		Return(types.BlockNumber(1))
+
+	uniquesAPIMock.On("GetItemDetails", collectionID, itemID).
+		Return(itemDetails, nil).
+		Once()
+
+	res, err := api.GetItemDetails(collectionID, itemID)
+	assert.NoError(t, err)
+	assert.Equal(t, itemDetails, res)
+
+	// Cached item details should be returned, even for a different U128 instance.
+	res, err = api.GetItemDetails(collectionID, types.NewU128(*big.NewInt(2)))
+	assert.NoError(t, err)
+	assert.Equal(t, itemDetails, res)
+
+	// Cache should be invalidated after a uniques event.
+	invalidator.processEvent(&centchain.Event{BlockNumber: 2, Name: "Uniques.Transferred"})
+
+	uniquesAPIMock.On("GetItemDetails", collectionID, itemID).
+		Return(itemDetails, nil).
+		Once()
+
+	res, err = api.GetItemDetails(collectionID, itemID)
+	assert.NoError(t, err)
+	assert.Equal(t, itemDetails, res)
+}
+
+func Test_uniquesKeyMatchFn(t *testing.T) {
+	itemID := types.NewU128(*big.NewInt(2))
+
+	matchFn, ok := uniquesKeyMatchFn(&centchain.Event{
+		Name: "Uniques.Transferred",
+		Fields: registry.DecodedFields{
+			{Name: "collection", Value: types.U64(1)},
+			{Name: "item", Value: itemID},
+		},
+	})
+	assert.True(t, ok)
+	assert.True(t, matchFn(itemCacheKey{collectionID: 1, itemID: itemID.String()}))
+	assert.False(t, matchFn(itemCacheKey{collectionID: 1, itemID: "3"}))
+	assert.False(t, matchFn(itemCacheKey{collectionID: 2, itemID: itemID.String()}))
+
+	matchFn, ok = uniquesKeyMatchFn(&centchain.Event{
+		Name:   "Uniques.CollectionFrozen",
+		Fields: registry.DecodedFields{{Name: "collection", Value: types.U64(1)}},
+	})
+	assert.True(t, ok)
+	assert.True(t, matchFn(itemCacheKey{collectionID: 1, itemID: "3"}))
+	assert.False(t, matchFn(itemCacheKey{collectionID: 2, itemID: "3"}))
+
+	_, ok = uniquesKeyMatchFn(&centchain.Event{Name: "Uniques.OwnershipAcceptanceChanged"})
+	assert.False(t, ok)
+}
@@ -39,6 +39,12 @@ const (
 	LastKeyByPurposeStorageName = "LastKeyByPurpose"
 )
 
+// KeyPurposeP2PDocumentEncryption is the purpose of the keys that the documents sent to an identity are encrypted for.
+//
+// The purpose is not known to the keystore pallet yet, so it can only be used with document encryption enabled
+// on a chain that supports it.
+const KeyPurposeP2PDocumentEncryption keystore.KeyPurpose = 2
+
 //go:generate mockery --name API --structname APIMock --filename api_mock.go --inpackage
 
 type API interface {
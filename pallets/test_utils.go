@@ -216,6 +216,14 @@ func getUnstoredAccountKeys(
 		},
 	}
 
+	// The document encryption key purpose is only supported by the chain when document encryption is enabled.
+	if cfg.IsP2PEncryptionEnabled() {
+		keys = append(keys, &keystoreTypes.KeyID{
+			Hash:       types.NewHash(acc.GetEncryptionPublicKey()),
+			KeyPurpose: keystore.KeyPurposeP2PDocumentEncryption,
+		})
+	}
+
 	return filterUnstoredAccountKeys(serviceCtx, acc.GetIdentity(), keys)
 }
 
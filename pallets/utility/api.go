@@ -15,7 +15,8 @@ import (
 )
 
 const (
-	ErrBatchCallCreation = errors.Error("couldn't create batch call")
+	ErrBatchCallCreation   = errors.Error("couldn't create batch call")
+	ErrBatchCallSubmission = errors.Error("couldn't submit batch call")
 )
 
 var (
@@ -32,6 +33,8 @@ const (
 
 type API interface {
 	BatchAll(ctx context.Context, callProviderFns ...centchain.CallProviderFn) (*centchain.ExtrinsicInfo, error)
+
+	BatchAllProxyCalls(ctx context.Context, proxyCallProviderFns ...centchain.CallProviderFn) (*centchain.ExtrinsicInfo, error)
 }
 
 type api struct {
@@ -91,6 +94,38 @@ func (a *api) BatchAll(ctx context.Context, callProviderFns ...centchain.CallPro
 	return extInfo, nil
 }
 
+// BatchAllProxyCalls submits a batch_all call that is signed by the pod operator.
+//
+// Unlike BatchAll, the batch is not proxied on behalf of the identity from the context, the provided calls
+// are expected to be proxy calls which allows batching calls of multiple identities.
+func (a *api) BatchAllProxyCalls(ctx context.Context, proxyCallProviderFns ...centchain.CallProviderFn) (*centchain.ExtrinsicInfo, error) {
+	meta, err := a.centAPI.GetMetadataLatest()
+
+	if err != nil {
+		log.Errorf("Couldn't retrieve latest metadata: %s", err)
+
+		return nil, errors.ErrMetadataRetrieval
+	}
+
+	batchCall, err := BatchCalls(proxyCallProviderFns...)(meta)
+
+	if err != nil {
+		log.Errorf("Couldn't create batch call: %s", err)
+
+		return nil, ErrBatchCallCreation
+	}
+
+	extInfo, err := a.centAPI.SubmitAndWatch(ctx, meta, *batchCall, a.podOperator.ToKeyringPair())
+
+	if err != nil {
+		log.Errorf("Couldn't submit batch call: %s", err)
+
+		return nil, ErrBatchCallSubmission
+	}
+
+	return &extInfo, nil
+}
+
 func BatchCalls(callCreationFns ...centchain.CallProviderFn) centchain.CallProviderFn {
 	return func(meta *types.Metadata) (*types.Call, error) {
 		var calls []*types.Call
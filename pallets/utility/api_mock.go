@@ -45,6 +45,36 @@ func (_m *APIMock) BatchAll(ctx context.Context, callProviderFns ...centchain.Ca
 	return r0, r1
 }
 
+// BatchAllProxyCalls provides a mock function with given fields: ctx, proxyCallProviderFns
+func (_m *APIMock) BatchAllProxyCalls(ctx context.Context, proxyCallProviderFns ...centchain.CallProviderFn) (*centchain.ExtrinsicInfo, error) {
+	_va := make([]interface{}, len(proxyCallProviderFns))
+	for _i := range proxyCallProviderFns {
+		_va[_i] = proxyCallProviderFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *centchain.ExtrinsicInfo
+	if rf, ok := ret.Get(0).(func(context.Context, ...centchain.CallProviderFn) *centchain.ExtrinsicInfo); ok {
+		r0 = rf(ctx, proxyCallProviderFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*centchain.ExtrinsicInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...centchain.CallProviderFn) error); ok {
+		r1 = rf(ctx, proxyCallProviderFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type NewAPIMockT interface {
 	mock.TestingT
 	Cleanup(func())
@@ -270,6 +270,139 @@ func TestAPI_BatchAll_ProxyCallError(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestAPI_BatchAllProxyCalls(t *testing.T) {
+	ctx := context.Background()
+
+	api, mocks := getAPIWithMocks(t)
+
+	meta, err := testingutils.GetTestMetadata()
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*centchain.APIMock](mocks).
+		On("GetMetadataLatest").
+		Return(meta, nil)
+
+	identity1, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	identity2, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	remarkCallCreationFn := centchain.CallProviderFn(func(meta *types.Metadata) (*types.Call, error) {
+		call, err := types.NewCall(meta, "System.remark", []byte{1, 2, 3})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &call, nil
+	})
+
+	callCreationFn1 := proxy.WrapWithProxyCall(identity1, types.NewOption(proxyType.PodOperation), remarkCallCreationFn)
+	callCreationFn2 := proxy.WrapWithProxyCall(identity2, types.NewOption(proxyType.PodOperation), remarkCallCreationFn)
+
+	batchCall, err := BatchCalls(callCreationFn1, callCreationFn2)(meta)
+	assert.NoError(t, err)
+
+	var krp signature.KeyringPair
+
+	genericUtils.GetMock[*config.PodOperatorMock](mocks).
+		On("ToKeyringPair").
+		Return(krp).Once()
+
+	extInfo := centchain.ExtrinsicInfo{}
+
+	genericUtils.GetMock[*centchain.APIMock](mocks).
+		On("SubmitAndWatch", ctx, meta, *batchCall, krp).
+		Return(extInfo, nil).Once()
+
+	res, err := api.BatchAllProxyCalls(ctx, callCreationFn1, callCreationFn2)
+	assert.NoError(t, err)
+	assert.Equal(t, &extInfo, res)
+}
+
+func TestAPI_BatchAllProxyCalls_MetadataRetrievalError(t *testing.T) {
+	ctx := context.Background()
+
+	api, mocks := getAPIWithMocks(t)
+
+	genericUtils.GetMock[*centchain.APIMock](mocks).
+		On("GetMetadataLatest").
+		Return(nil, errors.New("error"))
+
+	res, err := api.BatchAllProxyCalls(ctx)
+	assert.ErrorIs(t, err, errors.ErrMetadataRetrieval)
+	assert.Nil(t, res)
+}
+
+func TestAPI_BatchAllProxyCalls_BatchCallCreationError(t *testing.T) {
+	ctx := context.Background()
+
+	api, mocks := getAPIWithMocks(t)
+
+	meta, err := testingutils.GetTestMetadata()
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*centchain.APIMock](mocks).
+		On("GetMetadataLatest").
+		Return(meta, nil)
+
+	callCreationFn := centchain.CallProviderFn(func(meta *types.Metadata) (*types.Call, error) {
+		return nil, errors.New("error")
+	})
+
+	res, err := api.BatchAllProxyCalls(ctx, callCreationFn)
+	assert.ErrorIs(t, err, ErrBatchCallCreation)
+	assert.Nil(t, res)
+}
+
+func TestAPI_BatchAllProxyCalls_SubmitError(t *testing.T) {
+	ctx := context.Background()
+
+	api, mocks := getAPIWithMocks(t)
+
+	meta, err := testingutils.GetTestMetadata()
+	assert.NoError(t, err)
+
+	genericUtils.GetMock[*centchain.APIMock](mocks).
+		On("GetMetadataLatest").
+		Return(meta, nil)
+
+	identity, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	callCreationFn := proxy.WrapWithProxyCall(
+		identity,
+		types.NewOption(proxyType.PodOperation),
+		func(meta *types.Metadata) (*types.Call, error) {
+			call, err := types.NewCall(meta, "System.remark", []byte{1, 2, 3})
+
+			if err != nil {
+				return nil, err
+			}
+
+			return &call, nil
+		},
+	)
+
+	batchCall, err := BatchCalls(callCreationFn)(meta)
+	assert.NoError(t, err)
+
+	var krp signature.KeyringPair
+
+	genericUtils.GetMock[*config.PodOperatorMock](mocks).
+		On("ToKeyringPair").
+		Return(krp).Once()
+
+	genericUtils.GetMock[*centchain.APIMock](mocks).
+		On("SubmitAndWatch", ctx, meta, *batchCall, krp).
+		Return(centchain.ExtrinsicInfo{}, errors.New("error")).Once()
+
+	res, err := api.BatchAllProxyCalls(ctx, callCreationFn)
+	assert.ErrorIs(t, err, ErrBatchCallSubmission)
+	assert.Nil(t, res)
+}
+
 func getAPIWithMocks(t *testing.T) (*api, []any) {
 	centAPIMock := centchain.NewAPIMock(t)
 	proxyAPIMock := proxy.NewAPIMock(t)
@@ -64,6 +64,15 @@ type Service interface {
 	// UpdateRole updates a role in the given document
 	UpdateRole(ctx context.Context, docID, roleID []byte, collaborators []*types.AccountID) (*coredocumentpb.Role, error)
 
+	// GetRoles returns all the roles in the latest version of the document.
+	GetRoles(ctx context.Context, docID []byte) ([]*coredocumentpb.Role, error)
+
+	// DeleteRole deletes a role and removes it from the rules of the given document.
+	DeleteRole(ctx context.Context, docID, roleID []byte) error
+
+	// GetReadRules returns all the read rules in the latest version of the document.
+	GetReadRules(ctx context.Context, docID []byte) ([]*coredocumentpb.ReadRule, error)
+
 	// AddTransitionRules creates transition rules to the given document.
 	// The access is only given to the roleKey which is expected to be present already.
 	AddTransitionRules(ctx context.Context, docID []byte, addRules AddTransitionRules) ([]*coredocumentpb.TransitionRule, error)
@@ -71,8 +80,15 @@ type Service interface {
 	// GetTransitionRule returns the transition rule associated with ruleID from the latest version of the document.
 	GetTransitionRule(ctx context.Context, docID, ruleID []byte) (*coredocumentpb.TransitionRule, error)
 
+	// GetTransitionRules returns all the transition rules in the latest version of the document.
+	GetTransitionRules(ctx context.Context, docID []byte) ([]documents.DecodedTransitionRule, error)
+
 	// DeleteTransitionRule deletes the transition rule associated with ruleID in th document.
 	DeleteTransitionRule(ctx context.Context, docID, ruleID []byte) error
+
+	// DryRunComputeFieldsRule executes the WASM of the compute fields rule against the latest version of the document,
+	// without adding the rule to the document.
+	DryRunComputeFieldsRule(ctx context.Context, docID []byte, rule ComputeFieldsRule) (documents.ComputeFieldsExecution, error)
 }
 
 // service implements Service
@@ -311,7 +327,8 @@ func (s service) RemoveCollaborators(ctx context.Context, docID []byte, accountI
 	return doc, s.pendingRepo.Update(accountID.ToBytes(), docID, doc)
 }
 
-func (s service) GetRole(ctx context.Context, docID, roleID []byte) (*coredocumentpb.Role, error) {
+// getLatestDocument returns the pending document if present, else the latest committed version of the document.
+func (s service) getLatestDocument(ctx context.Context, docID []byte) (documents.Document, error) {
 	doc, _, err := s.getDocumentAndAccountID(ctx, docID)
 
 	switch err {
@@ -320,18 +337,49 @@ func (s service) GetRole(ctx context.Context, docID, roleID []byte) (*coredocume
 
 		return nil, err
 	case nil:
-		return doc.GetRole(roleID)
+		return doc, nil
 	}
 
 	// fetch the document from the doc service
 	doc, err = s.docSrv.GetCurrentVersion(ctx, docID)
 	if err != nil {
+		log.Errorf("Couldn't retrieve document: %s", err)
+
 		return nil, documents.ErrDocumentNotFound
 	}
 
+	return doc, nil
+}
+
+func (s service) GetRole(ctx context.Context, docID, roleID []byte) (*coredocumentpb.Role, error) {
+	doc, err := s.getLatestDocument(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+
 	return doc.GetRole(roleID)
 }
 
+// GetRoles returns all the roles in the latest version of the document.
+func (s service) GetRoles(ctx context.Context, docID []byte) ([]*coredocumentpb.Role, error) {
+	doc, err := s.getLatestDocument(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.GetRoles(), nil
+}
+
+// GetReadRules returns all the read rules in the latest version of the document.
+func (s service) GetReadRules(ctx context.Context, docID []byte) ([]*coredocumentpb.ReadRule, error) {
+	doc, err := s.getLatestDocument(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.GetReadRules(), nil
+}
+
 // AddRole adds a new role to given document
 func (s service) AddRole(ctx context.Context, docID []byte, roleKey string, collabs []*types.AccountID) (*coredocumentpb.Role, error) {
 	doc, accountID, err := s.getDocumentAndAccountID(ctx, docID)
@@ -370,6 +418,25 @@ func (s service) UpdateRole(ctx context.Context, docID, roleID []byte, collabs [
 	return r, s.pendingRepo.Update(accountID.ToBytes(), docID, doc)
 }
 
+// DeleteRole deletes a role and removes it from the rules of the given document
+func (s service) DeleteRole(ctx context.Context, docID, roleID []byte) error {
+	doc, accountID, err := s.getDocumentAndAccountID(ctx, docID)
+	if err != nil {
+		log.Errorf("Couldn't get document and account ID: %s", err)
+
+		return err
+	}
+
+	err = doc.DeleteRole(roleID)
+	if err != nil {
+		log.Errorf("Couldn't delete document role: %s", err)
+
+		return err
+	}
+
+	return s.pendingRepo.Update(accountID.ToBytes(), docID, doc)
+}
+
 // AttributeRule contains Attribute key label for which the rule has to be created
 // with write access enabled to RoleID
 // Note: role ID should already exist in the document.
@@ -391,12 +458,24 @@ type ComputeFieldsRule struct {
 	// TargetAttributeLabel is the label of the attribute which holds the result from the executed WASM.
 	// This attribute is automatically added and updated everytime document is updated.
 	TargetAttributeLabel string `json:"target_attribute_label"`
+
+	// OutputType is the attribute type of the target attribute, which the WASM result is decoded as.
+	// If empty, the target attribute holds the 32 raw bytes returned by the WASM.
+	OutputType documents.AttributeType `json:"output_type,omitempty" enums:"bytes,string,integer,decimal,timestamp,monetary" swaggertype:"primitive,string"`
+}
+
+// ValidationRule contains the expression that every version of the document must satisfy.
+type ValidationRule struct {
+	// Expression is the boolean expression on the attributes of the new and previous version of the document.
+	// ex: transition("status", "draft", "approved", "paid")
+	Expression string `json:"expression"`
 }
 
 // AddTransitionRules contains list of attribute rules to be created.
 type AddTransitionRules struct {
 	AttributeRules     []AttributeRule     `json:"attribute_rules"`
 	ComputeFieldsRules []ComputeFieldsRule `json:"compute_fields_rules"`
+	ValidationRules    []ValidationRule    `json:"validation_rules"`
 }
 
 func (s service) AddTransitionRules(ctx context.Context, docID []byte, addRules AddTransitionRules) ([]*coredocumentpb.TransitionRule, error) {
@@ -427,7 +506,7 @@ func (s service) AddTransitionRules(ctx context.Context, docID []byte, addRules
 	}
 
 	for _, r := range addRules.ComputeFieldsRules {
-		rule, err := doc.AddComputeFieldsRule(r.WASM, r.AttributeLabels, r.TargetAttributeLabel)
+		rule, err := doc.AddComputeFieldsRule(r.WASM, r.AttributeLabels, r.TargetAttributeLabel, r.OutputType)
 		if err != nil {
 			log.Errorf("Couldn't add compute fields rule: %s", err)
 
@@ -437,30 +516,37 @@ func (s service) AddTransitionRules(ctx context.Context, docID []byte, addRules
 		rules = append(rules, rule)
 	}
 
+	for _, r := range addRules.ValidationRules {
+		rule, err := doc.AddValidationRule(r.Expression)
+		if err != nil {
+			log.Errorf("Couldn't add validation rule: %s", err)
+
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
 	return rules, s.pendingRepo.Update(accountID.ToBytes(), docID, doc)
 }
 
 func (s service) GetTransitionRule(ctx context.Context, docID, ruleID []byte) (*coredocumentpb.TransitionRule, error) {
-	doc, _, err := s.getDocumentAndAccountID(ctx, docID)
-
-	switch err {
-	case errors.ErrContextIdentityRetrieval:
-		log.Errorf("Couldn't retrieve identity from context: %s", err)
-
+	doc, err := s.getLatestDocument(ctx, docID)
+	if err != nil {
 		return nil, err
-	case nil:
-		return doc.GetTransitionRule(ruleID)
 	}
 
-	// fetch the document from the doc service
-	doc, err = s.docSrv.GetCurrentVersion(ctx, docID)
-	if err != nil {
-		log.Errorf("Couldn't retrieve document: %s", err)
+	return doc.GetTransitionRule(ruleID)
+}
 
-		return nil, documents.ErrDocumentNotFound
+// GetTransitionRules returns all the transition rules in the latest version of the document.
+func (s service) GetTransitionRules(ctx context.Context, docID []byte) ([]documents.DecodedTransitionRule, error) {
+	doc, err := s.getLatestDocument(ctx, docID)
+	if err != nil {
+		return nil, err
 	}
 
-	return doc.GetTransitionRule(ruleID)
+	return doc.GetTransitionRules(), nil
 }
 
 func (s service) DeleteTransitionRule(ctx context.Context, docID, ruleID []byte) error {
@@ -481,6 +567,33 @@ func (s service) DeleteTransitionRule(ctx context.Context, docID, ruleID []byte)
 	return s.pendingRepo.Update(accountID.ToBytes(), docID, doc)
 }
 
+func (s service) DryRunComputeFieldsRule(
+	ctx context.Context,
+	docID []byte,
+	rule ComputeFieldsRule,
+) (documents.ComputeFieldsExecution, error) {
+	doc, _, err := s.getDocumentAndAccountID(ctx, docID)
+
+	switch err {
+	case errors.ErrContextIdentityRetrieval:
+		log.Errorf("Couldn't retrieve identity from context: %s", err)
+
+		return documents.ComputeFieldsExecution{}, err
+	case nil:
+		return documents.DryRunComputeFields(doc, rule.WASM, rule.AttributeLabels, rule.OutputType)
+	}
+
+	// fetch the document from the doc service
+	doc, err = s.docSrv.GetCurrentVersion(ctx, docID)
+	if err != nil {
+		log.Errorf("Couldn't retrieve document: %s", err)
+
+		return documents.ComputeFieldsExecution{}, documents.ErrDocumentNotFound
+	}
+
+	return documents.DryRunComputeFields(doc, rule.WASM, rule.AttributeLabels, rule.OutputType)
+}
+
 func (s service) AddAttributes(ctx context.Context, docID []byte, attrs []documents.Attribute) (documents.Document, error) {
 	doc, accountID, err := s.getDocumentAndAccountID(ctx, docID)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/centrifuge/pod/contextutil"
 	protocolIDDispatcher "github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/documents/entity"
 	"github.com/centrifuge/pod/documents/entityrelationship"
 	"github.com/centrifuge/pod/documents/generic"
@@ -53,6 +54,7 @@ var integrationTestBootstrappers = []bootstrap.TestBootstrapper{
 	documents.Bootstrapper{},
 	pending.Bootstrapper{},
 	&ipfs.TestBootstrapper{},
+	attachments.Bootstrapper{},
 	&nftv3.Bootstrapper{},
 	&p2p.Bootstrapper{},
 	documents.PostBootstrapper{},
@@ -213,6 +213,20 @@ func (_m *ServiceMock) DeleteAttribute(ctx context.Context, docID []byte, key do
 	return r0, r1
 }
 
+// DeleteRole provides a mock function with given fields: ctx, docID, roleID
+func (_m *ServiceMock) DeleteRole(ctx context.Context, docID []byte, roleID []byte) error {
+	ret := _m.Called(ctx, docID, roleID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, []byte) error); ok {
+		r0 = rf(ctx, docID, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteTransitionRule provides a mock function with given fields: ctx, docID, ruleID
 func (_m *ServiceMock) DeleteTransitionRule(ctx context.Context, docID []byte, ruleID []byte) error {
 	ret := _m.Called(ctx, docID, ruleID)
@@ -227,6 +241,27 @@ func (_m *ServiceMock) DeleteTransitionRule(ctx context.Context, docID []byte, r
 	return r0
 }
 
+// DryRunComputeFieldsRule provides a mock function with given fields: ctx, docID, rule
+func (_m *ServiceMock) DryRunComputeFieldsRule(ctx context.Context, docID []byte, rule ComputeFieldsRule) (documents.ComputeFieldsExecution, error) {
+	ret := _m.Called(ctx, docID, rule)
+
+	var r0 documents.ComputeFieldsExecution
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, ComputeFieldsRule) documents.ComputeFieldsExecution); ok {
+		r0 = rf(ctx, docID, rule)
+	} else {
+		r0 = ret.Get(0).(documents.ComputeFieldsExecution)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, ComputeFieldsRule) error); ok {
+		r1 = rf(ctx, docID, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, docID, status
 func (_m *ServiceMock) Get(ctx context.Context, docID []byte, status documents.Status) (documents.Document, error) {
 	ret := _m.Called(ctx, docID, status)
@@ -250,6 +285,29 @@ func (_m *ServiceMock) Get(ctx context.Context, docID []byte, status documents.S
 	return r0, r1
 }
 
+// GetReadRules provides a mock function with given fields: ctx, docID
+func (_m *ServiceMock) GetReadRules(ctx context.Context, docID []byte) ([]*coredocumentpb.ReadRule, error) {
+	ret := _m.Called(ctx, docID)
+
+	var r0 []*coredocumentpb.ReadRule
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) []*coredocumentpb.ReadRule); ok {
+		r0 = rf(ctx, docID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coredocumentpb.ReadRule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, docID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRole provides a mock function with given fields: ctx, docID, roleID
 func (_m *ServiceMock) GetRole(ctx context.Context, docID []byte, roleID []byte) (*coredocumentpb.Role, error) {
 	ret := _m.Called(ctx, docID, roleID)
@@ -273,6 +331,29 @@ func (_m *ServiceMock) GetRole(ctx context.Context, docID []byte, roleID []byte)
 	return r0, r1
 }
 
+// GetRoles provides a mock function with given fields: ctx, docID
+func (_m *ServiceMock) GetRoles(ctx context.Context, docID []byte) ([]*coredocumentpb.Role, error) {
+	ret := _m.Called(ctx, docID)
+
+	var r0 []*coredocumentpb.Role
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) []*coredocumentpb.Role); ok {
+		r0 = rf(ctx, docID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coredocumentpb.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, docID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransitionRule provides a mock function with given fields: ctx, docID, ruleID
 func (_m *ServiceMock) GetTransitionRule(ctx context.Context, docID []byte, ruleID []byte) (*coredocumentpb.TransitionRule, error) {
 	ret := _m.Called(ctx, docID, ruleID)
@@ -296,6 +377,29 @@ func (_m *ServiceMock) GetTransitionRule(ctx context.Context, docID []byte, rule
 	return r0, r1
 }
 
+// GetTransitionRules provides a mock function with given fields: ctx, docID
+func (_m *ServiceMock) GetTransitionRules(ctx context.Context, docID []byte) ([]documents.DecodedTransitionRule, error) {
+	ret := _m.Called(ctx, docID)
+
+	var r0 []documents.DecodedTransitionRule
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) []documents.DecodedTransitionRule); ok {
+		r0 = rf(ctx, docID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]documents.DecodedTransitionRule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, docID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVersion provides a mock function with given fields: ctx, docID, versionID
 func (_m *ServiceMock) GetVersion(ctx context.Context, docID []byte, versionID []byte) (documents.Document, error) {
 	ret := _m.Called(ctx, docID, versionID)
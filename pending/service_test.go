@@ -4,6 +4,7 @@ package pending
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	coredocumentpb "github.com/centrifuge/centrifuge-protobufs/gen/go/coredocument"
@@ -14,6 +15,7 @@ import (
 	"github.com/centrifuge/pod/documents"
 	"github.com/centrifuge/pod/errors"
 	testingcommons "github.com/centrifuge/pod/testingutils/common"
+	pathUtils "github.com/centrifuge/pod/testingutils/path"
 	"github.com/centrifuge/pod/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -2155,6 +2157,407 @@ func TestService_UpdateRole_RepositoryUpdateError(t *testing.T) {
 	assert.Equal(t, role, res)
 }
 
+func TestService_GetRoles_PendingDocumentPresent(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(documentMock, nil).
+		Once()
+
+	roles := []*coredocumentpb.Role{
+		{
+			RoleKey: utils.RandomSlice(32),
+		},
+	}
+
+	documentMock.On("GetRoles").
+		Return(roles).
+		Once()
+
+	res, err := pendingDocService.GetRoles(ctx, documentID)
+	assert.NoError(t, err)
+	assert.Equal(t, roles, res)
+}
+
+func TestService_GetRoles_PendingDocumentNotPresent(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	documentMock := documents.NewDocumentMock(t)
+
+	documentServiceMock.On("GetCurrentVersion", ctx, documentID).
+		Return(documentMock, nil).
+		Once()
+
+	roles := []*coredocumentpb.Role{
+		{
+			RoleKey: utils.RandomSlice(32),
+		},
+	}
+
+	documentMock.On("GetRoles").
+		Return(roles).
+		Once()
+
+	res, err := pendingDocService.GetRoles(ctx, documentID)
+	assert.NoError(t, err)
+	assert.Equal(t, roles, res)
+}
+
+func TestService_GetRoles_PendingDocumentNotPresent_DocumentRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	documentServiceMock.On("GetCurrentVersion", ctx, documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	res, err := pendingDocService.GetRoles(ctx, documentID)
+	assert.ErrorIs(t, err, documents.ErrDocumentNotFound)
+	assert.Nil(t, res)
+}
+
+func TestService_GetRoles_IdentityRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := context.Background()
+
+	res, err := pendingDocService.GetRoles(ctx, documentID)
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+	assert.Nil(t, res)
+}
+
+func TestService_DeleteRole(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+	roleID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(documentMock, nil).
+		Once()
+
+	documentMock.On("DeleteRole", roleID).
+		Return(nil).
+		Once()
+
+	repositoryMock.On("Update", accountID.ToBytes(), documentID, documentMock).
+		Return(nil).
+		Once()
+
+	err = pendingDocService.DeleteRole(ctx, documentID, roleID)
+	assert.NoError(t, err)
+}
+
+func TestService_DeleteRole_IdentityRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	documentID := utils.RandomSlice(32)
+	roleID := utils.RandomSlice(32)
+
+	ctx := context.Background()
+
+	err := pendingDocService.DeleteRole(ctx, documentID, roleID)
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+}
+
+func TestService_DeleteRole_DocumentRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+	roleID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	err = pendingDocService.DeleteRole(ctx, documentID, roleID)
+	assert.ErrorIs(t, err, documents.ErrDocumentNotFound)
+}
+
+func TestService_DeleteRole_RoleDeletionError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+	roleID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(documentMock, nil).
+		Once()
+
+	roleDeletionError := errors.New("error")
+
+	documentMock.On("DeleteRole", roleID).
+		Return(roleDeletionError).
+		Once()
+
+	err = pendingDocService.DeleteRole(ctx, documentID, roleID)
+	assert.ErrorIs(t, err, roleDeletionError)
+}
+
+func TestService_DeleteRole_RepositoryUpdateError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+	roleID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(documentMock, nil).
+		Once()
+
+	documentMock.On("DeleteRole", roleID).
+		Return(nil).
+		Once()
+
+	repositoryUpdateError := errors.New("error")
+
+	repositoryMock.On("Update", accountID.ToBytes(), documentID, documentMock).
+		Return(repositoryUpdateError).
+		Once()
+
+	err = pendingDocService.DeleteRole(ctx, documentID, roleID)
+	assert.ErrorIs(t, err, repositoryUpdateError)
+}
+
+func TestService_GetReadRules_PendingDocumentPresent(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(documentMock, nil).
+		Once()
+
+	readRules := []*coredocumentpb.ReadRule{
+		{
+			Roles:  [][]byte{utils.RandomSlice(32)},
+			Action: coredocumentpb.Action_ACTION_READ,
+		},
+	}
+
+	documentMock.On("GetReadRules").
+		Return(readRules).
+		Once()
+
+	res, err := pendingDocService.GetReadRules(ctx, documentID)
+	assert.NoError(t, err)
+	assert.Equal(t, readRules, res)
+}
+
+func TestService_GetReadRules_PendingDocumentNotPresent(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	documentMock := documents.NewDocumentMock(t)
+
+	documentServiceMock.On("GetCurrentVersion", ctx, documentID).
+		Return(documentMock, nil).
+		Once()
+
+	readRules := []*coredocumentpb.ReadRule{
+		{
+			Roles:  [][]byte{utils.RandomSlice(32)},
+			Action: coredocumentpb.Action_ACTION_READ,
+		},
+	}
+
+	documentMock.On("GetReadRules").
+		Return(readRules).
+		Once()
+
+	res, err := pendingDocService.GetReadRules(ctx, documentID)
+	assert.NoError(t, err)
+	assert.Equal(t, readRules, res)
+}
+
+func TestService_GetReadRules_PendingDocumentNotPresent_DocumentRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	documentServiceMock.On("GetCurrentVersion", ctx, documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	res, err := pendingDocService.GetReadRules(ctx, documentID)
+	assert.ErrorIs(t, err, documents.ErrDocumentNotFound)
+	assert.Nil(t, res)
+}
+
+func TestService_GetReadRules_IdentityRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := context.Background()
+
+	res, err := pendingDocService.GetReadRules(ctx, documentID)
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+	assert.Nil(t, res)
+}
+
 func TestService_AddTransitionRules(t *testing.T) {
 	documentServiceMock := documents.NewServiceMock(t)
 	repositoryMock := NewRepositoryMock(t)
@@ -2179,6 +2582,11 @@ func TestService_AddTransitionRules(t *testing.T) {
 			"attribute-label-1",
 		},
 		TargetAttributeLabel: "target-attribute-label-1",
+		OutputType:           documents.AttrDecimal,
+	}
+
+	validationRule := ValidationRule{
+		Expression: `new("target-attribute-label-1") > 0`,
 	}
 
 	documentID := utils.RandomSlice(32)
@@ -2190,6 +2598,9 @@ func TestService_AddTransitionRules(t *testing.T) {
 		ComputeFieldsRules: []ComputeFieldsRule{
 			computeFieldsRule,
 		},
+		ValidationRules: []ValidationRule{
+			validationRule,
+		},
 	}
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
@@ -2216,17 +2627,25 @@ func TestService_AddTransitionRules(t *testing.T) {
 		computeFieldsRule.WASM.Bytes(),
 		computeFieldsRule.AttributeLabels,
 		computeFieldsRule.TargetAttributeLabel,
+		computeFieldsRule.OutputType,
 	).Return(transitionRule2, nil).Once()
 
+	transitionRule3 := &coredocumentpb.TransitionRule{}
+
+	documentMock.On("AddValidationRule", validationRule.Expression).
+		Return(transitionRule3, nil).
+		Once()
+
 	repositoryMock.On("Update", accountID.ToBytes(), documentID, documentMock).
 		Return(nil).
 		Once()
 
 	res, err := pendingDocService.AddTransitionRules(ctx, documentID, addTransitionRules)
 	assert.NoError(t, err)
-	assert.Len(t, res, 2)
+	assert.Len(t, res, 3)
 	assert.Contains(t, res, transitionRule1)
 	assert.Contains(t, res, transitionRule2)
+	assert.Contains(t, res, transitionRule3)
 }
 
 func TestService_AddTransitionRules_IdentityRetrievalError(t *testing.T) {
@@ -2306,15 +2725,66 @@ func TestService_AddTransitionRules_DocumentRetrievalError(t *testing.T) {
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
 
 	repositoryMock.On("Get", accountID.ToBytes(), documentID).
-		Return(nil, errors.New("error")).
+		Return(nil, errors.New("error")).
+		Once()
+
+	res, err := pendingDocService.AddTransitionRules(ctx, documentID, addTransitionRules)
+	assert.ErrorIs(t, err, documents.ErrDocumentNotFound)
+	assert.Nil(t, res)
+}
+
+func TestService_AddTransitionRules_EmptyKeyLabelError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	attributeRule := AttributeRule{
+		// An empty key label should cause an error.
+		KeyLabel: "",
+		RoleID:   utils.RandomSlice(32),
+	}
+
+	computeFieldsRule := ComputeFieldsRule{
+		WASM: utils.RandomSlice(32),
+		AttributeLabels: []string{
+			"attribute-label-1",
+		},
+		TargetAttributeLabel: "target-attribute-label-1",
+	}
+
+	documentID := utils.RandomSlice(32)
+
+	addTransitionRules := AddTransitionRules{
+		AttributeRules: []AttributeRule{
+			attributeRule,
+		},
+		ComputeFieldsRules: []ComputeFieldsRule{
+			computeFieldsRule,
+		},
+	}
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(documentMock, nil).
 		Once()
 
 	res, err := pendingDocService.AddTransitionRules(ctx, documentID, addTransitionRules)
-	assert.ErrorIs(t, err, documents.ErrDocumentNotFound)
+	assert.NotNil(t, err)
 	assert.Nil(t, res)
 }
 
-func TestService_AddTransitionRules_EmptyKeyLabelError(t *testing.T) {
+func TestService_AddTransitionRules_TransitionRuleAdditionError(t *testing.T) {
 	documentServiceMock := documents.NewServiceMock(t)
 	repositoryMock := NewRepositoryMock(t)
 
@@ -2328,8 +2798,7 @@ func TestService_AddTransitionRules_EmptyKeyLabelError(t *testing.T) {
 		Return(accountID)
 
 	attributeRule := AttributeRule{
-		// An empty key label should cause an error.
-		KeyLabel: "",
+		KeyLabel: "key-label-1",
 		RoleID:   utils.RandomSlice(32),
 	}
 
@@ -2360,12 +2829,21 @@ func TestService_AddTransitionRules_EmptyKeyLabelError(t *testing.T) {
 		Return(documentMock, nil).
 		Once()
 
+	attributeKey, err := documents.AttrKeyFromLabel(attributeRule.KeyLabel)
+	assert.NoError(t, err)
+
+	transitionRuleAdditionError := errors.New("error")
+
+	documentMock.On("AddTransitionRuleForAttribute", attributeRule.RoleID.Bytes(), attributeKey).
+		Return(nil, transitionRuleAdditionError).
+		Once()
+
 	res, err := pendingDocService.AddTransitionRules(ctx, documentID, addTransitionRules)
-	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, transitionRuleAdditionError)
 	assert.Nil(t, res)
 }
 
-func TestService_AddTransitionRules_TransitionRuleAdditionError(t *testing.T) {
+func TestService_AddTransitionRules_ComputeFieldsRuleAdditionError(t *testing.T) {
 	documentServiceMock := documents.NewServiceMock(t)
 	repositoryMock := NewRepositoryMock(t)
 
@@ -2413,18 +2891,28 @@ func TestService_AddTransitionRules_TransitionRuleAdditionError(t *testing.T) {
 	attributeKey, err := documents.AttrKeyFromLabel(attributeRule.KeyLabel)
 	assert.NoError(t, err)
 
-	transitionRuleAdditionError := errors.New("error")
+	transitionRule1 := &coredocumentpb.TransitionRule{}
 
 	documentMock.On("AddTransitionRuleForAttribute", attributeRule.RoleID.Bytes(), attributeKey).
-		Return(nil, transitionRuleAdditionError).
+		Return(transitionRule1, nil).
 		Once()
 
+	computeFieldsRuleAdditionError := errors.New("error")
+
+	documentMock.On(
+		"AddComputeFieldsRule",
+		computeFieldsRule.WASM.Bytes(),
+		computeFieldsRule.AttributeLabels,
+		computeFieldsRule.TargetAttributeLabel,
+		computeFieldsRule.OutputType,
+	).Return(nil, computeFieldsRuleAdditionError).Once()
+
 	res, err := pendingDocService.AddTransitionRules(ctx, documentID, addTransitionRules)
-	assert.ErrorIs(t, err, transitionRuleAdditionError)
+	assert.ErrorIs(t, err, computeFieldsRuleAdditionError)
 	assert.Nil(t, res)
 }
 
-func TestService_AddTransitionRules_ComputeFieldsRuleAdditionError(t *testing.T) {
+func TestService_AddTransitionRules_ValidationRuleAdditionError(t *testing.T) {
 	documentServiceMock := documents.NewServiceMock(t)
 	repositoryMock := NewRepositoryMock(t)
 
@@ -2450,6 +2938,10 @@ func TestService_AddTransitionRules_ComputeFieldsRuleAdditionError(t *testing.T)
 		TargetAttributeLabel: "target-attribute-label-1",
 	}
 
+	validationRule := ValidationRule{
+		Expression: `new("target-attribute-label-1") > 0`,
+	}
+
 	documentID := utils.RandomSlice(32)
 
 	addTransitionRules := AddTransitionRules{
@@ -2459,6 +2951,9 @@ func TestService_AddTransitionRules_ComputeFieldsRuleAdditionError(t *testing.T)
 		ComputeFieldsRules: []ComputeFieldsRule{
 			computeFieldsRule,
 		},
+		ValidationRules: []ValidationRule{
+			validationRule,
+		},
 	}
 
 	ctx := contextutil.WithAccount(context.Background(), accountMock)
@@ -2478,17 +2973,24 @@ func TestService_AddTransitionRules_ComputeFieldsRuleAdditionError(t *testing.T)
 		Return(transitionRule1, nil).
 		Once()
 
-	computeFieldsRuleAdditionError := errors.New("error")
+	transitionRule2 := &coredocumentpb.TransitionRule{}
 
 	documentMock.On(
 		"AddComputeFieldsRule",
 		computeFieldsRule.WASM.Bytes(),
 		computeFieldsRule.AttributeLabels,
 		computeFieldsRule.TargetAttributeLabel,
-	).Return(nil, computeFieldsRuleAdditionError).Once()
+		computeFieldsRule.OutputType,
+	).Return(transitionRule2, nil).Once()
+
+	validationRuleAdditionError := errors.New("error")
+
+	documentMock.On("AddValidationRule", validationRule.Expression).
+		Return(nil, validationRuleAdditionError).
+		Once()
 
 	res, err := pendingDocService.AddTransitionRules(ctx, documentID, addTransitionRules)
-	assert.ErrorIs(t, err, computeFieldsRuleAdditionError)
+	assert.ErrorIs(t, err, validationRuleAdditionError)
 	assert.Nil(t, res)
 }
 
@@ -2553,6 +3055,7 @@ func TestService_AddTransitionRules_RepositoryUpdateError(t *testing.T) {
 		computeFieldsRule.WASM.Bytes(),
 		computeFieldsRule.AttributeLabels,
 		computeFieldsRule.TargetAttributeLabel,
+		computeFieldsRule.OutputType,
 	).Return(transitionRule2, nil).Once()
 
 	repositoryUpdateError := errors.New("error")
@@ -2763,6 +3266,137 @@ func TestService_GetTransitionRule_IdentityRetrievalError(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestService_GetTransitionRules_PendingDocumentPresent(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	documentMock := documents.NewDocumentMock(t)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(documentMock, nil).
+		Once()
+
+	transitionRules := []documents.DecodedTransitionRule{
+		{
+			TransitionRule: &coredocumentpb.TransitionRule{
+				RuleKey: utils.RandomSlice(32),
+			},
+			AttributeLabel: "label",
+		},
+	}
+
+	documentMock.On("GetTransitionRules").
+		Return(transitionRules).
+		Once()
+
+	res, err := pendingDocService.GetTransitionRules(ctx, documentID)
+	assert.NoError(t, err)
+	assert.Equal(t, transitionRules, res)
+}
+
+func TestService_GetTransitionRules_PendingDocumentNotPresent(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	documentMock := documents.NewDocumentMock(t)
+
+	documentServiceMock.On("GetCurrentVersion", ctx, documentID).
+		Return(documentMock, nil).
+		Once()
+
+	transitionRules := []documents.DecodedTransitionRule{
+		{
+			TransitionRule: &coredocumentpb.TransitionRule{
+				RuleKey: utils.RandomSlice(32),
+			},
+			AttributeLabel: "label",
+		},
+	}
+
+	documentMock.On("GetTransitionRules").
+		Return(transitionRules).
+		Once()
+
+	res, err := pendingDocService.GetTransitionRules(ctx, documentID)
+	assert.NoError(t, err)
+	assert.Equal(t, transitionRules, res)
+}
+
+func TestService_GetTransitionRules_PendingDocumentNotPresent_DocumentRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	documentServiceMock.On("GetCurrentVersion", ctx, documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	res, err := pendingDocService.GetTransitionRules(ctx, documentID)
+	assert.ErrorIs(t, err, documents.ErrDocumentNotFound)
+	assert.Nil(t, res)
+}
+
+func TestService_GetTransitionRules_IdentityRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := context.Background()
+
+	res, err := pendingDocService.GetTransitionRules(ctx, documentID)
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+	assert.Nil(t, res)
+}
+
 func TestService_DeleteTransitionRule(t *testing.T) {
 	documentServiceMock := documents.NewServiceMock(t)
 	repositoryMock := NewRepositoryMock(t)
@@ -3322,3 +3956,126 @@ func TestService_DeleteAttribute_RepositoryUpdateError(t *testing.T) {
 	assert.ErrorIs(t, err, updateError)
 	assert.Equal(t, documentMock, res)
 }
+
+func TestService_DryRunComputeFieldsRule_PendingDocumentPresent(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	attr, err := documents.NewStringAttribute("test", documents.AttrInt256, "1000")
+	assert.NoError(t, err)
+
+	documentMock := documents.NewDocumentMock(t)
+	documentMock.On("GetAttribute", attr.Key).
+		Return(attr, nil).
+		Once()
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(documentMock, nil).
+		Once()
+
+	rule := ComputeFieldsRule{
+		WASM:            getTestComputeFieldsWASM(t),
+		AttributeLabels: []string{"test"},
+	}
+
+	res, err := pendingDocService.DryRunComputeFieldsRule(ctx, documentID, rule)
+	assert.NoError(t, err)
+	assert.NoError(t, res.Err)
+	assert.NotZero(t, res.GasUsed)
+}
+
+func TestService_DryRunComputeFieldsRule_PendingDocumentNotPresent(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	documentID := utils.RandomSlice(32)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	attr, err := documents.NewStringAttribute("test", documents.AttrInt256, "1000")
+	assert.NoError(t, err)
+
+	documentMock := documents.NewDocumentMock(t)
+	documentMock.On("GetAttribute", attr.Key).
+		Return(attr, nil).
+		Once()
+
+	documentServiceMock.On("GetCurrentVersion", ctx, documentID).
+		Return(documentMock, nil).
+		Once()
+
+	rule := ComputeFieldsRule{
+		WASM:            getTestComputeFieldsWASM(t),
+		AttributeLabels: []string{"test"},
+	}
+
+	res, err := pendingDocService.DryRunComputeFieldsRule(ctx, documentID, rule)
+	assert.NoError(t, err)
+	assert.NoError(t, res.Err)
+}
+
+func TestService_DryRunComputeFieldsRule_DocumentRetrievalError(t *testing.T) {
+	documentServiceMock := documents.NewServiceMock(t)
+	repositoryMock := NewRepositoryMock(t)
+
+	pendingDocService := NewService(documentServiceMock, repositoryMock)
+
+	documentID := utils.RandomSlice(32)
+
+	// no identity in context
+	_, err := pendingDocService.DryRunComputeFieldsRule(context.Background(), documentID, ComputeFieldsRule{})
+	assert.ErrorIs(t, err, errors.ErrContextIdentityRetrieval)
+
+	accountID, err := testingcommons.GetRandomAccountID()
+	assert.NoError(t, err)
+
+	accountMock := config.NewAccountMock(t)
+	accountMock.On("GetIdentity").
+		Return(accountID)
+
+	ctx := contextutil.WithAccount(context.Background(), accountMock)
+
+	repositoryMock.On("Get", accountID.ToBytes(), documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	documentServiceMock.On("GetCurrentVersion", ctx, documentID).
+		Return(nil, errors.New("error")).
+		Once()
+
+	_, err = pendingDocService.DryRunComputeFieldsRule(ctx, documentID, ComputeFieldsRule{})
+	assert.ErrorIs(t, err, documents.ErrDocumentNotFound)
+}
+
+func getTestComputeFieldsWASM(t *testing.T) []byte {
+	wasm, err := os.ReadFile(pathUtils.AppendPathToProjectRoot("testingutils/compute_fields/simple_average.wasm"))
+	assert.NoError(t, err)
+
+	return wasm
+}
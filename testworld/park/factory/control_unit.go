@@ -5,15 +5,18 @@ package factory
 import (
 	"fmt"
 
+	"github.com/centrifuge/pod/balance"
 	"github.com/centrifuge/pod/bootstrap"
 	"github.com/centrifuge/pod/centchain"
 	"github.com/centrifuge/pod/config"
 	"github.com/centrifuge/pod/config/configstore"
 	"github.com/centrifuge/pod/dispatcher"
 	"github.com/centrifuge/pod/documents"
+	"github.com/centrifuge/pod/documents/attachments"
 	"github.com/centrifuge/pod/documents/entity"
 	"github.com/centrifuge/pod/documents/entityrelationship"
 	"github.com/centrifuge/pod/documents/generic"
+	"github.com/centrifuge/pod/documents/invoice"
 	"github.com/centrifuge/pod/http"
 	httpv2 "github.com/centrifuge/pod/http/v2"
 	httpv3 "github.com/centrifuge/pod/http/v3"
@@ -79,13 +82,16 @@ func getTestworldBootstrappers() []bootstrap.Bootstrapper {
 		&jobs.Bootstrapper{},
 		centchain.Bootstrapper{},
 		&pallets.Bootstrapper{},
+		&balance.Bootstrapper{},
 		&dispatcher.Bootstrapper{},
 		&identityv2.Bootstrapper{},
 		documents.Bootstrapper{},
 		&entityrelationship.Bootstrapper{},
 		generic.Bootstrapper{},
+		invoice.Bootstrapper{},
 		pending.Bootstrapper{},
 		&ipfs.TestBootstrapper{},
+		attachments.Bootstrapper{},
 		&nftv3.Bootstrapper{},
 		&p2p.Bootstrapper{},
 		documents.PostBootstrapper{},
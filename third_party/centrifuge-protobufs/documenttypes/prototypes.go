@@ -0,0 +1,18 @@
+package documenttypes
+
+import (
+	_ "github.com/centrifuge/precise-proofs/proofs/proto"
+)
+
+const (
+	InvoiceDocumentTypeUrl            = "http://github.com/centrifuge/centrifuge-protobufs/invoice/#invoice.InvoiceDocument"
+	InvoiceDataTypeUrl                = "http://github.com/centrifuge/centrifuge-protobufs/invoice/#invoice.InvoiceData"
+	InvoiceSaltsTypeUrl               = "http://github.com/centrifuge/centrifuge-protobufs/invoice/#invoice.InvoiceSalts"
+	EntityDocumentTypeUrl             = "http://github.com/centrifuge/centrifuge-protobufs/entity/#entity.EntityDocument"
+	EntityDataTypeUrl                 = "http://github.com/centrifuge/centrifuge-protobufs/entity/#entity.EntityData"
+	EntitySaltsTypeUrl                = "http://github.com/centrifuge/centrifuge-protobufs/entity/#entity.EntitySalts"
+	EntityRelationshipDocumentTypeUrl = "http://github.com/centrifuge/centrifuge-protobufs/entityrelationship/#entityrelationship.EntityRelationshipDocument"
+	EntityRelationshipDataTypeUrl     = "http://github.com/centrifuge/centrifuge-protobufs/entityrelationship/#entityrelationship.EntityRelationshipData"
+	EntityRelationshipSaltsTypeUrl    = "http://github.com/centrifuge/centrifuge-protobufs/entityrelationship/#entityrelationship.EntityRelationshipSalts"
+	GenericDataTypeUrl                = "http://github.com/centrifuge/centrifuge-protobufs/generic/#generic.Generic"
+)
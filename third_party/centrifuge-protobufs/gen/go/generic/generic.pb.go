@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.20.1
+// source: generic/generic.proto
+
+package genericpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenericData for generic documents.
+// Note: for now, it just contains a single field with default value always and not publicly exposed
+type GenericData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scheme []byte `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+}
+
+func (x *GenericData) Reset() {
+	*x = GenericData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generic_generic_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenericData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericData) ProtoMessage() {}
+
+func (x *GenericData) ProtoReflect() protoreflect.Message {
+	mi := &file_generic_generic_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericData.ProtoReflect.Descriptor instead.
+func (*GenericData) Descriptor() ([]byte, []int) {
+	return file_generic_generic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenericData) GetScheme() []byte {
+	if x != nil {
+		return x.Scheme
+	}
+	return nil
+}
+
+var File_generic_generic_proto protoreflect.FileDescriptor
+
+var file_generic_generic_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63,
+	0x22, 0x25, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x42, 0x62, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x66, 0x75, 0x67, 0x65, 0x2f, 0x63, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x66, 0x75, 0x67, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69,
+	0x63, 0x3b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_generic_generic_proto_rawDescOnce sync.Once
+	file_generic_generic_proto_rawDescData = file_generic_generic_proto_rawDesc
+)
+
+func file_generic_generic_proto_rawDescGZIP() []byte {
+	file_generic_generic_proto_rawDescOnce.Do(func() {
+		file_generic_generic_proto_rawDescData = protoimpl.X.CompressGZIP(file_generic_generic_proto_rawDescData)
+	})
+	return file_generic_generic_proto_rawDescData
+}
+
+var file_generic_generic_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_generic_generic_proto_goTypes = []interface{}{
+	(*GenericData)(nil), // 0: generic.GenericData
+}
+var file_generic_generic_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_generic_generic_proto_init() }
+func file_generic_generic_proto_init() {
+	if File_generic_generic_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_generic_generic_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenericData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_generic_generic_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_generic_generic_proto_goTypes,
+		DependencyIndexes: file_generic_generic_proto_depIdxs,
+		MessageInfos:      file_generic_generic_proto_msgTypes,
+	}.Build()
+	File_generic_generic_proto = out.File
+	file_generic_generic_proto_rawDesc = nil
+	file_generic_generic_proto_goTypes = nil
+	file_generic_generic_proto_depIdxs = nil
+}
@@ -0,0 +1,8 @@
+module github.com/centrifuge/centrifuge-protobufs
+
+go 1.18
+
+require (
+	github.com/centrifuge/precise-proofs v1.0.0
+	google.golang.org/protobuf v1.28.0
+)